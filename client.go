@@ -5,6 +5,7 @@ import (
 	"github.com/d-strobel/gowindows/connection"
 	"github.com/d-strobel/gowindows/windows/dhcp"
 	"github.com/d-strobel/gowindows/windows/dns"
+	"github.com/d-strobel/gowindows/windows/dnsclient"
 	"github.com/d-strobel/gowindows/windows/local/accounts"
 )
 
@@ -17,6 +18,7 @@ type Client struct {
 	Connection    connection.Connection
 	LocalAccounts *accounts.Client
 	Dns           *dns.Client
+	DnsClient     *dnsclient.Client
 	Dhcp          *dhcp.Client
 }
 
@@ -31,6 +33,7 @@ func NewClient(conn connection.Connection) *Client {
 	// Build the client with the subpackages.
 	c.LocalAccounts = accounts.NewClient(c.Connection)
 	c.Dns = dns.NewClient(c.Connection)
+	c.DnsClient = dnsclient.NewClient(c.Connection)
 	c.Dhcp = dhcp.NewClient(c.Connection)
 
 	return c
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/windows/dhcp"
+	"github.com/d-strobel/gowindows/windows/dns"
+	"github.com/d-strobel/gowindows/windows/dnsclient"
 	"github.com/d-strobel/gowindows/windows/local/accounts"
 	"github.com/stretchr/testify/suite"
 )
@@ -32,3 +35,14 @@ func (suite *GowindowsUnitTestSuite) TestNewClient() {
 		suite.Equal(expectedClient.Connection, actualClient.Connection)
 	})
 }
+
+func (suite *GowindowsUnitTestSuite) TestClientImplementsAPI() {
+	suite.Run("should implement the API interfaces of the subpackages", func() {
+		c := NewClient(mockConnection.NewMockConnection(suite.T()))
+
+		suite.Implements((*accounts.API)(nil), c.LocalAccounts)
+		suite.Implements((*dns.API)(nil), c.Dns)
+		suite.Implements((*dnsclient.API)(nil), c.DnsClient)
+		suite.Implements((*dhcp.API)(nil), c.Dhcp)
+	})
+}
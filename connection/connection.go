@@ -14,6 +14,7 @@ type Connection interface {
 	Run(ctx context.Context, cmd string) (CmdResult, error)
 
 	// RunWithPowershell runs a command using the configured connection and context via Powershell.
+	// Commands that exceed the command line limit of Windows are streamed via stdin.
 	// It returns the result of the command execution.
 	RunWithPowershell(ctx context.Context, cmd string) (CmdResult, error)
 
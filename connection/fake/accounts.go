@@ -0,0 +1,693 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// accountsHandlers contains the handlers for the commands of the windows/local/accounts package.
+var accountsHandlers = []handler{
+	{regexp.MustCompile(`^Get-LocalGroup \| ConvertTo-Json -Compress$`), (*Connection).groupList},
+	{regexp.MustCompile(`^Get-LocalGroup (.+) \| ConvertTo-Json -Compress$`), (*Connection).groupRead},
+	{regexp.MustCompile(`^New-LocalGroup (.+) \| ConvertTo-Json -Compress$`), (*Connection).groupCreate},
+	{regexp.MustCompile(`^Set-LocalGroup (.+)$`), (*Connection).groupUpdate},
+	{regexp.MustCompile(`^Remove-LocalGroup (.+)$`), (*Connection).groupDelete},
+	{regexp.MustCompile(`^Get-LocalGroupMember (.+) \| ConvertTo-Json -Compress$`), (*Connection).groupMemberRead},
+	{regexp.MustCompile(`^\$gm=Get-LocalGroupMember (.+) ;if\(\$gm\.Count -eq 1\)\{ConvertTo-Json @\(\$gm\) -Compress\}else\{ConvertTo-Json \$gm -Compress\}$`), (*Connection).groupMemberList},
+	{regexp.MustCompile(`^Add-LocalGroupMember (.+)$`), (*Connection).groupMemberCreate},
+	{regexp.MustCompile(`^Remove-LocalGroupMember (.+)$`), (*Connection).groupMemberDelete},
+	{regexp.MustCompile(`^Get-LocalUser \| ConvertTo-Json -Compress$`), (*Connection).userList},
+	{regexp.MustCompile(`^Get-LocalUser (.+) \| ConvertTo-Json -Compress$`), (*Connection).userRead},
+	{regexp.MustCompile(`^New-LocalUser (.+) \| ConvertTo-Json -Compress$`), (*Connection).userCreate},
+	{regexp.MustCompile(`^Set-LocalUser (.+) ;(Enable|Disable)-LocalUser (.+)$`), (*Connection).userUpdate},
+	{regexp.MustCompile(`^Remove-LocalUser (.+)$`), (*Connection).userDelete},
+}
+
+// user represents a local user of the fake server.
+type user struct {
+	name                   string
+	description            string
+	fullName               string
+	sid                    string
+	enabled                bool
+	password               string
+	passwordRequired       bool
+	passwordNeverExpires   bool
+	userMayChangePassword  bool
+	accountExpires         time.Time
+	passwordLastSet        time.Time
+	passwordChangeableDate time.Time
+}
+
+// group represents a local group of the fake server.
+type group struct {
+	name        string
+	description string
+	sid         string
+	members     []string
+}
+
+// principal represents a user or group that can be a member of a local group.
+type principal struct {
+	name        string
+	sid         string
+	objectClass string
+}
+
+// sidJson is the JSON representation of a security identifier.
+type sidJson struct {
+	BinaryLength     int      `json:"BinaryLength"`
+	AccountDomainSid *sidJson `json:"AccountDomainSid"`
+	Value            string   `json:"Value"`
+}
+
+// newSidJson returns the JSON representation of a SID.
+// SIDs of local accounts contain the SID of the machine as account domain SID.
+func newSidJson(sid string) *sidJson {
+	if !strings.HasPrefix(sid, machineSid+"-") {
+		return &sidJson{BinaryLength: 16, Value: sid}
+	}
+
+	return &sidJson{
+		BinaryLength:     28,
+		AccountDomainSid: &sidJson{BinaryLength: 24, Value: machineSid},
+		Value:            sid,
+	}
+}
+
+// dotnetDate is a datetime that is marshaled in the same format as Windows PowerShell 5.1 returns it.
+type dotnetDate time.Time
+
+// MarshalJSON implements the json.Marshaler interface for the dotnetDate type.
+func (d dotnetDate) MarshalJSON() ([]byte, error) {
+	t := time.Time(d)
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(fmt.Sprintf(`"\/Date(%d)\/"`, t.UnixMilli())), nil
+}
+
+// groupJson is the JSON representation of a local group.
+type groupJson struct {
+	Description     string   `json:"Description"`
+	Name            string   `json:"Name"`
+	SID             *sidJson `json:"SID"`
+	PrincipalSource int      `json:"PrincipalSource"`
+	ObjectClass     string   `json:"ObjectClass"`
+}
+
+// json returns the JSON representation of the group.
+func (g *group) json() groupJson {
+	return groupJson{
+		Description:     g.description,
+		Name:            g.name,
+		SID:             newSidJson(g.sid),
+		PrincipalSource: 1,
+		ObjectClass:     "Group",
+	}
+}
+
+// userJson is the JSON representation of a local user.
+type userJson struct {
+	AccountExpires         dotnetDate `json:"AccountExpires"`
+	Description            string     `json:"Description"`
+	Enabled                bool       `json:"Enabled"`
+	FullName               string     `json:"FullName"`
+	PasswordChangeableDate dotnetDate `json:"PasswordChangeableDate"`
+	PasswordExpires        dotnetDate `json:"PasswordExpires"`
+	UserMayChangePassword  bool       `json:"UserMayChangePassword"`
+	PasswordRequired       bool       `json:"PasswordRequired"`
+	PasswordLastSet        dotnetDate `json:"PasswordLastSet"`
+	LastLogon              dotnetDate `json:"LastLogon"`
+	Name                   string     `json:"Name"`
+	SID                    *sidJson   `json:"SID"`
+	PrincipalSource        int        `json:"PrincipalSource"`
+	ObjectClass            string     `json:"ObjectClass"`
+}
+
+// maxPasswordAge is the default maximum password age of a Windows server.
+const maxPasswordAge time.Duration = 42 * 24 * time.Hour
+
+// json returns the JSON representation of the user.
+func (u *user) json() userJson {
+	var passwordExpires time.Time
+	if !u.passwordLastSet.IsZero() && !u.passwordNeverExpires {
+		passwordExpires = u.passwordLastSet.Add(maxPasswordAge)
+	}
+
+	return userJson{
+		AccountExpires:         dotnetDate(u.accountExpires),
+		Description:            u.description,
+		Enabled:                u.enabled,
+		FullName:               u.fullName,
+		PasswordChangeableDate: dotnetDate(u.passwordChangeableDate),
+		PasswordExpires:        dotnetDate(passwordExpires),
+		UserMayChangePassword:  u.userMayChangePassword,
+		PasswordRequired:       u.passwordRequired,
+		PasswordLastSet:        dotnetDate(u.passwordLastSet),
+		Name:                   u.name,
+		SID:                    newSidJson(u.sid),
+		PrincipalSource:        1,
+		ObjectClass:            "User",
+	}
+}
+
+// groupMemberJson is the JSON representation of a local group member.
+type groupMemberJson struct {
+	Name            string   `json:"Name"`
+	SID             *sidJson `json:"SID"`
+	PrincipalSource int      `json:"PrincipalSource"`
+	ObjectClass     string   `json:"ObjectClass"`
+}
+
+// json returns the JSON representation of the principal as a group member.
+func (p principal) json() groupMemberJson {
+	return groupMemberJson{
+		Name:            fmt.Sprintf(`%s\%s`, ComputerName, p.name),
+		SID:             newSidJson(p.sid),
+		PrincipalSource: 1,
+		ObjectClass:     p.objectClass,
+	}
+}
+
+// seedAccounts adds the built-in local users and groups of a Windows server.
+func (c *Connection) seedAccounts() {
+	c.users = []*user{
+		{
+			name:                  "Administrator",
+			description:           "Built-in account for administering the computer/domain",
+			sid:                   machineSid + "-500",
+			enabled:               true,
+			passwordRequired:      true,
+			userMayChangePassword: true,
+			passwordLastSet:       time.UnixMilli(1701379505092).UTC(),
+		},
+		{
+			name:        "Guest",
+			description: "Built-in account for guest access to the computer/domain",
+			sid:         machineSid + "-501",
+		},
+		{
+			name:        "DefaultAccount",
+			description: "A user account managed by the system.",
+			sid:         machineSid + "-503",
+		},
+	}
+	c.users[0].passwordChangeableDate = c.users[0].passwordLastSet
+
+	c.groups = []*group{
+		{
+			name:        "Administrators",
+			description: "Administrators have complete and unrestricted access to the computer/domain",
+			sid:         "S-1-5-32-544",
+			members:     []string{machineSid + "-500"},
+		},
+		{
+			name:        "Guests",
+			description: "Guests have the same access as members of the Users group by default, except for the Guest account which is further restricted",
+			sid:         "S-1-5-32-546",
+			members:     []string{machineSid + "-501"},
+		},
+		{
+			name:        "Remote Desktop Users",
+			description: "Members in this group are granted the right to logon remotely",
+			sid:         "S-1-5-32-555",
+		},
+		{
+			name:        "Users",
+			description: "Users are prevented from making accidental or intentional system-wide changes and can run most applications",
+			sid:         "S-1-5-32-545",
+		},
+	}
+}
+
+// newSid returns a new SID for a local account.
+func (c *Connection) newSid() string {
+	sid := fmt.Sprintf("%s-%d", machineSid, c.nextRid)
+	c.nextRid++
+	return sid
+}
+
+// now returns the current time with the precision of a dotnet JSON datetime.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// findGroup returns the group that is identified by the SID or Name parameter.
+func (c *Connection) findGroup(cmdlet string, p params) (*group, error) {
+	for _, g := range c.groups {
+		if (p.has("SID") && g.sid == p.str("SID")) || (!p.has("SID") && strings.EqualFold(g.name, p.str("Name"))) {
+			return g, nil
+		}
+	}
+
+	name := p.str("Name")
+	if p.has("SID") {
+		name = p.str("SID")
+	}
+
+	return nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("Group %s was not found.", name),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:String", name),
+		exception: "GroupNotFoundException",
+		errorId:   "GroupNotFound," + commandType(cmdlet),
+	}
+}
+
+// findUser returns the user that is identified by the SID or Name parameter.
+func (c *Connection) findUser(cmdlet string, p params) (*user, error) {
+	for _, u := range c.users {
+		if (p.has("SID") && u.sid == p.str("SID")) || (!p.has("SID") && strings.EqualFold(u.name, p.str("Name"))) {
+			return u, nil
+		}
+	}
+
+	name := p.str("Name")
+	if p.has("SID") {
+		name = p.str("SID")
+	}
+
+	return nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("User %s was not found.", name),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:String", name),
+		exception: "UserNotFoundException",
+		errorId:   "UserNotFound," + commandType(cmdlet),
+	}
+}
+
+// findPrincipal returns the user or group with the given name or SID.
+// The name may be prefixed with the computer name, e.g. "WINSRV\test".
+func (c *Connection) findPrincipal(name string) (principal, bool) {
+	if prefix, n, found := strings.Cut(name, `\`); found && strings.EqualFold(prefix, ComputerName) {
+		name = n
+	}
+
+	for _, u := range c.users {
+		if strings.EqualFold(u.name, name) || u.sid == name {
+			return principal{name: u.name, sid: u.sid, objectClass: "User"}, true
+		}
+	}
+
+	for _, g := range c.groups {
+		if strings.EqualFold(g.name, name) || g.sid == name {
+			return principal{name: g.name, sid: g.sid, objectClass: "Group"}, true
+		}
+	}
+
+	return principal{}, false
+}
+
+// nameInUse returns an error if a local user or group with the name already exists.
+func (c *Connection) nameInUse(cmdlet string, name string) error {
+	for _, u := range c.users {
+		if strings.EqualFold(u.name, name) {
+			return &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("User %s already exists.", name),
+				category:  "ResourceExists",
+				target:    fmt.Sprintf("%s:String", name),
+				exception: "UserExistsException",
+				errorId:   "UserExists," + commandType(cmdlet),
+			}
+		}
+	}
+
+	for _, g := range c.groups {
+		if strings.EqualFold(g.name, name) {
+			return &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("Group %s already exists.", name),
+				category:  "ResourceExists",
+				target:    fmt.Sprintf("%s:String", name),
+				exception: "GroupExistsException",
+				errorId:   "GroupExists," + commandType(cmdlet),
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeMember removes a SID from all local groups.
+func (c *Connection) removeMember(sid string) {
+	for _, g := range c.groups {
+		g.members = removeItem(g.members, sid)
+	}
+}
+
+// removeItem returns the slice without all items that are equal to the value.
+func removeItem[T comparable](s []T, value T) []T {
+	result := s[:0]
+	for _, item := range s {
+		if item != value {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func (c *Connection) groupList(match []string) (string, error) {
+	groups := make([]groupJson, 0, len(c.groups))
+	for _, g := range c.groups {
+		groups = append(groups, g.json())
+	}
+	return pipelineJson(groups)
+}
+
+func (c *Connection) groupRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Get-LocalGroup", p)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(g.json())
+	return string(b), err
+}
+
+func (c *Connection) groupCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.nameInUse("New-LocalGroup", p.str("Name")); err != nil {
+		return "", err
+	}
+
+	g := &group{
+		name:        p.str("Name"),
+		description: p.str("Description"),
+		sid:         c.newSid(),
+	}
+	c.groups = append(c.groups, g)
+
+	b, err := json.Marshal(g.json())
+	return string(b), err
+}
+
+func (c *Connection) groupUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Set-LocalGroup", p)
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("Description") {
+		g.description = p.str("Description")
+	}
+
+	return "", nil
+}
+
+func (c *Connection) groupDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Remove-LocalGroup", p)
+	if err != nil {
+		return "", err
+	}
+
+	c.groups = removeItem(c.groups, g)
+	c.removeMember(g.sid)
+
+	return "", nil
+}
+
+func (c *Connection) groupMemberRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Get-LocalGroupMember", p)
+	if err != nil {
+		return "", err
+	}
+
+	member, ok := c.findPrincipal(p.str("Member"))
+	if !ok || !containsItem(g.members, member.sid) {
+		return "", &cmdletError{
+			cmdlet:    "Get-LocalGroupMember",
+			message:   fmt.Sprintf("Principal %s was not found in group %s.", p.str("Member"), g.name),
+			category:  "ObjectNotFound",
+			target:    fmt.Sprintf("%s:String", p.str("Member")),
+			exception: "PrincipalNotFoundException",
+			errorId:   "PrincipalNotFound," + commandType("Get-LocalGroupMember"),
+		}
+	}
+
+	b, err := json.Marshal(member.json())
+	return string(b), err
+}
+
+func (c *Connection) groupMemberList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Get-LocalGroupMember", p)
+	if err != nil {
+		return "", err
+	}
+
+	members := []groupMemberJson{}
+	for _, sid := range g.members {
+		if member, ok := c.findPrincipal(sid); ok {
+			members = append(members, member.json())
+		}
+	}
+
+	// Groups without members return an empty output.
+	if len(members) == 0 {
+		return "", nil
+	}
+
+	return arrayJson(members)
+}
+
+func (c *Connection) groupMemberCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Add-LocalGroupMember", p)
+	if err != nil {
+		return "", err
+	}
+
+	member, ok := c.findPrincipal(p.str("Member"))
+	if !ok {
+		return "", &cmdletError{
+			cmdlet:    "Add-LocalGroupMember",
+			message:   fmt.Sprintf("Principal %s was not found.", p.str("Member")),
+			category:  "ObjectNotFound",
+			target:    fmt.Sprintf("%s:String", p.str("Member")),
+			exception: "PrincipalNotFoundException",
+			errorId:   "PrincipalNotFound," + commandType("Add-LocalGroupMember"),
+		}
+	}
+
+	if containsItem(g.members, member.sid) {
+		return "", &cmdletError{
+			cmdlet:    "Add-LocalGroupMember",
+			message:   fmt.Sprintf(`%s\%s is already a member of group %s.`, ComputerName, member.name, g.name),
+			category:  "ResourceExists",
+			target:    fmt.Sprintf("%s:String", g.name),
+			exception: "MemberExistsException",
+			errorId:   "MemberExists," + commandType("Add-LocalGroupMember"),
+		}
+	}
+
+	g.members = append(g.members, member.sid)
+
+	return "", nil
+}
+
+func (c *Connection) groupMemberDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	g, err := c.findGroup("Remove-LocalGroupMember", p)
+	if err != nil {
+		return "", err
+	}
+
+	member, ok := c.findPrincipal(p.str("Member"))
+	if !ok || !containsItem(g.members, member.sid) {
+		return "", &cmdletError{
+			cmdlet:    "Remove-LocalGroupMember",
+			message:   fmt.Sprintf("Member %s was not found in group %s.", p.str("Member"), g.name),
+			category:  "ObjectNotFound",
+			target:    fmt.Sprintf("%s:String", p.str("Member")),
+			exception: "MemberNotFoundException",
+			errorId:   "MemberNotFound," + commandType("Remove-LocalGroupMember"),
+		}
+	}
+
+	g.members = removeItem(g.members, member.sid)
+
+	return "", nil
+}
+
+// containsItem returns true if the slice contains the value.
+func containsItem[T comparable](s []T, value T) bool {
+	for _, item := range s {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Connection) userList(match []string) (string, error) {
+	users := make([]userJson, 0, len(c.users))
+	for _, u := range c.users {
+		users = append(users, u.json())
+	}
+	return pipelineJson(users)
+}
+
+func (c *Connection) userRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	u, err := c.findUser("Get-LocalUser", p)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(u.json())
+	return string(b), err
+}
+
+func (c *Connection) userCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.nameInUse("New-LocalUser", p.str("Name")); err != nil {
+		return "", err
+	}
+
+	u := &user{
+		name:                  p.str("Name"),
+		description:           p.str("Description"),
+		fullName:              p.str("FullName"),
+		sid:                   c.newSid(),
+		enabled:               !p.flag("Disabled"),
+		userMayChangePassword: !p.flag("UserMayNotChangePassword"),
+	}
+
+	if p.has("AccountExpires") {
+		if u.accountExpires, err = p.date("AccountExpires"); err != nil {
+			return "", err
+		}
+	}
+
+	if p.has("Password") {
+		if err := u.setPassword(p); err != nil {
+			return "", err
+		}
+	}
+	u.passwordNeverExpires = p.flag("PasswordNeverExpires")
+
+	c.users = append(c.users, u)
+
+	b, err := json.Marshal(u.json())
+	return string(b), err
+}
+
+// setPassword sets the password of the user from the Password parameter.
+func (u *user) setPassword(p params) error {
+	password, err := p.secureString("Password")
+	if err != nil {
+		return err
+	}
+
+	u.password = password
+	u.passwordRequired = true
+	u.passwordLastSet = now()
+	if u.userMayChangePassword {
+		u.passwordChangeableDate = u.passwordLastSet
+	}
+
+	return nil
+}
+
+func (c *Connection) userUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	u, err := c.findUser("Set-LocalUser", p)
+	if err != nil {
+		return "", err
+	}
+
+	u.description = p.str("Description")
+	u.fullName = p.str("FullName")
+	u.passwordNeverExpires = p.flag("PasswordNeverExpires")
+	u.userMayChangePassword = p.flag("UserMayChangePassword")
+
+	u.accountExpires = time.Time{}
+	if p.has("AccountExpires") {
+		if u.accountExpires, err = p.date("AccountExpires"); err != nil {
+			return "", err
+		}
+	}
+
+	if p.has("Password") {
+		if err := u.setPassword(p); err != nil {
+			return "", err
+		}
+	}
+
+	// Enable or disable the user with the second command.
+	u.enabled = match[2] == "Enable"
+
+	return "", nil
+}
+
+func (c *Connection) userDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	u, err := c.findUser("Remove-LocalUser", p)
+	if err != nil {
+		return "", err
+	}
+
+	c.users = removeItem(c.users, u)
+	c.removeMember(u.sid)
+
+	return "", nil
+}
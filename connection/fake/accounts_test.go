@@ -0,0 +1,138 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/d-strobel/gowindows/connection/fake"
+	"github.com/d-strobel/gowindows/windows/local/accounts"
+	"github.com/d-strobel/gowindows/winerror"
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for the local accounts scenarios of the fake connection
+type AccountsFakeUnitTestSuite struct {
+	suite.Suite
+	client *accounts.Client
+}
+
+// Run all local accounts scenario tests
+func TestAccountsFakeUnitTestSuite(t *testing.T) {
+	suite.Run(t, &AccountsFakeUnitTestSuite{})
+}
+
+func (suite *AccountsFakeUnitTestSuite) SetupTest() {
+	suite.client = accounts.NewClient(fake.NewConnection())
+}
+
+func (suite *AccountsFakeUnitTestSuite) TestUserScenario() {
+	ctx := context.Background()
+
+	suite.Run("should list the built-in users", func() {
+		users, err := suite.client.UserList(ctx)
+		suite.Require().NoError(err)
+		suite.Len(users, 3)
+		suite.Equal("Administrator", users[0].Name)
+		suite.Equal("S-1-5-21-153895498-367353507-3704405138-500", users[0].SID.Value)
+	})
+
+	suite.Run("should create, read, update and delete a user", func() {
+		created, err := suite.client.UserCreate(ctx, accounts.UserCreateParams{
+			Name:        "test-user",
+			Description: "Test user",
+			FullName:    "Test User",
+			Password:    "Passw0rd!",
+			Enabled:     true,
+		})
+		suite.Require().NoError(err)
+		suite.Equal("test-user", created.Name)
+		suite.True(created.Enabled)
+		suite.False(created.PasswordLastSet.IsZero())
+
+		read, err := suite.client.UserRead(ctx, accounts.UserReadParams{SID: created.SID.Value})
+		suite.Require().NoError(err)
+		suite.Equal(created.Name, read.Name)
+
+		err = suite.client.UserUpdate(ctx, accounts.UserUpdateParams{
+			SID:         created.SID.Value,
+			Description: "Updated user",
+		})
+		suite.Require().NoError(err)
+
+		read, err = suite.client.UserRead(ctx, accounts.UserReadParams{Name: "test-user"})
+		suite.Require().NoError(err)
+		suite.Equal("Updated user", read.Description)
+		suite.False(read.Enabled)
+
+		suite.Require().NoError(suite.client.UserDelete(ctx, accounts.UserDeleteParams{Name: "test-user"}))
+
+		_, err = suite.client.UserRead(ctx, accounts.UserReadParams{Name: "test-user"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should return a resource exists error", func() {
+		_, err := suite.client.UserCreate(ctx, accounts.UserCreateParams{Name: "Administrator"})
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+	})
+}
+
+func (suite *AccountsFakeUnitTestSuite) TestGroupScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create, read, update and delete a group", func() {
+		created, err := suite.client.GroupCreate(ctx, accounts.GroupCreateParams{Name: "test-group", Description: "Test group"})
+		suite.Require().NoError(err)
+		suite.Equal("test-group", created.Name)
+
+		read, err := suite.client.GroupRead(ctx, accounts.GroupReadParams{SID: created.SID.Value})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		err = suite.client.GroupUpdate(ctx, accounts.GroupUpdateParams{Name: "test-group", Description: "Updated group"})
+		suite.Require().NoError(err)
+
+		read, err = suite.client.GroupRead(ctx, accounts.GroupReadParams{Name: "test-group"})
+		suite.Require().NoError(err)
+		suite.Equal("Updated group", read.Description)
+
+		groups, err := suite.client.GroupList(ctx)
+		suite.Require().NoError(err)
+		suite.Len(groups, 5)
+
+		suite.Require().NoError(suite.client.GroupDelete(ctx, accounts.GroupDeleteParams{Name: "test-group"}))
+
+		_, err = suite.client.GroupRead(ctx, accounts.GroupReadParams{Name: "test-group"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should add, list and remove group members", func() {
+		members, err := suite.client.GroupMemberList(ctx, accounts.GroupMemberListParams{Name: "Users"})
+		suite.Require().NoError(err)
+		suite.Empty(members)
+
+		err = suite.client.GroupMemberCreate(ctx, accounts.GroupMemberCreateParams{Name: "Users", Member: "Guest"})
+		suite.Require().NoError(err)
+
+		err = suite.client.GroupMemberCreate(ctx, accounts.GroupMemberCreateParams{Name: "Users", Member: "Administrator"})
+		suite.Require().NoError(err)
+
+		members, err = suite.client.GroupMemberList(ctx, accounts.GroupMemberListParams{Name: "Users"})
+		suite.Require().NoError(err)
+		suite.Len(members, 2)
+
+		member, err := suite.client.GroupMemberRead(ctx, accounts.GroupMemberReadParams{Name: "Users", Member: "Guest"})
+		suite.Require().NoError(err)
+		suite.Equal(`WINSRV\Guest`, member.Name)
+		suite.Equal("User", member.ObjectClass)
+
+		err = suite.client.GroupMemberCreate(ctx, accounts.GroupMemberCreateParams{Name: "Users", Member: "Guest"})
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+
+		err = suite.client.GroupMemberDelete(ctx, accounts.GroupMemberDeleteParams{Name: "Users", Member: "Guest"})
+		suite.Require().NoError(err)
+
+		members, err = suite.client.GroupMemberList(ctx, accounts.GroupMemberListParams{Name: "Users"})
+		suite.Require().NoError(err)
+		suite.Len(members, 1)
+	})
+}
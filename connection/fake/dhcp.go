@@ -0,0 +1,892 @@
+package fake
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// dhcpHandlers contains the handlers for the commands of the windows/dhcp package.
+var dhcpHandlers = []handler{
+	{regexp.MustCompile(`^Get-DhcpServerv4Scope (.+) \| ConvertTo-Json -Compress$`), (*Connection).scopeRead},
+	{regexp.MustCompile(`^Add-DhcpServerv4Scope (.+) \| ConvertTo-Json -Compress$`), (*Connection).scopeCreate},
+	{regexp.MustCompile(`^Set-DhcpServerv4Scope (.+) \| ConvertTo-Json -Compress$`), (*Connection).scopeUpdate},
+	{regexp.MustCompile(`^Remove-DhcpServerv4Scope (.+)$`), (*Connection).scopeDelete},
+	{regexp.MustCompile(`^Get-DhcpServerv4ExclusionRange (.+) \| Where-Object \{\$_\.StartRange\.IPAddressToString -eq '([^']*)' -and \$_\.EndRange\.IPAddressToString -eq '([^']*)'\} \| ConvertTo-Json -Compress$`), (*Connection).exclusionRangeRead},
+	{regexp.MustCompile(`^Add-DhcpServerv4ExclusionRange (.+) \| ConvertTo-Json -Compress$`), (*Connection).exclusionRangeCreate},
+	{regexp.MustCompile(`^Remove-DhcpServerv4ExclusionRange (.+)$`), (*Connection).exclusionRangeDelete},
+	{regexp.MustCompile(`^Get-DhcpServerv4Failover (.+) \| ConvertTo-Json -Compress$`), (*Connection).failoverRead},
+	{regexp.MustCompile(`^Add-DhcpServerv4Failover (.+)$`), (*Connection).failoverCreate},
+	{regexp.MustCompile(`^Get-DhcpServerv4Reservation ([^|]+?)(?: \| Where-Object \{\$_\.IPAddress\.IPAddressToString -eq '([^']*)'\})? \| ConvertTo-Json -Compress$`), (*Connection).reservationRead},
+	{regexp.MustCompile(`^\$r=@\(Get-DhcpServerv4Reservation (.+)\) ;if\(\$r\.Count -ge 2\)\{ConvertTo-Json \$r -Compress\}else\{ConvertTo-Json @\(\$r\) -Compress\}$`), (*Connection).reservationList},
+	{regexp.MustCompile(`^Add-DhcpServerv4Reservation (.+) \| ConvertTo-Json -Compress$`), (*Connection).reservationCreate},
+	{regexp.MustCompile(`^Get-DhcpServerv4Reservation ([^|]+?)(?: \| Where-Object \{\$_\.IPAddress\.IPAddressToString -eq '([^']*)'\})? \| Set-DhcpServerv4Reservation (.+) \| ConvertTo-Json -Compress$`), (*Connection).reservationUpdate},
+	{regexp.MustCompile(`^Get-DhcpServerv4Reservation ([^|]+?)(?: \| Where-Object \{\$_\.IPAddress\.IPAddressToString -eq '([^']*)'\})? \| Remove-DhcpServerv4Reservation -Confirm:\$false -PassThru \| ConvertTo-Json -Compress$`), (*Connection).reservationDelete},
+}
+
+// Default values of the DHCP server.
+const (
+	defaultLeaseDuration      time.Duration = 8 * 24 * time.Hour
+	defaultMaxBootpClients    uint32        = 4294967295
+	defaultMaxClientLeadTime  time.Duration = time.Hour
+	defaultLoadBalancePercent uint32        = 50
+	defaultReservePercent     uint32        = 5
+)
+
+// serverIp is the IPv4 address of the fake DHCP server.
+var serverIp = netip.MustParseAddr("192.168.5.1")
+
+// scope represents an IPv4 DHCP scope of the fake server.
+type scope struct {
+	scopeId          netip.Addr
+	subnetMask       netip.Addr
+	startRange       netip.Addr
+	endRange         netip.Addr
+	name             string
+	description      string
+	state            string
+	scopeType        string
+	superscope       string
+	activatePolicies bool
+	napEnable        bool
+	napProfile       string
+	delay            uint16
+	maxBootpClients  uint32
+	leaseDuration    time.Duration
+}
+
+// exclusion represents an IPv4 DHCP exclusion range of the fake server.
+type exclusion struct {
+	scopeId    netip.Addr
+	startRange netip.Addr
+	endRange   netip.Addr
+}
+
+// failover represents an IPv4 DHCP failover relationship of the fake server.
+type failover struct {
+	name                string
+	partnerServer       string
+	scopeIds            []netip.Addr
+	loadBalancePercent  uint32
+	reservePercent      uint32
+	maxClientLeadTime   time.Duration
+	stateSwitchInterval time.Duration
+	serverRole          string
+	sharedSecret        string
+}
+
+// reservation represents an IPv4 DHCP reservation of the fake server.
+type reservation struct {
+	scopeId         netip.Addr
+	ipAddress       netip.Addr
+	clientId        string
+	name            string
+	description     string
+	reservationType string
+}
+
+// ipAddressJson is the JSON representation of a dotnet IP address object.
+type ipAddressJson struct {
+	Address            uint32  `json:"Address"`
+	AddressFamily      int     `json:"AddressFamily"`
+	ScopeId            *string `json:"ScopeId"`
+	IsIPv6Multicast    bool    `json:"IsIPv6Multicast"`
+	IsIPv6LinkLocal    bool    `json:"IsIPv6LinkLocal"`
+	IsIPv6SiteLocal    bool    `json:"IsIPv6SiteLocal"`
+	IsIPv6Teredo       bool    `json:"IsIPv6Teredo"`
+	IsIPv4MappedToIPv6 bool    `json:"IsIPv4MappedToIPv6"`
+	IPAddressToString  string  `json:"IPAddressToString,omitempty"`
+}
+
+// newIpAddressJson returns the JSON representation of an IPv4 address.
+// Invalid addresses are returned as nil.
+func newIpAddressJson(ip netip.Addr) *ipAddressJson {
+	if !ip.Is4() {
+		return nil
+	}
+
+	b := ip.As4()
+	return &ipAddressJson{
+		Address:           binary.LittleEndian.Uint32(b[:]),
+		AddressFamily:     2,
+		IPAddressToString: ip.String(),
+	}
+}
+
+// scopeJson is the JSON representation of an IPv4 DHCP scope.
+type scopeJson struct {
+	ScopeId          *ipAddressJson          `json:"ScopeId"`
+	SubnetMask       *ipAddressJson          `json:"SubnetMask"`
+	StartRange       *ipAddressJson          `json:"StartRange"`
+	EndRange         *ipAddressJson          `json:"EndRange"`
+	ActivatePolicies bool                    `json:"ActivatePolicies"`
+	Delay            uint16                  `json:"Delay"`
+	Description      string                  `json:"Description"`
+	LeaseDuration    parsing.CimTimeDuration `json:"LeaseDuration"`
+	MaxBootpClients  uint32                  `json:"MaxBootpClients"`
+	Name             string                  `json:"Name"`
+	NapEnable        bool                    `json:"NapEnable"`
+	NapProfile       string                  `json:"NapProfile"`
+	State            string                  `json:"State"`
+	SuperscopeName   string                  `json:"SuperscopeName"`
+	Type             string                  `json:"Type"`
+	PSComputerName   *string                 `json:"PSComputerName"`
+}
+
+// json returns the JSON representation of the scope.
+func (s *scope) json() scopeJson {
+	return scopeJson{
+		ScopeId:          newIpAddressJson(s.scopeId),
+		SubnetMask:       newIpAddressJson(s.subnetMask),
+		StartRange:       newIpAddressJson(s.startRange),
+		EndRange:         newIpAddressJson(s.endRange),
+		ActivatePolicies: s.activatePolicies,
+		Delay:            s.delay,
+		Description:      s.description,
+		LeaseDuration:    parsing.CimTimeDuration{Duration: s.leaseDuration},
+		MaxBootpClients:  s.maxBootpClients,
+		Name:             s.name,
+		NapEnable:        s.napEnable,
+		NapProfile:       s.napProfile,
+		State:            s.state,
+		SuperscopeName:   s.superscope,
+		Type:             s.scopeType,
+	}
+}
+
+// exclusionJson is the JSON representation of an IPv4 DHCP exclusion range.
+type exclusionJson struct {
+	ScopeId        *ipAddressJson `json:"ScopeId"`
+	StartRange     *ipAddressJson `json:"StartRange"`
+	EndRange       *ipAddressJson `json:"EndRange"`
+	PSComputerName *string        `json:"PSComputerName"`
+}
+
+// json returns the JSON representation of the exclusion range.
+func (e *exclusion) json() exclusionJson {
+	return exclusionJson{
+		ScopeId:    newIpAddressJson(e.scopeId),
+		StartRange: newIpAddressJson(e.startRange),
+		EndRange:   newIpAddressJson(e.endRange),
+	}
+}
+
+// reservationJson is the JSON representation of an IPv4 DHCP reservation.
+type reservationJson struct {
+	IPAddress      *ipAddressJson `json:"IPAddress"`
+	ScopeId        *ipAddressJson `json:"ScopeId"`
+	AddressState   string         `json:"AddressState"`
+	ClientId       string         `json:"ClientId"`
+	Description    string         `json:"Description"`
+	Name           string         `json:"Name"`
+	Type           string         `json:"Type"`
+	PSComputerName *string        `json:"PSComputerName"`
+}
+
+// json returns the JSON representation of the reservation.
+// The fake server has no clients, so the reservations are never in use.
+func (r *reservation) json() reservationJson {
+	return reservationJson{
+		IPAddress:    newIpAddressJson(r.ipAddress),
+		ScopeId:      newIpAddressJson(r.scopeId),
+		AddressState: "InactiveReservation",
+		ClientId:     r.clientId,
+		Description:  r.description,
+		Name:         r.name,
+		Type:         r.reservationType,
+	}
+}
+
+// failoverJson is the JSON representation of an IPv4 DHCP failover relationship.
+type failoverJson struct {
+	ScopeId             failoverScopeIdJson      `json:"ScopeId"`
+	PrimaryServerIP     *ipAddressJson           `json:"PrimaryServerIP"`
+	SecondaryServerIP   *ipAddressJson           `json:"SecondaryServerIP"`
+	AutoStateTransition bool                     `json:"AutoStateTransition"`
+	EnableAuth          bool                     `json:"EnableAuth"`
+	LoadBalancePercent  *uint32                  `json:"LoadBalancePercent"`
+	MaxClientLeadTime   parsing.CimTimeDuration  `json:"MaxClientLeadTime"`
+	Mode                string                   `json:"Mode"`
+	Name                string                   `json:"Name"`
+	PartnerServer       string                   `json:"PartnerServer"`
+	PrimaryServerName   string                   `json:"PrimaryServerName"`
+	ReservePercent      *uint32                  `json:"ReservePercent"`
+	SecondaryServerName string                   `json:"SecondaryServerName"`
+	ServerRole          *string                  `json:"ServerRole"`
+	ServerType          string                   `json:"ServerType"`
+	State               string                   `json:"State"`
+	StateSwitchInterval *parsing.CimTimeDuration `json:"StateSwitchInterval"`
+	PSComputerName      *string                  `json:"PSComputerName"`
+}
+
+// failoverScopeIdJson is the JSON representation of the scope IDs of a failover relationship,
+// which Windows PowerShell 5.1 wraps into an object with a "value" field.
+type failoverScopeIdJson struct {
+	Value []*ipAddressJson `json:"value"`
+	Count int              `json:"Count"`
+}
+
+// json returns the JSON representation of the failover relationship.
+func (f *failover) json() failoverJson {
+	j := failoverJson{
+		PrimaryServerIP:     newIpAddressJson(serverIp),
+		EnableAuth:          f.sharedSecret != "",
+		MaxClientLeadTime:   parsing.CimTimeDuration{Duration: f.maxClientLeadTime},
+		Name:                f.name,
+		PartnerServer:       f.partnerServer,
+		PrimaryServerName:   ComputerName,
+		SecondaryServerName: f.partnerServer,
+		ServerType:          "PrimaryServer",
+		State:               "Normal",
+	}
+
+	// The scope IDs are returned without the IPAddressToString field.
+	for _, scopeId := range f.scopeIds {
+		ip := newIpAddressJson(scopeId)
+		ip.IPAddressToString = ""
+		j.ScopeId.Value = append(j.ScopeId.Value, ip)
+	}
+	j.ScopeId.Count = len(f.scopeIds)
+
+	if partnerIp, err := netip.ParseAddr(f.partnerServer); err == nil {
+		j.SecondaryServerIP = newIpAddressJson(partnerIp)
+	}
+
+	if f.stateSwitchInterval != 0 {
+		j.AutoStateTransition = true
+		j.StateSwitchInterval = &parsing.CimTimeDuration{Duration: f.stateSwitchInterval}
+	}
+
+	// The hot standby mode is used if a server role is set.
+	if f.serverRole != "" {
+		j.Mode = "HotStandby"
+		j.ReservePercent = &f.reservePercent
+		j.ServerRole = &f.serverRole
+	} else {
+		j.Mode = "LoadBalance"
+		j.LoadBalancePercent = &f.loadBalancePercent
+	}
+
+	return j
+}
+
+// scopeNotFound returns the error of a DHCP cmdlet if a scope does not exist.
+func scopeNotFound(cmdlet string, scopeId string) error {
+	return &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("Failed to get the scope %s on DHCP server %s.", scopeId, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...cpServerv4Scope", scopeId),
+		exception: "CimException",
+		errorId:   "DHCP 20022," + cmdlet,
+	}
+}
+
+// findScope returns the scope with the given scope ID.
+func (c *Connection) findScope(cmdlet string, scopeId string) (*scope, error) {
+	for _, s := range c.scopes {
+		if s.scopeId.String() == scopeId {
+			return s, nil
+		}
+	}
+
+	return nil, scopeNotFound(cmdlet, scopeId)
+}
+
+// parseAddr parses an IPv4 address parameter.
+func parseAddr(p params, name string) (netip.Addr, error) {
+	ip, err := netip.ParseAddr(p.str(name))
+	if err != nil {
+		return ip, fmt.Errorf("connection.fake: parameter %s is not an IP address: %s", name, p.str(name))
+	}
+	return ip, nil
+}
+
+// networkAddress returns the network address of an IPv4 address and its subnet mask.
+func networkAddress(ip netip.Addr, mask netip.Addr) netip.Addr {
+	a, m := ip.As4(), mask.As4()
+	for i := range a {
+		a[i] &= m[i]
+	}
+	return netip.AddrFrom4(a)
+}
+
+// setOptional sets the optional parameters of the Add-DhcpServerv4Scope and Set-DhcpServerv4Scope cmdlets.
+func (s *scope) setOptional(p params) error {
+	if p.has("Name") {
+		s.name = p.str("Name")
+	}
+
+	if p.has("Description") {
+		s.description = p.str("Description")
+	}
+
+	if p.has("State") {
+		s.state = "Inactive"
+		if strings.EqualFold(p.str("State"), "Active") {
+			s.state = "Active"
+		}
+	}
+
+	if p.has("Type") {
+		s.scopeType = p.str("Type")
+	}
+
+	if p.has("SuperscopeName") {
+		s.superscope = p.str("SuperscopeName")
+	}
+
+	if p.has("ActivatePolicies") {
+		s.activatePolicies = p.flag("ActivatePolicies")
+	}
+
+	if p.has("NapEnable") {
+		s.napEnable = p.flag("NapEnable")
+	}
+
+	if p.has("NapProfile") {
+		s.napProfile = p.str("NapProfile")
+	}
+
+	if p.has("Delay") {
+		delay, err := p.int("Delay")
+		if err != nil {
+			return err
+		}
+		s.delay = uint16(delay)
+	}
+
+	if p.has("MaxBootpClients") {
+		maxBootpClients, err := p.int("MaxBootpClients")
+		if err != nil {
+			return err
+		}
+		s.maxBootpClients = uint32(maxBootpClients)
+	}
+
+	if p.has("LeaseDuration") {
+		leaseDuration, err := p.timespan("LeaseDuration")
+		if err != nil {
+			return err
+		}
+		s.leaseDuration = leaseDuration
+	}
+
+	var err error
+	if p.has("StartRange") {
+		if s.startRange, err = parseAddr(p, "StartRange"); err != nil {
+			return err
+		}
+	}
+
+	if p.has("EndRange") {
+		if s.endRange, err = parseAddr(p, "EndRange"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Connection) scopeRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Get-DhcpServerv4Scope", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(s.json())
+	return string(b), err
+}
+
+func (c *Connection) scopeCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s := &scope{
+		state:           "Active",
+		scopeType:       "Dhcp",
+		maxBootpClients: defaultMaxBootpClients,
+		leaseDuration:   defaultLeaseDuration,
+	}
+
+	if s.subnetMask, err = parseAddr(p, "SubnetMask"); err != nil {
+		return "", err
+	}
+
+	if err := s.setOptional(p); err != nil {
+		return "", err
+	}
+	s.scopeId = networkAddress(s.startRange, s.subnetMask)
+
+	if _, err := c.findScope("Add-DhcpServerv4Scope", s.scopeId.String()); err == nil {
+		return "", &cmdletError{
+			cmdlet:    "Add-DhcpServerv4Scope",
+			message:   fmt.Sprintf("Failed to add the scope %s on DHCP server %s. The specified subnet already exists.", s.scopeId, ComputerName),
+			category:  "ResourceExists",
+			target:    fmt.Sprintf("%s:root/Microsoft/...cpServerv4Scope", s.scopeId),
+			exception: "CimException",
+			errorId:   "DHCP 20044,Add-DhcpServerv4Scope",
+		}
+	}
+
+	c.scopes = append(c.scopes, s)
+
+	b, err := json.Marshal(s.json())
+	return string(b), err
+}
+
+func (c *Connection) scopeUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Set-DhcpServerv4Scope", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.setOptional(p); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(s.json())
+	return string(b), err
+}
+
+func (c *Connection) scopeDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Remove-DhcpServerv4Scope", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	// Remove the scope with its exclusion ranges, reservations and failover relationships.
+	c.scopes = removeItem(c.scopes, s)
+	for _, e := range c.exclusions {
+		if e.scopeId == s.scopeId {
+			c.exclusions = removeItem(c.exclusions, e)
+		}
+	}
+	for _, r := range c.reservations {
+		if r.scopeId == s.scopeId {
+			c.reservations = removeItem(c.reservations, r)
+		}
+	}
+	for _, f := range c.failovers {
+		f.scopeIds = removeItem(f.scopeIds, s.scopeId)
+	}
+
+	return "", nil
+}
+
+// findExclusion returns the exclusion range of a scope with the given start and end address.
+func (c *Connection) findExclusion(scopeId netip.Addr, startRange string, endRange string) *exclusion {
+	for _, e := range c.exclusions {
+		if e.scopeId == scopeId && e.startRange.String() == startRange && e.endRange.String() == endRange {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *Connection) exclusionRangeRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Get-DhcpServerv4ExclusionRange", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	// The Where-Object filter returns an empty output if the exclusion range does not exist.
+	e := c.findExclusion(s.scopeId, match[2], match[3])
+	if e == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(e.json())
+	return string(b), err
+}
+
+func (c *Connection) exclusionRangeCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Add-DhcpServerv4ExclusionRange", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	e := &exclusion{scopeId: s.scopeId}
+	if e.startRange, err = parseAddr(p, "StartRange"); err != nil {
+		return "", err
+	}
+	if e.endRange, err = parseAddr(p, "EndRange"); err != nil {
+		return "", err
+	}
+
+	if c.findExclusion(e.scopeId, e.startRange.String(), e.endRange.String()) != nil {
+		return "", &cmdletError{
+			cmdlet:    "Add-DhcpServerv4ExclusionRange",
+			message:   fmt.Sprintf("Failed to add the exclusion range %s-%s to the scope %s on DHCP server %s.", e.startRange, e.endRange, s.scopeId, ComputerName),
+			category:  "ResourceExists",
+			target:    fmt.Sprintf("%s:root/Microsoft/...4ExclusionRange", s.scopeId),
+			exception: "CimException",
+			errorId:   "DHCP 20019,Add-DhcpServerv4ExclusionRange",
+		}
+	}
+
+	c.exclusions = append(c.exclusions, e)
+
+	b, err := json.Marshal(e.json())
+	return string(b), err
+}
+
+func (c *Connection) exclusionRangeDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Remove-DhcpServerv4ExclusionRange", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	e := c.findExclusion(s.scopeId, p.str("StartRange"), p.str("EndRange"))
+	if e == nil {
+		return "", &cmdletError{
+			cmdlet:    "Remove-DhcpServerv4ExclusionRange",
+			message:   fmt.Sprintf("Failed to remove the exclusion range %s-%s from the scope %s on DHCP server %s.", p.str("StartRange"), p.str("EndRange"), s.scopeId, ComputerName),
+			category:  "ObjectNotFound",
+			target:    fmt.Sprintf("%s:root/Microsoft/...4ExclusionRange", s.scopeId),
+			exception: "CimException",
+			errorId:   "DHCP 20020,Remove-DhcpServerv4ExclusionRange",
+		}
+	}
+
+	c.exclusions = removeItem(c.exclusions, e)
+
+	return "", nil
+}
+
+// findFailover returns the failover relationship with the given name.
+func (c *Connection) findFailover(name string) *failover {
+	for _, f := range c.failovers {
+		if strings.EqualFold(f.name, name) {
+			return f
+		}
+	}
+	return nil
+}
+
+func (c *Connection) failoverRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	f := c.findFailover(p.str("Name"))
+	if f == nil {
+		return "", &cmdletError{
+			cmdlet:    "Get-DhcpServerv4Failover",
+			message:   fmt.Sprintf("Failed to get the failover relationship %s on DHCP server %s.", p.str("Name"), ComputerName),
+			category:  "ObjectNotFound",
+			target:    fmt.Sprintf("%s:root/Microsoft/...erverv4Failover", p.str("Name")),
+			exception: "CimException",
+			errorId:   "DHCP 20116,Get-DhcpServerv4Failover",
+		}
+	}
+
+	b, err := json.Marshal(f.json())
+	return string(b), err
+}
+
+// failoverCreate adds a failover relationship.
+// The command is not converted to JSON, so it returns an empty output.
+func (c *Connection) failoverCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if c.findFailover(p.str("Name")) != nil {
+		return "", &cmdletError{
+			cmdlet:    "Add-DhcpServerv4Failover",
+			message:   fmt.Sprintf("Failed to create the failover relationship %s on DHCP server %s. The failover relationship already exists.", p.str("Name"), ComputerName),
+			category:  "ResourceExists",
+			target:    fmt.Sprintf("%s:root/Microsoft/...erverv4Failover", p.str("Name")),
+			exception: "CimException",
+			errorId:   "DHCP 20114,Add-DhcpServerv4Failover",
+		}
+	}
+
+	f := &failover{
+		name:               p.str("Name"),
+		partnerServer:      p.str("PartnerServer"),
+		loadBalancePercent: defaultLoadBalancePercent,
+		reservePercent:     defaultReservePercent,
+		maxClientLeadTime:  defaultMaxClientLeadTime,
+		serverRole:         p.str("ServerRole"),
+		sharedSecret:       p.str("SharedSecret"),
+	}
+
+	for _, scopeId := range p.list("ScopeId") {
+		s, err := c.findScope("Add-DhcpServerv4Failover", scopeId)
+		if err != nil {
+			return "", err
+		}
+		f.scopeIds = append(f.scopeIds, s.scopeId)
+	}
+
+	if p.has("LoadBalancePercent") {
+		percent, err := p.int("LoadBalancePercent")
+		if err != nil {
+			return "", err
+		}
+		f.loadBalancePercent = uint32(percent)
+	}
+
+	if p.has("ReservePercent") {
+		percent, err := p.int("ReservePercent")
+		if err != nil {
+			return "", err
+		}
+		f.reservePercent = uint32(percent)
+	}
+
+	if p.has("MaxClientLeadTime") {
+		if f.maxClientLeadTime, err = p.timespan("MaxClientLeadTime"); err != nil {
+			return "", err
+		}
+	}
+
+	if p.has("StateSwitchInterval") {
+		if f.stateSwitchInterval, err = p.timespan("StateSwitchInterval"); err != nil {
+			return "", err
+		}
+	}
+
+	c.failovers = append(c.failovers, f)
+
+	return "", nil
+}
+
+// reservationNotFound returns the error of a DHCP cmdlet if a reservation does not exist.
+func reservationNotFound(cmdlet string, key string) error {
+	return &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("Failed to get the reservation %s on DHCP server %s.", key, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...erverv4Reservation", key),
+		exception: "CimException",
+		errorId:   "DHCP 20016," + cmdlet,
+	}
+}
+
+// findReservation returns the reservation with the given IP address or the reservation of a scope with the given client ID.
+// The client ID is compared like the DHCP server does, so the separators ':' and '-' are equal.
+func (c *Connection) findReservation(scopeId netip.Addr, ipAddress string, clientId string) *reservation {
+	clientId = strings.ReplaceAll(clientId, ":", "-")
+	for _, r := range c.reservations {
+		if ipAddress != "" && r.ipAddress.String() == ipAddress {
+			return r
+		}
+		if clientId != "" && r.scopeId == scopeId && strings.EqualFold(r.clientId, clientId) {
+			return r
+		}
+	}
+	return nil
+}
+
+// selectReservation returns the reservation selected by the Get-DhcpServerv4Reservation cmdlet.
+// The reservation is selected by the -ClientId parameter or by the IP address of the Where-Object filter.
+// A filter without a matching reservation returns nil without an error.
+func (c *Connection) selectReservation(selector string, ipAddress string) (*reservation, error) {
+	p, err := parseParams(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := c.findScope("Get-DhcpServerv4Reservation", p.str("ScopeId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.has("ClientId") {
+		if ipAddress == "" {
+			return nil, nil
+		}
+		r := c.findReservation(s.scopeId, ipAddress, "")
+		if r == nil || r.scopeId != s.scopeId {
+			return nil, nil
+		}
+		return r, nil
+	}
+
+	r := c.findReservation(s.scopeId, "", p.str("ClientId"))
+	if r == nil {
+		return nil, reservationNotFound("Get-DhcpServerv4Reservation", p.str("ClientId"))
+	}
+	return r, nil
+}
+
+// reservationExists returns the error of a DHCP cmdlet if the IP address or the client ID is already reserved.
+func reservationExists(cmdlet string, key string) error {
+	return &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("Failed to add the reservation %s on DHCP server %s. The specified IP address or hardware address is being used by another client.", key, ComputerName),
+		category:  "ResourceExists",
+		target:    fmt.Sprintf("%s:root/Microsoft/...erverv4Reservation", key),
+		exception: "CimException",
+		errorId:   "DHCP 20017," + cmdlet,
+	}
+}
+
+func (c *Connection) reservationRead(match []string) (string, error) {
+	r, err := c.selectReservation(match[1], match[2])
+	if err != nil || r == nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(r.json())
+	return string(b), err
+}
+
+func (c *Connection) reservationList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Get-DhcpServerv4Reservation", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	var j []reservationJson
+	for _, r := range c.reservations {
+		if r.scopeId == s.scopeId {
+			j = append(j, r.json())
+		}
+	}
+
+	return arrayJson(j)
+}
+
+func (c *Connection) reservationCreate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findScope("Add-DhcpServerv4Reservation", p.str("ScopeId"))
+	if err != nil {
+		return "", err
+	}
+
+	r := &reservation{
+		scopeId:         s.scopeId,
+		clientId:        strings.ToLower(strings.ReplaceAll(p.str("ClientId"), ":", "-")),
+		name:            p.str("Name"),
+		description:     p.str("Description"),
+		reservationType: "Both",
+	}
+	if r.ipAddress, err = parseAddr(p, "IPAddress"); err != nil {
+		return "", err
+	}
+	if p.has("Type") {
+		r.reservationType = p.str("Type")
+	}
+
+	if !r.ipAddress.Is4() || networkAddress(r.ipAddress, s.subnetMask) != s.scopeId {
+		return "", &cmdletError{
+			cmdlet:    "Add-DhcpServerv4Reservation",
+			message:   fmt.Sprintf("Failed to add the reservation %s to the scope %s on DHCP server %s. The specified IP address is not in the subnet of the scope.", r.ipAddress, s.scopeId, ComputerName),
+			category:  "InvalidArgument",
+			target:    fmt.Sprintf("%s:root/Microsoft/...erverv4Reservation", r.ipAddress),
+			exception: "CimException",
+			errorId:   "DHCP 20018,Add-DhcpServerv4Reservation",
+		}
+	}
+
+	if c.findReservation(s.scopeId, r.ipAddress.String(), r.clientId) != nil {
+		return "", reservationExists("Add-DhcpServerv4Reservation", r.ipAddress.String())
+	}
+
+	c.reservations = append(c.reservations, r)
+
+	b, err := json.Marshal(r.json())
+	return string(b), err
+}
+
+// reservationUpdate handles the Get-DhcpServerv4Reservation call that is piped into the Set-DhcpServerv4Reservation call.
+func (c *Connection) reservationUpdate(match []string) (string, error) {
+	r, err := c.selectReservation(match[1], match[2])
+	if err != nil || r == nil {
+		return "", err
+	}
+
+	p, err := parseParams(match[3])
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("ClientId") {
+		clientId := strings.ToLower(strings.ReplaceAll(p.str("ClientId"), ":", "-"))
+		if other := c.findReservation(r.scopeId, "", clientId); other != nil && other != r {
+			return "", reservationExists("Set-DhcpServerv4Reservation", clientId)
+		}
+		r.clientId = clientId
+	}
+
+	if p.has("Name") {
+		r.name = p.str("Name")
+	}
+
+	if p.has("Description") {
+		r.description = p.str("Description")
+	}
+
+	if p.has("Type") {
+		r.reservationType = p.str("Type")
+	}
+
+	b, err := json.Marshal(r.json())
+	return string(b), err
+}
+
+// reservationDelete handles the Get-DhcpServerv4Reservation call that is piped into the Remove-DhcpServerv4Reservation call.
+func (c *Connection) reservationDelete(match []string) (string, error) {
+	r, err := c.selectReservation(match[1], match[2])
+	if err != nil || r == nil {
+		return "", err
+	}
+
+	c.reservations = removeItem(c.reservations, r)
+
+	b, err := json.Marshal(r.json())
+	return string(b), err
+}
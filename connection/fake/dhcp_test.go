@@ -0,0 +1,258 @@
+package fake_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection/fake"
+	"github.com/d-strobel/gowindows/windows/dhcp"
+	"github.com/d-strobel/gowindows/winerror"
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for the DHCP scenarios of the fake connection
+type DhcpFakeUnitTestSuite struct {
+	suite.Suite
+	client *dhcp.Client
+}
+
+// Run all DHCP scenario tests
+func TestDhcpFakeUnitTestSuite(t *testing.T) {
+	suite.Run(t, &DhcpFakeUnitTestSuite{})
+}
+
+func (suite *DhcpFakeUnitTestSuite) SetupTest() {
+	suite.client = dhcp.NewClient(fake.NewConnection())
+}
+
+func (suite *DhcpFakeUnitTestSuite) TestScopeV4Scenario() {
+	ctx := context.Background()
+	scopeId := netip.MustParseAddr("192.168.10.0")
+
+	suite.Run("should create, read, update and delete a scope", func() {
+		created, err := suite.client.ScopeV4Create(ctx, dhcp.ScopeV4CreateParams{
+			Name:       "test-scope",
+			StartRange: netip.MustParseAddr("192.168.10.10"),
+			EndRange:   netip.MustParseAddr("192.168.10.200"),
+			SubnetMask: netip.MustParseAddr("255.255.255.0"),
+			Enabled:    true,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(scopeId, created.ScopeId.Address)
+		suite.Equal("Active", created.State)
+		suite.Equal(8*24*time.Hour, created.LeaseDuration.Duration)
+
+		read, err := suite.client.ScopeV4Read(ctx, dhcp.ScopeV4ReadParams{ScopeId: scopeId})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		updated, err := suite.client.ScopeV4Update(ctx, dhcp.ScopeV4UpdateParams{
+			ScopeId:       scopeId,
+			Description:   "Updated scope",
+			LeaseDuration: 24 * time.Hour,
+		})
+		suite.Require().NoError(err)
+		suite.Equal("Updated scope", updated.Description)
+		suite.Equal("Inactive", updated.State)
+		suite.Equal(24*time.Hour, updated.LeaseDuration.Duration)
+
+		_, err = suite.client.ScopeV4Create(ctx, dhcp.ScopeV4CreateParams{
+			Name:       "duplicate",
+			StartRange: netip.MustParseAddr("192.168.10.10"),
+			EndRange:   netip.MustParseAddr("192.168.10.200"),
+			SubnetMask: netip.MustParseAddr("255.255.255.0"),
+		})
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+
+		suite.Require().NoError(suite.client.ScopeV4Delete(ctx, dhcp.ScopeV4DeleteParams{ScopeId: scopeId}))
+
+		_, err = suite.client.ScopeV4Read(ctx, dhcp.ScopeV4ReadParams{ScopeId: scopeId})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DhcpFakeUnitTestSuite) TestExclusionRangeV4Scenario() {
+	ctx := context.Background()
+	scopeId := netip.MustParseAddr("192.168.20.0")
+	startRange := netip.MustParseAddr("192.168.20.10")
+	endRange := netip.MustParseAddr("192.168.20.20")
+
+	suite.Run("should create, read and delete an exclusion range", func() {
+		_, err := suite.client.ScopeV4Create(ctx, dhcp.ScopeV4CreateParams{
+			Name:       "test-scope",
+			StartRange: netip.MustParseAddr("192.168.20.1"),
+			EndRange:   netip.MustParseAddr("192.168.20.254"),
+			SubnetMask: netip.MustParseAddr("255.255.255.0"),
+		})
+		suite.Require().NoError(err)
+
+		created, err := suite.client.ExclusionRangeV4Create(ctx, dhcp.ExclusionRangeV4CreateParams{
+			ScopeId:    scopeId,
+			StartRange: startRange,
+			EndRange:   endRange,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(startRange, created.StartRange.Address)
+
+		read, err := suite.client.ExclusionRangeV4Read(ctx, dhcp.ExclusionRangeV4ReadParams{
+			ScopeId:    scopeId,
+			StartRange: startRange,
+			EndRange:   endRange,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		err = suite.client.ExclusionRangeV4Delete(ctx, dhcp.ExclusionRangeV4DeleteParams{
+			ScopeId:    scopeId,
+			StartRange: startRange,
+			EndRange:   endRange,
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.ExclusionRangeV4Read(ctx, dhcp.ExclusionRangeV4ReadParams{
+			ScopeId:    scopeId,
+			StartRange: startRange,
+			EndRange:   endRange,
+		})
+		suite.Error(err)
+	})
+}
+
+func (suite *DhcpFakeUnitTestSuite) TestFailoverV4Scenario() {
+	ctx := context.Background()
+	scopeId := netip.MustParseAddr("192.168.30.0")
+
+	suite.Run("should create and read a failover", func() {
+		_, err := suite.client.ScopeV4Create(ctx, dhcp.ScopeV4CreateParams{
+			Name:       "test-scope",
+			StartRange: netip.MustParseAddr("192.168.30.1"),
+			EndRange:   netip.MustParseAddr("192.168.30.254"),
+			SubnetMask: netip.MustParseAddr("255.255.255.0"),
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.FailoverV4Create(ctx, dhcp.FailoverV4CreateParams{
+			Name:            "test-failover",
+			PartnerServerIp: netip.MustParseAddr("192.168.5.2"),
+			ScopeIds:        []netip.Addr{scopeId},
+		})
+		suite.Require().NoError(err)
+
+		read, err := suite.client.FailoverV4Read(ctx, dhcp.FailoverV4ReadParams{Name: "test-failover"})
+		suite.Require().NoError(err)
+		suite.Equal("test-failover", read.Name)
+		suite.Equal("LoadBalance", read.Mode)
+		suite.Equal(uint32(50), read.LoadBalancePercent)
+		suite.Require().Len(read.ScopeId.Value, 1)
+		suite.Equal(scopeId, read.ScopeId.Value[0].Address.Addr)
+	})
+
+	suite.Run("should return an object not found error", func() {
+		_, err := suite.client.FailoverV4Read(ctx, dhcp.FailoverV4ReadParams{Name: "notexist"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DhcpFakeUnitTestSuite) TestReservationV4Scenario() {
+	ctx := context.Background()
+	scopeId := netip.MustParseAddr("192.168.40.0")
+	ipAddress := netip.MustParseAddr("192.168.40.50")
+
+	suite.Run("should create, read, update, list and delete a reservation", func() {
+		_, err := suite.client.ScopeV4Create(ctx, dhcp.ScopeV4CreateParams{
+			Name:       "test-scope",
+			StartRange: netip.MustParseAddr("192.168.40.10"),
+			EndRange:   netip.MustParseAddr("192.168.40.200"),
+			SubnetMask: netip.MustParseAddr("255.255.255.0"),
+		})
+		suite.Require().NoError(err)
+
+		created, err := suite.client.ReservationV4Create(ctx, dhcp.ReservationV4CreateParams{
+			ScopeId:   scopeId,
+			IPAddress: ipAddress,
+			ClientId:  "00:15:5D:01:02:03",
+			Name:      "printer",
+		})
+		suite.Require().NoError(err)
+		suite.Equal("00-15-5d-01-02-03", created.ClientId)
+		suite.Equal("Both", created.Type)
+
+		read, err := suite.client.ReservationV4Read(ctx, dhcp.ReservationV4ReadParams{ScopeId: scopeId, ClientId: "00155d010203"})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		description := "Floor 2"
+		updated, err := suite.client.ReservationV4Update(ctx, dhcp.ReservationV4UpdateParams{
+			ScopeId:     scopeId,
+			IPAddress:   ipAddress,
+			Description: &description,
+			Type:        "Dhcp",
+			NewClientId: "00-15-5d-01-02-04",
+		})
+		suite.Require().NoError(err)
+		suite.Equal("printer", updated.Name)
+		suite.Equal("Floor 2", updated.Description)
+		suite.Equal("Dhcp", updated.Type)
+		suite.Equal("00-15-5d-01-02-04", updated.ClientId)
+
+		updated, err = suite.client.ReservationV4Update(ctx, dhcp.ReservationV4UpdateParams{ScopeId: scopeId, IPAddress: ipAddress})
+		suite.Require().NoError(err)
+		suite.Equal("printer", updated.Name)
+		suite.Equal("Floor 2", updated.Description)
+
+		_, err = suite.client.ReservationV4Create(ctx, dhcp.ReservationV4CreateParams{
+			ScopeId:   scopeId,
+			IPAddress: netip.MustParseAddr("192.168.40.51"),
+			ClientId:  "00-15-5d-01-02-04",
+		})
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+
+		list, err := suite.client.ReservationV4List(ctx, dhcp.ReservationV4ListParams{ScopeId: scopeId})
+		suite.Require().NoError(err)
+		suite.Equal([]dhcp.ReservationV4{updated}, list)
+
+		suite.Require().NoError(suite.client.ReservationV4Delete(ctx, dhcp.ReservationV4DeleteParams{ScopeId: scopeId, ClientId: "00-15-5d-01-02-04"}))
+
+		_, err = suite.client.ReservationV4Read(ctx, dhcp.ReservationV4ReadParams{ScopeId: scopeId, IPAddress: ipAddress})
+		suite.EqualError(err, "windows.dhcp.ReservationV4Read: reservation not found")
+
+		err = suite.client.ReservationV4Delete(ctx, dhcp.ReservationV4DeleteParams{ScopeId: scopeId, IPAddress: ipAddress})
+		suite.EqualError(err, "windows.dhcp.ReservationV4Delete: reservation not found")
+
+		err = suite.client.ReservationV4Delete(ctx, dhcp.ReservationV4DeleteParams{ScopeId: scopeId, ClientId: "00-15-5d-01-02-04"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should only delete a reservation of the given scope", func() {
+		otherScopeId := netip.MustParseAddr("192.168.41.0")
+		otherIPAddress := netip.MustParseAddr("192.168.41.50")
+		_, err := suite.client.ScopeV4Create(ctx, dhcp.ScopeV4CreateParams{
+			Name:       "other-scope",
+			StartRange: netip.MustParseAddr("192.168.41.10"),
+			EndRange:   netip.MustParseAddr("192.168.41.200"),
+			SubnetMask: netip.MustParseAddr("255.255.255.0"),
+		})
+		suite.Require().NoError(err)
+		_, err = suite.client.ReservationV4Create(ctx, dhcp.ReservationV4CreateParams{ScopeId: otherScopeId, IPAddress: otherIPAddress, ClientId: "00-15-5d-01-02-06"})
+		suite.Require().NoError(err)
+
+		err = suite.client.ReservationV4Delete(ctx, dhcp.ReservationV4DeleteParams{ScopeId: scopeId, IPAddress: otherIPAddress})
+		suite.EqualError(err, "windows.dhcp.ReservationV4Delete: reservation not found")
+
+		_, err = suite.client.ReservationV4Read(ctx, dhcp.ReservationV4ReadParams{ScopeId: otherScopeId, IPAddress: otherIPAddress})
+		suite.Require().NoError(err)
+
+		suite.Require().NoError(suite.client.ReservationV4Delete(ctx, dhcp.ReservationV4DeleteParams{ScopeId: otherScopeId, IPAddress: otherIPAddress}))
+	})
+
+	suite.Run("should reject an IP address outside of the scope range", func() {
+		_, err := suite.client.ReservationV4Create(ctx, dhcp.ReservationV4CreateParams{
+			ScopeId:   scopeId,
+			IPAddress: netip.MustParseAddr("192.168.40.250"),
+			ClientId:  "00-15-5d-01-02-05",
+		})
+		suite.ErrorContains(err, "reservation parameter 'IPAddress' must be within the range 192.168.40.10-192.168.40.200")
+	})
+}
@@ -0,0 +1,3237 @@
+package fake
+
+import (
+	"cmp"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// dnsHandlers contains the handlers for the commands of the windows/dns package.
+var dnsHandlers = []handler{
+	{regexp.MustCompile(`^Get-DnsServerZone \| ConvertTo-Json -Compress$`), (*Connection).zoneList},
+	{regexp.MustCompile(`^Get-DnsServerZone (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneRead},
+	{regexp.MustCompile(`^((?:(?:Add|Set)-DnsServer(?:Primary|Secondary|Stub|ConditionalForwarder)Zone [^;]+ -ErrorAction Stop ;)+)Get-DnsServerZone (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneChange},
+	{regexp.MustCompile(`^(Add-DnsServerPrimaryZone [^;]+ -ErrorAction Stop) ;try\{(Set-DnsServerPrimaryZone [^;]+ -ErrorAction Stop)\}catch\{Remove-DnsServerZone ([^;]+) ;throw \$_\} ;Get-DnsServerZone (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneCreate},
+	{regexp.MustCompile(`^Remove-DnsServerZone (.+)$`), (*Connection).zoneDelete},
+	{regexp.MustCompile(`^\$z=Get-DnsServerZone (.+) -ErrorAction Stop ;if\(\$z\.ZoneType -ne 'Forwarder'\)\{throw "([^"]*)"\} ;\$z \| Remove-DnsServerZone -Force$`), (*Connection).forwarderZoneDelete},
+	{regexp.MustCompile(`^Get-DnsServerForwarder \| ConvertTo-Json -Compress$`), (*Connection).forwarderRead},
+	{regexp.MustCompile(`^(\$f=Get-DnsServerForwarder ;if\(\$f\.IPAddress\)\{Remove-DnsServerForwarder -IPAddress \$f\.IPAddress -Force -ErrorAction Stop\} ;)?Set-DnsServerForwarder (.*?) -ErrorAction Stop ;Get-DnsServerForwarder \| ConvertTo-Json -Compress$`), (*Connection).forwarderUpdate},
+	{regexp.MustCompile(`^Get-DnsServerZoneAging (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneAgingRead},
+	{regexp.MustCompile(`^Set-DnsServerZoneAging (.+?) -ErrorAction Stop ;Get-DnsServerZoneAging (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneAgingUpdate},
+	{regexp.MustCompile(`^Get-DnsServerScavenging \| ConvertTo-Json -Compress$`), (*Connection).scavengingRead},
+	{regexp.MustCompile(`^Set-DnsServerScavenging (.+?) -ErrorAction Stop ;Get-DnsServerScavenging \| ConvertTo-Json -Compress$`), (*Connection).scavengingUpdate},
+	{regexp.MustCompile(`^Start-DnsServerScavenging -Force$`), (*Connection).scavengingStart},
+	{regexp.MustCompile(`^Get-DnsServerSetting -All \| ConvertTo-Json -Compress$`), (*Connection).serverSettingRead},
+	{regexp.MustCompile(`^\$s=Get-DnsServerSetting -All ;\$s\.ListeningIPAddress=(\$s\.AllIPAddress|@\([^)]*\)) ;\$s\.RoundRobin=(\$true|\$false) ;\$s\.LocalNetPriority=(\$true|\$false) ;Set-DnsServerSetting -InputObject \$s -ErrorAction Stop ;Get-DnsServerSetting -All \| ConvertTo-Json -Compress$`), (*Connection).serverSettingUpdate},
+	{regexp.MustCompile(`^Get-DnsServerRecursion \| ConvertTo-Json -Compress$`), (*Connection).recursionRead},
+	{regexp.MustCompile(`^Set-DnsServerRecursion (.+?) -ErrorAction Stop ;Get-DnsServerRecursion \| ConvertTo-Json -Compress$`), (*Connection).recursionUpdate},
+	{regexp.MustCompile(`^Get-DnsServerEDns \| ConvertTo-Json -Compress$`), (*Connection).ednsRead},
+	{regexp.MustCompile(`^Set-DnsServerEDns (.+?) -ErrorAction Stop ;Get-DnsServerEDns \| ConvertTo-Json -Compress$`), (*Connection).ednsUpdate},
+	{regexp.MustCompile(`^Get-DnsServerCache \| ConvertTo-Json -Compress$`), (*Connection).cacheRead},
+	{regexp.MustCompile(`^Set-DnsServerCache (.+?) -ErrorAction Stop ;Get-DnsServerCache \| ConvertTo-Json -Compress$`), (*Connection).cacheUpdate},
+	{regexp.MustCompile(`^Show-DnsServerCache \| ForEach-Object\{ConvertTo-Json \$_ -Compress\}$`), (*Connection).cacheRecordList},
+	{regexp.MustCompile(`^Clear-DnsServerCache -Force$`), (*Connection).cacheClear},
+	{regexp.MustCompile(`^Get-DnsServerStatistics \| ForEach-Object\{.+\} \| ConvertTo-Json -Compress$`), (*Connection).statisticsRead},
+	{regexp.MustCompile(`^Clear-DnsServerStatistics -Force$`), (*Connection).statisticsClear},
+	{regexp.MustCompile(`^Invoke-DnsServerZone(Sign|Unsign) (.+?) -Force -ErrorAction Stop ;Get-DnsServerZone (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneSign},
+	{regexp.MustCompile(`^Get-DnsServerSigningKey (.+) \| ConvertTo-Json -Compress$`), (*Connection).signingKeyRead},
+	{regexp.MustCompile(`^\$k=@\(Get-DnsServerSigningKey (.+)\) ;if\(\$k\.Count -ge 2\)\{ConvertTo-Json \$k -Compress\}else\{ConvertTo-Json @\(\$k\) -Compress\}$`), (*Connection).signingKeyList},
+	{regexp.MustCompile(`^(Add|Set)-DnsServerSigningKey (.+?) -PassThru -ErrorAction Stop \| ConvertTo-Json -Compress$`), (*Connection).signingKeyChange},
+	{regexp.MustCompile(`^Remove-DnsServerSigningKey (.+) -Force$`), (*Connection).signingKeyDelete},
+	{regexp.MustCompile(`^\$t=@\(Get-DnsServerTrustAnchor (.+)\) ;if\(\$t\.Count -ge 2\)\{ConvertTo-Json \$t -Compress\}else\{ConvertTo-Json @\(\$t\) -Compress\}$`), (*Connection).trustAnchorList},
+	{regexp.MustCompile(`^\$r=Get-DnsServerResourceRecord (.+) ;if\(\$r\.Count -ge 2\)\{ConvertTo-Json \$r -Compress\}else\{ConvertTo-Json @\(\$r\) -Compress\}$`), (*Connection).recordReadArray},
+	{regexp.MustCompile(`^Get-DnsServerResourceRecord (.+) \| ConvertTo-Json -Compress$`), (*Connection).recordRead},
+	{regexp.MustCompile(`^\$r=Add-DnsServerResourceRecord(\w+) (.+) ;if\(\$r\.Count -ge 2\)\{ConvertTo-Json \$r -Compress\}else\{ConvertTo-Json @\(\$r\) -Compress\}$`), (*Connection).recordCreateArray},
+	{regexp.MustCompile(`^Add-DnsServerResourceRecord(\w+) (.+) \| ConvertTo-Json -Compress$`), (*Connection).recordCreate},
+	{regexp.MustCompile(`^\$r=@\(\) ;try\{(\$r\+=Add-DnsServerResourceRecord\w* .+?)\}catch\{\$r\|Remove-DnsServerResourceRecord .+? -Force -ErrorAction SilentlyContinue ;throw \$_\} ;if\(\$r\.Count -ge 2\)\{ConvertTo-Json \$r -Compress\}else\{ConvertTo-Json @\(\$r\) -Compress\}$`), (*Connection).recordAddEach},
+	{regexp.MustCompile(`^\$o=@\(Get-DnsServerResourceRecord (-RRType '\w+' -Name '(?:[^']|'')*' .+?) -ErrorAction SilentlyContinue\) ;\$r=@\(\) ;try\{\$o\|Remove-DnsServerResourceRecord .+? -Force -ErrorAction Stop ;(\$r\+=Add-DnsServerResourceRecord\w* .+?)\}catch\{\$r\|Remove-DnsServerResourceRecord .+? -Force -ErrorAction SilentlyContinue ;\$o\|Add-DnsServerResourceRecord .+? -ErrorAction SilentlyContinue ;throw \$_\}$`), (*Connection).recordReplaceEach},
+	{regexp.MustCompile(`^(` + recordCallsRegex + `(?: ;` + recordCallsRegex + `)*)$`), (*Connection).recordCalls},
+	{regexp.MustCompile(`^((?:(?:Add-DnsServerResourceRecord(?:A|AAAA)|Remove-DnsServerResourceRecord) [^;]+ -ErrorAction Stop ;)+)(\$nr=@\(\);Get-DnsServerResourceRecord .+)$`), (*Connection).recordReplace},
+	{regexp.MustCompile(`^\$nr=@\(\);Get-DnsServerResourceRecord (.+) \| ForEach-Object\{\$r=\$_;\$n=\[ciminstance\]::new\(\$r\);\$n\.TimeToLive=New-TimeSpan -Seconds (\d+) ;\$nr\+=Set-DnsServerResourceRecord -OldInputObject \$r -NewInputObject \$n -ZoneName '((?:[^']|'')*)'(?: -ZoneScope '(?:[^']|'')*')? -PassThru\} ;if\(\$nr\.Count -ge 2\)\{ConvertTo-Json \$nr -Compress\}else\{ConvertTo-Json @\(\$nr\) -Compress\}$`), (*Connection).recordUpdateTimeToLive},
+	{regexp.MustCompile(`^\$r=Get-DnsServerResourceRecord (.+) ;\$n=\[ciminstance\]::new\(\$r\) ;\$n\.TimeToLive=New-TimeSpan -Seconds (\d+) ;\$n\.RecordData\.(\w+)='((?:[^']|'')*)' ;Set-DnsServerResourceRecord -OldInputObject \$r -NewInputObject \$n -ZoneName '(?:[^']|'')*'(?: -ZoneScope '(?:[^']|'')*')? -PassThru \| ConvertTo-Json -Compress$`), (*Connection).recordUpdate},
+	{regexp.MustCompile(`^Remove-DnsServerResourceRecord (.+)$`), (*Connection).recordDelete},
+	{regexp.MustCompile(`^Get-DnsServerResourceRecord (.+?)(?: \| Where-Object\{(.+)\})?( \| Sort-Object -Property HostName,RecordType)? \| ForEach-Object\{ConvertTo-Json \$_ -Compress\}$`), (*Connection).recordList},
+	{regexp.MustCompile(`^\$r=Get-DnsServerResourceRecord -RRType 'SOA' ([^;]+) ;\[pscustomobject\]@\{.+\} \| ConvertTo-Json -Compress$`), (*Connection).soaRead},
+	{regexp.MustCompile(`^\$r=Get-DnsServerResourceRecord -RRType 'SOA' (.+?) ;\$n=\[ciminstance\]::new\(\$r\) ;\$s=\[int64\]\$r\.RecordData\.SerialNumber ;(?:\$d=\((\d+)-\$s\+4294967296\)%4294967296 ;if\([^{]+\)\{throw "([^"]*)"\} ;)?((?:\$n\.RecordData\.\w+=[^;]+ ;)+)\$r=Set-DnsServerResourceRecord .+ -PassThru ;\[pscustomobject\]@\{.+\} \| ConvertTo-Json -Compress$`), (*Connection).soaUpdate},
+	{regexp.MustCompile(`^` + delegationReadRegex + `$`), (*Connection).delegationRead},
+	{regexp.MustCompile(`^\$n=@\(\) ;try\{(.+)\}catch\{\$n\|ForEach-Object\{Remove-DnsServerZoneDelegation [^}]+\} ;throw \$_\} ;` + delegationReadRegex + `$`), (*Connection).delegationCreate},
+	{regexp.MustCompile(`^\$e=@\(Get-DnsServerZoneDelegation (.+?) -ErrorAction Stop \| ForEach-Object\{\$_\.NameServer\.RecordData\.NameServer\}\) ;\$n=@\(\) ;try\{(.+)\}catch\{\$n\|ForEach-Object\{Remove-DnsServerZoneDelegation [^}]+\} ;throw \$_\} ;\$e\|Where-Object\{@\(([^)]*)\) -notcontains \$_\}\|ForEach-Object\{Remove-DnsServerZoneDelegation [^}]+\} ;` + delegationReadRegex + `$`), (*Connection).delegationUpdate},
+	{regexp.MustCompile(`^Remove-DnsServerZoneDelegation (.+)$`), (*Connection).delegationDelete},
+	{regexp.MustCompile(`^Get-DnsServerZoneScope (.+) \| ConvertTo-Json -Compress$`), (*Connection).zoneScopeRead},
+	{regexp.MustCompile(`^\$s=@\(Get-DnsServerZoneScope (.+)\) ;if\(\$s\.Count -ge 2\)\{ConvertTo-Json \$s -Compress\}else\{ConvertTo-Json @\(\$s\) -Compress\}$`), (*Connection).zoneScopeList},
+	{regexp.MustCompile(`^Add-DnsServerZoneScope (.+?) -PassThru -ErrorAction Stop \| ConvertTo-Json -Compress$`), (*Connection).zoneScopeCreate},
+	{regexp.MustCompile(`^Remove-DnsServerZoneScope (.+) -Force$`), (*Connection).zoneScopeDelete},
+	{regexp.MustCompile(`^Get-DnsServerClientSubnet (.+) \| ConvertTo-Json -Compress$`), (*Connection).clientSubnetRead},
+	{regexp.MustCompile(`^\$s=@\(Get-DnsServerClientSubnet\) ;if\(\$s\.Count -ge 2\)\{ConvertTo-Json \$s -Compress\}else\{ConvertTo-Json @\(\$s\) -Compress\}$`), (*Connection).clientSubnetList},
+	{regexp.MustCompile(`^Add-DnsServerClientSubnet (.+?) -PassThru -ErrorAction Stop \| ConvertTo-Json -Compress$`), (*Connection).clientSubnetCreate},
+	{regexp.MustCompile(`^Set-DnsServerClientSubnet (.+?) -ErrorAction Stop (?:;\$s=Get-DnsServerClientSubnet .+? ;if\(\$s\.(IPv4Subnet|IPv6Subnet)\)\{.+?\} )?;Get-DnsServerClientSubnet (.+) \| ConvertTo-Json -Compress$`), (*Connection).clientSubnetUpdate},
+	{regexp.MustCompile(`^Remove-DnsServerClientSubnet (.+) -Force$`), (*Connection).clientSubnetDelete},
+	{regexp.MustCompile(`^Get-DnsServerQueryResolutionPolicy (.+?) \| ForEach-Object\{.+\} \| ConvertTo-Json -Compress$`), (*Connection).policyRead},
+	{regexp.MustCompile(`^\$p=@\(Get-DnsServerQueryResolutionPolicy(.*?) \| ForEach-Object\{.+\}\) ;if\(\$p\.Count -ge 2\)\{ConvertTo-Json \$p -Compress\}else\{ConvertTo-Json @\(\$p\) -Compress\}$`), (*Connection).policyList},
+	{regexp.MustCompile(`^(Add|Set)-DnsServerQueryResolutionPolicy (.+?) -PassThru -ErrorAction Stop \| ForEach-Object\{.+\} \| ConvertTo-Json -Compress$`), (*Connection).policyChange},
+	{regexp.MustCompile(`^Remove-DnsServerQueryResolutionPolicy (.+) -Force$`), (*Connection).policyDelete},
+	{regexp.MustCompile(`^\$r=@\(Resolve-DnsName (.+?) \| Where-Object\{\$_\.Section -eq 'Answer'\}\) ;if\(\$r\.Count -ge 2\)\{ConvertTo-Json \$r -Compress\}else\{ConvertTo-Json @\(\$r\) -Compress\}$`), (*Connection).resolve},
+}
+
+// recordTypes maps the record types to their numeric type and their record data properties.
+// The properties map the parameter names of the Add-DnsServerResourceRecord* cmdlets to the record data.
+var recordTypes = map[string]struct {
+	name       string
+	number     int
+	properties []string
+}{
+	"a":      {"A", 1, []string{"IPv4Address"}},
+	"aaaa":   {"AAAA", 28, []string{"IPv6Address"}},
+	"cname":  {"CNAME", 5, []string{"HostNameAlias"}},
+	"ptr":    {"PTR", 12, []string{"PtrDomainName"}},
+	"mx":     {"MX", 15, []string{"MailExchange", "Preference"}},
+	"srv":    {"SRV", 33, []string{"DomainName", "Port", "Priority", "Weight"}},
+	"txt":    {"TXT", 16, []string{"DescriptiveText"}},
+	"dnskey": {"DNSKEY", 48, []string{"Base64Data", "CryptoAlgorithm", "KeyProtocol", "Revoked", "SecureEntryPoint", "ZoneKey"}},
+	"ns":     {"NS", 2, []string{"NameServer"}},
+}
+
+// delegationTimeToLive is the time to live of the records that are added for a zone delegation.
+const delegationTimeToLive = time.Hour
+
+// zone represents a DNS zone of the fake server.
+type zone struct {
+	name          string
+	autoCreated   bool
+	dsIntegrated  bool
+	reverseLookup bool
+	soa           *soa
+
+	// Settings of the zone that differ from the defaults.
+	zoneType          string
+	replicationScope  string
+	partition         string
+	zoneFile          string
+	dynamicUpdate     string
+	secureSecondaries string
+	notify            string
+	masterServers     []string
+	secondaryServers  []string
+	notifyServers     []string
+	forwarderTimeout  int64
+
+	// Aging settings of the zone. The intervals default to the intervals of the server.
+	aging                bool
+	noRefreshInterval    time.Duration
+	refreshInterval      time.Duration
+	scavengeServers      []string
+	availForScavengeTime time.Time
+
+	// DNSSEC settings of the zone.
+	signed      bool
+	signingKeys []*signingKey
+
+	// Zone scopes of the zone without the default zone scope.
+	scopes []string
+}
+
+// defaultForwarderTimeout is the default forwarder timeout of a conditional forwarder zone in seconds.
+const defaultForwarderTimeout int64 = 5
+
+// forwarder represents the global forwarder settings of the fake server.
+type forwarder struct {
+	addresses   []string
+	useRootHint bool
+	timeout     int64
+}
+
+// forwarderJson is the JSON representation of the forwarder settings.
+type forwarderJson struct {
+	EnableReordering   bool            `json:"EnableReordering"`
+	IPAddress          []ipAddressJson `json:"IPAddress"`
+	ReorderedIPAddress []ipAddressJson `json:"ReorderedIPAddress"`
+	Timeout            int64           `json:"Timeout"`
+	UseRootHint        bool            `json:"UseRootHint"`
+	PSComputerName     *string         `json:"PSComputerName"`
+}
+
+// ipAddressesJson returns the JSON representation of the IP addresses.
+func ipAddressesJson(addresses []string) []ipAddressJson {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	j := make([]ipAddressJson, 0, len(addresses))
+	for _, address := range addresses {
+		ip, err := netip.ParseAddr(address)
+		if err != nil {
+			continue
+		}
+
+		if a := newIpAddressJson(ip); a != nil {
+			j = append(j, *a)
+		} else {
+			j = append(j, ipAddressJson{AddressFamily: 23, IPAddressToString: ip.String()})
+		}
+	}
+	return j
+}
+
+// scavenging represents the scavenging settings of the fake server.
+type scavenging struct {
+	state             bool
+	interval          time.Duration
+	noRefreshInterval time.Duration
+	refreshInterval   time.Duration
+	lastScavengeTime  time.Time
+}
+
+// zoneAgingJson is the JSON representation of the aging settings of a zone.
+type zoneAgingJson struct {
+	AgingEnabled         bool                    `json:"AgingEnabled"`
+	AvailForScavengeTime dotnetDate              `json:"AvailForScavengeTime"`
+	NoRefreshInterval    parsing.CimTimeDuration `json:"NoRefreshInterval"`
+	RefreshInterval      parsing.CimTimeDuration `json:"RefreshInterval"`
+	ScavengeServers      []ipAddressJson         `json:"ScavengeServers"`
+	ZoneName             string                  `json:"ZoneName"`
+	PSComputerName       *string                 `json:"PSComputerName"`
+}
+
+// scavengingJson is the JSON representation of the scavenging settings of the server.
+type scavengingJson struct {
+	LastScavengeTime   dotnetDate              `json:"LastScavengeTime"`
+	NoRefreshInterval  parsing.CimTimeDuration `json:"NoRefreshInterval"`
+	RefreshInterval    parsing.CimTimeDuration `json:"RefreshInterval"`
+	ScavengingInterval parsing.CimTimeDuration `json:"ScavengingInterval"`
+	ScavengingState    bool                    `json:"ScavengingState"`
+	PSComputerName     *string                 `json:"PSComputerName"`
+}
+
+// signingKey represents a DNSSEC signing key of a zone.
+type signingKey struct {
+	id               string
+	keyType          string
+	algorithm        string
+	length           int64
+	rolloverPeriod   time.Duration
+	nextRolloverTime time.Time
+	publicKey        []byte
+}
+
+// signingKeyJson is the JSON representation of a signing key.
+type signingKeyJson struct {
+	KeyId                 string                  `json:"KeyId"`
+	KeyType               string                  `json:"KeyType"`
+	CurrentState          string                  `json:"CurrentState"`
+	CurrentRolloverStatus string                  `json:"CurrentRolloverStatus"`
+	CryptoAlgorithm       string                  `json:"CryptoAlgorithm"`
+	KeyLength             int64                   `json:"KeyLength"`
+	KeyStorageProvider    string                  `json:"KeyStorageProvider"`
+	StoreKeysInAD         bool                    `json:"StoreKeysInAD"`
+	RolloverPeriod        parsing.CimTimeDuration `json:"RolloverPeriod"`
+	NextRolloverAction    string                  `json:"NextRolloverAction"`
+	LastRolloverTime      dotnetDate              `json:"LastRolloverTime"`
+	NextRolloverTime      dotnetDate              `json:"NextRolloverTime"`
+	PSComputerName        *string                 `json:"PSComputerName"`
+}
+
+// trustAnchorJson is the JSON representation of a trust anchor.
+type trustAnchorJson struct {
+	TrustAnchorName  string         `json:"TrustAnchorName"`
+	TrustAnchorType  string         `json:"TrustAnchorType"`
+	TrustAnchorState string         `json:"TrustAnchorState"`
+	TrustAnchorData  recordDataJson `json:"TrustAnchorData"`
+	PSComputerName   *string        `json:"PSComputerName"`
+}
+
+// soa represents the SOA-Record data of a zone.
+type soa struct {
+	serialNumber      uint32
+	refreshInterval   time.Duration
+	retryDelay        time.Duration
+	expireLimit       time.Duration
+	minimumTimeToLive time.Duration
+}
+
+// soaAssignmentRegex matches a single assignment of a SOA-Record update, e.g. "$n.RecordData.SerialNumber=43".
+// The increment of the current serial number is matched as well.
+var soaAssignmentRegex = regexp.MustCompile(`^\$n\.RecordData\.(\w+)=(?:New-TimeSpan -Seconds )?(\d+|\[uint32\]\(\(\$s\+1\)%4294967296\))$`)
+
+// soaSerialIncrement is the assignment value that increments the current serial number.
+const soaSerialIncrement string = "[uint32](($s+1)%4294967296)"
+
+// serialGreater returns true if the serial number s1 is greater than s2.
+// Serial numbers are compared with the sequence space arithmetic, so they can wrap around.
+// https://www.rfc-editor.org/rfc/rfc1982#section-3.2
+func serialGreater(s1 uint32, s2 uint32) bool {
+	return s1 != s2 && s1-s2 < 1<<31
+}
+
+// record represents a DNS resource record of the fake server.
+// Records of the default zone scope have an empty zone scope.
+type record struct {
+	zone       string
+	zoneScope  string
+	name       string
+	recordType string
+	timeToLive time.Duration
+	timestamp  time.Time
+	data       parsing.CimClassKeyVal
+}
+
+// zoneJson is the JSON representation of a DNS zone.
+type zoneJson struct {
+	NotifyServers                     []string `json:"NotifyServers"`
+	SecondaryServers                  []string `json:"SecondaryServers"`
+	MasterServers                     []string `json:"MasterServers"`
+	AllowedDcForNsRecordsAutoCreation []string `json:"AllowedDcForNsRecordsAutoCreation"`
+	DistinguishedName                 *string  `json:"DistinguishedName"`
+	IsAutoCreated                     bool     `json:"IsAutoCreated"`
+	IsDsIntegrated                    bool     `json:"IsDsIntegrated"`
+	IsPaused                          bool     `json:"IsPaused"`
+	IsReadOnly                        bool     `json:"IsReadOnly"`
+	IsReverseLookupZone               bool     `json:"IsReverseLookupZone"`
+	IsShutdown                        bool     `json:"IsShutdown"`
+	ZoneName                          string   `json:"ZoneName"`
+	ZoneType                          string   `json:"ZoneType"`
+	DirectoryPartitionName            *string  `json:"DirectoryPartitionName"`
+	DynamicUpdate                     string   `json:"DynamicUpdate"`
+	IgnorePolicies                    bool     `json:"IgnorePolicies"`
+	IsSigned                          bool     `json:"IsSigned"`
+	IsWinsEnabled                     bool     `json:"IsWinsEnabled"`
+	Notify                            string   `json:"Notify"`
+	ReplicationScope                  string   `json:"ReplicationScope"`
+	SecureSecondaries                 string   `json:"SecureSecondaries"`
+	ZoneFile                          *string  `json:"ZoneFile"`
+	ForwarderTimeout                  *int64   `json:"ForwarderTimeout,omitempty"`
+	PSComputerName                    *string  `json:"PSComputerName"`
+}
+
+// domainDn returns the distinguished name of the domain, e.g. "DC=test,DC=local".
+func domainDn() string {
+	return "DC=" + strings.ReplaceAll(Domain, ".", ",DC=")
+}
+
+// soaRecord returns the SOA-Record data of the zone.
+// The SOA-Record is created with the default values of a new zone on the first access.
+func (z *zone) soaRecord() *soa {
+	if z.soa == nil {
+		z.soa = &soa{
+			serialNumber:      1,
+			refreshInterval:   15 * time.Minute,
+			retryDelay:        10 * time.Minute,
+			expireLimit:       24 * time.Hour,
+			minimumTimeToLive: time.Hour,
+		}
+	}
+	return z.soa
+}
+
+// json returns the JSON representation of the zone.
+func (z *zone) json() zoneJson {
+	j := zoneJson{
+		NotifyServers:       z.notifyServers,
+		SecondaryServers:    z.secondaryServers,
+		MasterServers:       z.masterServers,
+		IsAutoCreated:       z.autoCreated,
+		IsDsIntegrated:      z.dsIntegrated,
+		IsReverseLookupZone: z.reverseLookup,
+		IsSigned:            z.signed,
+		ZoneName:            z.name,
+		ZoneType:            cmp.Or(z.zoneType, "Primary"),
+		DynamicUpdate:       "None",
+		Notify:              "NoNotify",
+		ReplicationScope:    "None",
+		SecureSecondaries:   "NoTransfer",
+	}
+
+	if z.dsIntegrated {
+		j.DynamicUpdate = "Secure"
+		j.Notify = "NotifyServers"
+		j.ReplicationScope = cmp.Or(z.replicationScope, "Domain")
+
+		partition := "DomainDnsZones." + Domain
+		switch j.ReplicationScope {
+		case "Forest":
+			partition = "ForestDnsZones." + Domain
+		case "Custom":
+			partition = z.partition
+		}
+		dn := fmt.Sprintf("DC=%s,cn=MicrosoftDNS,DC=%s,%s", z.name, strings.Split(partition, ".")[0], domainDn())
+		j.DistinguishedName = &dn
+		j.DirectoryPartitionName = &partition
+	} else {
+		zoneFile := cmp.Or(z.zoneFile, z.name+".dns")
+		j.ZoneFile = &zoneFile
+	}
+
+	if j.ZoneType == "Forwarder" {
+		timeout := cmp.Or(z.forwarderTimeout, defaultForwarderTimeout)
+		j.ForwarderTimeout = &timeout
+		j.ZoneFile = nil
+		j.DynamicUpdate = "None"
+		j.Notify = "NoNotify"
+	}
+
+	j.DynamicUpdate = cmp.Or(z.dynamicUpdate, j.DynamicUpdate)
+	j.Notify = cmp.Or(z.notify, j.Notify)
+	j.SecureSecondaries = cmp.Or(z.secureSecondaries, j.SecureSecondaries)
+
+	return j
+}
+
+// recordJson is the JSON representation of a DNS resource record.
+type recordJson struct {
+	DistinguishedName string                  `json:"DistinguishedName"`
+	HostName          string                  `json:"HostName"`
+	RecordClass       string                  `json:"RecordClass"`
+	RecordData        recordDataJson          `json:"RecordData"`
+	RecordType        string                  `json:"RecordType"`
+	Timestamp         dotnetDate              `json:"Timestamp"`
+	TimeToLive        parsing.CimTimeDuration `json:"TimeToLive"`
+	Type              int                     `json:"Type"`
+	PSComputerName    *string                 `json:"PSComputerName"`
+}
+
+// recordDataJson is the JSON representation of the CimInstance that contains the record data.
+type recordDataJson struct {
+	CimClass              string                 `json:"CimClass"`
+	CimInstanceProperties parsing.CimClassKeyVal `json:"CimInstanceProperties"`
+	CimSystemProperties   string                 `json:"CimSystemProperties"`
+}
+
+// json returns the JSON representation of the record.
+func (r *record) json() recordJson {
+	recordType := recordTypes[strings.ToLower(r.recordType)]
+
+	return recordJson{
+		DistinguishedName: fmt.Sprintf("DC=%s,DC=%s,cn=MicrosoftDNS,DC=DomainDnsZones,%s", r.name, r.zone, domainDn()),
+		HostName:          r.name,
+		RecordClass:       "IN",
+		RecordData: recordDataJson{
+			CimClass:              "root/Microsoft/Windows/DNS:DnsServerResourceRecord" + recordType.name,
+			CimInstanceProperties: r.data,
+			CimSystemProperties:   "Microsoft.Management.Infrastructure.CimSystemProperties",
+		},
+		RecordType: recordType.name,
+		Timestamp:  dotnetDate(r.timestamp),
+		TimeToLive: parsing.CimTimeDuration{Duration: r.timeToLive},
+		Type:       recordType.number,
+	}
+}
+
+// seedDns adds the default zones of a DNS server on a domain controller.
+func (c *Connection) seedDns() {
+	c.zones = []*zone{
+		{name: "0.in-addr.arpa", autoCreated: true, reverseLookup: true},
+		{name: "127.in-addr.arpa", autoCreated: true, reverseLookup: true},
+		{name: "255.in-addr.arpa", autoCreated: true, reverseLookup: true},
+		{name: "_msdcs." + Domain, dsIntegrated: true},
+		{name: Domain, dsIntegrated: true},
+		{name: "TrustAnchors", dsIntegrated: true},
+	}
+	c.forwarder = forwarder{useRootHint: true, timeout: 3}
+	c.scavenging = scavenging{interval: 7 * 24 * time.Hour, noRefreshInterval: 7 * 24 * time.Hour, refreshInterval: 7 * 24 * time.Hour}
+	c.setting = serverSetting{listeningAddresses: []string{serverIp.String(), "fd00::5:1"}, allAddresses: []string{serverIp.String(), "fd00::5:1"}, roundRobin: true}
+	c.recursion = recursion{enable: true, secureResponse: true, timeout: 8, retryInterval: 3, additionalTimeout: 4}
+	c.edns = edns{cacheTimeout: 15 * time.Minute, enableProbes: true, enableReception: true}
+	c.cache = cache{maxTTL: 24 * time.Hour, maxNegativeTTL: 15 * time.Minute, lockingPercent: 100, enablePollutionProtection: true}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	c.statistics = statistics{serverStartTime: now, lastClearTime: now}
+}
+
+// AddZone adds an Active Directory integrated primary zone to the fake DNS server.
+// Zones that end with "in-addr.arpa" or "ip6.arpa" are added as reverse lookup zones.
+func (c *Connection) AddZone(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.zones = append(c.zones, &zone{
+		name:          name,
+		dsIntegrated:  true,
+		reverseLookup: strings.HasSuffix(name, "in-addr.arpa") || strings.HasSuffix(name, "ip6.arpa"),
+	})
+}
+
+// findZone returns the zone with the given name.
+func (c *Connection) findZone(cmdlet string, name string) (*zone, error) {
+	for _, z := range c.zones {
+		if strings.EqualFold(z.name, name) {
+			return z, nil
+		}
+	}
+
+	return nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("The zone %s was not found on server %s.", name, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...S_DnsServerZone", name),
+		exception: "CimException",
+		errorId:   "WIN32 9601," + cmdlet,
+	}
+}
+
+// findZoneScope returns the zone scope with the given name of a zone.
+// The default zone scope, which has the name of the zone, is returned as empty string.
+func (c *Connection) findZoneScope(cmdlet string, zoneName string, scope string) (string, error) {
+	z, err := c.findZone(cmdlet, zoneName)
+	if err != nil {
+		return "", err
+	}
+
+	if scope == "" || strings.EqualFold(scope, z.name) {
+		return "", nil
+	}
+	for _, s := range z.scopes {
+		if strings.EqualFold(s, scope) {
+			return s, nil
+		}
+	}
+
+	return "", zoneScopeNotFound(cmdlet, z.name, scope)
+}
+
+// in returns true if the record is part of the zone scope of a zone.
+func (r *record) in(zoneName string, zoneScope string) bool {
+	return strings.EqualFold(r.zone, zoneName) && strings.EqualFold(r.zoneScope, zoneScope)
+}
+
+// findRecords returns the records with the given name and type of a zone scope.
+func (c *Connection) findRecords(cmdlet string, p params) ([]*record, error) {
+	zoneScope, err := c.findZoneScope(cmdlet, p.str("ZoneName"), p.str("ZoneScope"))
+	if err != nil {
+		return nil, err
+	}
+	zoneName, name, recordType := p.str("ZoneName"), p.str("Name"), p.str("RRType")
+
+	var records []*record
+	for _, r := range c.records {
+		if r.in(zoneName, zoneScope) && strings.EqualFold(r.name, name) && strings.EqualFold(r.recordType, recordType) {
+			records = append(records, r)
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("Failed to get %s record in %s zone on %s server.", name, zoneName, ComputerName),
+			category:  "ObjectNotFound",
+			target:    fmt.Sprintf("%s:root/Microsoft/...rverResourceRecord", name),
+			exception: "CimException",
+			errorId:   "WIN32 9714," + cmdlet,
+		}
+	}
+
+	return records, nil
+}
+
+// recordsJson returns the JSON representations of the records.
+func recordsJson(records []*record) []recordJson {
+	result := make([]recordJson, 0, len(records))
+	for _, r := range records {
+		result = append(result, r.json())
+	}
+	return result
+}
+
+// recordDataValue returns the normalized value of a record data property.
+// Domain names are stored as fully qualified domain names with a trailing dot.
+func recordDataValue(property string, value string) (string, error) {
+	switch property {
+	case "IPv4Address", "IPv6Address":
+		ip, err := netip.ParseAddr(value)
+		if err != nil {
+			return "", err
+		}
+		return ip.String(), nil
+	case "HostNameAlias", "PtrDomainName", "MailExchange", "DomainName", "NameServer":
+		if !strings.HasSuffix(value, ".") {
+			value += "."
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+// SetRecordTimestamp sets the timestamp of the records with the given name in a zone,
+// e.g. to simulate stale records of clients that no longer refresh their records.
+// A zero timestamp turns the records into static records.
+func (c *Connection) SetRecordTimestamp(zoneName string, name string, timestamp time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, r := range c.records {
+		if strings.EqualFold(r.zone, zoneName) && strings.EqualFold(r.name, name) {
+			r.timestamp = timestamp
+		}
+	}
+}
+
+// RejectRecordData makes the server reject the creation of records with the given record data,
+// e.g. "mail3.test.local." for a mail exchanger, to simulate a server that cannot add a record of a record set.
+func (c *Connection) RejectRecordData(value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rejectedData = append(c.rejectedData, value)
+}
+
+func (c *Connection) zoneList(match []string) (string, error) {
+	zones := make([]zoneJson, 0, len(c.zones))
+	for _, z := range c.zones {
+		zones = append(zones, z.json())
+	}
+	return pipelineJson(zones)
+}
+
+func (c *Connection) zoneRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Get-DnsServerZone", p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(z.json())
+	return string(b), err
+}
+
+// changeZone handles a single Add-DnsServer*Zone or Set-DnsServer*Zone call.
+func (c *Connection) changeZone(call string) error {
+	cmdlet, args, _ := strings.Cut(call, " ")
+
+	p, err := parseParams(strings.TrimSuffix(args, " -ErrorAction Stop"))
+	if err != nil {
+		return err
+	}
+
+	verb, zoneType, _ := strings.Cut(strings.TrimSuffix(cmdlet, "Zone"), "-DnsServer")
+	if zoneType == "ConditionalForwarder" {
+		zoneType = "Forwarder"
+	}
+	name := p.str("Name")
+
+	var z *zone
+	switch verb {
+	case "Add":
+		if _, err := c.findZone(cmdlet, name); err == nil {
+			return &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("Failed to create zone %s on server %s.", name, ComputerName),
+				category:  "ResourceExists",
+				target:    fmt.Sprintf("%s:root/Microsoft/...S_DnsServerZone", name),
+				exception: "CimException",
+				errorId:   "WIN32 9609," + cmdlet,
+			}
+		}
+
+		z = &zone{
+			name:          name,
+			zoneType:      zoneType,
+			dsIntegrated:  p.has("ReplicationScope"),
+			reverseLookup: strings.HasSuffix(name, "in-addr.arpa") || strings.HasSuffix(name, "ip6.arpa"),
+		}
+		c.zones = append(c.zones, z)
+	case "Set":
+		if z, err = c.findZone(cmdlet, name); err != nil {
+			return err
+		}
+
+		if cmp.Or(z.zoneType, "Primary") != zoneType {
+			return &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("The zone %s is not a %s zone on server %s.", name, strings.ToLower(zoneType), ComputerName),
+				category:  "InvalidArgument",
+				target:    fmt.Sprintf("%s:root/Microsoft/...S_DnsServerZone", name),
+				exception: "CimException",
+				errorId:   "WIN32 87," + cmdlet,
+			}
+		}
+
+		// The server rejects unspecified addresses as secondary or notify servers.
+		for _, address := range append(p.list("SecondaryServers"), p.list("NotifyServers")...) {
+			if ip, err := netip.ParseAddr(address); err == nil && ip.IsUnspecified() {
+				return &cmdletError{
+					cmdlet:    cmdlet,
+					message:   fmt.Sprintf("The address %s is not a valid server address on server %s.", address, ComputerName),
+					category:  "InvalidArgument",
+					target:    fmt.Sprintf("%s:root/Microsoft/...S_DnsServerZone", name),
+					exception: "CimException",
+					errorId:   "WIN32 87," + cmdlet,
+				}
+			}
+		}
+	}
+
+	// Apply the settings of the call.
+	for param, setting := range map[string]*string{
+		"ReplicationScope":       &z.replicationScope,
+		"DirectoryPartitionName": &z.partition,
+		"ZoneFile":               &z.zoneFile,
+		"DynamicUpdate":          &z.dynamicUpdate,
+		"SecureSecondaries":      &z.secureSecondaries,
+		"Notify":                 &z.notify,
+	} {
+		if p.has(param) {
+			*setting = p.str(param)
+		}
+	}
+	for param, setting := range map[string]*[]string{
+		"MasterServers":    &z.masterServers,
+		"SecondaryServers": &z.secondaryServers,
+		"NotifyServers":    &z.notifyServers,
+	} {
+		if p.has(param) {
+			*setting = p.list(param)
+		}
+	}
+	if p.has("ReplicationScope") {
+		z.dsIntegrated = true
+	}
+	if p.has("ForwarderTimeout") {
+		if z.forwarderTimeout, err = p.int("ForwarderTimeout"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// zoneChange handles the commands that add or change a zone and read it afterwards.
+// Like the ErrorAction Stop of the calls, the command stops at the first call that fails.
+func (c *Connection) zoneChange(match []string) (string, error) {
+	for _, call := range strings.Split(strings.TrimSuffix(match[1], " ;"), " ;") {
+		if err := c.changeZone(call); err != nil {
+			return "", err
+		}
+	}
+
+	return c.zoneRead([]string{match[0], match[2]})
+}
+
+// zoneCreate handles the command that adds a primary zone and sets its zone transfer and notify settings afterwards.
+// Like the catch block of the command, the zone is removed again if the settings cannot be set.
+func (c *Connection) zoneCreate(match []string) (string, error) {
+	if err := c.changeZone(match[1]); err != nil {
+		return "", err
+	}
+
+	if err := c.changeZone(match[2]); err != nil {
+		_, _ = c.zoneDelete([]string{"", match[3]})
+		return "", err
+	}
+
+	return c.zoneRead([]string{match[0], match[4]})
+}
+
+// zoneDelete removes a zone with all its records.
+func (c *Connection) zoneDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Remove-DnsServerZone", p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	c.zones = removeItem(c.zones, z)
+	for _, r := range slices.Clone(c.records) {
+		if strings.EqualFold(r.zone, z.name) {
+			c.records = removeItem(c.records, r)
+		}
+	}
+	c.policies = slices.DeleteFunc(c.policies, func(pol *policy) bool {
+		return strings.EqualFold(pol.zone, z.name)
+	})
+
+	return "", nil
+}
+
+// forwarderZoneDelete removes a zone if it is a conditional forwarder zone
+// and throws the error of the command otherwise.
+func (c *Connection) forwarderZoneDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Get-DnsServerZone", p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	if z.zoneType != "Forwarder" {
+		return "", thrownError(match[2])
+	}
+
+	c.zones = removeItem(c.zones, z)
+	return "", nil
+}
+
+func (c *Connection) forwarderRead(match []string) (string, error) {
+	j := forwarderJson{
+		EnableReordering:   true,
+		IPAddress:          ipAddressesJson(c.forwarder.addresses),
+		ReorderedIPAddress: ipAddressesJson(c.forwarder.addresses),
+		Timeout:            c.forwarder.timeout,
+		UseRootHint:        c.forwarder.useRootHint,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// forwarderUpdate handles the Set-DnsServerForwarder call and the removal of all forwarders.
+func (c *Connection) forwarderUpdate(match []string) (string, error) {
+	p, err := parseParams(match[2])
+	if err != nil {
+		return "", err
+	}
+
+	if match[1] != "" {
+		c.forwarder.addresses = nil
+	}
+	if p.has("IPAddress") {
+		c.forwarder.addresses = p.list("IPAddress")
+	}
+	if p.has("UseRootHint") {
+		c.forwarder.useRootHint = p.flag("UseRootHint")
+	}
+	if p.has("Timeout") {
+		if c.forwarder.timeout, err = p.int("Timeout"); err != nil {
+			return "", err
+		}
+	}
+
+	return c.forwarderRead(match)
+}
+
+// agingIntervals returns the no-refresh and refresh interval of a zone.
+func (c *Connection) agingIntervals(z *zone) (time.Duration, time.Duration) {
+	return cmp.Or(z.noRefreshInterval, c.scavenging.noRefreshInterval), cmp.Or(z.refreshInterval, c.scavenging.refreshInterval)
+}
+
+func (c *Connection) zoneAgingRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Get-DnsServerZoneAging", p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	noRefreshInterval, refreshInterval := c.agingIntervals(z)
+	j := zoneAgingJson{
+		AgingEnabled:         z.aging,
+		AvailForScavengeTime: dotnetDate(z.availForScavengeTime),
+		NoRefreshInterval:    parsing.CimTimeDuration{Duration: noRefreshInterval},
+		RefreshInterval:      parsing.CimTimeDuration{Duration: refreshInterval},
+		ScavengeServers:      ipAddressesJson(z.scavengeServers),
+		ZoneName:             z.name,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// zoneAgingUpdate handles the Set-DnsServerZoneAging call.
+// Like the DNS server, the zone is available for scavenging one refresh interval after the aging is enabled.
+func (c *Connection) zoneAgingUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Set-DnsServerZoneAging", p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("NoRefreshInterval") {
+		if z.noRefreshInterval, err = p.timespan("NoRefreshInterval"); err != nil {
+			return "", err
+		}
+	}
+	if p.has("RefreshInterval") {
+		if z.refreshInterval, err = p.timespan("RefreshInterval"); err != nil {
+			return "", err
+		}
+	}
+	if p.has("ScavengeServers") {
+		z.scavengeServers = p.list("ScavengeServers")
+	}
+	if p.has("Aging") {
+		aging := p.flag("Aging")
+		if aging && !z.aging {
+			_, refreshInterval := c.agingIntervals(z)
+			z.availForScavengeTime = time.Now().UTC().Truncate(time.Hour).Add(refreshInterval)
+		}
+		if !aging {
+			z.availForScavengeTime = time.Time{}
+		}
+		z.aging = aging
+	}
+
+	return c.zoneAgingRead([]string{"", match[2]})
+}
+
+func (c *Connection) scavengingRead(match []string) (string, error) {
+	j := scavengingJson{
+		LastScavengeTime:   dotnetDate(c.scavenging.lastScavengeTime),
+		NoRefreshInterval:  parsing.CimTimeDuration{Duration: c.scavenging.noRefreshInterval},
+		RefreshInterval:    parsing.CimTimeDuration{Duration: c.scavenging.refreshInterval},
+		ScavengingInterval: parsing.CimTimeDuration{Duration: c.scavenging.interval},
+		ScavengingState:    c.scavenging.state,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// scavengingUpdate handles the Set-DnsServerScavenging call.
+func (c *Connection) scavengingUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("ScavengingState") {
+		c.scavenging.state = p.flag("ScavengingState")
+	}
+	for name, interval := range map[string]*time.Duration{
+		"ScavengingInterval": &c.scavenging.interval,
+		"NoRefreshInterval":  &c.scavenging.noRefreshInterval,
+		"RefreshInterval":    &c.scavenging.refreshInterval,
+	} {
+		if !p.has(name) {
+			continue
+		}
+		if *interval, err = p.timespan(name); err != nil {
+			return "", err
+		}
+	}
+
+	return c.scavengingRead(match)
+}
+
+// scavengingStart removes the stale records of all zones with aging.
+// A record is stale if its timestamp is older than the no-refresh and the refresh interval of its zone.
+// Unlike the DNS server, the fake does not wait until the zone is available for scavenging.
+func (c *Connection) scavengingStart(match []string) (string, error) {
+	now := time.Now().UTC()
+
+	for _, z := range c.zones {
+		if !z.aging {
+			continue
+		}
+
+		noRefreshInterval, refreshInterval := c.agingIntervals(z)
+		c.records = slices.DeleteFunc(c.records, func(r *record) bool {
+			return strings.EqualFold(r.zone, z.name) && !r.timestamp.IsZero() && r.timestamp.Add(noRefreshInterval+refreshInterval).Before(now)
+		})
+	}
+
+	c.scavenging.lastScavengeTime = now
+	return "", nil
+}
+
+// serverSetting represents the general settings of the fake server.
+type serverSetting struct {
+	listeningAddresses []string
+	allAddresses       []string
+	roundRobin         bool
+	localNetPriority   bool
+}
+
+// serverSettingJson is the JSON representation of the general settings of the server.
+type serverSettingJson struct {
+	AllIPAddress       []ipAddressJson `json:"AllIPAddress"`
+	ComputerName       string          `json:"ComputerName"`
+	ListeningIPAddress []ipAddressJson `json:"ListeningIPAddress"`
+	LocalNetPriority   bool            `json:"LocalNetPriority"`
+	RoundRobin         bool            `json:"RoundRobin"`
+	PSComputerName     *string         `json:"PSComputerName"`
+}
+
+// recursion represents the recursion settings of the fake server.
+type recursion struct {
+	enable            bool
+	secureResponse    bool
+	timeout           int64
+	retryInterval     int64
+	additionalTimeout int64
+}
+
+// recursionJson is the JSON representation of the recursion settings of the server.
+type recursionJson struct {
+	AdditionalTimeout int64   `json:"AdditionalTimeout"`
+	Enable            bool    `json:"Enable"`
+	RetryInterval     int64   `json:"RetryInterval"`
+	SecureResponse    bool    `json:"SecureResponse"`
+	Timeout           int64   `json:"Timeout"`
+	PSComputerName    *string `json:"PSComputerName"`
+}
+
+// edns represents the EDNS settings of the fake server.
+type edns struct {
+	cacheTimeout    time.Duration
+	enableProbes    bool
+	enableReception bool
+}
+
+// ednsJson is the JSON representation of the EDNS settings of the server.
+type ednsJson struct {
+	CacheTimeout    parsing.CimTimeDuration `json:"CacheTimeout"`
+	EnableProbes    bool                    `json:"EnableProbes"`
+	EnableReception bool                    `json:"EnableReception"`
+	PSComputerName  *string                 `json:"PSComputerName"`
+}
+
+// cache represents the cache settings of the fake server.
+// The fake does not resolve names recursively, so the cache never contains records.
+type cache struct {
+	maxTTL                           time.Duration
+	maxNegativeTTL                   time.Duration
+	maxKBSize                        int64
+	lockingPercent                   int64
+	enablePollutionProtection        bool
+	storeEmptyAuthenticationResponse bool
+}
+
+// cacheJson is the JSON representation of the cache settings of the server.
+type cacheJson struct {
+	EnablePollutionProtection        bool                    `json:"EnablePollutionProtection"`
+	IgnorePolicies                   bool                    `json:"IgnorePolicies"`
+	LockingPercent                   int64                   `json:"LockingPercent"`
+	MaxKBSize                        int64                   `json:"MaxKBSize"`
+	MaxNegativeTtl                   parsing.CimTimeDuration `json:"MaxNegativeTtl"`
+	MaxTTL                           parsing.CimTimeDuration `json:"MaxTTL"`
+	StoreEmptyAuthenticationResponse bool                    `json:"StoreEmptyAuthenticationResponse"`
+	ZoneName                         string                  `json:"ZoneName"`
+	PSComputerName                   *string                 `json:"PSComputerName"`
+}
+
+// statistics represents the statistics of the fake server.
+// The fake does not answer DNS queries, so only the times of the statistics change.
+type statistics struct {
+	serverStartTime time.Time
+	lastClearTime   time.Time
+}
+
+// statisticsJson is the JSON representation of the projected statistics of the server.
+type statisticsJson struct {
+	ServerStartTime             dotnetDate              `json:"ServerStartTime"`
+	LastClearTime               dotnetDate              `json:"LastClearTime"`
+	TimeElapsedSinceServerStart parsing.CimTimeDuration `json:"TimeElapsedSinceServerStart"`
+	TimeElapsedSinceLastClear   parsing.CimTimeDuration `json:"TimeElapsedSinceLastClear"`
+	Queries                     map[string]uint64       `json:"Queries"`
+	QueryTypes                  map[string]uint64       `json:"QueryTypes"`
+	Errors                      map[string]uint64       `json:"Errors"`
+}
+
+func (c *Connection) serverSettingRead(match []string) (string, error) {
+	j := serverSettingJson{
+		AllIPAddress:       ipAddressesJson(c.setting.allAddresses),
+		ComputerName:       strings.ToLower(ComputerName) + "." + Domain,
+		ListeningIPAddress: ipAddressesJson(c.setting.listeningAddresses),
+		LocalNetPriority:   c.setting.localNetPriority,
+		RoundRobin:         c.setting.roundRobin,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// serverSettingUpdate handles the modification of the settings object and the Set-DnsServerSetting call.
+// Like the DNS server, the listening addresses must be addresses of the server.
+func (c *Connection) serverSettingUpdate(match []string) (string, error) {
+	addresses := slices.Clone(c.setting.allAddresses)
+	if match[1] != "$s.AllIPAddress" {
+		addresses = splitItems(strings.TrimSuffix(strings.TrimPrefix(match[1], "@("), ")"))
+	}
+
+	for _, address := range addresses {
+		if !slices.Contains(c.setting.allAddresses, address) {
+			return "", &cmdletError{
+				cmdlet:    "Set-DnsServerSetting",
+				message:   fmt.Sprintf("The IP address %s is not an IP address of the server %s.", address, ComputerName),
+				category:  "InvalidArgument",
+				target:    "DnsServerSetting:root/Microsoft/...DnsServerSetting",
+				exception: "CimException",
+				errorId:   "WIN32 87,Set-DnsServerSetting",
+			}
+		}
+	}
+
+	c.setting.listeningAddresses = addresses
+	c.setting.roundRobin = strings.EqualFold(match[2], "$true")
+	c.setting.localNetPriority = strings.EqualFold(match[3], "$true")
+
+	return c.serverSettingRead(match)
+}
+
+func (c *Connection) recursionRead(match []string) (string, error) {
+	j := recursionJson{
+		AdditionalTimeout: c.recursion.additionalTimeout,
+		Enable:            c.recursion.enable,
+		RetryInterval:     c.recursion.retryInterval,
+		SecureResponse:    c.recursion.secureResponse,
+		Timeout:           c.recursion.timeout,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// recursionUpdate handles the Set-DnsServerRecursion call.
+func (c *Connection) recursionUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("Enable") {
+		c.recursion.enable = p.flag("Enable")
+	}
+	if p.has("SecureResponse") {
+		c.recursion.secureResponse = p.flag("SecureResponse")
+	}
+	for name, seconds := range map[string]*int64{
+		"Timeout":           &c.recursion.timeout,
+		"RetryInterval":     &c.recursion.retryInterval,
+		"AdditionalTimeout": &c.recursion.additionalTimeout,
+	} {
+		if !p.has(name) {
+			continue
+		}
+		if *seconds, err = p.int(name); err != nil {
+			return "", err
+		}
+	}
+
+	return c.recursionRead(match)
+}
+
+func (c *Connection) ednsRead(match []string) (string, error) {
+	j := ednsJson{
+		CacheTimeout:    parsing.CimTimeDuration{Duration: c.edns.cacheTimeout},
+		EnableProbes:    c.edns.enableProbes,
+		EnableReception: c.edns.enableReception,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// ednsUpdate handles the Set-DnsServerEDns call.
+func (c *Connection) ednsUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("CacheTimeout") {
+		if c.edns.cacheTimeout, err = p.timespan("CacheTimeout"); err != nil {
+			return "", err
+		}
+	}
+	if p.has("EnableProbes") {
+		c.edns.enableProbes = p.flag("EnableProbes")
+	}
+	if p.has("EnableReception") {
+		c.edns.enableReception = p.flag("EnableReception")
+	}
+
+	return c.ednsRead(match)
+}
+
+func (c *Connection) cacheRead(match []string) (string, error) {
+	j := cacheJson{
+		EnablePollutionProtection:        c.cache.enablePollutionProtection,
+		LockingPercent:                   c.cache.lockingPercent,
+		MaxKBSize:                        c.cache.maxKBSize,
+		MaxNegativeTtl:                   parsing.CimTimeDuration{Duration: c.cache.maxNegativeTTL},
+		MaxTTL:                           parsing.CimTimeDuration{Duration: c.cache.maxTTL},
+		StoreEmptyAuthenticationResponse: c.cache.storeEmptyAuthenticationResponse,
+		ZoneName:                         "..Cache",
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// cacheUpdate handles the Set-DnsServerCache call.
+func (c *Connection) cacheUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	for name, ttl := range map[string]*time.Duration{
+		"MaxTTL":         &c.cache.maxTTL,
+		"MaxNegativeTtl": &c.cache.maxNegativeTTL,
+	} {
+		if !p.has(name) {
+			continue
+		}
+		if *ttl, err = p.timespan(name); err != nil {
+			return "", err
+		}
+	}
+	for name, value := range map[string]*int64{
+		"MaxKBSize":      &c.cache.maxKBSize,
+		"LockingPercent": &c.cache.lockingPercent,
+	} {
+		if !p.has(name) {
+			continue
+		}
+		if *value, err = p.int(name); err != nil {
+			return "", err
+		}
+	}
+	if p.has("EnablePollutionProtection") {
+		c.cache.enablePollutionProtection = p.flag("EnablePollutionProtection")
+	}
+	if p.has("StoreEmptyAuthenticationResponse") {
+		c.cache.storeEmptyAuthenticationResponse = p.flag("StoreEmptyAuthenticationResponse")
+	}
+
+	return c.cacheRead(match)
+}
+
+// cacheRecordList handles the Show-DnsServerCache call, which returns no records for the empty cache.
+func (c *Connection) cacheRecordList(match []string) (string, error) {
+	return "", nil
+}
+
+// cacheClear handles the Clear-DnsServerCache call, which succeeds for the empty cache.
+func (c *Connection) cacheClear(match []string) (string, error) {
+	return "", nil
+}
+
+func (c *Connection) statisticsRead(match []string) (string, error) {
+	now := time.Now().UTC()
+
+	j := statisticsJson{
+		ServerStartTime:             dotnetDate(c.statistics.serverStartTime),
+		LastClearTime:               dotnetDate(c.statistics.lastClearTime),
+		TimeElapsedSinceServerStart: parsing.CimTimeDuration{Duration: now.Sub(c.statistics.serverStartTime).Truncate(time.Second)},
+		TimeElapsedSinceLastClear:   parsing.CimTimeDuration{Duration: now.Sub(c.statistics.lastClearTime).Truncate(time.Second)},
+		Queries:                     map[string]uint64{},
+		QueryTypes:                  map[string]uint64{},
+		Errors:                      map[string]uint64{},
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// statisticsClear handles the Clear-DnsServerStatistics call.
+func (c *Connection) statisticsClear(match []string) (string, error) {
+	c.statistics.lastClearTime = time.Now().UTC().Truncate(time.Second)
+	return "", nil
+}
+
+// newGuid returns a random GUID, e.g. "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10".
+func newGuid() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// newSigningKey returns a signing key with a random public key.
+// The default rollover periods of the server are used if the rollover period is not set.
+func newSigningKey(keyType string, algorithm string, length int64, rolloverPeriod time.Duration) *signingKey {
+	if rolloverPeriod == 0 {
+		rolloverPeriod = 90 * 24 * time.Hour
+		if keyType == "KeySigningKey" {
+			rolloverPeriod = 755 * 24 * time.Hour
+		}
+	}
+
+	publicKey := make([]byte, 64)
+	rand.Read(publicKey)
+
+	return &signingKey{
+		id:             newGuid(),
+		keyType:        keyType,
+		algorithm:      algorithm,
+		length:         length,
+		rolloverPeriod: rolloverPeriod,
+		publicKey:      publicKey,
+	}
+}
+
+// json returns the JSON representation of the signing key.
+func (k *signingKey) json() signingKeyJson {
+	state := "Inactive"
+	if !k.nextRolloverTime.IsZero() {
+		state = "Active"
+	}
+
+	return signingKeyJson{
+		KeyId:                 k.id,
+		KeyType:               k.keyType,
+		CurrentState:          state,
+		CurrentRolloverStatus: "NotRolling",
+		CryptoAlgorithm:       k.algorithm,
+		KeyLength:             k.length,
+		KeyStorageProvider:    "Microsoft Software Key Storage Provider",
+		StoreKeysInAD:         true,
+		RolloverPeriod:        parsing.CimTimeDuration{Duration: k.rolloverPeriod},
+		NextRolloverAction:    "Normal",
+		NextRolloverTime:      dotnetDate(k.nextRolloverTime),
+	}
+}
+
+// recordData returns the record data of the DNSKEY-Record of the signing key.
+func (k *signingKey) recordData() parsing.CimClassKeyVal {
+	secureEntryPoint := "False"
+	if k.keyType == "KeySigningKey" {
+		secureEntryPoint = "True"
+	}
+
+	return parsing.CimClassKeyVal{
+		"Base64Data":       base64.StdEncoding.EncodeToString(k.publicKey),
+		"CryptoAlgorithm":  k.algorithm,
+		"KeyProtocol":      "DnsSec",
+		"Revoked":          "False",
+		"SecureEntryPoint": secureEntryPoint,
+		"ZoneKey":          "True",
+	}
+}
+
+// findSigningKey returns the signing key with the given ID of a zone.
+func (c *Connection) findSigningKey(cmdlet string, args string) (*zone, *signingKey, error) {
+	p, err := parseParams(args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	z, err := c.findZone(cmdlet, p.str("ZoneName"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, k := range z.signingKeys {
+		if strings.EqualFold(k.id, p.str("KeyId")) {
+			return z, k, nil
+		}
+	}
+
+	return nil, nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("The signing key %s was not found in zone %s on server %s.", p.str("KeyId"), z.name, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...erverSigningKey", p.str("KeyId")),
+		exception: "CimException",
+		errorId:   "WIN32 1168," + cmdlet,
+	}
+}
+
+// zoneSign handles the Invoke-DnsServerZoneSign and Invoke-DnsServerZoneUnsign calls.
+// Signing publishes a DNSKEY-Record for each signing key, the other DNSSEC records are not simulated.
+// A zone can only be signed with its own keys if it has at least one key signing key and one zone signing key.
+func (c *Connection) zoneSign(match []string) (string, error) {
+	cmdlet := "Invoke-DnsServerZone" + match[1]
+
+	p, err := parseParams(match[2])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone(cmdlet, p.str("ZoneName"))
+	if err != nil {
+		return "", err
+	}
+
+	// Remove the DNSKEY-Records of the current keys.
+	c.records = slices.DeleteFunc(c.records, func(r *record) bool {
+		return strings.EqualFold(r.zone, z.name) && r.recordType == "DNSKEY"
+	})
+	for _, k := range z.signingKeys {
+		k.nextRolloverTime = time.Time{}
+	}
+
+	if match[1] == "Unsign" {
+		z.signed = false
+		return c.zoneRead([]string{"", match[3]})
+	}
+
+	if p.flag("SignWithDefault") {
+		z.signingKeys = []*signingKey{
+			newSigningKey("KeySigningKey", "RsaSha256", 2048, 0),
+			newSigningKey("ZoneSigningKey", "RsaSha256", 1024, 0),
+		}
+	}
+
+	var ksk, zsk bool
+	for _, k := range z.signingKeys {
+		ksk = ksk || k.keyType == "KeySigningKey"
+		zsk = zsk || k.keyType == "ZoneSigningKey"
+	}
+	if !ksk || !zsk {
+		return "", &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("The zone %s on server %s must have at least one key signing key and one zone signing key to be signed.", z.name, ComputerName),
+			category:  "InvalidOperation",
+			target:    fmt.Sprintf("%s:root/Microsoft/...S_DnsServerZone", z.name),
+			exception: "CimException",
+			errorId:   "WIN32 9108," + cmdlet,
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, k := range z.signingKeys {
+		k.nextRolloverTime = now.Add(k.rolloverPeriod)
+		c.records = append(c.records, &record{
+			zone:       z.name,
+			name:       "@",
+			recordType: "DNSKEY",
+			timeToLive: time.Hour,
+			data:       k.recordData(),
+		})
+	}
+	z.signed = true
+
+	return c.zoneRead([]string{"", match[3]})
+}
+
+func (c *Connection) signingKeyRead(match []string) (string, error) {
+	_, k, err := c.findSigningKey("Get-DnsServerSigningKey", match[1])
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(k.json())
+	return string(b), err
+}
+
+func (c *Connection) signingKeyList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Get-DnsServerSigningKey", p.str("ZoneName"))
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]signingKeyJson, 0, len(z.signingKeys))
+	for _, k := range z.signingKeys {
+		keys = append(keys, k.json())
+	}
+	return arrayJson(keys)
+}
+
+// signingKeyChange handles the Add-DnsServerSigningKey and Set-DnsServerSigningKey calls.
+func (c *Connection) signingKeyChange(match []string) (string, error) {
+	cmdlet := match[1] + "-DnsServerSigningKey"
+
+	p, err := parseParams(match[2])
+	if err != nil {
+		return "", err
+	}
+
+	var rolloverPeriod time.Duration
+	if p.has("RolloverPeriod") {
+		if rolloverPeriod, err = p.timespan("RolloverPeriod"); err != nil {
+			return "", err
+		}
+	}
+
+	if match[1] == "Set" {
+		_, k, err := c.findSigningKey(cmdlet, match[2])
+		if err != nil {
+			return "", err
+		}
+		if rolloverPeriod != 0 {
+			k.rolloverPeriod = rolloverPeriod
+		}
+
+		b, err := json.Marshal(k.json())
+		return string(b), err
+	}
+
+	z, err := c.findZone(cmdlet, p.str("ZoneName"))
+	if err != nil {
+		return "", err
+	}
+
+	var length int64
+	if p.has("KeyLength") {
+		if length, err = p.int("KeyLength"); err != nil {
+			return "", err
+		}
+	}
+
+	k := newSigningKey(p.str("Type"), p.str("CryptoAlgorithm"), length, rolloverPeriod)
+	z.signingKeys = append(z.signingKeys, k)
+
+	b, err := json.Marshal(k.json())
+	return string(b), err
+}
+
+// signingKeyDelete removes a signing key. The keys of a signed zone can not be removed.
+func (c *Connection) signingKeyDelete(match []string) (string, error) {
+	cmdlet := "Remove-DnsServerSigningKey"
+
+	z, k, err := c.findSigningKey(cmdlet, match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if z.signed {
+		return "", &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("The signing key %s is in use by the signed zone %s on server %s.", k.id, z.name, ComputerName),
+			category:  "InvalidOperation",
+			target:    fmt.Sprintf("%s:root/Microsoft/...erverSigningKey", k.id),
+			exception: "CimException",
+			errorId:   "WIN32 9108," + cmdlet,
+		}
+	}
+
+	z.signingKeys = removeItem(z.signingKeys, k)
+	return "", nil
+}
+
+// trustAnchorList returns the trust anchors of a zone.
+// The fake distributes the key signing keys of its signed zones as DNSKEY trust anchors.
+func (c *Connection) trustAnchorList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	anchors := []trustAnchorJson{}
+	for _, z := range c.zones {
+		if !z.signed || !strings.EqualFold(z.name, strings.TrimSuffix(p.str("Name"), ".")) {
+			continue
+		}
+
+		for _, k := range z.signingKeys {
+			if k.keyType != "KeySigningKey" {
+				continue
+			}
+			anchors = append(anchors, trustAnchorJson{
+				TrustAnchorName:  z.name + ".",
+				TrustAnchorType:  "DNSKEY",
+				TrustAnchorState: "Valid",
+				TrustAnchorData: recordDataJson{
+					CimClass:              "root/Microsoft/Windows/DNS:DnsServerResourceRecordDnsKey",
+					CimInstanceProperties: k.recordData(),
+					CimSystemProperties:   "Microsoft.Management.Infrastructure.CimSystemProperties",
+				},
+			})
+		}
+	}
+
+	return arrayJson(anchors)
+}
+
+// readRecords returns the records of a Get-DnsServerResourceRecord call.
+func (c *Connection) readRecords(args string) ([]*record, error) {
+	p, err := parseParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.findRecords("Get-DnsServerResourceRecord", p)
+}
+
+func (c *Connection) recordReadArray(match []string) (string, error) {
+	records, err := c.readRecords(match[1])
+	if err != nil {
+		// The output is always converted to an array, even if the record was not found.
+		stdout, _ := arrayJson([]recordJson{})
+		return stdout, err
+	}
+
+	return arrayJson(recordsJson(records))
+}
+
+func (c *Connection) recordRead(match []string) (string, error) {
+	records, err := c.readRecords(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	return pipelineJson(recordsJson(records))
+}
+
+// createRecords adds the records of an Add-DnsServerResourceRecord* call.
+// A record is created for each value of the record data parameter.
+// The generic Add-DnsServerResourceRecord cmdlet selects the record type with a switch parameter, e.g. "-Srv".
+func (c *Connection) createRecords(recordType string, args string) ([]*record, error) {
+	cmdlet := "Add-DnsServerResourceRecord" + recordType
+
+	p, err := parseParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordType == "" {
+		for name := range recordTypes {
+			if p.flag(name) {
+				recordType = name
+			}
+		}
+	}
+
+	rt, ok := recordTypes[strings.ToLower(recordType)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCommand, cmdlet)
+	}
+
+	zoneName, name := p.str("ZoneName"), p.str("Name")
+	zoneScope, err := c.findZoneScope(cmdlet, zoneName, p.str("ZoneScope"))
+	if err != nil {
+		return nil, err
+	}
+
+	timeToLive, err := p.timespan("TimeToLive")
+	if err != nil {
+		return nil, err
+	}
+
+	// The data property with multiple values, e.g. the IPv4Address of an A-Record, creates multiple records.
+	property := rt.properties[0]
+	values := p.list(property)
+	if !strings.HasPrefix(p[strings.ToLower(property)], "@(") {
+		values = []string{p.str(property)}
+	}
+
+	var records []*record
+	for _, value := range values {
+		data := parsing.CimClassKeyVal{}
+		if data[property], err = recordDataValue(property, value); err != nil {
+			return nil, err
+		}
+		for _, other := range rt.properties[1:] {
+			if data[other], err = recordDataValue(other, p.str(other)); err != nil {
+				return nil, err
+			}
+		}
+
+		r := &record{
+			zone:       zoneName,
+			zoneScope:  zoneScope,
+			name:       name,
+			recordType: rt.name,
+			timeToLive: timeToLive,
+			data:       data,
+		}
+
+		// Aged records get a timestamp like dynamically updated records, which is rounded down to the hour.
+		if p.flag("AgeRecord") {
+			r.timestamp = time.Now().UTC().Truncate(time.Hour)
+		}
+
+		records = append(records, r)
+	}
+
+	// Reject records with rejected record data.
+	for _, r := range records {
+		for _, value := range r.data {
+			if slices.ContainsFunc(c.rejectedData, func(rejected string) bool { return strings.EqualFold(rejected, value) }) {
+				return nil, &cmdletError{
+					cmdlet:    cmdlet,
+					message:   fmt.Sprintf(`Failed to create resource record %s in zone %s on server %s.`, name, zoneName, ComputerName),
+					category:  "PermissionDenied",
+					target:    fmt.Sprintf("%s:root/Microsoft/...ResourceRecord%s", name, rt.name),
+					exception: "CimException",
+					errorId:   "WIN32 5," + cmdlet,
+				}
+			}
+		}
+	}
+
+	// Reject records that already exist.
+	// A CName record must be the only record with its name.
+	for _, existing := range c.records {
+		if !existing.in(zoneName, zoneScope) || !strings.EqualFold(existing.name, name) || existing.recordType != rt.name {
+			continue
+		}
+
+		for _, r := range records {
+			if rt.name == "CNAME" || equalData(existing.data, r.data) {
+				return nil, &cmdletError{
+					cmdlet:    cmdlet,
+					message:   fmt.Sprintf(`Failed to create resource record %s in zone %s on server %s.`, name, zoneName, ComputerName),
+					category:  "ResourceExists",
+					target:    fmt.Sprintf("%s:root/Microsoft/...ResourceRecord%s", name, rt.name),
+					exception: "CimException",
+					errorId:   "WIN32 9711," + cmdlet,
+				}
+			}
+		}
+	}
+
+	c.records = append(c.records, records...)
+
+	return records, nil
+}
+
+// equalData returns true if the record data of two records is equal.
+func equalData(a parsing.CimClassKeyVal, b parsing.CimClassKeyVal) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if !strings.EqualFold(b[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Connection) recordCreateArray(match []string) (string, error) {
+	records, err := c.createRecords(match[1], match[2])
+	if err != nil {
+		stdout, _ := arrayJson([]recordJson{})
+		return stdout, err
+	}
+
+	return arrayJson(recordsJson(records))
+}
+
+func (c *Connection) recordCreate(match []string) (string, error) {
+	records, err := c.createRecords(match[1], match[2])
+	if err != nil {
+		return "", err
+	}
+
+	return pipelineJson(recordsJson(records))
+}
+
+// recordAddEachRegex matches a single Add-DnsServerResourceRecord* call of a recordAddEach command.
+var recordAddEachRegex = regexp.MustCompile(`^\$r\+=Add-DnsServerResourceRecord(\w*) (.+)$`)
+
+// recordAddEach handles the commands that add a record for each entry in separate calls within a try block,
+// e.g. "$r=@() ;try{$r+=Add-DnsServerResourceRecordMX ...;$r+=Add-DnsServerResourceRecordMX ...}catch{...}".
+// Like the catch block, the records that were already added are removed again if a call fails.
+func (c *Connection) recordAddEach(match []string) (string, error) {
+	var records []*record
+	for _, call := range strings.Split(strings.TrimPrefix(match[1], "$r+="), ";$r+=") {
+		callMatch := recordAddEachRegex.FindStringSubmatch("$r+=" + call)
+		if callMatch == nil {
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedCommand, call)
+		}
+
+		created, err := c.createRecords(callMatch[1], callMatch[2])
+		if err != nil {
+			for _, r := range records {
+				c.records = removeItem(c.records, r)
+			}
+			return "", err
+		}
+		records = append(records, created...)
+	}
+
+	return arrayJson(recordsJson(records))
+}
+
+// recordReplaceEach handles the commands that replace the records of a name and record type,
+// e.g. "$o=@(Get-DnsServerResourceRecord ...) ;$r=@() ;try{$o|Remove-DnsServerResourceRecord ... ;$r+=Add-DnsServerResourceRecordMX ...}catch{...}".
+// Like the catch block, the removed records are added again if a call fails.
+func (c *Connection) recordReplaceEach(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	// Records that cannot be read are skipped like the ErrorAction SilentlyContinue of the read.
+	existing, _ := c.findRecords("Get-DnsServerResourceRecord", p)
+	for _, r := range existing {
+		c.records = removeItem(c.records, r)
+	}
+
+	if _, err := c.recordAddEach([]string{"", match[2]}); err != nil {
+		c.records = append(c.records, existing...)
+		return "", err
+	}
+
+	return "", nil
+}
+
+func (c *Connection) recordUpdateTimeToLive(match []string) (string, error) {
+	records, err := c.readRecords(match[1])
+	if err != nil {
+		stdout, _ := arrayJson([]recordJson{})
+		return stdout, err
+	}
+
+	seconds, err := time.ParseDuration(match[2] + "s")
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range records {
+		r.timeToLive = seconds
+	}
+
+	return arrayJson(recordsJson(records))
+}
+
+func (c *Connection) recordUpdate(match []string) (string, error) {
+	records, err := c.readRecords(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	seconds, err := time.ParseDuration(match[2] + "s")
+	if err != nil {
+		return "", err
+	}
+
+	value, err := recordDataValue(match[3], unquote("'"+match[4]+"'"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range records {
+		r.timeToLive = seconds
+		r.data[match[3]] = value
+	}
+
+	return pipelineJson(recordsJson(records))
+}
+
+func (c *Connection) recordDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	records, err := c.findRecords("Remove-DnsServerResourceRecord", p)
+	if err != nil {
+		return "", err
+	}
+
+	// The RecordData removes a single record of the node.
+	if p.has("RecordData") {
+		property := recordTypes[strings.ToLower(p.str("RRType"))].properties[0]
+		value, err := recordDataValue(property, p.str("RecordData"))
+		if err != nil {
+			return "", err
+		}
+
+		records = slices.DeleteFunc(records, func(r *record) bool {
+			return !strings.EqualFold(r.data[property], value)
+		})
+	}
+
+	for _, r := range records {
+		c.records = removeItem(c.records, r)
+	}
+
+	return "", nil
+}
+
+// recordCallsRegex matches the commands that only consist of add and remove calls of records,
+// e.g. the update of the PTR-Records of an A-Record.
+const recordCallsRegex = `(?:Add-DnsServerResourceRecord\w*|Remove-DnsServerResourceRecord) [^;]+ -ErrorAction (?:Stop|SilentlyContinue)`
+
+// runRecordCalls runs the add and remove calls of records that are separated by " ;".
+// Like the ErrorAction Stop of the calls, it stops at the first call that fails.
+// The errors of calls with the ErrorAction SilentlyContinue are ignored.
+func (c *Connection) runRecordCalls(calls string) error {
+	for _, call := range strings.Split(calls, " ;") {
+		call, silent := strings.CutSuffix(call, " -ErrorAction SilentlyContinue")
+		call = strings.TrimSuffix(call, " -ErrorAction Stop")
+
+		var err error
+		if cmdlet, args, _ := strings.Cut(call, " "); strings.HasPrefix(cmdlet, "Add-") {
+			_, err = c.createRecords(strings.TrimPrefix(cmdlet, "Add-DnsServerResourceRecord"), args)
+		} else {
+			_, err = c.handle(call)
+		}
+
+		var cmdletErr *cmdletError
+		if err != nil && !(silent && errors.As(err, &cmdletErr)) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Connection) recordCalls(match []string) (string, error) {
+	return "", c.runRecordCalls(match[1])
+}
+
+// recordReplace handles the commands that replace the addresses of an A- or AAAA-Record
+// with separate add and remove calls before the TTL update.
+func (c *Connection) recordReplace(match []string) (string, error) {
+	if err := c.runRecordCalls(strings.TrimSuffix(match[1], " ;")); err != nil {
+		return "", err
+	}
+
+	return c.handle(match[2])
+}
+
+// delegationJson is the JSON representation of a name server of a zone delegation,
+// as projected by the read command of the windows/dns package.
+type delegationJson struct {
+	ChildZoneName string   `json:"ChildZoneName"`
+	NameServer    string   `json:"NameServer"`
+	IPAddress     []string `json:"IPAddress"`
+}
+
+// delegationReadRegex matches the read command of a zone delegation.
+const delegationReadRegex = `\$d=@\(Get-DnsServerZoneDelegation (.+?) \| ForEach-Object\{.+\}\) ;if\(\$d\.Count -ge 2\)\{ConvertTo-Json \$d -Compress\}else\{ConvertTo-Json @\(\$d\) -Compress\}`
+
+// delegationNode returns the node of the child zone in the parent zone, e.g. "child" for "child.test.local".
+func delegationNode(zoneName string, childZoneName string) string {
+	childZoneName = strings.TrimSuffix(childZoneName, ".")
+	if len(childZoneName) > len(zoneName) && strings.HasSuffix(strings.ToLower(childZoneName), "."+strings.ToLower(zoneName)) {
+		return childZoneName[:len(childZoneName)-len(zoneName)-1]
+	}
+	return childZoneName
+}
+
+// glueNode returns the node of the glue records of a name server in the parent zone.
+// Name servers outside of the parent zone have no glue records.
+func glueNode(zoneName string, nameServer string) (string, bool) {
+	nameServer = strings.TrimSuffix(nameServer, ".")
+	suffix := "." + strings.ToLower(zoneName)
+	if !strings.HasSuffix(strings.ToLower(nameServer), suffix) {
+		return "", false
+	}
+	return nameServer[:len(nameServer)-len(suffix)], true
+}
+
+// delegationNotFound returns the error of a zone delegation that does not exist.
+func delegationNotFound(cmdlet string, zoneName string, childZoneName string) *cmdletError {
+	return &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("Failed to get the zone delegation %s in zone %s on server %s.", childZoneName, zoneName, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...ZoneDelegation", childZoneName),
+		exception: "CimException",
+		errorId:   "WIN32 9714," + cmdlet,
+	}
+}
+
+// delegationRecords returns the NS-Records of a zone delegation.
+func (c *Connection) delegationRecords(zoneName string, node string) []*record {
+	var records []*record
+	for _, r := range c.records {
+		if r.in(zoneName, "") && strings.EqualFold(r.name, node) && r.recordType == "NS" {
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+// setGlueRecords replaces the glue records of a name server with the given addresses.
+func (c *Connection) setGlueRecords(zoneName string, nameServer string, addresses []string) error {
+	node, ok := glueNode(zoneName, nameServer)
+	if !ok {
+		return nil
+	}
+
+	for _, r := range slices.Clone(c.records) {
+		if r.in(zoneName, "") && strings.EqualFold(r.name, node) && (r.recordType == "A" || r.recordType == "AAAA") {
+			c.records = removeItem(c.records, r)
+		}
+	}
+
+	for _, address := range addresses {
+		ip, err := netip.ParseAddr(address)
+		if err != nil {
+			return err
+		}
+
+		r := &record{zone: zoneName, name: node, recordType: "A", timeToLive: delegationTimeToLive, data: parsing.CimClassKeyVal{"IPv4Address": ip.String()}}
+		if ip.Is6() {
+			r.recordType = "AAAA"
+			r.data = parsing.CimClassKeyVal{"IPv6Address": ip.String()}
+		}
+		c.records = append(c.records, r)
+	}
+
+	return nil
+}
+
+// changeDelegation handles a single Add-DnsServerZoneDelegation or Set-DnsServerZoneDelegation call.
+// It returns the fully qualified name of the added or changed name server.
+func (c *Connection) changeDelegation(call string) (string, error) {
+	cmdlet, args, _ := strings.Cut(call, " ")
+
+	p, err := parseParams(args)
+	if err != nil {
+		return "", err
+	}
+
+	zoneName := p.str("Name")
+	if _, err := c.findZone(cmdlet, zoneName); err != nil {
+		return "", err
+	}
+
+	node := delegationNode(zoneName, p.str("ChildZoneName"))
+	nameServer, _ := recordDataValue("NameServer", p.str("NameServer"))
+
+	var existing *record
+	for _, r := range c.delegationRecords(zoneName, node) {
+		if strings.EqualFold(r.data["NameServer"], nameServer) {
+			existing = r
+		}
+	}
+
+	switch cmdlet {
+	case "Add-DnsServerZoneDelegation":
+		if existing != nil {
+			return "", &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("Failed to add the zone delegation %s in zone %s on server %s.", node, zoneName, ComputerName),
+				category:  "ResourceExists",
+				target:    fmt.Sprintf("%s:root/Microsoft/...ZoneDelegation", node),
+				exception: "CimException",
+				errorId:   "WIN32 9711," + cmdlet,
+			}
+		}
+
+		c.records = append(c.records, &record{
+			zone:       zoneName,
+			name:       node,
+			recordType: "NS",
+			timeToLive: delegationTimeToLive,
+			data:       parsing.CimClassKeyVal{"NameServer": nameServer},
+		})
+	case "Set-DnsServerZoneDelegation":
+		if existing == nil {
+			return "", delegationNotFound(cmdlet, zoneName, node)
+		}
+	}
+
+	return nameServer, c.setGlueRecords(zoneName, nameServer, p.list("IPAddress"))
+}
+
+// delegationRead handles the read command of a zone delegation.
+func (c *Connection) delegationRead(match []string) (string, error) {
+	return c.readDelegation(match[1])
+}
+
+// delegationCreate handles the command that adds each name server of a zone delegation.
+// Like the catch block of the command, the name servers that were already added are removed again
+// if a name server cannot be added.
+func (c *Connection) delegationCreate(match []string) (string, error) {
+	var added []string
+	for _, call := range strings.Split(match[1], " ;") {
+		if strings.HasPrefix(call, "$n+=") {
+			continue
+		}
+
+		nameServer, err := c.changeDelegation(call)
+		if err != nil {
+			c.removeDelegationNameServers(match[2], added)
+			stdout, _ := arrayJson([]delegationJson{})
+			return stdout, err
+		}
+		added = append(added, nameServer)
+	}
+
+	return c.readDelegation(match[2])
+}
+
+// delegationChangeRegex matches the change of a single name server in the update command of a zone delegation.
+var delegationChangeRegex = regexp.MustCompile(`if\(\$e -contains '([^']*)'\)\{(Set-DnsServerZoneDelegation [^}]+)\}else\{(Add-DnsServerZoneDelegation [^}]+?) ;\$n\+='[^']*'\}`)
+
+// delegationUpdate handles the update command of a zone delegation.
+// The name servers of the delegation are updated, the new name servers are added
+// and the name servers that are not specified are removed afterwards.
+func (c *Connection) delegationUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	zoneName := p.str("Name")
+	if _, err := c.findZone("Get-DnsServerZoneDelegation", zoneName); err != nil {
+		return "", err
+	}
+
+	node := delegationNode(zoneName, p.str("ChildZoneName"))
+	existing := c.delegationRecords(zoneName, node)
+	if len(existing) == 0 {
+		return "", delegationNotFound("Get-DnsServerZoneDelegation", zoneName, node)
+	}
+
+	isExisting := func(nameServer string) bool {
+		return slices.ContainsFunc(existing, func(r *record) bool { return strings.EqualFold(r.data["NameServer"], nameServer) })
+	}
+
+	var added []string
+	for _, change := range delegationChangeRegex.FindAllStringSubmatch(match[2], -1) {
+		if isExisting(change[1]) {
+			if _, err := c.changeDelegation(change[2]); err != nil {
+				c.removeDelegationNameServers(match[1], added)
+				stdout, _ := arrayJson([]delegationJson{})
+				return stdout, err
+			}
+			continue
+		}
+
+		nameServer, err := c.changeDelegation(change[3])
+		if err != nil {
+			c.removeDelegationNameServers(match[1], added)
+			stdout, _ := arrayJson([]delegationJson{})
+			return stdout, err
+		}
+		added = append(added, nameServer)
+	}
+
+	keep := strings.Split(match[3], ",")
+	for _, r := range existing {
+		if !slices.ContainsFunc(keep, func(ns string) bool { return strings.EqualFold(strings.Trim(ns, "'"), r.data["NameServer"]) }) {
+			c.removeDelegationNameServers(match[1], []string{r.data["NameServer"]})
+		}
+	}
+
+	return c.readDelegation(match[4])
+}
+
+// removeDelegationNameServers removes name servers with their glue records from a zone delegation.
+// The zone delegation is given by the parameters of a Get-DnsServerZoneDelegation call.
+func (c *Connection) removeDelegationNameServers(args string, nameServers []string) {
+	p, err := parseParams(args)
+	if err != nil {
+		return
+	}
+
+	zoneName := p.str("Name")
+	node := delegationNode(zoneName, p.str("ChildZoneName"))
+
+	for _, nameServer := range nameServers {
+		for _, r := range c.delegationRecords(zoneName, node) {
+			if strings.EqualFold(r.data["NameServer"], nameServer) {
+				c.records = removeItem(c.records, r)
+			}
+		}
+		_ = c.setGlueRecords(zoneName, nameServer, nil)
+	}
+}
+
+// readDelegation returns the name servers of a zone delegation with the addresses of their glue records.
+func (c *Connection) readDelegation(args string) (string, error) {
+	p, err := parseParams(args)
+	if err != nil {
+		return "", err
+	}
+
+	zoneName := p.str("Name")
+	if _, err := c.findZone("Get-DnsServerZoneDelegation", zoneName); err != nil {
+		stdout, _ := arrayJson([]delegationJson{})
+		return stdout, err
+	}
+
+	node := delegationNode(zoneName, p.str("ChildZoneName"))
+	nameServers := c.delegationRecords(zoneName, node)
+	if len(nameServers) == 0 {
+		stdout, _ := arrayJson([]delegationJson{})
+		return stdout, delegationNotFound("Get-DnsServerZoneDelegation", zoneName, node)
+	}
+
+	result := make([]delegationJson, 0, len(nameServers))
+	for _, ns := range nameServers {
+		d := delegationJson{
+			ChildZoneName: node + "." + zoneName,
+			NameServer:    ns.data["NameServer"],
+		}
+
+		// Name servers without glue records return a single empty address.
+		if glue, ok := glueNode(zoneName, ns.data["NameServer"]); ok {
+			for _, r := range c.records {
+				if r.in(zoneName, "") && strings.EqualFold(r.name, glue) && (r.recordType == "A" || r.recordType == "AAAA") {
+					d.IPAddress = append(d.IPAddress, r.data["IPv4Address"]+r.data["IPv6Address"])
+				}
+			}
+		}
+		if len(d.IPAddress) == 0 {
+			d.IPAddress = []string{""}
+		}
+
+		result = append(result, d)
+	}
+
+	return arrayJson(result)
+}
+
+// delegationDelete removes a zone delegation with all records of the delegated subtree.
+func (c *Connection) delegationDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	zoneName := p.str("Name")
+	if _, err := c.findZone("Remove-DnsServerZoneDelegation", zoneName); err != nil {
+		return "", err
+	}
+
+	node := delegationNode(zoneName, p.str("ChildZoneName"))
+	if len(c.delegationRecords(zoneName, node)) == 0 {
+		return "", delegationNotFound("Remove-DnsServerZoneDelegation", zoneName, node)
+	}
+
+	for _, r := range slices.Clone(c.records) {
+		if strings.EqualFold(r.zone, zoneName) && (strings.EqualFold(r.name, node) || strings.HasSuffix(strings.ToLower(r.name), "."+strings.ToLower(node))) {
+			c.records = removeItem(c.records, r)
+		}
+	}
+
+	return "", nil
+}
+
+// soaJson is the JSON representation of a SOA-Record,
+// as projected by the read command of the windows/dns package.
+type soaJson struct {
+	DistinguishedName string                  `json:"DistinguishedName"`
+	HostName          string                  `json:"HostName"`
+	TimeToLive        parsing.CimTimeDuration `json:"TimeToLive"`
+	PrimaryServer     string                  `json:"PrimaryServer"`
+	ResponsiblePerson string                  `json:"ResponsiblePerson"`
+	SerialNumber      uint32                  `json:"SerialNumber"`
+	RefreshInterval   parsing.CimTimeDuration `json:"RefreshInterval"`
+	RetryDelay        parsing.CimTimeDuration `json:"RetryDelay"`
+	ExpireLimit       parsing.CimTimeDuration `json:"ExpireLimit"`
+	MinimumTimeToLive parsing.CimTimeDuration `json:"MinimumTimeToLive"`
+}
+
+// soaJson returns the JSON of the SOA-Record of a zone.
+func (z *zone) soaJson() (string, error) {
+	s := z.soaRecord()
+
+	b, err := json.Marshal(soaJson{
+		DistinguishedName: fmt.Sprintf("DC=@,DC=%s,cn=MicrosoftDNS,DC=DomainDnsZones,%s", z.name, domainDn()),
+		HostName:          "@",
+		TimeToLive:        parsing.CimTimeDuration{Duration: time.Hour},
+		PrimaryServer:     strings.ToLower(ComputerName) + "." + Domain + ".",
+		ResponsiblePerson: "hostmaster." + Domain + ".",
+		SerialNumber:      s.serialNumber,
+		RefreshInterval:   parsing.CimTimeDuration{Duration: s.refreshInterval},
+		RetryDelay:        parsing.CimTimeDuration{Duration: s.retryDelay},
+		ExpireLimit:       parsing.CimTimeDuration{Duration: s.expireLimit},
+		MinimumTimeToLive: parsing.CimTimeDuration{Duration: s.minimumTimeToLive},
+	})
+	return string(b), err
+}
+
+// soaZone returns the zone of a Get-DnsServerResourceRecord call for the SOA-Record.
+func (c *Connection) soaZone(args string) (*zone, error) {
+	p, err := parseParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.findZone("Get-DnsServerResourceRecord", p.str("ZoneName"))
+}
+
+func (c *Connection) soaRead(match []string) (string, error) {
+	z, err := c.soaZone(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	return z.soaJson()
+}
+
+func (c *Connection) soaUpdate(match []string) (string, error) {
+	z, err := c.soaZone(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	// Apply the assignments to a copy, so a failed update does not change the SOA-Record.
+	s := *z.soaRecord()
+
+	// The new serial number must be greater than the current serial number.
+	if match[2] != "" {
+		serialNumber, err := strconv.ParseUint(match[2], 10, 32)
+		if err != nil {
+			return "", err
+		}
+		if !serialGreater(uint32(serialNumber), s.serialNumber) {
+			return "", thrownError(strings.ReplaceAll(match[3], "$s", strconv.FormatUint(uint64(s.serialNumber), 10)))
+		}
+	}
+
+	for _, assignment := range strings.Split(strings.TrimSuffix(match[4], " ;"), " ;") {
+		assignmentMatch := soaAssignmentRegex.FindStringSubmatch(assignment)
+		if assignmentMatch == nil {
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedCommand, assignment)
+		}
+
+		if assignmentMatch[2] == soaSerialIncrement {
+			s.serialNumber++
+			continue
+		}
+
+		value, err := strconv.ParseUint(assignmentMatch[2], 10, 32)
+		if err != nil {
+			return "", err
+		}
+
+		switch assignmentMatch[1] {
+		case "SerialNumber":
+			s.serialNumber = uint32(value)
+		case "RefreshInterval":
+			s.refreshInterval = time.Duration(value) * time.Second
+		case "RetryDelay":
+			s.retryDelay = time.Duration(value) * time.Second
+		case "ExpireLimit":
+			s.expireLimit = time.Duration(value) * time.Second
+		case "MinimumTimeToLive":
+			s.minimumTimeToLive = time.Duration(value) * time.Second
+		default:
+			return "", fmt.Errorf("%w: %s", ErrUnsupportedCommand, assignment)
+		}
+	}
+	z.soa = &s
+
+	return z.soaJson()
+}
+
+// recordFilters maps the patterns of the Where-Object conditions of a record listing to their record filters.
+var recordFilters = []struct {
+	pattern *regexp.Regexp
+	filter  func(r *record, match []string) bool
+}{
+	{regexp.MustCompile(`^\$_\.HostName\.StartsWith\('((?:[^']|'')*)','OrdinalIgnoreCase'\)$`), func(r *record, match []string) bool {
+		return strings.HasPrefix(strings.ToLower(r.name), strings.ToLower(unquote("'"+match[1]+"'")))
+	}},
+	{regexp.MustCompile(`^\$_\.HostName\.EndsWith\('((?:[^']|'')*)','OrdinalIgnoreCase'\)$`), func(r *record, match []string) bool {
+		return strings.HasSuffix(strings.ToLower(r.name), strings.ToLower(unquote("'"+match[1]+"'")))
+	}},
+	{regexp.MustCompile(`^\$_\.Timestamp -eq \$null$`), func(r *record, match []string) bool {
+		return r.timestamp.IsZero()
+	}},
+	{regexp.MustCompile(`^\$_\.Timestamp -ne \$null$`), func(r *record, match []string) bool {
+		return !r.timestamp.IsZero()
+	}},
+	{regexp.MustCompile(`^\$_\.Timestamp -lt \(Get-Date\)\.AddSeconds\(-(\d+)\)$`), func(r *record, match []string) bool {
+		seconds, _ := strconv.ParseInt(match[1], 10, 64)
+		return r.timestamp.Before(time.Now().Add(-time.Duration(seconds) * time.Second))
+	}},
+}
+
+// recordList handles the listing of the records of a zone, which returns a JSON object per line.
+func (c *Connection) recordList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	zoneName, recordType := p.str("ZoneName"), p.str("RRType")
+	zoneScope, err := c.findZoneScope("Get-DnsServerResourceRecord", zoneName, p.str("ZoneScope"))
+	if err != nil {
+		return "", err
+	}
+
+	var conditions []string
+	if match[2] != "" {
+		conditions = strings.Split(match[2], " -and ")
+	}
+
+	var records []*record
+	for _, r := range c.records {
+		if !r.in(zoneName, zoneScope) || (recordType != "" && !strings.EqualFold(r.recordType, recordType)) {
+			continue
+		}
+
+		matches, err := matchRecordFilters(r, conditions)
+		if err != nil {
+			return "", err
+		}
+		if matches {
+			records = append(records, r)
+		}
+	}
+
+	// Like the Sort-Object cmdlet, the records are sorted case-insensitive and the order of equal records is kept.
+	if match[3] != "" {
+		slices.SortStableFunc(records, func(a *record, b *record) int {
+			return cmp.Or(
+				cmp.Compare(strings.ToLower(a.name), strings.ToLower(b.name)),
+				cmp.Compare(strings.ToLower(a.recordType), strings.ToLower(b.recordType)),
+			)
+		})
+	}
+
+	var lines []string
+	for _, r := range records {
+		b, err := json.Marshal(r.json())
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, string(b))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// matchRecordFilters returns true if the record matches all conditions of a Where-Object filter.
+func matchRecordFilters(r *record, conditions []string) (bool, error) {
+	for _, condition := range conditions {
+		supported := false
+		for _, f := range recordFilters {
+			if m := f.pattern.FindStringSubmatch(condition); m != nil {
+				supported = true
+				if !f.filter(r, m) {
+					return false, nil
+				}
+			}
+		}
+
+		if !supported {
+			return false, fmt.Errorf("%w: %s", ErrUnsupportedCommand, condition)
+		}
+	}
+
+	return true, nil
+}
+
+// zoneScopeJson is the JSON representation of a zone scope.
+type zoneScopeJson struct {
+	ZoneScope      string  `json:"ZoneScope"`
+	FileName       string  `json:"FileName"`
+	PSComputerName *string `json:"PSComputerName"`
+}
+
+// zoneScopeJson returns the JSON representation of a zone scope of the zone.
+// Active Directory integrated zones store their zone scopes in the directory, so they have no file name.
+func (z *zone) zoneScopeJson(scope string) zoneScopeJson {
+	j := zoneScopeJson{ZoneScope: scope}
+	if !z.dsIntegrated {
+		j.FileName = scope + ".dns"
+	}
+	return j
+}
+
+// zoneScopeNotFound returns the error of a zone scope that does not exist.
+func zoneScopeNotFound(cmdlet string, zoneName string, scope string) *cmdletError {
+	return &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("The zone scope %s does not exist in zone %s on server %s.", scope, zoneName, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...rverZoneScope", scope),
+		exception: "CimException",
+		errorId:   "WIN32 9954," + cmdlet,
+	}
+}
+
+// findZoneScopeParams returns the zone and the zone scope of a zone scope call.
+func (c *Connection) findZoneScopeParams(cmdlet string, args string) (*zone, string, error) {
+	p, err := parseParams(args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	z, err := c.findZone(cmdlet, p.str("ZoneName"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return z, p.str("Name"), nil
+}
+
+func (c *Connection) zoneScopeRead(match []string) (string, error) {
+	cmdlet := "Get-DnsServerZoneScope"
+
+	z, name, err := c.findZoneScopeParams(cmdlet, match[1])
+	if err != nil {
+		return "", err
+	}
+
+	zoneScope, err := c.findZoneScope(cmdlet, z.name, name)
+	if err != nil {
+		return "", err
+	}
+	if zoneScope == "" {
+		zoneScope = z.name
+	}
+
+	b, err := json.Marshal(z.zoneScopeJson(zoneScope))
+	return string(b), err
+}
+
+func (c *Connection) zoneScopeList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	z, err := c.findZone("Get-DnsServerZoneScope", p.str("ZoneName"))
+	if err != nil {
+		return "", err
+	}
+
+	scopes := []zoneScopeJson{z.zoneScopeJson(z.name)}
+	for _, scope := range z.scopes {
+		scopes = append(scopes, z.zoneScopeJson(scope))
+	}
+	return arrayJson(scopes)
+}
+
+func (c *Connection) zoneScopeCreate(match []string) (string, error) {
+	cmdlet := "Add-DnsServerZoneScope"
+
+	z, name, err := c.findZoneScopeParams(cmdlet, match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if strings.EqualFold(name, z.name) || slices.ContainsFunc(z.scopes, func(s string) bool { return strings.EqualFold(s, name) }) {
+		return "", &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("The zone scope %s already exists in zone %s on server %s.", name, z.name, ComputerName),
+			category:  "ResourceExists",
+			target:    fmt.Sprintf("%s:root/Microsoft/...rverZoneScope", name),
+			exception: "CimException",
+			errorId:   "WIN32 9953," + cmdlet,
+		}
+	}
+
+	z.scopes = append(z.scopes, name)
+
+	b, err := json.Marshal(z.zoneScopeJson(name))
+	return string(b), err
+}
+
+// zoneScopeDelete removes a zone scope and its records.
+// The default zone scope and zone scopes that are referenced by a policy can not be removed.
+func (c *Connection) zoneScopeDelete(match []string) (string, error) {
+	cmdlet := "Remove-DnsServerZoneScope"
+
+	z, name, err := c.findZoneScopeParams(cmdlet, match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if strings.EqualFold(name, z.name) {
+		return "", &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("The default zone scope of zone %s on server %s can not be removed.", z.name, ComputerName),
+			category:  "InvalidOperation",
+			target:    fmt.Sprintf("%s:root/Microsoft/...rverZoneScope", name),
+			exception: "CimException",
+			errorId:   "WIN32 9955," + cmdlet,
+		}
+	}
+
+	zoneScope, err := c.findZoneScope(cmdlet, z.name, name)
+	if err != nil {
+		return "", err
+	}
+
+	for _, pol := range c.policies {
+		if strings.EqualFold(pol.zone, z.name) && slices.ContainsFunc(pol.content, func(content policyContent) bool { return strings.EqualFold(content.scope, zoneScope) }) {
+			return "", &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("The zone scope %s of zone %s is referenced by the policy %s on server %s.", zoneScope, z.name, pol.name, ComputerName),
+				category:  "InvalidOperation",
+				target:    fmt.Sprintf("%s:root/Microsoft/...rverZoneScope", zoneScope),
+				exception: "CimException",
+				errorId:   "WIN32 9967," + cmdlet,
+			}
+		}
+	}
+
+	z.scopes = removeItem(z.scopes, zoneScope)
+	c.records = slices.DeleteFunc(c.records, func(r *record) bool {
+		return r.in(z.name, zoneScope)
+	})
+
+	return "", nil
+}
+
+// clientSubnet represents a client subnet of the fake server.
+type clientSubnet struct {
+	name        string
+	ipv4Subnets []string
+	ipv6Subnets []string
+}
+
+// clientSubnetJson is the JSON representation of a client subnet.
+type clientSubnetJson struct {
+	Name           string   `json:"Name"`
+	IPV4Subnet     []string `json:"IPV4Subnet"`
+	IPV6Subnet     []string `json:"IPV6Subnet"`
+	PSComputerName *string  `json:"PSComputerName"`
+}
+
+// json returns the JSON representation of the client subnet.
+func (s *clientSubnet) json() clientSubnetJson {
+	return clientSubnetJson{
+		Name:       s.name,
+		IPV4Subnet: slices.Clone(s.ipv4Subnets),
+		IPV6Subnet: slices.Clone(s.ipv6Subnets),
+	}
+}
+
+// findClientSubnet returns the client subnet with the given name.
+func (c *Connection) findClientSubnet(cmdlet string, name string) (*clientSubnet, error) {
+	for _, s := range c.clientSubnets {
+		if strings.EqualFold(s.name, name) {
+			return s, nil
+		}
+	}
+
+	return nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("The client subnet %s does not exist on server %s.", name, ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...erClientSubnet", name),
+		exception: "CimException",
+		errorId:   "WIN32 9976," + cmdlet,
+	}
+}
+
+// subnets returns the normalized subnets of a subnet parameter of a client subnet call.
+func subnets(p params, name string, ipv6 bool) ([]string, error) {
+	var result []string
+	for _, value := range p.list(name) {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil || prefix.Addr().Is6() != ipv6 {
+			return nil, fmt.Errorf("connection.fake: parameter %s is not a list of subnets: %s", name, p[strings.ToLower(name)])
+		}
+		result = append(result, prefix.Masked().String())
+	}
+	return result, nil
+}
+
+func (c *Connection) clientSubnetRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findClientSubnet("Get-DnsServerClientSubnet", p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(s.json())
+	return string(b), err
+}
+
+func (c *Connection) clientSubnetList(match []string) (string, error) {
+	subnets := make([]clientSubnetJson, 0, len(c.clientSubnets))
+	for _, s := range c.clientSubnets {
+		subnets = append(subnets, s.json())
+	}
+	return arrayJson(subnets)
+}
+
+func (c *Connection) clientSubnetCreate(match []string) (string, error) {
+	cmdlet := "Add-DnsServerClientSubnet"
+
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	name := p.str("Name")
+	if _, err := c.findClientSubnet(cmdlet, name); err == nil {
+		return "", &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("The client subnet %s already exists on server %s.", name, ComputerName),
+			category:  "ResourceExists",
+			target:    fmt.Sprintf("%s:root/Microsoft/...erClientSubnet", name),
+			exception: "CimException",
+			errorId:   "WIN32 9977," + cmdlet,
+		}
+	}
+
+	s := &clientSubnet{name: name}
+	if s.ipv4Subnets, err = subnets(p, "IPv4Subnet", false); err != nil {
+		return "", err
+	}
+	if s.ipv6Subnets, err = subnets(p, "IPv6Subnet", true); err != nil {
+		return "", err
+	}
+	c.clientSubnets = append(c.clientSubnets, s)
+
+	b, err := json.Marshal(s.json())
+	return string(b), err
+}
+
+// clientSubnetUpdate replaces the subnets of a client subnet
+// and removes the subnets of the address family that is checked by the command.
+func (c *Connection) clientSubnetUpdate(match []string) (string, error) {
+	cmdlet := "Set-DnsServerClientSubnet"
+
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findClientSubnet(cmdlet, p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("IPv4Subnet") {
+		if s.ipv4Subnets, err = subnets(p, "IPv4Subnet", false); err != nil {
+			return "", err
+		}
+	}
+	if p.has("IPv6Subnet") {
+		if s.ipv6Subnets, err = subnets(p, "IPv6Subnet", true); err != nil {
+			return "", err
+		}
+	}
+
+	switch strings.ToLower(match[2]) {
+	case "ipv4subnet":
+		s.ipv4Subnets = nil
+	case "ipv6subnet":
+		s.ipv6Subnets = nil
+	}
+
+	return c.clientSubnetRead([]string{"", match[3]})
+}
+
+// clientSubnetDelete removes a client subnet. Client subnets that are used by a policy can not be removed.
+func (c *Connection) clientSubnetDelete(match []string) (string, error) {
+	cmdlet := "Remove-DnsServerClientSubnet"
+
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	s, err := c.findClientSubnet(cmdlet, p.str("Name"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, pol := range c.policies {
+		if slices.ContainsFunc(pol.criteria, func(criteria policyCriteria) bool { return criteria.usesClientSubnet(s.name) }) {
+			return "", &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("The client subnet %s is used by the policy %s on server %s.", s.name, pol.name, ComputerName),
+				category:  "InvalidOperation",
+				target:    fmt.Sprintf("%s:root/Microsoft/...erClientSubnet", s.name),
+				exception: "CimException",
+				errorId:   "WIN32 9975," + cmdlet,
+			}
+		}
+	}
+
+	c.clientSubnets = removeItem(c.clientSubnets, s)
+	return "", nil
+}
+
+// policyCriteriaTypes contains the criteria types of a query resolution policy in the order of the server.
+var policyCriteriaTypes = []string{"ClientSubnet", "TransportProtocol", "InternetProtocol", "ServerInterfaceIP", "FQDN", "QType", "TimeOfDay"}
+
+// policy represents a query resolution policy of the fake server.
+// Server level policies have an empty zone.
+type policy struct {
+	zone            string
+	name            string
+	action          string
+	processingOrder int
+	enabled         bool
+	condition       string
+	criteria        []policyCriteria
+	content         []policyContent
+}
+
+// policyCriteria represents a criteria of a query resolution policy, e.g. "EQ,internal" of the type "ClientSubnet".
+type policyCriteria struct {
+	criteriaType string
+	criteria     string
+}
+
+// usesClientSubnet returns true if the criteria matches the client subnet with the given name.
+func (c policyCriteria) usesClientSubnet(name string) bool {
+	if c.criteriaType != "ClientSubnet" {
+		return false
+	}
+	for _, condition := range strings.Split(c.criteria, ";") {
+		_, values, _ := strings.Cut(condition, ",")
+		for _, value := range strings.Split(values, ",") {
+			if strings.EqualFold(value, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// policyContent represents a zone scope of a query resolution policy.
+type policyContent struct {
+	scope  string
+	weight int64
+}
+
+// policyJson is the JSON representation of a query resolution policy,
+// as projected by the commands of the windows/dns package.
+type policyJson struct {
+	Name            string               `json:"Name"`
+	Action          string               `json:"Action"`
+	ProcessingOrder int                  `json:"ProcessingOrder"`
+	IsEnabled       bool                 `json:"IsEnabled"`
+	Condition       string               `json:"Condition"`
+	Criteria        []policyCriteriaJson `json:"Criteria"`
+	Content         []policyContentJson  `json:"Content"`
+}
+type policyCriteriaJson struct {
+	Type     string `json:"Type"`
+	Criteria string `json:"Criteria"`
+}
+type policyContentJson struct {
+	ScopeName string `json:"ScopeName"`
+	Weight    int64  `json:"Weight"`
+}
+
+// json returns the JSON representation of the policy.
+func (pol *policy) json() policyJson {
+	j := policyJson{
+		Name:            pol.name,
+		Action:          pol.action,
+		ProcessingOrder: pol.processingOrder,
+		IsEnabled:       pol.enabled,
+		Condition:       pol.condition,
+		Criteria:        []policyCriteriaJson{},
+		Content:         []policyContentJson{},
+	}
+	for _, criteria := range pol.criteria {
+		j.Criteria = append(j.Criteria, policyCriteriaJson{Type: criteria.criteriaType, Criteria: criteria.criteria})
+	}
+	for _, content := range pol.content {
+		j.Content = append(j.Content, policyContentJson{ScopeName: content.scope, Weight: content.weight})
+	}
+	return j
+}
+
+// levelPolicies returns the policies of a zone in their processing order.
+// The server level policies are returned for an empty zone.
+func (c *Connection) levelPolicies(zoneName string) []*policy {
+	var policies []*policy
+	for _, pol := range c.policies {
+		if strings.EqualFold(pol.zone, zoneName) {
+			policies = append(policies, pol)
+		}
+	}
+	slices.SortFunc(policies, func(a *policy, b *policy) int {
+		return cmp.Compare(a.processingOrder, b.processingOrder)
+	})
+	return policies
+}
+
+// reorderPolicies moves a policy to a position in the processing order of its level.
+// The other policies keep their order and are renumbered without gaps.
+func (c *Connection) reorderPolicies(pol *policy, processingOrder int) {
+	policies := slices.DeleteFunc(c.levelPolicies(pol.zone), func(other *policy) bool { return other == pol })
+
+	position := min(max(processingOrder, 1), len(policies)+1) - 1
+	policies = slices.Insert(policies, position, pol)
+
+	for i, other := range policies {
+		other.processingOrder = i + 1
+	}
+}
+
+// lookupPolicy returns the policy with the given name of a zone or nil if it does not exist.
+// The zone of a zone level policy must exist.
+func (c *Connection) lookupPolicy(cmdlet string, zoneName string, name string) (*policy, error) {
+	if zoneName != "" {
+		if _, err := c.findZone(cmdlet, zoneName); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, pol := range c.levelPolicies(zoneName) {
+		if strings.EqualFold(pol.name, name) {
+			return pol, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findPolicy returns the policy of a query resolution policy call.
+func (c *Connection) findPolicy(cmdlet string, p params) (*policy, error) {
+	pol, err := c.lookupPolicy(cmdlet, p.str("ZoneName"), p.str("Name"))
+	if pol != nil || err != nil {
+		return pol, err
+	}
+
+	return nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("The policy %s does not exist on server %s.", p.str("Name"), ComputerName),
+		category:  "ObjectNotFound",
+		target:    fmt.Sprintf("%s:root/Microsoft/...DnsServerPolicy", p.str("Name")),
+		exception: "CimException",
+		errorId:   "WIN32 9972," + cmdlet,
+	}
+}
+
+// setPolicySettings sets the criteria and zone scopes of a query resolution policy call.
+// The client subnets of the criteria and the zone scopes must exist.
+func (c *Connection) setPolicySettings(cmdlet string, pol *policy, p params) error {
+	invalid := func(message string) error {
+		return &cmdletError{
+			cmdlet:    cmdlet,
+			message:   fmt.Sprintf("%s on server %s.", message, ComputerName),
+			category:  "InvalidArgument",
+			target:    fmt.Sprintf("%s:root/Microsoft/...DnsServerPolicy", pol.name),
+			exception: "CimException",
+			errorId:   "WIN32 9973," + cmdlet,
+		}
+	}
+
+	for _, criteriaType := range policyCriteriaTypes {
+		if !p.has(criteriaType) {
+			continue
+		}
+
+		criteria := policyCriteria{criteriaType: criteriaType, criteria: p.str(criteriaType)}
+		for _, condition := range strings.Split(criteria.criteria, ";") {
+			operator, values, _ := strings.Cut(condition, ",")
+			if (operator != "EQ" && operator != "NE") || values == "" {
+				return invalid(fmt.Sprintf("The criteria %s of the policy %s is invalid", criteria.criteria, pol.name))
+			}
+			if criteriaType != "ClientSubnet" {
+				continue
+			}
+			for _, value := range strings.Split(values, ",") {
+				if _, err := c.findClientSubnet(cmdlet, value); err != nil {
+					return err
+				}
+			}
+		}
+
+		pol.criteria = slices.DeleteFunc(pol.criteria, func(other policyCriteria) bool { return other.criteriaType == criteriaType })
+		pol.criteria = append(pol.criteria, criteria)
+	}
+	slices.SortFunc(pol.criteria, func(a policyCriteria, b policyCriteria) int {
+		return cmp.Compare(slices.Index(policyCriteriaTypes, a.criteriaType), slices.Index(policyCriteriaTypes, b.criteriaType))
+	})
+
+	if p.has("ZoneScope") {
+		if pol.zone == "" {
+			return invalid(fmt.Sprintf("The server level policy %s can not have zone scopes", pol.name))
+		}
+
+		var content []policyContent
+		for _, entry := range strings.Split(p.str("ZoneScope"), ";") {
+			scope, weight, _ := strings.Cut(entry, ",")
+			zoneScope, err := c.findZoneScope(cmdlet, pol.zone, scope)
+			if err != nil {
+				return err
+			}
+			if zoneScope == "" {
+				zoneScope = pol.zone
+			}
+
+			n, err := strconv.ParseInt(weight, 10, 64)
+			if err != nil || n < 1 {
+				return invalid(fmt.Sprintf("The weight of the zone scope %s of the policy %s is invalid", scope, pol.name))
+			}
+			content = append(content, policyContent{scope: zoneScope, weight: n})
+		}
+		pol.content = content
+	}
+
+	return nil
+}
+
+func (c *Connection) policyRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	pol, err := c.findPolicy("Get-DnsServerQueryResolutionPolicy", p)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(pol.json())
+	return string(b), err
+}
+
+func (c *Connection) policyList(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	zoneName := p.str("ZoneName")
+	if zoneName != "" {
+		if _, err := c.findZone("Get-DnsServerQueryResolutionPolicy", zoneName); err != nil {
+			return "", err
+		}
+	}
+
+	policies := []policyJson{}
+	for _, pol := range c.levelPolicies(zoneName) {
+		policies = append(policies, pol.json())
+	}
+	return arrayJson(policies)
+}
+
+// policyChange handles the Add-DnsServerQueryResolutionPolicy and Set-DnsServerQueryResolutionPolicy calls.
+// The settings are applied to a copy, so a failed call does not change the policy.
+func (c *Connection) policyChange(match []string) (string, error) {
+	cmdlet := match[1] + "-DnsServerQueryResolutionPolicy"
+
+	p, err := parseParams(match[2])
+	if err != nil {
+		return "", err
+	}
+
+	var pol *policy
+	if match[1] == "Set" {
+		// The zone scopes of a policy can only be set on creation.
+		if p.has("ZoneScope") {
+			return "", &cmdletError{
+				cmdlet:    cmdlet,
+				message:   "A parameter cannot be found that matches parameter name 'ZoneScope'.",
+				category:  "InvalidArgument",
+				target:    ":",
+				exception: "ParameterBindingException",
+				errorId:   "NamedParameterNotFound," + cmdlet,
+			}
+		}
+		if pol, err = c.findPolicy(cmdlet, p); err != nil {
+			return "", err
+		}
+	} else {
+		existing, err := c.lookupPolicy(cmdlet, p.str("ZoneName"), p.str("Name"))
+		if err != nil {
+			return "", err
+		}
+		if existing != nil {
+			return "", &cmdletError{
+				cmdlet:    cmdlet,
+				message:   fmt.Sprintf("The policy %s already exists on server %s.", p.str("Name"), ComputerName),
+				category:  "ResourceExists",
+				target:    fmt.Sprintf("%s:root/Microsoft/...DnsServerPolicy", p.str("Name")),
+				exception: "CimException",
+				errorId:   "WIN32 9971," + cmdlet,
+			}
+		}
+
+		pol = &policy{
+			zone:      p.str("ZoneName"),
+			name:      p.str("Name"),
+			action:    "Allow",
+			enabled:   !p.flag("Disable"),
+			condition: "And",
+		}
+		if p.has("Action") {
+			pol.action = strings.ToUpper(p.str("Action")[:1]) + strings.ToLower(p.str("Action")[1:])
+		}
+	}
+
+	changed := *pol
+	changed.criteria = slices.Clone(pol.criteria)
+	changed.content = slices.Clone(pol.content)
+
+	if p.has("Condition") {
+		changed.condition = strings.ToUpper(p.str("Condition")[:1]) + strings.ToLower(p.str("Condition")[1:])
+	}
+	if p.has("State") {
+		changed.enabled = strings.EqualFold(p.str("State"), "Enable")
+	}
+	if err := c.setPolicySettings(cmdlet, &changed, p); err != nil {
+		return "", err
+	}
+
+	processingOrder := pol.processingOrder
+	if p.has("ProcessingOrder") {
+		n, err := p.int("ProcessingOrder")
+		if err != nil {
+			return "", err
+		}
+		processingOrder = int(n)
+	}
+
+	if match[1] == "Set" {
+		*pol = changed
+	} else {
+		pol = &changed
+		processingOrder = cmp.Or(processingOrder, len(c.levelPolicies(pol.zone))+1)
+		c.policies = append(c.policies, pol)
+	}
+	c.reorderPolicies(pol, processingOrder)
+
+	b, err := json.Marshal(pol.json())
+	return string(b), err
+}
+
+func (c *Connection) policyDelete(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	pol, err := c.findPolicy("Remove-DnsServerQueryResolutionPolicy", p)
+	if err != nil {
+		return "", err
+	}
+
+	c.policies = removeItem(c.policies, pol)
+	for i, other := range c.levelPolicies(pol.zone) {
+		other.processingOrder = i + 1
+	}
+
+	return "", nil
+}
+
+// resolveAnswerJson is the JSON representation of a record of the answer section of Resolve-DnsName.
+type resolveAnswerJson struct {
+	Name         string   `json:"Name"`
+	Type         int      `json:"Type"`
+	CharacterSet int      `json:"CharacterSet"`
+	Section      int      `json:"Section"`
+	TTL          int64    `json:"TTL"`
+	IP4Address   string   `json:"IP4Address,omitempty"`
+	IP6Address   string   `json:"IP6Address,omitempty"`
+	NameHost     string   `json:"NameHost,omitempty"`
+	NameExchange string   `json:"NameExchange,omitempty"`
+	Preference   uint16   `json:"Preference,omitempty"`
+	NameTarget   string   `json:"NameTarget,omitempty"`
+	Priority     uint16   `json:"Priority,omitempty"`
+	Weight       uint16   `json:"Weight,omitempty"`
+	Port         uint16   `json:"Port,omitempty"`
+	Strings      []string `json:"Strings,omitempty"`
+}
+
+// resolveAnswer returns the answer of a record with the fully qualified name.
+func resolveAnswer(r *record, fqdn string) resolveAnswerJson {
+	number := func(property string) uint16 {
+		n, _ := strconv.ParseUint(r.data[property], 10, 16)
+		return uint16(n)
+	}
+
+	j := resolveAnswerJson{
+		Name:         fqdn,
+		Type:         recordTypes[strings.ToLower(r.recordType)].number,
+		CharacterSet: 1,
+		Section:      1,
+		TTL:          int64(r.timeToLive.Seconds()),
+	}
+
+	switch strings.ToUpper(r.recordType) {
+	case "A":
+		j.IP4Address = r.data["IPv4Address"]
+	case "AAAA":
+		j.IP6Address = r.data["IPv6Address"]
+	case "CNAME":
+		j.NameHost = strings.TrimSuffix(r.data["HostNameAlias"], ".")
+	case "PTR":
+		j.NameHost = strings.TrimSuffix(r.data["PtrDomainName"], ".")
+	case "NS":
+		j.NameHost = strings.TrimSuffix(r.data["NameServer"], ".")
+	case "MX":
+		j.NameExchange = strings.TrimSuffix(r.data["MailExchange"], ".")
+		j.Preference = number("Preference")
+	case "SRV":
+		j.NameTarget = strings.TrimSuffix(r.data["DomainName"], ".")
+		j.Priority, j.Weight, j.Port = number("Priority"), number("Weight"), number("Port")
+	case "TXT":
+		j.Strings = []string{r.data["DescriptiveText"]}
+	}
+
+	return j
+}
+
+// resolveZone returns the zone of the fake server with the longest name that contains the name.
+func (c *Connection) resolveZone(name string) (*zone, string) {
+	var best *zone
+	relativeName := ""
+	for _, z := range c.zones {
+		if best != nil && len(z.name) <= len(best.name) {
+			continue
+		}
+		if strings.EqualFold(name, z.name) {
+			best, relativeName = z, "@"
+		} else if strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(z.name)) {
+			best, relativeName = z, name[:len(name)-len(z.name)-1]
+		}
+	}
+	return best, relativeName
+}
+
+// resolve handles the Resolve-DnsName call with the answers of the default zone scopes of the fake server.
+// Like an authoritative server, aliases are followed within the zones of the server.
+// The fake does not resolve names recursively, so names outside of its zones do not exist.
+func (c *Connection) resolve(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	recordType := cmp.Or(strings.ToUpper(p.str("Type")), "A")
+	name := strings.TrimSuffix(p.str("Name"), ".")
+
+	answers := []resolveAnswerJson{}
+	for range 8 {
+		z, relativeName := c.resolveZone(name)
+		if z == nil {
+			break
+		}
+
+		var records, aliases []*record
+		exists := relativeName == "@"
+		for _, r := range c.records {
+			if !r.in(z.name, "") || !strings.EqualFold(r.name, relativeName) {
+				continue
+			}
+			exists = true
+			if strings.EqualFold(r.recordType, recordType) {
+				records = append(records, r)
+			} else if strings.EqualFold(r.recordType, "CNAME") {
+				aliases = append(aliases, r)
+			}
+		}
+
+		if !exists && len(answers) == 0 {
+			return "", &cmdletError{
+				cmdlet:    "Resolve-DnsName",
+				message:   fmt.Sprintf("%s : DNS name does not exist", name),
+				category:  "ResourceUnavailable",
+				target:    name + ":String",
+				exception: "Win32Exception",
+				errorId:   "DNS_ERROR_RCODE_NAME_ERROR,Microsoft.DnsClient.Commands.ResolveDnsName",
+			}
+		}
+
+		for _, r := range records {
+			answers = append(answers, resolveAnswer(r, name))
+		}
+		if len(records) > 0 || len(aliases) == 0 {
+			break
+		}
+
+		alias := resolveAnswer(aliases[0], name)
+		answers = append(answers, alias)
+		name = alias.NameHost
+	}
+
+	return arrayJson(answers)
+}
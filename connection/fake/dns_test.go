@@ -0,0 +1,1355 @@
+package fake_test
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection/fake"
+	"github.com/d-strobel/gowindows/windows/dns"
+	"github.com/d-strobel/gowindows/winerror"
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for the DNS scenarios of the fake connection
+type DnsFakeUnitTestSuite struct {
+	suite.Suite
+	client *dns.Client
+}
+
+// Run all DNS scenario tests
+func TestDnsFakeUnitTestSuite(t *testing.T) {
+	suite.Run(t, &DnsFakeUnitTestSuite{})
+}
+
+func (suite *DnsFakeUnitTestSuite) SetupTest() {
+	conn := fake.NewConnection()
+	conn.AddZone("10.168.192.in-addr.arpa")
+	suite.client = dns.NewClient(conn)
+}
+
+func (suite *DnsFakeUnitTestSuite) TestZoneScenario() {
+	ctx := context.Background()
+
+	suite.Run("should list the zones", func() {
+		zones, err := suite.client.ZoneList(ctx)
+		suite.Require().NoError(err)
+		suite.Len(zones, 7)
+	})
+
+	suite.Run("should read a zone", func() {
+		zone, err := suite.client.ZoneRead(ctx, dns.ZoneReadParams{Name: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal("test.local", zone.ZoneName)
+		suite.True(zone.IsDsIntegrated)
+		suite.False(zone.IsReverseLookupZone)
+	})
+
+	suite.Run("should return an object not found error", func() {
+		_, err := suite.client.ZoneRead(ctx, dns.ZoneReadParams{Name: "notexist.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestZoneLifecycleScenario() {
+	ctx := context.Background()
+	notifyServers := []netip.Addr{netip.MustParseAddr("192.168.10.5"), netip.MustParseAddr("192.168.10.6")}
+
+	suite.Run("should create, update and delete a primary zone", func() {
+		zone, err := suite.client.ZoneCreate(ctx, dns.ZoneCreateParams{Name: "file.local"})
+		suite.Require().NoError(err)
+		suite.Equal("Primary", zone.ZoneType)
+		suite.False(zone.IsDsIntegrated)
+		suite.Equal("file.local.dns", zone.ZoneFile)
+
+		zone, err = suite.client.ZoneUpdate(ctx, dns.ZoneUpdateParams{
+			Name:              "file.local",
+			SecureSecondaries: "TransferToSecureServers",
+			SecondaryServers:  notifyServers,
+			Notify:            "NotifyServers",
+			NotifyServers:     notifyServers,
+		})
+		suite.Require().NoError(err)
+		suite.Equal("TransferToSecureServers", zone.SecureSecondaries)
+		suite.Equal(notifyServers, zone.SecondaryServers)
+		suite.Equal("NotifyServers", zone.Notify)
+		suite.Equal(notifyServers, zone.NotifyServers)
+
+		err = suite.client.ZoneDelete(ctx, dns.ZoneDeleteParams{Name: "file.local"})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.ZoneRead(ctx, dns.ZoneReadParams{Name: "file.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should create a reverse zone from the network ID", func() {
+		zone, err := suite.client.ZoneCreate(ctx, dns.ZoneCreateParams{
+			NetworkID:        netip.MustParsePrefix("192.168.20.0/24"),
+			ReplicationScope: "Forest",
+		})
+		suite.Require().NoError(err)
+		suite.Equal("20.168.192.in-addr.arpa", zone.ZoneName)
+		suite.True(zone.IsReverseLookupZone)
+		suite.True(zone.IsDsIntegrated)
+		suite.Equal("Forest", zone.ReplicationScope)
+	})
+
+	suite.Run("should create a secondary zone", func() {
+		zone, err := suite.client.ZoneCreate(ctx, dns.ZoneCreateParams{
+			Name:          "secondary.local",
+			ZoneType:      "Secondary",
+			MasterServers: notifyServers[:1],
+		})
+		suite.Require().NoError(err)
+		suite.Equal("Secondary", zone.ZoneType)
+		suite.Equal(notifyServers[:1], zone.MasterServers)
+	})
+
+	suite.Run("should return an error if the zone already exists", func() {
+		_, err := suite.client.ZoneCreate(ctx, dns.ZoneCreateParams{Name: "test.local", ReplicationScope: "Domain"})
+		suite.ErrorContains(err, "the specified zone already exists")
+	})
+
+	suite.Run("should remove the zone if the transfer settings cannot be set", func() {
+		_, err := suite.client.ZoneCreate(ctx, dns.ZoneCreateParams{
+			Name:              "rollback.local",
+			SecureSecondaries: "TransferToSecureServers",
+			SecondaryServers:  []netip.Addr{netip.IPv4Unspecified()},
+		})
+		suite.Equal(winerror.CategoryInvalidArgument, winerror.Category(err))
+
+		_, err = suite.client.ZoneRead(ctx, dns.ZoneReadParams{Name: "rollback.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestConditionalForwarderScenario() {
+	ctx := context.Background()
+	masterServers := []netip.Addr{netip.MustParseAddr("192.168.4.1"), netip.MustParseAddr("fd00::1")}
+
+	suite.Run("should create, read, update and delete a conditional forwarder", func() {
+		forwarder, err := suite.client.ConditionalForwarderCreate(ctx, dns.ConditionalForwarderCreateParams{
+			Name:             "partner.example",
+			MasterServers:    masterServers,
+			ReplicationScope: "Forest",
+		})
+		suite.Require().NoError(err)
+		suite.Equal(masterServers, forwarder.MasterServers)
+		suite.True(forwarder.IsDsIntegrated)
+		suite.Equal(5*time.Second, forwarder.ForwarderTimeout)
+
+		forwarder, err = suite.client.ConditionalForwarderUpdate(ctx, dns.ConditionalForwarderUpdateParams{
+			Name:             "partner.example",
+			MasterServers:    masterServers[:1],
+			ForwarderTimeout: 10 * time.Second,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(masterServers[:1], forwarder.MasterServers)
+		suite.Equal(10*time.Second, forwarder.ForwarderTimeout)
+
+		forwarder, err = suite.client.ConditionalForwarderRead(ctx, dns.ConditionalForwarderReadParams{Name: "partner.example"})
+		suite.Require().NoError(err)
+		suite.Equal("Forest", forwarder.ReplicationScope)
+
+		err = suite.client.ConditionalForwarderDelete(ctx, dns.ConditionalForwarderDeleteParams{Name: "partner.example"})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.ConditionalForwarderRead(ctx, dns.ConditionalForwarderReadParams{Name: "partner.example"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should not read or delete a zone of another type", func() {
+		_, err := suite.client.ConditionalForwarderRead(ctx, dns.ConditionalForwarderReadParams{Name: "test.local"})
+		suite.ErrorContains(err, "is not a conditional forwarder")
+
+		err = suite.client.ConditionalForwarderDelete(ctx, dns.ConditionalForwarderDeleteParams{Name: "test.local"})
+		suite.EqualError(err, "windows.dns.ConditionalForwarderDelete: zone 'test.local' is not a conditional forwarder")
+
+		_, err = suite.client.ZoneRead(ctx, dns.ZoneReadParams{Name: "test.local"})
+		suite.NoError(err)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestServerForwarderScenario() {
+	ctx := context.Background()
+	addresses := []netip.Addr{netip.MustParseAddr("8.8.8.8"), netip.MustParseAddr("2001:4860:4860::8888")}
+
+	suite.Run("should read and update the forwarders", func() {
+		forwarder, err := suite.client.ServerForwarderRead(ctx)
+		suite.Require().NoError(err)
+		suite.Empty(forwarder.IPAddresses)
+		suite.True(forwarder.UseRootHint)
+		suite.Equal(3*time.Second, forwarder.Timeout)
+
+		forwarder, err = suite.client.ServerForwarderUpdate(ctx, dns.ServerForwarderUpdateParams{IPAddresses: addresses, Timeout: 5 * time.Second})
+		suite.Require().NoError(err)
+		suite.Equal(addresses, forwarder.IPAddresses)
+		suite.False(forwarder.UseRootHint)
+		suite.Equal(5*time.Second, forwarder.Timeout)
+
+		forwarder, err = suite.client.ServerForwarderUpdate(ctx, dns.ServerForwarderUpdateParams{UseRootHint: true})
+		suite.Require().NoError(err)
+		suite.Empty(forwarder.IPAddresses)
+		suite.True(forwarder.UseRootHint)
+		suite.Equal(3*time.Second, forwarder.Timeout)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestServerSettingsScenario() {
+	ctx := context.Background()
+	serverAddress := netip.MustParseAddr("192.168.5.1")
+
+	suite.Run("should read and update the general settings", func() {
+		setting, err := suite.client.ServerSettingRead(ctx)
+		suite.Require().NoError(err)
+		suite.Len(setting.AllIPAddresses, 2)
+		suite.Equal(setting.AllIPAddresses, setting.ListeningIPAddresses)
+		suite.True(setting.RoundRobin)
+
+		setting, err = suite.client.ServerSettingUpdate(ctx, dns.ServerSettingUpdateParams{ListeningIPAddresses: []netip.Addr{serverAddress}, LocalNetPriority: true})
+		suite.Require().NoError(err)
+		suite.Equal([]netip.Addr{serverAddress}, setting.ListeningIPAddresses)
+		suite.False(setting.RoundRobin)
+		suite.True(setting.LocalNetPriority)
+
+		setting, err = suite.client.ServerSettingUpdate(ctx, dns.ServerSettingUpdateParams{RoundRobin: true})
+		suite.Require().NoError(err)
+		suite.Equal(setting.AllIPAddresses, setting.ListeningIPAddresses)
+	})
+
+	suite.Run("should return an invalid argument error for a foreign address", func() {
+		_, err := suite.client.ServerSettingUpdate(ctx, dns.ServerSettingUpdateParams{ListeningIPAddresses: []netip.Addr{netip.MustParseAddr("10.0.0.1")}})
+		suite.Equal(winerror.CategoryInvalidArgument, winerror.Category(err))
+	})
+
+	suite.Run("should read and update the recursion", func() {
+		recursion, err := suite.client.ServerRecursionRead(ctx)
+		suite.Require().NoError(err)
+		suite.True(recursion.Enable)
+		suite.Equal(8*time.Second, recursion.Timeout)
+
+		recursion, err = suite.client.ServerRecursionUpdate(ctx, dns.ServerRecursionUpdateParams{SecureResponse: true, Timeout: 10 * time.Second})
+		suite.Require().NoError(err)
+		suite.False(recursion.Enable)
+		suite.True(recursion.SecureResponse)
+		suite.Equal(10*time.Second, recursion.Timeout)
+		suite.Equal(3*time.Second, recursion.RetryInterval)
+		suite.Equal(4*time.Second, recursion.AdditionalTimeout)
+	})
+
+	suite.Run("should read and update the EDNS settings", func() {
+		edns, err := suite.client.ServerEDnsRead(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(15*time.Minute, edns.CacheTimeout)
+		suite.True(edns.EnableProbes)
+
+		edns, err = suite.client.ServerEDnsUpdate(ctx, dns.ServerEDnsUpdateParams{CacheTimeout: time.Hour, EnableReception: true})
+		suite.Require().NoError(err)
+		suite.Equal(time.Hour, edns.CacheTimeout)
+		suite.False(edns.EnableProbes)
+		suite.True(edns.EnableReception)
+	})
+
+	suite.Run("should read, update and clear the cache", func() {
+		cache, err := suite.client.ServerCacheRead(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(24*time.Hour, cache.MaxTTL)
+		suite.Equal(uint32(100), cache.LockingPercent)
+
+		cache, err = suite.client.ServerCacheUpdate(ctx, dns.ServerCacheUpdateParams{MaxTTL: time.Hour, MaxKBSize: 10240, LockingPercent: 50})
+		suite.Require().NoError(err)
+		suite.Equal(time.Hour, cache.MaxTTL)
+		suite.Equal(15*time.Minute, cache.MaxNegativeTTL)
+		suite.Equal(uint32(10240), cache.MaxKBSize)
+		suite.Equal(uint32(50), cache.LockingPercent)
+		suite.False(cache.EnablePollutionProtection)
+
+		records, err := suite.client.ServerCacheRecordList(ctx)
+		suite.Require().NoError(err)
+		suite.Empty(records.A)
+		suite.NoError(suite.client.ServerCacheClear(ctx))
+	})
+
+	suite.Run("should read and clear the statistics", func() {
+		statistics, err := suite.client.ServerStatisticsRead(ctx)
+		suite.Require().NoError(err)
+		suite.False(statistics.ServerStartTime.IsZero())
+		suite.Zero(statistics.Queries.Total)
+
+		suite.Require().NoError(suite.client.ServerStatisticsClear(ctx))
+		cleared, err := suite.client.ServerStatisticsRead(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(statistics.ServerStartTime, cleared.ServerStartTime)
+		suite.False(cleared.LastClearTime.Before(statistics.LastClearTime))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordAScenario() {
+	ctx := context.Background()
+	addresses := []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("192.168.10.2")}
+
+	suite.Run("should create, read, update and delete an A-Record", func() {
+		created, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{
+			Name:       "www",
+			Zone:       "test.local",
+			Addresses:  addresses,
+			TimeToLive: time.Hour,
+		})
+		suite.Require().NoError(err)
+		suite.Equal("www", created.Name)
+		suite.ElementsMatch(addresses, created.Addresses)
+		suite.Equal(time.Hour, created.TimeToLive)
+
+		read, err := suite.client.RecordARead(ctx, dns.RecordAReadParams{Name: "www", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		updated, err := suite.client.RecordAUpdate(ctx, dns.RecordAUpdateParams{
+			Name:       "www",
+			Zone:       "test.local",
+			TimeToLive: 2 * time.Hour,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(2*time.Hour, updated.TimeToLive)
+		suite.ElementsMatch(addresses, updated.Addresses)
+
+		_, err = suite.client.RecordACreate(ctx, dns.RecordACreateParams{Name: "www", Zone: "test.local", Addresses: addresses})
+		suite.Error(err)
+
+		suite.Require().NoError(suite.client.RecordADelete(ctx, dns.RecordADeleteParams{Name: "www", Zone: "test.local"}))
+
+		_, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Name: "www", Zone: "test.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should replace the addresses of an A-Record", func() {
+		_, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{Name: "app", Zone: "test.local", Addresses: addresses})
+		suite.Require().NoError(err)
+
+		replaced := []netip.Addr{netip.MustParseAddr("192.168.10.2"), netip.MustParseAddr("192.168.10.3")}
+		updated, err := suite.client.RecordAUpdate(ctx, dns.RecordAUpdateParams{
+			Name:       "app",
+			Zone:       "test.local",
+			TimeToLive: time.Hour,
+			Addresses:  replaced,
+		})
+		suite.Require().NoError(err)
+		suite.ElementsMatch(replaced, updated.Addresses)
+		suite.Equal(time.Hour, updated.TimeToLive)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordAAAAScenario() {
+	ctx := context.Background()
+
+	suite.Run("should replace the addresses of an AAAA-Record", func() {
+		_, err := suite.client.RecordAAAACreate(ctx, dns.RecordAAAACreateParams{
+			Name:      "app",
+			Zone:      "test.local",
+			Addresses: []netip.Addr{netip.MustParseAddr("fd00::1")},
+		})
+		suite.Require().NoError(err)
+
+		replaced := []netip.Addr{netip.MustParseAddr("fd00::2"), netip.MustParseAddr("fd00::3")}
+		updated, err := suite.client.RecordAAAAUpdate(ctx, dns.RecordAAAAUpdateParams{
+			Name:       "app",
+			Zone:       "test.local",
+			TimeToLive: 30 * time.Minute,
+			Addresses:  replaced,
+		})
+		suite.Require().NoError(err)
+		suite.ElementsMatch(replaced, updated.Addresses)
+		suite.Equal(30*time.Minute, updated.TimeToLive)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordPtrManagementScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create, update and remove the PTR-Records of an A-Record", func() {
+		created, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{
+			Name:      "host",
+			Zone:      "test.local",
+			Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.7"), netip.MustParseAddr("10.1.1.1")},
+			ManagePtr: true,
+		})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"1.1.10.in-addr.arpa"}, created.MissingReverseZones)
+
+		ptr, err := suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "7", Zone: "10.168.192.in-addr.arpa"})
+		suite.Require().NoError(err)
+		suite.Equal("host.test.local.", ptr.PTR)
+
+		updated, err := suite.client.RecordAUpdate(ctx, dns.RecordAUpdateParams{
+			Name:       "host",
+			Zone:       "test.local",
+			TimeToLive: time.Hour,
+			Addresses:  []netip.Addr{netip.MustParseAddr("192.168.10.8")},
+			ManagePtr:  true,
+		})
+		suite.Require().NoError(err)
+		suite.Empty(updated.MissingReverseZones)
+
+		_, err = suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "7", Zone: "10.168.192.in-addr.arpa"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		ptr, err = suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "8", Zone: "10.168.192.in-addr.arpa"})
+		suite.Require().NoError(err)
+		suite.Equal(time.Hour, ptr.TimeToLive)
+
+		err = suite.client.RecordADelete(ctx, dns.RecordADeleteParams{Name: "host", Zone: "test.local", ManagePtr: true})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "8", Zone: "10.168.192.in-addr.arpa"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should skip PTR-Records that do not exist on removal", func() {
+		_, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{
+			Name:      "noptr",
+			Zone:      "test.local",
+			Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.9")},
+		})
+		suite.Require().NoError(err)
+
+		err = suite.client.RecordADelete(ctx, dns.RecordADeleteParams{Name: "noptr", Zone: "test.local", ManagePtr: true})
+		suite.NoError(err)
+	})
+
+	suite.Run("should keep the old PTR-Records and name the PTR-Record that cannot be created", func() {
+		_, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{
+			Name:      "keep",
+			Zone:      "test.local",
+			Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.20")},
+			ManagePtr: true,
+		})
+		suite.Require().NoError(err)
+		_, err = suite.client.RecordPTRCreate(ctx, dns.RecordPTRCreateParams{Name: "21", Zone: "10.168.192.in-addr.arpa", PTR: "keep.test.local."})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.RecordAUpdate(ctx, dns.RecordAUpdateParams{
+			Name:       "keep",
+			Zone:       "test.local",
+			TimeToLive: time.Hour,
+			Addresses:  []netip.Addr{netip.MustParseAddr("192.168.10.21")},
+			ManagePtr:  true,
+		})
+		suite.ErrorContains(err, "windows.dns.RecordAUpdate: failed to create PTR-Record '21' in zone '10.168.192.in-addr.arpa': ")
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+
+		ptr, err := suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "20", Zone: "10.168.192.in-addr.arpa"})
+		suite.Require().NoError(err)
+		suite.Equal("keep.test.local.", ptr.PTR)
+	})
+
+	suite.Run("should remove the record and the created PTR-Records if a PTR-Record cannot be created", func() {
+		_, err := suite.client.RecordPTRCreate(ctx, dns.RecordPTRCreateParams{Name: "31", Zone: "10.168.192.in-addr.arpa", PTR: "partial.test.local."})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.RecordACreate(ctx, dns.RecordACreateParams{
+			Name:      "partial",
+			Zone:      "test.local",
+			Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.30"), netip.MustParseAddr("192.168.10.31")},
+			ManagePtr: true,
+		})
+		suite.ErrorContains(err, "windows.dns.RecordACreate: failed to create PTR-Record '31' in zone '10.168.192.in-addr.arpa': ")
+
+		_, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Name: "partial", Zone: "test.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		_, err = suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "30", Zone: "10.168.192.in-addr.arpa"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		// The PTR-Record that existed before is kept.
+		_, err = suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "31", Zone: "10.168.192.in-addr.arpa"})
+		suite.NoError(err)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordCNameScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create, read, update and delete a CName-Record", func() {
+		created, err := suite.client.RecordCNameCreate(ctx, dns.RecordCNameCreateParams{
+			Name:  "alias",
+			Zone:  "test.local",
+			CName: "www.test.local",
+		})
+		suite.Require().NoError(err)
+		suite.Equal("www.test.local.", created.CName)
+
+		updated, err := suite.client.RecordCNameUpdate(ctx, dns.RecordCNameUpdateParams{
+			Name:  "alias",
+			Zone:  "test.local",
+			CName: "web.test.local",
+		})
+		suite.Require().NoError(err)
+		suite.Equal("web.test.local.", updated.CName)
+
+		read, err := suite.client.RecordCNameRead(ctx, dns.RecordCNameReadParams{Name: "alias", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(updated, read)
+
+		suite.Require().NoError(suite.client.RecordCNameDelete(ctx, dns.RecordCNameDeleteParams{Name: "alias", Zone: "test.local"}))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordPTRScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create and read a PTR-Record", func() {
+		created, err := suite.client.RecordPTRCreate(ctx, dns.RecordPTRCreateParams{
+			Name: "1",
+			Zone: "10.168.192.in-addr.arpa",
+			PTR:  "www.test.local",
+		})
+		suite.Require().NoError(err)
+		suite.Equal("www.test.local.", created.PTR)
+
+		read, err := suite.client.RecordPTRRead(ctx, dns.RecordPTRReadParams{Name: "1", Zone: "10.168.192.in-addr.arpa"})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+	})
+
+	suite.Run("should return an object not found error for a missing zone", func() {
+		_, err := suite.client.RecordPTRCreate(ctx, dns.RecordPTRCreateParams{
+			Name: "1",
+			Zone: "20.168.192.in-addr.arpa",
+			PTR:  "www.test.local",
+		})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordMXScenario() {
+	ctx := context.Background()
+	mailExchangers := []dns.MailExchanger{
+		{MailExchange: "mail1.test.local", Preference: 10},
+		{MailExchange: "mail2.test.local", Preference: 20},
+	}
+
+	suite.Run("should create, read, update and delete a MX-Record", func() {
+		created, err := suite.client.RecordMXCreate(ctx, dns.RecordMXCreateParams{
+			Name:           "@",
+			Zone:           "test.local",
+			MailExchangers: mailExchangers,
+		})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.MailExchanger{
+			{MailExchange: "mail1.test.local.", Preference: 10},
+			{MailExchange: "mail2.test.local.", Preference: 20},
+		}, created.MailExchangers)
+
+		updated, err := suite.client.RecordMXUpdate(ctx, dns.RecordMXUpdateParams{Name: "@", Zone: "test.local", TimeToLive: time.Hour})
+		suite.Require().NoError(err)
+		suite.Equal(time.Hour, updated.TimeToLive)
+
+		read, err := suite.client.RecordMXRead(ctx, dns.RecordMXReadParams{Name: "@", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(updated, read)
+
+		_, err = suite.client.RecordMXCreate(ctx, dns.RecordMXCreateParams{Name: "@", Zone: "test.local", MailExchangers: mailExchangers[:1]})
+		suite.EqualError(err, "windows.dns.RecordMXCreate: the specified record already exists")
+
+		suite.Require().NoError(suite.client.RecordMXDelete(ctx, dns.RecordMXDeleteParams{Name: "@", Zone: "test.local"}))
+
+		_, err = suite.client.RecordMXRead(ctx, dns.RecordMXReadParams{Name: "@", Zone: "test.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should remove the added mail exchangers if a mail exchanger cannot be added", func() {
+		_, err := suite.client.RecordMXCreate(ctx, dns.RecordMXCreateParams{Name: "@", Zone: "test.local", MailExchangers: mailExchangers[1:]})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.RecordMXCreate(ctx, dns.RecordMXCreateParams{Name: "@", Zone: "test.local", MailExchangers: mailExchangers})
+		suite.EqualError(err, "windows.dns.RecordMXCreate: the specified record already exists")
+
+		read, err := suite.client.RecordMXRead(ctx, dns.RecordMXReadParams{Name: "@", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.MailExchanger{{MailExchange: "mail2.test.local.", Preference: 20}}, read.MailExchangers)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordSRVScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create, read and delete a SRV-Record", func() {
+		created, err := suite.client.RecordSRVCreate(ctx, dns.RecordSRVCreateParams{
+			Name: "_ldap._tcp",
+			Zone: "test.local",
+			Targets: []dns.SRVTarget{
+				{Target: "dc01.test.local", Priority: 0, Weight: 100, Port: 389},
+				{Target: "dc02.test.local", Priority: 10, Weight: 100, Port: 389},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Equal("_ldap", created.Service)
+		suite.Equal("_tcp", created.Protocol)
+		suite.Len(created.Targets, 2)
+		suite.Equal("dc02.test.local.", created.Targets[1].Target)
+		suite.Equal(uint16(10), created.Targets[1].Priority)
+
+		read, err := suite.client.RecordSRVRead(ctx, dns.RecordSRVReadParams{Name: "_ldap._tcp", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		suite.Require().NoError(suite.client.RecordSRVDelete(ctx, dns.RecordSRVDeleteParams{Name: "_ldap._tcp", Zone: "test.local"}))
+	})
+
+	suite.Run("should remove the added targets if a target cannot be added", func() {
+		targets := []dns.SRVTarget{
+			{Target: "dc01.test.local", Priority: 0, Weight: 100, Port: 389},
+			{Target: "dc02.test.local", Priority: 10, Weight: 100, Port: 389},
+		}
+		_, err := suite.client.RecordSRVCreate(ctx, dns.RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local", Targets: targets[1:]})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.RecordSRVCreate(ctx, dns.RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local", Targets: targets})
+		suite.Error(err)
+
+		read, err := suite.client.RecordSRVRead(ctx, dns.RecordSRVReadParams{Name: "_ldap._tcp", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.SRVTarget{{Target: "dc02.test.local.", Priority: 10, Weight: 100, Port: 389}}, read.Targets)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordTXTScenario() {
+	ctx := context.Background()
+	values := []string{
+		`v=spf1 include:"spf.test.local" -all`,
+		"v=DKIM1; k=rsa; p=" + strings.Repeat("A", 400),
+	}
+
+	suite.Run("should create, read and delete a TXT-Record with long and quoted values", func() {
+		created, err := suite.client.RecordTXTCreate(ctx, dns.RecordTXTCreateParams{Name: "@", Zone: "test.local", Values: values})
+		suite.Require().NoError(err)
+		suite.Equal(values, created.Values)
+
+		read, err := suite.client.RecordTXTRead(ctx, dns.RecordTXTReadParams{Name: "@", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(created, read)
+
+		suite.Require().NoError(suite.client.RecordTXTDelete(ctx, dns.RecordTXTDeleteParams{Name: "@", Zone: "test.local"}))
+	})
+
+	suite.Run("should remove the added values if a value cannot be added", func() {
+		_, err := suite.client.RecordTXTCreate(ctx, dns.RecordTXTCreateParams{Name: "@", Zone: "test.local", Values: values[1:]})
+		suite.Require().NoError(err)
+
+		_, err = suite.client.RecordTXTCreate(ctx, dns.RecordTXTCreateParams{Name: "@", Zone: "test.local", Values: values})
+		suite.Error(err)
+
+		read, err := suite.client.RecordTXTRead(ctx, dns.RecordTXTReadParams{Name: "@", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(values[1:], read.Values)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordNSScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create, read, update and delete a NS-Record", func() {
+		created, err := suite.client.RecordNSCreate(ctx, dns.RecordNSCreateParams{
+			Name:        "sub",
+			Zone:        "test.local",
+			NameServers: []string{"ns1.test.local", "ns2.test.local"},
+		})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"ns1.test.local.", "ns2.test.local."}, created.NameServers)
+
+		updated, err := suite.client.RecordNSUpdate(ctx, dns.RecordNSUpdateParams{Name: "sub", Zone: "test.local", TimeToLive: time.Hour})
+		suite.Require().NoError(err)
+		suite.Equal(time.Hour, updated.TimeToLive)
+
+		read, err := suite.client.RecordNSRead(ctx, dns.RecordNSReadParams{Name: "sub", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(updated, read)
+
+		suite.Require().NoError(suite.client.RecordNSDelete(ctx, dns.RecordNSDeleteParams{Name: "sub", Zone: "test.local"}))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestZoneDelegationScenario() {
+	ctx := context.Background()
+
+	suite.Run("should create, read, update and delete a zone delegation", func() {
+		created, err := suite.client.ZoneDelegationCreate(ctx, dns.ZoneDelegationCreateParams{
+			Zone:          "test.local",
+			ChildZoneName: "child",
+			NameServers: []dns.ZoneDelegationNameServer{
+				{NameServer: "ns1.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("fd00::1")}},
+				{NameServer: "ns2.external.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.20.1")}},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(dns.ZoneDelegation{
+			ChildZoneName: "child.test.local",
+			NameServers: []dns.ZoneDelegationNameServer{
+				{NameServer: "ns1.child.test.local.", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("fd00::1")}},
+				{NameServer: "ns2.external.local."},
+			},
+		}, created)
+
+		// The delegation is reconstructed from the records of the parent zone.
+		ns, err := suite.client.RecordNSRead(ctx, dns.RecordNSReadParams{Name: "child", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"ns1.child.test.local.", "ns2.external.local."}, ns.NameServers)
+
+		// The name servers that are not specified are removed after the new name servers are added.
+		updated, err := suite.client.ZoneDelegationUpdate(ctx, dns.ZoneDelegationUpdateParams{
+			Zone:          "test.local",
+			ChildZoneName: "child",
+			NameServers: []dns.ZoneDelegationNameServer{
+				{NameServer: "ns1.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.2")}},
+				{NameServer: "ns3.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.3")}},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(dns.ZoneDelegation{
+			ChildZoneName: "child.test.local",
+			NameServers: []dns.ZoneDelegationNameServer{
+				{NameServer: "ns1.child.test.local.", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.2")}},
+				{NameServer: "ns3.child.test.local.", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.3")}},
+			},
+		}, updated)
+
+		// The added name servers are removed again and no name server is removed if a name server cannot be added.
+		_, err = suite.client.ZoneDelegationUpdate(ctx, dns.ZoneDelegationUpdateParams{
+			Zone:          "test.local",
+			ChildZoneName: "child",
+			NameServers: []dns.ZoneDelegationNameServer{
+				{NameServer: "ns4.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.4")}},
+				{NameServer: "ns4.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.5")}},
+			},
+		})
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+
+		read, err := suite.client.ZoneDelegationRead(ctx, dns.ZoneDelegationReadParams{Zone: "test.local", ChildZoneName: "child.test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(updated, read)
+
+		_, err = suite.client.ZoneDelegationCreate(ctx, dns.ZoneDelegationCreateParams{
+			Zone:          "test.local",
+			ChildZoneName: "child",
+			NameServers:   []dns.ZoneDelegationNameServer{{NameServer: "ns1.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1")}}},
+		})
+		suite.EqualError(err, "windows.dns.ZoneDelegationCreate: the specified delegation already exists")
+
+		suite.Require().NoError(suite.client.ZoneDelegationDelete(ctx, dns.ZoneDelegationDeleteParams{Zone: "test.local", ChildZoneName: "child"}))
+
+		_, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Name: "ns1.child", Zone: "test.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		_, err = suite.client.ZoneDelegationRead(ctx, dns.ZoneDelegationReadParams{Zone: "test.local", ChildZoneName: "child"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should remove the added name servers if a name server cannot be added", func() {
+		_, err := suite.client.ZoneDelegationCreate(ctx, dns.ZoneDelegationCreateParams{
+			Zone:          "test.local",
+			ChildZoneName: "rollback",
+			NameServers: []dns.ZoneDelegationNameServer{
+				{NameServer: "ns1.rollback.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.30.1")}},
+				{NameServer: "ns1.rollback.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.30.2")}},
+			},
+		})
+		suite.EqualError(err, "windows.dns.ZoneDelegationCreate: the specified delegation already exists")
+
+		_, err = suite.client.ZoneDelegationRead(ctx, dns.ZoneDelegationReadParams{Zone: "test.local", ChildZoneName: "rollback"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		_, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Name: "ns1.rollback", Zone: "test.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordSOAScenario() {
+	ctx := context.Background()
+
+	suite.Run("should read and update a SOA-Record with a monotonic serial number", func() {
+		read, err := suite.client.RecordSOARead(ctx, dns.RecordSOAReadParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal("winsrv.test.local.", read.PrimaryServer)
+		suite.Equal(15*time.Minute, read.RefreshInterval)
+
+		updated, err := suite.client.RecordSOAUpdate(ctx, dns.RecordSOAUpdateParams{Zone: "test.local", RefreshInterval: 30 * time.Minute, MinimumTimeToLive: 5 * time.Minute})
+		suite.Require().NoError(err)
+		suite.Equal(read.SerialNumber+1, updated.SerialNumber)
+		suite.Equal(30*time.Minute, updated.RefreshInterval)
+		suite.Equal(read.RetryDelay, updated.RetryDelay)
+		suite.Equal(5*time.Minute, updated.MinimumTimeToLive)
+
+		serialNumber := uint32(2026101801)
+		updated, err = suite.client.RecordSOAUpdate(ctx, dns.RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber})
+		suite.Require().NoError(err)
+		suite.Equal(serialNumber, updated.SerialNumber)
+
+		_, err = suite.client.RecordSOAUpdate(ctx, dns.RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber})
+		suite.EqualError(err, "windows.dns.RecordSOAUpdate: record parameter 'SerialNumber' must be greater than the current serial number 2026101801, got 2026101801")
+
+		// A serial number of 0 is valid after the serial number wrapped around.
+		for _, serialNumber = range []uint32{4000000000, 4294967295} {
+			_, err = suite.client.RecordSOAUpdate(ctx, dns.RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber})
+			suite.Require().NoError(err)
+		}
+		serialNumber = 0
+		updated, err = suite.client.RecordSOAUpdate(ctx, dns.RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(0), updated.SerialNumber)
+
+		updated, err = suite.client.RecordSOAUpdate(ctx, dns.RecordSOAUpdateParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(1), updated.SerialNumber)
+
+		_, err = suite.client.RecordSOARead(ctx, dns.RecordSOAReadParams{Zone: "notexist.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordListScenario() {
+	ctx := context.Background()
+	conn := fake.NewConnection()
+	client := dns.NewClient(conn)
+
+	for _, name := range []string{"web01", "web02", "db01"} {
+		_, err := client.RecordACreate(ctx, dns.RecordACreateParams{Name: name, Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.1")}})
+		suite.Require().NoError(err)
+	}
+	_, err := client.RecordCNameCreate(ctx, dns.RecordCNameCreateParams{Name: "www", Zone: "test.local", CName: "web01.test.local"})
+	suite.Require().NoError(err)
+	conn.SetRecordTimestamp("test.local", "web02", time.Now().Add(-30*24*time.Hour))
+
+	suite.Run("should list all records of a zone", func() {
+		records, err := client.RecordList(ctx, dns.RecordListParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Len(records.A, 3)
+		suite.Len(records.CName, 1)
+	})
+
+	suite.Run("should list the records that match the filters", func() {
+		records, err := client.RecordList(ctx, dns.RecordListParams{Zone: "test.local", NamePrefix: "WEB", RecordType: "A", Timestamp: dns.RecordTimestampStatic})
+		suite.Require().NoError(err)
+		suite.Len(records.A, 1)
+		suite.Equal("web01", records.A[0].Name)
+	})
+
+	suite.Run("should list the records with quotes in the name filters", func() {
+		records, err := client.RecordList(ctx, dns.RecordListParams{Zone: "test.local", NamePrefix: "o'", NameSuffix: "'s"})
+		suite.Require().NoError(err)
+		suite.Empty(records.A)
+	})
+
+	suite.Run("should list the stale records", func() {
+		records, err := client.RecordList(ctx, dns.RecordListParams{Zone: "test.local", StaleAge: 7 * 24 * time.Hour})
+		suite.Require().NoError(err)
+		suite.Len(records.A, 1)
+		suite.Equal("web02", records.A[0].Name)
+		suite.Empty(records.CName)
+	})
+
+	suite.Run("should pass the records sorted by name to the function", func() {
+		var names []string
+		err := client.RecordListFunc(ctx, dns.RecordListParams{Zone: "test.local"}, func(r dns.Records) error {
+			for _, record := range r.A {
+				names = append(names, record.Name)
+			}
+			for _, record := range r.CName {
+				names = append(names, record.Name)
+			}
+			return nil
+		})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"db01", "web01", "web02", "www"}, names)
+	})
+
+	suite.Run("should return an object not found error", func() {
+		_, err := client.RecordList(ctx, dns.RecordListParams{Zone: "notexist.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		err = client.RecordListFunc(ctx, dns.RecordListParams{Zone: "notexist.local"}, func(r dns.Records) error { return nil })
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestAgingScenario() {
+	ctx := context.Background()
+	conn := fake.NewConnection()
+	client := dns.NewClient(conn)
+
+	for _, name := range []string{"client01", "client02"} {
+		_, err := client.RecordACreate(ctx, dns.RecordACreateParams{Name: name, Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.1")}, AgeRecord: true})
+		suite.Require().NoError(err)
+	}
+	_, err := client.RecordACreate(ctx, dns.RecordACreateParams{Name: "server01", Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.2")}})
+	suite.Require().NoError(err)
+	conn.SetRecordTimestamp("test.local", "client02", time.Now().Add(-30*24*time.Hour))
+
+	suite.Run("should create an aging record", func() {
+		record, err := client.RecordARead(ctx, dns.RecordAReadParams{Name: "client01", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.False(record.Timestamp.IsZero())
+	})
+
+	suite.Run("should update the server scavenging", func() {
+		scavenging, err := client.ServerScavengingRead(ctx)
+		suite.Require().NoError(err)
+		suite.False(scavenging.ScavengingState)
+		suite.Equal(7*24*time.Hour, scavenging.NoRefreshInterval)
+
+		scavenging, err = client.ServerScavengingUpdate(ctx, dns.ServerScavengingUpdateParams{ScavengingState: true, ScavengingInterval: 24 * time.Hour})
+		suite.Require().NoError(err)
+		suite.True(scavenging.ScavengingState)
+		suite.Equal(24*time.Hour, scavenging.ScavengingInterval)
+		suite.Equal(7*24*time.Hour, scavenging.RefreshInterval)
+	})
+
+	suite.Run("should not scavenge zones without aging", func() {
+		suite.Require().NoError(client.ServerScavengingStart(ctx))
+		records, err := client.RecordList(ctx, dns.RecordListParams{Zone: "test.local", RecordType: "A"})
+		suite.Require().NoError(err)
+		suite.Len(records.A, 3)
+	})
+
+	suite.Run("should update the zone aging", func() {
+		aging, err := client.ZoneAgingRead(ctx, dns.ZoneAgingReadParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.False(aging.AgingEnabled)
+		suite.True(aging.AvailForScavengeTime.IsZero())
+
+		aging, err = client.ZoneAgingUpdate(ctx, dns.ZoneAgingUpdateParams{Zone: "test.local", Aging: true, NoRefreshInterval: 3 * 24 * time.Hour, ScavengeServers: []netip.Addr{netip.MustParseAddr("192.168.10.10")}})
+		suite.Require().NoError(err)
+		suite.True(aging.AgingEnabled)
+		suite.Equal(3*24*time.Hour, aging.NoRefreshInterval)
+		suite.Equal(7*24*time.Hour, aging.RefreshInterval)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("192.168.10.10")}, aging.ScavengeServers)
+		suite.False(aging.AvailForScavengeTime.IsZero())
+	})
+
+	suite.Run("should scavenge the stale records", func() {
+		suite.Require().NoError(client.ServerScavengingStart(ctx))
+		records, err := client.RecordList(ctx, dns.RecordListParams{Zone: "test.local", RecordType: "A"})
+		suite.Require().NoError(err)
+		suite.Len(records.A, 2)
+		for _, record := range records.A {
+			suite.NotEqual("client02", record.Name)
+		}
+
+		scavenging, err := client.ServerScavengingRead(ctx)
+		suite.Require().NoError(err)
+		suite.False(scavenging.LastScavengeTime.IsZero())
+	})
+
+	suite.Run("should return an object not found error", func() {
+		_, err := client.ZoneAgingRead(ctx, dns.ZoneAgingReadParams{Zone: "notexist.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestDnssecScenario() {
+	ctx := context.Background()
+
+	suite.Run("should sign a zone with its own keys", func() {
+		_, err := suite.client.ZoneSign(ctx, dns.ZoneSignParams{Zone: "test.local"})
+		suite.Equal(winerror.CategoryInvalidOperation, winerror.Category(err))
+
+		ksk, err := suite.client.SigningKeyCreate(ctx, dns.SigningKeyCreateParams{Zone: "test.local", KeyType: dns.SigningKeyTypeKeySigningKey, CryptoAlgorithm: "ECDsaP256Sha256"})
+		suite.Require().NoError(err)
+		suite.NotEmpty(ksk.KeyId)
+		suite.Equal(755*24*time.Hour, ksk.RolloverPeriod)
+		zsk, err := suite.client.SigningKeyCreate(ctx, dns.SigningKeyCreateParams{Zone: "test.local", KeyType: dns.SigningKeyTypeZoneSigningKey, CryptoAlgorithm: "RsaSha256", RolloverPeriod: 30 * 24 * time.Hour})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(1024), zsk.KeyLength)
+
+		zone, err := suite.client.ZoneSign(ctx, dns.ZoneSignParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.True(zone.IsSigned)
+
+		keys, err := suite.client.SigningKeyList(ctx, dns.SigningKeyListParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Len(keys, 2)
+		suite.False(keys[0].NextRolloverTime.IsZero())
+	})
+
+	suite.Run("should export the DS-Records and trust anchors of the key signing key", func() {
+		records, err := suite.client.ZoneDsRecordList(ctx, dns.ZoneDsRecordListParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Require().Len(records, 1)
+		suite.Equal("ECDsaP256Sha256", records[0].CryptoAlgorithm)
+		suite.Len(records[0].Digest, 64)
+		suite.True(strings.HasPrefix(records[0].String(), "test.local. IN DS "))
+
+		anchors, err := suite.client.TrustAnchorList(ctx, dns.TrustAnchorListParams{Name: "test.local"})
+		suite.Require().NoError(err)
+		suite.Require().Len(anchors, 1)
+		suite.Equal(records[0].KeyTag, anchors[0].KeyTag)
+	})
+
+	suite.Run("should update the signing keys of an unsigned zone", func() {
+		keys, err := suite.client.SigningKeyList(ctx, dns.SigningKeyListParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		err = suite.client.SigningKeyDelete(ctx, dns.SigningKeyDeleteParams{Zone: "test.local", KeyId: keys[1].KeyId})
+		suite.Equal(winerror.CategoryInvalidOperation, winerror.Category(err))
+
+		zone, err := suite.client.ZoneUnsign(ctx, dns.ZoneUnsignParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.False(zone.IsSigned)
+		_, err = suite.client.ZoneDsRecordList(ctx, dns.ZoneDsRecordListParams{Zone: "test.local"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		key, err := suite.client.SigningKeyUpdate(ctx, dns.SigningKeyUpdateParams{Zone: "test.local", KeyId: keys[1].KeyId, RolloverPeriod: 60 * 24 * time.Hour})
+		suite.Require().NoError(err)
+		suite.Equal(60*24*time.Hour, key.RolloverPeriod)
+
+		suite.Require().NoError(suite.client.SigningKeyDelete(ctx, dns.SigningKeyDeleteParams{Zone: "test.local", KeyId: keys[1].KeyId}))
+		_, err = suite.client.SigningKeyRead(ctx, dns.SigningKeyReadParams{Zone: "test.local", KeyId: keys[1].KeyId})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should sign a zone with the default keys", func() {
+		zone, err := suite.client.ZoneSign(ctx, dns.ZoneSignParams{Zone: "test.local", SignWithDefault: true})
+		suite.Require().NoError(err)
+		suite.True(zone.IsSigned)
+
+		records, err := suite.client.ZoneDsRecordList(ctx, dns.ZoneDsRecordListParams{Zone: "test.local", DigestType: "Sha384"})
+		suite.Require().NoError(err)
+		suite.Require().Len(records, 1)
+		suite.Equal("RsaSha256", records[0].CryptoAlgorithm)
+		suite.Len(records[0].Digest, 96)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestSplitBrainScenario() {
+	ctx := context.Background()
+	internalSubnets := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.168.0.0/16")}
+
+	suite.Run("should create the client subnet and the zone scope", func() {
+		subnet, err := suite.client.ClientSubnetCreate(ctx, dns.ClientSubnetCreateParams{Name: "internal", IPv4Subnets: internalSubnets})
+		suite.Require().NoError(err)
+		suite.Equal(internalSubnets, subnet.IPv4Subnets)
+		suite.Empty(subnet.IPv6Subnets)
+
+		scope, err := suite.client.ZoneScopeCreate(ctx, dns.ZoneScopeCreateParams{Zone: "test.local", Name: "internal"})
+		suite.Require().NoError(err)
+		suite.Equal("internal", scope.Name)
+
+		scopes, err := suite.client.ZoneScopeList(ctx, dns.ZoneScopeListParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.ZoneScope{{Name: "test.local"}, {Name: "internal"}}, scopes)
+	})
+
+	suite.Run("should answer with different records per zone scope", func() {
+		_, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{Zone: "test.local", Name: "www", Addresses: []netip.Addr{netip.MustParseAddr("203.0.113.10")}})
+		suite.Require().NoError(err)
+		_, err = suite.client.RecordACreate(ctx, dns.RecordACreateParams{Zone: "test.local", ZoneScope: "internal", Name: "www", Addresses: []netip.Addr{netip.MustParseAddr("10.0.0.10")}})
+		suite.Require().NoError(err)
+
+		record, err := suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", ZoneScope: "internal", Name: "www"})
+		suite.Require().NoError(err)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("10.0.0.10")}, record.Addresses)
+
+		record, err = suite.client.RecordAUpdate(ctx, dns.RecordAUpdateParams{Zone: "test.local", ZoneScope: "internal", Name: "www", Addresses: []netip.Addr{netip.MustParseAddr("10.0.0.11")}, TimeToLive: time.Hour})
+		suite.Require().NoError(err)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("10.0.0.11")}, record.Addresses)
+
+		record, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", Name: "www"})
+		suite.Require().NoError(err)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("203.0.113.10")}, record.Addresses)
+
+		records, err := suite.client.RecordList(ctx, dns.RecordListParams{Zone: "test.local", ZoneScope: "internal"})
+		suite.Require().NoError(err)
+		suite.Len(records.A, 1)
+
+		_, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", ZoneScope: "notexist", Name: "www"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+
+	suite.Run("should resolve the internal clients from the zone scope", func() {
+		_, err := suite.client.QueryResolutionPolicyCreate(ctx, dns.QueryResolutionPolicyCreateParams{
+			Name:       "internal",
+			Zone:       "test.local",
+			Criteria:   dns.QueryResolutionPolicyCriteria{ClientSubnet: "EQ,notexist"},
+			ZoneScopes: []dns.QueryResolutionPolicyZoneScope{{Name: "internal"}},
+		})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+
+		policy, err := suite.client.QueryResolutionPolicyCreate(ctx, dns.QueryResolutionPolicyCreateParams{
+			Name:       "internal",
+			Zone:       "test.local",
+			Criteria:   dns.QueryResolutionPolicyCriteria{ClientSubnet: "EQ,internal"},
+			ZoneScopes: []dns.QueryResolutionPolicyZoneScope{{Name: "internal"}},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(dns.QueryResolutionPolicy{
+			Name:            "internal",
+			Zone:            "test.local",
+			Action:          dns.QueryResolutionPolicyActionAllow,
+			ProcessingOrder: 1,
+			IsEnabled:       true,
+			Condition:       "And",
+			Criteria:        dns.QueryResolutionPolicyCriteria{ClientSubnet: "EQ,internal"},
+			ZoneScopes:      []dns.QueryResolutionPolicyZoneScope{{Name: "internal", Weight: 1}},
+		}, policy)
+
+		_, err = suite.client.QueryResolutionPolicyCreate(ctx, dns.QueryResolutionPolicyCreateParams{Name: "internal", Zone: "test.local"})
+		suite.Equal(winerror.CategoryResourceExists, winerror.Category(err))
+	})
+
+	suite.Run("should keep the processing order of the policies", func() {
+		policy, err := suite.client.QueryResolutionPolicyCreate(ctx, dns.QueryResolutionPolicyCreateParams{
+			Name:            "deny-tcp",
+			Zone:            "test.local",
+			Action:          dns.QueryResolutionPolicyActionDeny,
+			ProcessingOrder: 1,
+			Criteria:        dns.QueryResolutionPolicyCriteria{TransportProtocol: "EQ,TCP", FQDN: "EQ,*.test.local"},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(1), policy.ProcessingOrder)
+
+		policies, err := suite.client.QueryResolutionPolicyList(ctx, dns.QueryResolutionPolicyListParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Require().Len(policies, 2)
+		suite.Equal("deny-tcp", policies[0].Name)
+		suite.Equal("internal", policies[1].Name)
+		suite.Equal(uint32(2), policies[1].ProcessingOrder)
+
+		policy, err = suite.client.QueryResolutionPolicyUpdate(ctx, dns.QueryResolutionPolicyUpdateParams{Name: "deny-tcp", Zone: "test.local", ProcessingOrder: 2})
+		suite.Require().NoError(err)
+		suite.True(policy.IsEnabled)
+		suite.Equal(uint32(2), policy.ProcessingOrder)
+		suite.Equal("EQ,TCP", policy.Criteria.TransportProtocol)
+
+		isEnabled := false
+		policy, err = suite.client.QueryResolutionPolicyUpdate(ctx, dns.QueryResolutionPolicyUpdateParams{Name: "deny-tcp", Zone: "test.local", IsEnabled: &isEnabled})
+		suite.Require().NoError(err)
+		suite.False(policy.IsEnabled)
+		suite.Equal(uint32(2), policy.ProcessingOrder)
+
+		policy, err = suite.client.QueryResolutionPolicyRead(ctx, dns.QueryResolutionPolicyReadParams{Name: "internal", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(1), policy.ProcessingOrder)
+
+		serverPolicies, err := suite.client.QueryResolutionPolicyList(ctx, dns.QueryResolutionPolicyListParams{})
+		suite.Require().NoError(err)
+		suite.Empty(serverPolicies)
+	})
+
+	suite.Run("should update the client subnet", func() {
+		ipv6Subnets := []netip.Prefix{netip.MustParsePrefix("fd00::/8")}
+		subnet, err := suite.client.ClientSubnetUpdate(ctx, dns.ClientSubnetUpdateParams{Name: "internal", IPv6Subnets: ipv6Subnets})
+		suite.Require().NoError(err)
+		suite.Empty(subnet.IPv4Subnets)
+		suite.Equal(ipv6Subnets, subnet.IPv6Subnets)
+	})
+
+	suite.Run("should not delete the client subnet and zone scope of a policy", func() {
+		err := suite.client.ClientSubnetDelete(ctx, dns.ClientSubnetDeleteParams{Name: "internal"})
+		suite.Equal(winerror.CategoryInvalidOperation, winerror.Category(err))
+		err = suite.client.ZoneScopeDelete(ctx, dns.ZoneScopeDeleteParams{Zone: "test.local", Name: "internal"})
+		suite.Equal(winerror.CategoryInvalidOperation, winerror.Category(err))
+
+		suite.Require().NoError(suite.client.QueryResolutionPolicyDelete(ctx, dns.QueryResolutionPolicyDeleteParams{Name: "internal", Zone: "test.local"}))
+		suite.Require().NoError(suite.client.ClientSubnetDelete(ctx, dns.ClientSubnetDeleteParams{Name: "internal"}))
+		suite.Require().NoError(suite.client.ZoneScopeDelete(ctx, dns.ZoneScopeDeleteParams{Zone: "test.local", Name: "internal"}))
+
+		policy, err := suite.client.QueryResolutionPolicyRead(ctx, dns.QueryResolutionPolicyReadParams{Name: "deny-tcp", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(1), policy.ProcessingOrder)
+
+		subnets, err := suite.client.ClientSubnetList(ctx)
+		suite.Require().NoError(err)
+		suite.Empty(subnets)
+		_, err = suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", ZoneScope: "internal", Name: "www"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestZoneFileScenario() {
+	ctx := context.Background()
+	zoneFile := `$TTL 3600
+@	IN	SOA	ns1.example.com. hostmaster.example.com. ( 1 3600 600 86400 3600 )
+	IN	NS	ns1.example.com.
+	IN	MX	10 mail
+web	IN	A	192.168.10.1
+	IN	A	192.168.10.2
+www	IN	CNAME	web
+_sip._tcp	IN	SRV	10 60 5060 sip
+@	IN	TXT	"v=spf1 mx -all"
+`
+
+	suite.Run("should import the records of a zone file", func() {
+		records, err := dns.ParseZoneFile(strings.NewReader(zoneFile), "test.local")
+		suite.Require().NoError(err)
+
+		// The NS-Records of the zone itself are managed by the DNS server.
+		records.NS = nil
+
+		created, err := suite.client.ZoneImport(ctx, dns.ZoneImportParams{Zone: "test.local", Records: records})
+		suite.Require().NoError(err)
+		suite.Len(created.A, 1)
+		suite.Len(created.MX, 1)
+		suite.Len(created.SRV, 1)
+
+		record, err := suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", Name: "web"})
+		suite.Require().NoError(err)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("192.168.10.2")}, record.Addresses)
+		suite.Equal(time.Hour, record.TimeToLive)
+	})
+
+	suite.Run("should export the imported records", func() {
+		exported, err := suite.client.ZoneExport(ctx, dns.ZoneExportParams{Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Contains(exported, "web\t3600\tIN\tA\t192.168.10.2\n")
+		suite.Contains(exported, "_sip._tcp\t3600\tIN\tSRV\t10 60 5060 sip.test.local.\n")
+
+		records, err := dns.ParseZoneFile(strings.NewReader(exported), "test.local")
+		suite.Require().NoError(err)
+		suite.Equal(exported, records.ZoneFile("test.local"))
+	})
+
+	suite.Run("should stop the import at an existing record", func() {
+		records, err := dns.ParseZoneFile(strings.NewReader(zoneFile), "test.local")
+		suite.Require().NoError(err)
+
+		created, err := suite.client.ZoneImport(ctx, dns.ZoneImportParams{Zone: "test.local", Records: records})
+		suite.ErrorContains(err, "windows.dns.ZoneImport: windows.dns.RecordACreate")
+		suite.Empty(created.A)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestRecordImportScenario() {
+	ctx := context.Background()
+	csv := `name,zone,type,data,ttl
+web,test.local,A,192.168.10.1,1h
+web,test.local,A,192.168.10.2,1h
+www,test.local,CNAME,web,5m
+@,test.local,MX,20 mail2,1h
+@,test.local,TXT,v=spf1 mx -all,1h
+`
+
+	_, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{Zone: "test.local", Name: "web", Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.1")}, TimeToLive: time.Hour})
+	suite.Require().NoError(err)
+	_, err = suite.client.RecordMXCreate(ctx, dns.RecordMXCreateParams{Zone: "test.local", Name: "@", MailExchangers: []dns.MailExchanger{{MailExchange: "mail.test.local", Preference: 10}}, TimeToLive: time.Hour})
+	suite.Require().NoError(err)
+
+	rows, err := dns.ParseRecordImportCSV(strings.NewReader(csv))
+	suite.Require().NoError(err)
+
+	actions := func(results []dns.RecordImportResult) []string {
+		var a []string
+		for _, result := range results {
+			a = append(a, result.Action)
+		}
+		return a
+	}
+
+	suite.Run("should report the actions in a dry-run", func() {
+		results, err := suite.client.RecordImport(ctx, dns.RecordImportParams{Rows: rows, DryRun: true})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"Update", "Update", "Create", "Update", "Create"}, actions(results))
+
+		record, err := suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", Name: "web"})
+		suite.Require().NoError(err)
+		suite.Len(record.Addresses, 1)
+	})
+
+	suite.Run("should import the records", func() {
+		_, err := suite.client.RecordImport(ctx, dns.RecordImportParams{Rows: rows})
+		suite.Require().NoError(err)
+
+		record, err := suite.client.RecordARead(ctx, dns.RecordAReadParams{Zone: "test.local", Name: "web"})
+		suite.Require().NoError(err)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("192.168.10.2")}, record.Addresses)
+
+		mx, err := suite.client.RecordMXRead(ctx, dns.RecordMXReadParams{Zone: "test.local", Name: "@"})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.MailExchanger{{MailExchange: "mail2.test.local.", Preference: 20}}, mx.MailExchangers)
+	})
+
+	suite.Run("should not change imported records", func() {
+		results, err := suite.client.RecordImport(ctx, dns.RecordImportParams{Rows: rows})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"Unchanged", "Unchanged", "Unchanged", "Unchanged", "Unchanged"}, actions(results))
+	})
+
+	suite.Run("should reject a CNAME-Record for a name with other records", func() {
+		results, err := suite.client.RecordImport(ctx, dns.RecordImportParams{Rows: []dns.RecordImportRow{
+			{Name: "web", Zone: "test.local", RecordType: "CNAME", Data: "www"},
+		}})
+		suite.ErrorContains(err, "windows.dns.RecordImport: no records were changed")
+		suite.EqualError(results[0].Err, "CNAME-Record of name 'web' conflicts with the existing A-Record")
+	})
+
+	suite.Run("should keep the existing records if a record of a record set cannot be added", func() {
+		conn := fake.NewConnection()
+		client := dns.NewClient(conn)
+		conn.RejectRecordData("mail4.test.local.")
+
+		_, err := client.RecordMXCreate(ctx, dns.RecordMXCreateParams{Zone: "test.local", Name: "@", MailExchangers: []dns.MailExchanger{{MailExchange: "mail.test.local", Preference: 10}}, TimeToLive: time.Hour})
+		suite.Require().NoError(err)
+
+		results, err := client.RecordImport(ctx, dns.RecordImportParams{Rows: []dns.RecordImportRow{
+			{Name: "@", Zone: "test.local", RecordType: "MX", Data: "10 mail"},
+			{Name: "@", Zone: "test.local", RecordType: "MX", Data: "20 mail3"},
+			{Name: "@", Zone: "test.local", RecordType: "MX", Data: "30 mail4"},
+		}})
+		suite.ErrorContains(err, "windows.dns.RecordImport: 3 of 3 rows failed")
+		suite.ErrorContains(results[0].Err, "failed to replace MX-Records of name '@'")
+		suite.Equal(winerror.CategoryPermissionDenied, winerror.Category(results[0].Err))
+
+		mx, err := client.RecordMXRead(ctx, dns.RecordMXReadParams{Zone: "test.local", Name: "@"})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.MailExchanger{{MailExchange: "mail.test.local.", Preference: 10}}, mx.MailExchangers)
+		suite.Equal(time.Hour, mx.TimeToLive)
+	})
+}
+
+func (suite *DnsFakeUnitTestSuite) TestResolveScenario() {
+	ctx := context.Background()
+	addresses := []netip.Addr{netip.MustParseAddr("192.168.10.20"), netip.MustParseAddr("192.168.10.21")}
+
+	_, err := suite.client.RecordACreate(ctx, dns.RecordACreateParams{Zone: "test.local", Name: "web", Addresses: addresses, TimeToLive: time.Hour})
+	suite.Require().NoError(err)
+	_, err = suite.client.RecordCNameCreate(ctx, dns.RecordCNameCreateParams{Zone: "test.local", Name: "www", CName: "web.test.local", TimeToLive: 5 * time.Minute})
+	suite.Require().NoError(err)
+
+	suite.Run("should resolve the records of a name", func() {
+		resolution, err := suite.client.Resolve(ctx, dns.ResolveParams{Name: "web.test.local", Server: "192.168.5.1"})
+		suite.Require().NoError(err)
+		suite.True(resolution.NameExists)
+		suite.Equal([]dns.RecordA{{Name: "web.test.local", Addresses: addresses, TimeToLive: time.Hour}}, resolution.Answers.A)
+	})
+
+	suite.Run("should follow an alias", func() {
+		resolution, err := suite.client.Resolve(ctx, dns.ResolveParams{Name: "www.test.local", Server: "192.168.5.1"})
+		suite.Require().NoError(err)
+		suite.Equal([]dns.RecordCName{{Name: "www.test.local", CName: "web.test.local", TimeToLive: 5 * time.Minute}}, resolution.Answers.CName)
+		suite.Len(resolution.Answers.A, 1)
+	})
+
+	suite.Run("should report a name that does not exist", func() {
+		resolution, err := suite.client.Resolve(ctx, dns.ResolveParams{Name: "nx.test.local", Server: "192.168.5.1", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.False(resolution.NameExists)
+		suite.Empty(resolution.Missing)
+		suite.Empty(resolution.Unexpected)
+	})
+
+	suite.Run("should compare the answers with the stored records", func() {
+		resolution, err := suite.client.Resolve(ctx, dns.ResolveParams{Name: "www.test.local", Server: "192.168.5.1", Zone: "test.local"})
+		suite.Require().NoError(err)
+		suite.Empty(resolution.Missing)
+		suite.Empty(resolution.Unexpected)
+
+		_, err = suite.client.ZoneScopeCreate(ctx, dns.ZoneScopeCreateParams{Zone: "test.local", Name: "internal"})
+		suite.Require().NoError(err)
+		_, err = suite.client.RecordACreate(ctx, dns.RecordACreateParams{Zone: "test.local", ZoneScope: "internal", Name: "web", Addresses: []netip.Addr{netip.MustParseAddr("10.0.0.20")}})
+		suite.Require().NoError(err)
+
+		resolution, err = suite.client.Resolve(ctx, dns.ResolveParams{Name: "web.test.local", Server: "192.168.5.1", Zone: "test.local", ZoneScope: "internal"})
+		suite.Require().NoError(err)
+		suite.Equal([]string{"A 10.0.0.20"}, resolution.Missing)
+		suite.Equal([]string{"A 192.168.10.20", "A 192.168.10.21"}, resolution.Unexpected)
+	})
+}
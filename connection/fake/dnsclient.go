@@ -0,0 +1,285 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// dnsClientHandlers contains the handlers for the commands of the windows/dnsclient package.
+var dnsClientHandlers = []handler{
+	{regexp.MustCompile(`^\$a=@\(Get-DnsClientServerAddress (.+)\) ;if\(\$a\.Count -ge 2\)\{ConvertTo-Json \$a -Compress\}else\{ConvertTo-Json @\(\$a\) -Compress\}$`), (*Connection).serverAddressRead},
+	{regexp.MustCompile(`^Set-DnsClientServerAddress (.+?) -ResetServerAddresses -ErrorAction Stop ;(?:Set-DnsClientServerAddress (.+?) -ErrorAction Stop ;)?(\$a=@\(Get-DnsClientServerAddress .+)$`), (*Connection).serverAddressUpdate},
+	{regexp.MustCompile(`^Get-DnsClient (.+) \| ConvertTo-Json -Compress$`), (*Connection).dnsClientRead},
+	{regexp.MustCompile(`^\$i=@\(Get-DnsClient\) ;if\(\$i\.Count -ge 2\)\{ConvertTo-Json \$i -Compress\}else\{ConvertTo-Json @\(\$i\) -Compress\}$`), (*Connection).dnsClientList},
+	{regexp.MustCompile(`^Set-DnsClient (.+?) -ErrorAction Stop ;(Get-DnsClient .+)$`), (*Connection).dnsClientUpdate},
+	{regexp.MustCompile(`^Get-DnsClientGlobalSetting \| ConvertTo-Json -Compress$`), (*Connection).dnsClientGlobalSettingRead},
+	{regexp.MustCompile(`^Set-DnsClientGlobalSetting (.+?) -ErrorAction Stop ;Get-DnsClientGlobalSetting \| ConvertTo-Json -Compress$`), (*Connection).dnsClientGlobalSettingUpdate},
+}
+
+// Address families of the server address objects.
+const (
+	addressFamilyIPv4 uint16 = 2
+	addressFamilyIPv6 uint16 = 23
+)
+
+// netInterface represents the DNS client settings of a network interface of the fake server.
+// The fake server has no DHCP client, so resetting the server addresses or the suffix clears them.
+type netInterface struct {
+	alias             string
+	index             uint32
+	ipv4Servers       []string
+	ipv6Servers       []string
+	suffix            string
+	registerAddress   bool
+	useSuffixRegister bool
+	suffixSearchList  []string
+}
+
+// dnsClientGlobalSetting represents the global DNS client settings of the fake server.
+type dnsClientGlobalSetting struct {
+	suffixSearchList []string
+	useDevolution    bool
+	devolutionLevel  uint32
+}
+
+// serverAddressJson is the JSON representation of the DNS server addresses of an address family of an interface.
+type serverAddressJson struct {
+	InterfaceAlias  string   `json:"InterfaceAlias"`
+	InterfaceIndex  uint32   `json:"InterfaceIndex"`
+	AddressFamily   uint16   `json:"AddressFamily"`
+	ServerAddresses []string `json:"ServerAddresses"`
+	PSComputerName  *string  `json:"PSComputerName"`
+}
+
+// dnsClientJson is the JSON representation of the DNS client settings of an interface.
+type dnsClientJson struct {
+	InterfaceAlias                     string   `json:"InterfaceAlias"`
+	InterfaceIndex                     uint32   `json:"InterfaceIndex"`
+	ConnectionSpecificSuffix           string   `json:"ConnectionSpecificSuffix"`
+	ConnectionSpecificSuffixSearchList []string `json:"ConnectionSpecificSuffixSearchList"`
+	RegisterThisConnectionsAddress     bool     `json:"RegisterThisConnectionsAddress"`
+	UseSuffixWhenRegistering           bool     `json:"UseSuffixWhenRegistering"`
+	PSComputerName                     *string  `json:"PSComputerName"`
+}
+
+// dnsClientGlobalSettingJson is the JSON representation of the global DNS client settings.
+type dnsClientGlobalSettingJson struct {
+	UseSuffixSearchList bool     `json:"UseSuffixSearchList"`
+	SuffixSearchList    []string `json:"SuffixSearchList"`
+	UseDevolution       bool     `json:"UseDevolution"`
+	DevolutionLevel     uint32   `json:"DevolutionLevel"`
+	PSComputerName      *string  `json:"PSComputerName"`
+}
+
+// seedDnsClient adds the network interfaces and the global DNS client settings of a fresh domain member.
+// The server uses itself as DNS server.
+func (c *Connection) seedDnsClient() {
+	c.interfaces = []*netInterface{
+		{alias: "Ethernet", index: 4, ipv4Servers: []string{serverIp.String()}, ipv6Servers: []string{"fd00::5:1"}, suffix: Domain, registerAddress: true, suffixSearchList: []string{}},
+		{alias: "Loopback Pseudo-Interface 1", index: 1, registerAddress: true, suffixSearchList: []string{}},
+	}
+	c.clientSetting = dnsClientGlobalSetting{suffixSearchList: []string{Domain}, useDevolution: true}
+}
+
+// findInterface returns the network interface that is selected by the -InterfaceAlias or -InterfaceIndex parameter.
+// The error names the CIM class of the cmdlet like the Windows cmdlets do.
+func (c *Connection) findInterface(cmdlet string, class string, p params) (*netInterface, error) {
+	property, value := "InterfaceAlias", p.str("InterfaceAlias")
+	target := value + ":String"
+	if !p.has("InterfaceAlias") {
+		property, value = "InterfaceIndex", p.str("InterfaceIndex")
+		target = value + ":UInt32"
+	}
+
+	for _, i := range c.interfaces {
+		if (property == "InterfaceAlias" && strings.EqualFold(i.alias, value)) ||
+			(property == "InterfaceIndex" && strconv.FormatUint(uint64(i.index), 10) == value) {
+			return i, nil
+		}
+	}
+
+	return nil, &cmdletError{
+		cmdlet:    cmdlet,
+		message:   fmt.Sprintf("No %s objects found with property '%s' equal to '%s'.  Verify the value of the property and retry.", class, property, value),
+		category:  "ObjectNotFound",
+		target:    target,
+		exception: "CimJobException",
+		errorId:   fmt.Sprintf("CmdletizationQuery_NotFound_%s,%s", property, cmdlet),
+	}
+}
+
+func (c *Connection) serverAddressRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	i, err := c.findInterface("Get-DnsClientServerAddress", "MSFT_DNSClientServerAddress", p)
+	if err != nil {
+		return "", err
+	}
+
+	return arrayJson([]serverAddressJson{
+		{InterfaceAlias: i.alias, InterfaceIndex: i.index, AddressFamily: addressFamilyIPv4, ServerAddresses: nonNil(i.ipv4Servers)},
+		{InterfaceAlias: i.alias, InterfaceIndex: i.index, AddressFamily: addressFamilyIPv6, ServerAddresses: nonNil(i.ipv6Servers)},
+	})
+}
+
+// serverAddressUpdate handles the reset of the server addresses, the optional Set-DnsClientServerAddress call
+// and the read of the server addresses.
+func (c *Connection) serverAddressUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	i, err := c.findInterface("Set-DnsClientServerAddress", "MSFT_DNSClientServerAddress", p)
+	if err != nil {
+		return "", err
+	}
+
+	// The addresses are reset even if the following call fails.
+	i.ipv4Servers = nil
+	i.ipv6Servers = nil
+
+	if match[2] != "" {
+		p, err := parseParams(match[2])
+		if err != nil {
+			return "", err
+		}
+
+		for _, address := range p.list("ServerAddresses") {
+			ip, err := netip.ParseAddr(address)
+			if err != nil {
+				return "", &cmdletError{
+					cmdlet:    "Set-DnsClientServerAddress",
+					message:   fmt.Sprintf("The parameter ServerAddresses contains an invalid address %s.", address),
+					category:  "InvalidArgument",
+					target:    "MSFT_DNSClientServerAddress",
+					exception: "CimException",
+					errorId:   "Windows System Error 87,Set-DnsClientServerAddress",
+				}
+			}
+
+			if ip.Is4() {
+				i.ipv4Servers = append(i.ipv4Servers, ip.String())
+			} else {
+				i.ipv6Servers = append(i.ipv6Servers, ip.String())
+			}
+		}
+	}
+
+	return c.handle(match[3])
+}
+
+// dnsClientJson returns the JSON representation of the DNS client settings of an interface.
+func (i *netInterface) dnsClientJson() dnsClientJson {
+	return dnsClientJson{
+		InterfaceAlias:                     i.alias,
+		InterfaceIndex:                     i.index,
+		ConnectionSpecificSuffix:           i.suffix,
+		ConnectionSpecificSuffixSearchList: nonNil(i.suffixSearchList),
+		RegisterThisConnectionsAddress:     i.registerAddress,
+		UseSuffixWhenRegistering:           i.useSuffixRegister,
+	}
+}
+
+func (c *Connection) dnsClientRead(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	i, err := c.findInterface("Get-DnsClient", "MSFT_DNSClient", p)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(i.dnsClientJson())
+	return string(b), err
+}
+
+func (c *Connection) dnsClientList(match []string) (string, error) {
+	var j []dnsClientJson
+	for _, i := range c.interfaces {
+		j = append(j, i.dnsClientJson())
+	}
+
+	return arrayJson(j)
+}
+
+// dnsClientUpdate handles the Set-DnsClient call and the read of the interface.
+func (c *Connection) dnsClientUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	i, err := c.findInterface("Set-DnsClient", "MSFT_DNSClient", p)
+	if err != nil {
+		return "", err
+	}
+
+	if p.flag("ResetConnectionSpecificSuffix") {
+		i.suffix = ""
+	}
+	if p.has("ConnectionSpecificSuffix") {
+		i.suffix = p.str("ConnectionSpecificSuffix")
+	}
+	if p.has("RegisterThisConnectionsAddress") {
+		i.registerAddress = p.flag("RegisterThisConnectionsAddress")
+	}
+	if p.has("UseSuffixWhenRegistering") {
+		i.useSuffixRegister = p.flag("UseSuffixWhenRegistering")
+	}
+
+	return c.handle(match[2])
+}
+
+func (c *Connection) dnsClientGlobalSettingRead(match []string) (string, error) {
+	j := dnsClientGlobalSettingJson{
+		UseSuffixSearchList: len(c.clientSetting.suffixSearchList) > 0,
+		SuffixSearchList:    nonNil(c.clientSetting.suffixSearchList),
+		UseDevolution:       c.clientSetting.useDevolution,
+		DevolutionLevel:     c.clientSetting.devolutionLevel,
+	}
+
+	b, err := json.Marshal(j)
+	return string(b), err
+}
+
+// dnsClientGlobalSettingUpdate handles the Set-DnsClientGlobalSetting call.
+func (c *Connection) dnsClientGlobalSettingUpdate(match []string) (string, error) {
+	p, err := parseParams(match[1])
+	if err != nil {
+		return "", err
+	}
+
+	if p.has("SuffixSearchList") {
+		c.clientSetting.suffixSearchList = p.list("SuffixSearchList")
+	}
+	if p.has("UseDevolution") {
+		c.clientSetting.useDevolution = p.flag("UseDevolution")
+	}
+	if p.has("DevolutionLevel") {
+		level, err := p.int("DevolutionLevel")
+		if err != nil {
+			return "", err
+		}
+		c.clientSetting.devolutionLevel = uint32(level)
+	}
+
+	return c.dnsClientGlobalSettingRead(match)
+}
+
+// nonNil returns an empty list instead of nil, because PowerShell converts empty lists to an empty JSON array.
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
@@ -0,0 +1,119 @@
+package fake_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/d-strobel/gowindows/connection/fake"
+	"github.com/d-strobel/gowindows/windows/dnsclient"
+	"github.com/d-strobel/gowindows/winerror"
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for the DNS client scenarios of the fake connection
+type DnsClientFakeUnitTestSuite struct {
+	suite.Suite
+	client *dnsclient.Client
+}
+
+// Run all DNS client scenario tests
+func TestDnsClientFakeUnitTestSuite(t *testing.T) {
+	suite.Run(t, &DnsClientFakeUnitTestSuite{})
+}
+
+func (suite *DnsClientFakeUnitTestSuite) SetupTest() {
+	suite.client = dnsclient.NewClient(fake.NewConnection())
+}
+
+func (suite *DnsClientFakeUnitTestSuite) TestServerAddressScenario() {
+	ctx := context.Background()
+
+	suite.Run("should read the seeded server addresses", func() {
+		serverAddress, err := suite.client.ServerAddressRead(ctx, dnsclient.ServerAddressReadParams{InterfaceAlias: "Ethernet"})
+		suite.Require().NoError(err)
+		suite.Equal(uint32(4), serverAddress.InterfaceIndex)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("192.168.5.1")}, serverAddress.IPv4Addresses)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("fd00::5:1")}, serverAddress.IPv6Addresses)
+	})
+
+	suite.Run("should update and reset the server addresses", func() {
+		ipv4Addresses := []netip.Addr{netip.MustParseAddr("192.168.5.2"), netip.MustParseAddr("192.168.5.3")}
+		updated, err := suite.client.ServerAddressUpdate(ctx, dnsclient.ServerAddressUpdateParams{InterfaceIndex: 4, IPv4Addresses: ipv4Addresses})
+		suite.Require().NoError(err)
+		suite.Equal("Ethernet", updated.InterfaceAlias)
+		suite.Equal(ipv4Addresses, updated.IPv4Addresses)
+		suite.Empty(updated.IPv6Addresses)
+
+		reset, err := suite.client.ServerAddressUpdate(ctx, dnsclient.ServerAddressUpdateParams{InterfaceAlias: "Ethernet"})
+		suite.Require().NoError(err)
+		suite.Empty(reset.IPv4Addresses)
+		suite.Empty(reset.IPv6Addresses)
+	})
+
+	suite.Run("should return an object not found error", func() {
+		_, err := suite.client.ServerAddressRead(ctx, dnsclient.ServerAddressReadParams{InterfaceIndex: 99})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+		suite.Equal("CmdletizationQuery_NotFound_InterfaceIndex,Get-DnsClientServerAddress", winerror.FullyQualifiedErrorId(err))
+	})
+}
+
+func (suite *DnsClientFakeUnitTestSuite) TestInterfaceScenario() {
+	ctx := context.Background()
+
+	suite.Run("should list the interfaces", func() {
+		interfaces, err := suite.client.InterfaceList(ctx)
+		suite.Require().NoError(err)
+		suite.Len(interfaces, 2)
+	})
+
+	suite.Run("should update the connection-specific suffix and registration", func() {
+		updated, err := suite.client.InterfaceUpdate(ctx, dnsclient.InterfaceUpdateParams{
+			InterfaceAlias:                 "Ethernet",
+			ConnectionSpecificSuffix:       "corp.example.com",
+			RegisterThisConnectionsAddress: true,
+			UseSuffixWhenRegistering:       true,
+		})
+		suite.Require().NoError(err)
+		suite.Equal("corp.example.com", updated.ConnectionSpecificSuffix)
+		suite.True(updated.UseSuffixWhenRegistering)
+
+		read, err := suite.client.InterfaceRead(ctx, dnsclient.InterfaceReadParams{InterfaceIndex: 4})
+		suite.Require().NoError(err)
+		suite.Equal(updated, read)
+
+		reset, err := suite.client.InterfaceUpdate(ctx, dnsclient.InterfaceUpdateParams{InterfaceIndex: 4})
+		suite.Require().NoError(err)
+		suite.Empty(reset.ConnectionSpecificSuffix)
+		suite.False(reset.RegisterThisConnectionsAddress)
+	})
+
+	suite.Run("should return an object not found error", func() {
+		_, err := suite.client.InterfaceRead(ctx, dnsclient.InterfaceReadParams{InterfaceAlias: "notexist"})
+		suite.Equal(winerror.CategoryObjectNotFound, winerror.Category(err))
+		suite.Equal("CmdletizationQuery_NotFound_InterfaceAlias,Get-DnsClient", winerror.FullyQualifiedErrorId(err))
+	})
+}
+
+func (suite *DnsClientFakeUnitTestSuite) TestGlobalSettingScenario() {
+	ctx := context.Background()
+
+	suite.Run("should read the seeded global settings", func() {
+		setting, err := suite.client.GlobalSettingRead(ctx)
+		suite.Require().NoError(err)
+		suite.Equal(dnsclient.GlobalSetting{SuffixSearchList: []string{fake.Domain}, UseDevolution: true}, setting)
+	})
+
+	suite.Run("should update the global settings", func() {
+		updated, err := suite.client.GlobalSettingUpdate(ctx, dnsclient.GlobalSettingUpdateParams{
+			SuffixSearchList: []string{"corp.example.com", fake.Domain},
+			DevolutionLevel:  2,
+		})
+		suite.Require().NoError(err)
+		suite.Equal(dnsclient.GlobalSetting{SuffixSearchList: []string{"corp.example.com", fake.Domain}, DevolutionLevel: 2}, updated)
+
+		cleared, err := suite.client.GlobalSettingUpdate(ctx, dnsclient.GlobalSettingUpdateParams{})
+		suite.Require().NoError(err)
+		suite.Empty(cleared.SuffixSearchList)
+	})
+}
@@ -0,0 +1,245 @@
+// Package fake provides an in-memory Windows server that implements the connection.Connection interface.
+// It understands the PowerShell commands emitted by the windows subpackages and keeps the state of
+// users, groups, DNS zones, DNS records, DHCP scopes, exclusion ranges, reservations,
+// failovers and the DNS client settings of the network interfaces in memory.
+//
+// The output is returned in the same JSON format as Windows PowerShell 5.1 returns it
+// and errors are returned as CLIXML on stderr, including the culture-neutral error category and ID.
+// This allows to test create, read, update and delete scenarios without a Windows machine.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/d-strobel/gowindows/connection"
+)
+
+const (
+	// ComputerName is the name of the fake Windows server.
+	ComputerName string = "WINSRV"
+
+	// Domain is the name of the Active Directory domain of the fake Windows server.
+	Domain string = "test.local"
+
+	// machineSid is the SID of the fake Windows server, which is the prefix of the local account SIDs.
+	machineSid string = "S-1-5-21-153895498-367353507-3704405138"
+)
+
+// ErrUnsupportedCommand is returned if the fake connection does not understand a command.
+var ErrUnsupportedCommand = errors.New("connection.fake: unsupported command")
+
+// handler handles a PowerShell command that matches its pattern.
+// It returns the stdout of the command or an error.
+// A *cmdletError is returned as CLIXML on stderr, any other error is returned as connection error.
+type handler struct {
+	pattern *regexp.Regexp
+	handle  func(c *Connection, match []string) (string, error)
+}
+
+// Connection is an in-memory fake of a Windows server that implements the connection.Connection interface.
+// Use NewConnection to create a new instance with the default state of a fresh Windows server.
+type Connection struct {
+	mu       sync.Mutex
+	handlers []handler
+
+	// Local accounts
+	users   []*user
+	groups  []*group
+	nextRid int
+
+	// DNS server
+	zones         []*zone
+	records       []*record
+	rejectedData  []string
+	forwarder     forwarder
+	scavenging    scavenging
+	setting       serverSetting
+	recursion     recursion
+	edns          edns
+	cache         cache
+	statistics    statistics
+	clientSubnets []*clientSubnet
+	policies      []*policy
+
+	// DHCP server
+	scopes       []*scope
+	exclusions   []*exclusion
+	reservations []*reservation
+	failovers    []*failover
+
+	// DNS client
+	interfaces    []*netInterface
+	clientSetting dnsClientGlobalSetting
+}
+
+// Ensure that the Connection implements the connection.Connection interface.
+var _ connection.Connection = (*Connection)(nil)
+
+// NewConnection returns a new fake connection.
+// The fake server contains the built-in local users and groups
+// and the default zones of a DNS server in the domain "test.local".
+// The DNS client of the "Ethernet" interface uses the server itself as DNS server.
+func NewConnection() *Connection {
+	c := &Connection{
+		handlers: slices.Concat(accountsHandlers, dnsHandlers, dhcpHandlers, dnsClientHandlers),
+		nextRid:  1000,
+	}
+
+	c.seedAccounts()
+	c.seedDns()
+	c.seedDnsClient()
+
+	return c
+}
+
+// Run runs a command against the fake server.
+// Only PowerShell commands are supported, so it always returns an ErrUnsupportedCommand.
+func (c *Connection) Run(ctx context.Context, cmd string) (connection.CmdResult, error) {
+	return connection.CmdResult{}, fmt.Errorf("%w: %s", ErrUnsupportedCommand, cmd)
+}
+
+// RunWithPowershell runs a PowerShell command against the fake server.
+// It returns an ErrUnsupportedCommand if the command is not emitted by one of the windows subpackages.
+func (c *Connection) RunWithPowershell(ctx context.Context, cmd string) (connection.CmdResult, error) {
+	if err := ctx.Err(); err != nil {
+		return connection.CmdResult{}, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stdout, err := c.handle(cmd)
+	if err != nil {
+		var cmdletErr *cmdletError
+		if errors.As(err, &cmdletErr) {
+			return connection.CmdResult{StdOut: stdout, StdErr: cmdletErr.cliXml()}, nil
+		}
+		return connection.CmdResult{}, err
+	}
+
+	return connection.CmdResult{StdOut: stdout}, nil
+}
+
+// handle runs a command with the first handler that matches it.
+// Handlers of composed commands use it to run the parts of the command.
+func (c *Connection) handle(cmd string) (string, error) {
+	for _, h := range c.handlers {
+		if match := h.pattern.FindStringSubmatch(cmd); match != nil {
+			return h.handle(c, match)
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedCommand, cmd)
+}
+
+// Close closes the fake connection.
+// The state of the fake server is kept.
+func (c *Connection) Close() error {
+	return nil
+}
+
+// cmdletError represents an error that a PowerShell cmdlet writes to the error stream.
+type cmdletError struct {
+	// Cmdlet that caused the error, e.g. "Get-LocalUser".
+	cmdlet string
+
+	// Human-readable error message.
+	message string
+
+	// Culture-neutral error category, e.g. "ObjectNotFound".
+	category string
+
+	// Target of the error, e.g. "test:String".
+	target string
+
+	// Name of the exception, e.g. "UserNotFoundException".
+	exception string
+
+	// Fully qualified error ID, e.g. "UserNotFound,Microsoft.PowerShell.Commands.GetLocalUserCommand".
+	errorId string
+}
+
+// Error implements the error interface.
+// Errors that are thrown by the script itself are not caused by a cmdlet.
+func (e *cmdletError) Error() string {
+	if e.cmdlet == "" {
+		return e.message
+	}
+	return fmt.Sprintf("%s : %s", e.cmdlet, e.message)
+}
+
+// thrownError returns the error of a throw statement with a message, which has the message as error ID.
+func thrownError(message string) *cmdletError {
+	return &cmdletError{
+		message:   message,
+		category:  "OperationStopped",
+		target:    message + ":String",
+		exception: "RuntimeException",
+		errorId:   message,
+	}
+}
+
+// cliXml returns the error as a CLIXML error string in the same format as PowerShell writes it to stderr.
+func (e *cmdletError) cliXml() string {
+	lines := []string{
+		e.Error(),
+		"At line:1 char:1",
+		fmt.Sprintf("    + CategoryInfo          : %s: (%s) [%s], %s", e.category, e.target, e.cmdlet, e.exception),
+		fmt.Sprintf("    + FullyQualifiedErrorId : %s", e.errorId),
+		" ",
+	}
+
+	var b strings.Builder
+	b.WriteString("#< CLIXML\r\n")
+	b.WriteString(`<Objs Version="1.1.0.1" xmlns="http://schemas.microsoft.com/powershell/2004/04">`)
+	for _, line := range lines {
+		b.WriteString(`<S S="Error">`)
+		b.WriteString(escapeXml(line))
+		b.WriteString("_x000D__x000A_</S>")
+	}
+	b.WriteString("</Objs>")
+
+	return b.String()
+}
+
+// escapeXml escapes the special XML characters of a string.
+func escapeXml(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "'", "&apos;").Replace(s)
+}
+
+// commandType returns the fully qualified type name of a cmdlet of the Microsoft.PowerShell.Commands namespace,
+// e.g. "Microsoft.PowerShell.Commands.GetLocalUserCommand" for the "Get-LocalUser" cmdlet.
+func commandType(cmdlet string) string {
+	return fmt.Sprintf("Microsoft.PowerShell.Commands.%sCommand", strings.ReplaceAll(cmdlet, "-", ""))
+}
+
+// pipelineJson returns the JSON of objects that are piped into the ConvertTo-Json cmdlet.
+// Like PowerShell, a single object is converted to a JSON object and no object returns an empty output.
+func pipelineJson[T any](objs []T) (string, error) {
+	switch len(objs) {
+	case 0:
+		return "", nil
+	case 1:
+		b, err := json.Marshal(objs[0])
+		return string(b), err
+	default:
+		b, err := json.Marshal(objs)
+		return string(b), err
+	}
+}
+
+// arrayJson returns the JSON of objects that are passed as an array to the ConvertTo-Json cmdlet.
+func arrayJson[T any](objs []T) (string, error) {
+	if objs == nil {
+		objs = []T{}
+	}
+	b, err := json.Marshal(objs)
+	return string(b), err
+}
@@ -0,0 +1,67 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/d-strobel/gowindows/connection/fake"
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for the fake connection
+type FakeConnectionUnitTestSuite struct {
+	suite.Suite
+}
+
+// Run all fake connection unit tests
+func TestFakeConnectionUnitTestSuite(t *testing.T) {
+	suite.Run(t, &FakeConnectionUnitTestSuite{})
+}
+
+func (suite *FakeConnectionUnitTestSuite) TestRun() {
+	suite.Run("should return an unsupported command error", func() {
+		c := fake.NewConnection()
+		_, err := c.Run(context.Background(), "hostname")
+		suite.ErrorIs(err, fake.ErrUnsupportedCommand)
+	})
+}
+
+func (suite *FakeConnectionUnitTestSuite) TestRunWithPowershell() {
+	suite.Run("should return an unsupported command error", func() {
+		c := fake.NewConnection()
+		_, err := c.RunWithPowershell(context.Background(), "Get-Process | ConvertTo-Json -Compress")
+		suite.ErrorIs(err, fake.ErrUnsupportedCommand)
+	})
+
+	suite.Run("should return the context error", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c := fake.NewConnection()
+		_, err := c.RunWithPowershell(ctx, "Get-LocalUser | ConvertTo-Json -Compress")
+		suite.ErrorIs(err, context.Canceled)
+	})
+
+	suite.Run("should return a decodable CLIXML error", func() {
+		c := fake.NewConnection()
+		result, err := c.RunWithPowershell(context.Background(), "Get-LocalUser -Name 'notexist' | ConvertTo-Json -Compress")
+		suite.Require().NoError(err)
+		suite.Empty(result.StdOut)
+
+		msg, err := parsing.DecodeCliXmlErr(result.StdErr)
+		suite.Require().NoError(err)
+		pwshErr := parsing.NewPwshError(msg)
+		suite.Equal("ObjectNotFound", pwshErr.Category)
+		suite.Equal("UserNotFound,Microsoft.PowerShell.Commands.GetLocalUserCommand", pwshErr.FullyQualifiedErrorId)
+	})
+}
+
+func (suite *FakeConnectionUnitTestSuite) TestClose() {
+	suite.Run("should keep the state after close", func() {
+		c := fake.NewConnection()
+		suite.NoError(c.Close())
+		result, err := c.RunWithPowershell(context.Background(), "Get-LocalUser -Name 'Administrator' | ConvertTo-Json -Compress")
+		suite.NoError(err)
+		suite.Contains(result.StdOut, `"Name":"Administrator"`)
+	})
+}
@@ -0,0 +1,248 @@
+package fake
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// params contains the parameters of a cmdlet call, keyed by the lowercase parameter name.
+// The values are kept as raw PowerShell expressions, e.g. "'test'" or "$(New-TimeSpan -Seconds 3600)".
+// Switch parameters without a value have the value "$true".
+type params map[string]string
+
+var (
+	// timespanRegex matches the timespan expressions emitted by the windows subpackages.
+	timespanRegex = regexp.MustCompile(`^\$\(New-TimeSpan((?: -\w+ -?\d+)+)\)$`)
+
+	// dateRegex matches the date expressions emitted by the windows subpackages.
+	dateRegex = regexp.MustCompile(`^\$\(Get-Date '([^']*)'\)$`)
+
+	// secureStringRegex matches the secure string expressions emitted by the windows subpackages.
+	secureStringRegex = regexp.MustCompile(`^\$\(ConvertTo-SecureString -String '((?:[^']|'')*)' -AsPlainText -Force\)$`)
+
+	// joinRegex matches the expressions that join multiple strings with a line break, e.g. "('a','b' -join "`n")".
+	joinRegex = regexp.MustCompile("^\\((.*) -join \"`n\"\\)$")
+)
+
+// parseParams parses the parameters of a cmdlet call, e.g. "-Name 'test' -Force -Count 2".
+func parseParams(s string) (params, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := params{}
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if !isParamName(token) {
+			return nil, fmt.Errorf("connection.fake: unexpected positional argument %s", token)
+		}
+
+		// Handle parameters with a colon separated value, e.g. "-Disabled:$false".
+		name, value, found := strings.Cut(token[1:], ":")
+		if found {
+			p[strings.ToLower(name)] = value
+			continue
+		}
+
+		// Handle parameters with a value.
+		if i+1 < len(tokens) && !isParamName(tokens[i+1]) {
+			p[strings.ToLower(name)] = tokens[i+1]
+			i++
+			continue
+		}
+
+		// Handle switch parameters.
+		p[strings.ToLower(name)] = "$true"
+	}
+
+	return p, nil
+}
+
+// isParamName returns true if the token is the name of a parameter, e.g. "-Name".
+func isParamName(token string) bool {
+	return len(token) > 1 && token[0] == '-' && (token[1] < '0' || token[1] > '9')
+}
+
+// tokenize splits a PowerShell argument string into its tokens.
+// Single quoted strings and subexpressions like "$(...)" and "@(...)" are kept as a single token.
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	depth := 0
+	quoted := false
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		switch {
+		case quoted:
+			current.WriteByte(ch)
+			if ch == '\'' {
+				// Two single quotes are an escaped single quote.
+				if i+1 < len(s) && s[i+1] == '\'' {
+					current.WriteByte(s[i+1])
+					i++
+				} else {
+					quoted = false
+				}
+			}
+		case ch == '\'':
+			quoted = true
+			current.WriteByte(ch)
+		case ch == '(':
+			depth++
+			current.WriteByte(ch)
+		case ch == ')':
+			depth--
+			current.WriteByte(ch)
+		case ch == ' ' && depth == 0:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteByte(ch)
+		}
+	}
+
+	if quoted || depth != 0 {
+		return nil, fmt.Errorf("connection.fake: unterminated expression in arguments: %s", s)
+	}
+
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens, nil
+}
+
+// unquote returns the value of a single quoted PowerShell string.
+// Values without quotes are returned unchanged.
+func unquote(value string) string {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+	}
+	return value
+}
+
+// has returns true if the parameter is set.
+func (p params) has(name string) bool {
+	_, ok := p[strings.ToLower(name)]
+	return ok
+}
+
+// str returns the unquoted string value of a parameter.
+// Strings that are joined with a line break are returned as a single string with line breaks.
+func (p params) str(name string) string {
+	value := p[strings.ToLower(name)]
+	if match := joinRegex.FindStringSubmatch(value); match != nil {
+		return strings.Join(splitItems(match[1]), "\n")
+	}
+	return unquote(value)
+}
+
+// flag returns true if the switch parameter is set and not explicitly disabled.
+func (p params) flag(name string) bool {
+	value, ok := p[strings.ToLower(name)]
+	return ok && !strings.EqualFold(value, "$false")
+}
+
+// list returns the unquoted values of an array parameter, e.g. "@('a','b')".
+func (p params) list(name string) []string {
+	value := p[strings.ToLower(name)]
+	value = strings.TrimPrefix(value, "@(")
+	value = strings.TrimSuffix(value, ")")
+
+	return splitItems(value)
+}
+
+// splitItems returns the unquoted items of a comma separated list, e.g. "'a','b'".
+func splitItems(value string) []string {
+	// Split the items at the commas outside of single quotes.
+	var result []string
+	var current strings.Builder
+	quoted := false
+	for _, ch := range value + "," {
+		switch {
+		case ch == '\'':
+			quoted = !quoted
+			current.WriteRune(ch)
+		case ch == ',' && !quoted:
+			if item := strings.TrimSpace(current.String()); item != "" {
+				result = append(result, unquote(item))
+			}
+			current.Reset()
+		default:
+			current.WriteRune(ch)
+		}
+	}
+
+	return result
+}
+
+// int returns the integer value of a parameter.
+func (p params) int(name string) (int64, error) {
+	return strconv.ParseInt(p.str(name), 10, 64)
+}
+
+// timespan returns the duration of a "$(New-TimeSpan ...)" parameter.
+func (p params) timespan(name string) (time.Duration, error) {
+	value := p[strings.ToLower(name)]
+
+	match := timespanRegex.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("connection.fake: parameter %s is not a timespan: %s", name, value)
+	}
+
+	units, err := parseParams(match[1])
+	if err != nil {
+		return 0, err
+	}
+
+	var d time.Duration
+	for unit, duration := range map[string]time.Duration{
+		"days":    24 * time.Hour,
+		"hours":   time.Hour,
+		"minutes": time.Minute,
+		"seconds": time.Second,
+	} {
+		if !units.has(unit) {
+			continue
+		}
+		n, err := units.int(unit)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * duration
+	}
+
+	return d, nil
+}
+
+// date returns the time of a "$(Get-Date '...')" parameter.
+func (p params) date(name string) (time.Time, error) {
+	value := p[strings.ToLower(name)]
+
+	match := dateRegex.FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("connection.fake: parameter %s is not a date: %s", name, value)
+	}
+
+	return time.Parse(time.DateTime, match[1])
+}
+
+// secureString returns the plain text of a "$(ConvertTo-SecureString ...)" parameter.
+func (p params) secureString(name string) (string, error) {
+	value := p[strings.ToLower(name)]
+
+	match := secureStringRegex.FindStringSubmatch(value)
+	if match == nil {
+		return "", fmt.Errorf("connection.fake: parameter %s is not a secure string: %s", name, value)
+	}
+
+	return unquote("'" + match[1] + "'"), nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/user"
 
+	"github.com/d-strobel/gowindows/parsing"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/knownhosts"
 )
@@ -25,6 +26,11 @@ type Config struct {
 	PrivateKeyPath string
 	KnownHostsPath string
 	Insecure       bool
+
+	// Powershell specifies the PowerShell executable that runs the commands of RunWithPowershell.
+	// Use parsing.WindowsPowershell, parsing.Pwsh or a custom executable with arguments.
+	// Defaults to Windows PowerShell 5.1.
+	Powershell parsing.Powershell
 }
 
 // validate validates the SSH configuration parameters.
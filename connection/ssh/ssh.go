@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 
 	"github.com/d-strobel/gowindows/connection"
@@ -19,9 +20,11 @@ import (
 )
 
 // Connection represents an SSH connection.
-// It holds a client object for interacting with the remote system.
+// It holds a client object for interacting with the remote system
+// and the PowerShell executable that is used to run PowerShell commands.
 type Connection struct {
-	Client *ssh.Client
+	Client     *ssh.Client
+	Powershell parsing.Powershell
 }
 
 // NewConnection creates a new SSH client based on the provided configuration.
@@ -65,7 +68,7 @@ func NewConnection(config *Config) (*Connection, error) {
 		return nil, fmt.Errorf("ssh: %s", err)
 	}
 
-	return &Connection{Client: client}, nil
+	return &Connection{Client: client, Powershell: config.Powershell}, nil
 }
 
 // Close closes the SSH connection.
@@ -74,19 +77,36 @@ func (c *Connection) Close() error {
 }
 
 // RunWithPowershell runs a command using the configured SSH connection and context via Powershell.
+// The command is executed with the configured PowerShell executable.
 func (c *Connection) RunWithPowershell(ctx context.Context, cmd string) (connection.CmdResult, error) {
 	// Prepare powershell command.
-	pwshCmd, err := parsing.EncodePwshCmd(cmd)
+	pwshCmd, err := c.Powershell.EncodeCmd(cmd)
 	if err != nil {
 		return connection.CmdResult{}, err
 	}
 
+	// Stream commands that exceed the command line limit via stdin.
+	if len(pwshCmd) > parsing.MaxCmdLength {
+		pwshCmd, stdin, err := c.Powershell.EncodeStagedCmd(cmd)
+		if err != nil {
+			return connection.CmdResult{}, err
+		}
+
+		return c.run(ctx, pwshCmd, stdin)
+	}
+
 	return c.Run(ctx, pwshCmd)
 }
 
 // Run runs a command using the configured SSH connection and context.
 // It returns the result of the command execution, including stdout and stderr.
 func (c *Connection) Run(ctx context.Context, cmd string) (connection.CmdResult, error) {
+	return c.run(ctx, cmd, "")
+}
+
+// run runs a command using the configured SSH connection and context
+// and streams the stdin string to the command if not empty.
+func (c *Connection) run(ctx context.Context, cmd string, stdin string) (connection.CmdResult, error) {
 	var r connection.CmdResult
 
 	// Open a new SSH session.
@@ -96,6 +116,11 @@ func (c *Connection) Run(ctx context.Context, cmd string) (connection.CmdResult,
 	}
 	defer s.Close()
 
+	// Stream stdin to the command.
+	if stdin != "" {
+		s.Stdin = strings.NewReader(stdin)
+	}
+
 	// Prepare channels for stdout, stderr and errors.
 	stdoutChan := make(chan string)
 	stderrChan := make(chan string)
@@ -3,6 +3,8 @@ package winrm
 import (
 	"errors"
 	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
 )
 
 // Default values for WinRM configuration.
@@ -23,6 +25,11 @@ type Config struct {
 	UseTLS   bool
 	Insecure bool
 	Timeout  time.Duration
+
+	// Powershell specifies the PowerShell executable that runs the commands of RunWithPowershell.
+	// Use parsing.WindowsPowershell, parsing.Pwsh or a custom executable with arguments.
+	// Defaults to Windows PowerShell 5.1.
+	Powershell parsing.Powershell
 }
 
 // validate validates the WinRM configuration.
@@ -16,8 +16,11 @@ import (
 )
 
 // Connection represents a WinRM connection.
+// It holds a client object for interacting with the remote system
+// and the PowerShell executable that is used to run PowerShell commands.
 type Connection struct {
-	Client *winrm.Client
+	Client     *winrm.Client
+	Powershell parsing.Powershell
 }
 
 // NewConnection creates a new WinRM client based on the provided WinRM configuration.
@@ -50,7 +53,7 @@ func NewConnection(config *Config) (*Connection, error) {
 		return nil, err
 	}
 
-	return &Connection{Client: client}, nil
+	return &Connection{Client: client, Powershell: config.Powershell}, nil
 }
 
 // Close closes the WinRM connection.
@@ -60,23 +63,40 @@ func (c *Connection) Close() error {
 }
 
 // RunWithPowershell runs a command using the configured WinRM connection and context via Powershell.
+// The command is executed with the configured PowerShell executable.
 // It returns the result of the command execution, including stdout and stderr.
 func (c *Connection) RunWithPowershell(ctx context.Context, cmd string) (connection.CmdResult, error) {
 	// Prepare powershell command.
-	pwshCmd, err := parsing.EncodePwshCmd(cmd)
+	pwshCmd, err := c.Powershell.EncodeCmd(cmd)
 	if err != nil {
 		return connection.CmdResult{}, err
 	}
 
+	// Stream commands that exceed the command line limit via stdin.
+	if len(pwshCmd) > parsing.MaxCmdLength {
+		pwshCmd, stdin, err := c.Powershell.EncodeStagedCmd(cmd)
+		if err != nil {
+			return connection.CmdResult{}, err
+		}
+
+		return c.run(ctx, pwshCmd, stdin)
+	}
+
 	return c.Run(ctx, pwshCmd)
 }
 
 // Run runs a command using the configured WinRM connection and context.
 // It returns a connection.CMDResult object, including stdout and stderr.
 func (c *Connection) Run(ctx context.Context, cmd string) (connection.CmdResult, error) {
+	return c.run(ctx, cmd, "")
+}
+
+// run runs a command using the configured WinRM connection and context
+// and streams the stdin string to the command if not empty.
+func (c *Connection) run(ctx context.Context, cmd string, stdin string) (connection.CmdResult, error) {
 	var r connection.CmdResult
 
-	stdout, stderr, _, err := c.Client.RunWithContextWithString(ctx, cmd, "")
+	stdout, stderr, _, err := c.Client.RunWithContextWithString(ctx, cmd, stdin)
 	if err != nil {
 		return r, err
 	}
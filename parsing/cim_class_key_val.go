@@ -1,8 +1,10 @@
 package parsing
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -11,55 +13,102 @@ import (
 // as a single string with key-value pairs.
 type CimClassKeyVal map[string]string
 
+var (
+	// cimKeyRegex matches the key of a key-value pair at the beginning of a string.
+	cimKeyRegex = regexp.MustCompile(`^([^\s=]+)\s*=\s*`)
+
+	// cimValueEndRegex matches the remainder of a string after a quoted value,
+	// which is either the next key-value pair or the end of the string.
+	cimValueEndRegex = regexp.MustCompile(`^(?:\s+[^\s=]+\s*=|\s*$)`)
+)
+
 // UnmarshalJSON unmarshals a JSON object into a map of strings.
 // The expected format is a string with key-value pairs separated by spaces, where the key is
 // separated from the value by an equals sign.
+// Quoted values may contain quotes and line breaks, e.g. the descriptive text of a TXT-Record.
 func (kv *CimClassKeyVal) UnmarshalJSON(b []byte) error {
 	// Convert the input bytes to a string
 	raw := string(b)
 
-	// Remove surrounding brackets.
-	// This is usally the case when the input is a JSON array of strings.
-	raw = strings.TrimPrefix(raw, `[`)
-	raw = strings.TrimSuffix(raw, `],`)
-
-	// Remove surrounding quotes
-	raw = strings.TrimPrefix(raw, `"`)
-	raw = strings.TrimSuffix(raw, `"`)
+	// Join the elements of a JSON array of strings.
+	// This is usally the case for records with multiple properties, e.g. MX-Records.
+	if strings.HasPrefix(raw, `[`) {
+		var elements []string
+		if err := json.Unmarshal([]byte(strings.TrimSuffix(raw, `,`)), &elements); err != nil {
+			return fmt.Errorf("parsing.UnmarshalJSON(CimClassKeyVal): %s", err)
+		}
+		raw = strings.Join(elements, " ")
+	} else if err := json.Unmarshal(b, &raw); err != nil {
+		// Remove surrounding quotes
+		raw = strings.TrimPrefix(string(b), `"`)
+		raw = strings.TrimSuffix(raw, `"`)
 
-	// Unescape the JSON string
-	raw = strings.ReplaceAll(raw, `\"`, `"`)
+		// Unescape the JSON string
+		raw = strings.ReplaceAll(raw, `\"`, `"`)
+	}
 
 	// Initialize the result map.
 	result := make(map[string]string)
 
-	// Regular expression to match key-value pairs with quoted and unquoted values.
-	pairRegex, err := regexp.Compile(`(\S+)\s*=\s*("(.*?)"|'(.*?)'|(\S+))`)
-	if err != nil {
-		return fmt.Errorf("parsing.UnmarshalJSON(CimClassKeyVal): %s", err)
-	}
-
-	// Find all key-value pairs in the input string.
-	matches := pairRegex.FindAllStringSubmatch(raw, -1)
-
-	for _, match := range matches {
-		key := match[1]
+	for rest := strings.TrimSpace(raw); rest != ""; rest = strings.TrimSpace(rest) {
+		// Skip everything up to the next whitespace that is not a key.
+		match := cimKeyRegex.FindStringSubmatchIndex(rest)
+		if match == nil {
+			if i := strings.IndexAny(rest, " \t\r\n"); i != -1 {
+				rest = rest[i:]
+				continue
+			}
+			break
+		}
+		key := rest[match[2]:match[3]]
+		rest = rest[match[1]:]
 
-		// Use the longest group that matches for the value
-		value := match[3] // Match from the first quoted group
+		// Handle quoted values.
+		// The closing quote is the first quote that is followed by the next key-value pair or the end of the string,
+		// so the value itself can contain quotes.
+		if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+			quote := rest[0]
+			end := len(rest)
+			for i := 1; i < len(rest); i++ {
+				if rest[i] == quote && cimValueEndRegex.MatchString(rest[i+1:]) {
+					end = i
+					break
+				}
+			}
 
-		if value == "" {
-			value = match[4] // Match from the second quoted group
-		}
-		if value == "" {
-			value = match[5] // Match unquoted value
+			result[key] = rest[1:end]
+			rest = rest[min(end+1, len(rest)):]
+			continue
 		}
 
-		// Write the key-value pair to the result.
-		result[key] = value
+		// Handle unquoted values.
+		end := strings.IndexAny(rest, " \t\r\n")
+		if end == -1 {
+			end = len(rest)
+		}
+		result[key] = rest[:end]
+		rest = rest[end:]
 	}
 
 	*kv = result
 
 	return nil
 }
+
+// MarshalJSON implements the json.Marshaler interface for the CimClassKeyVal type.
+// It returns a single string with the key-value pairs sorted by key and the values quoted,
+// which is the same format that is expected by the UnmarshalJSON function.
+func (kv CimClassKeyVal) MarshalJSON() ([]byte, error) {
+	keys := make([]string, 0, len(kv))
+	for key := range kv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s = "%s"`, key, kv[key]))
+	}
+
+	return json.Marshal(strings.Join(pairs, " "))
+}
@@ -132,4 +132,53 @@ func (suite *CimClassKeyValUnitTestSuite) TestUnmarshalJSON() {
 			"HostNameAlias": "test.local.",
 		}, cimClassKeyVal)
 	})
+
+	suite.Run("should unmarshal quoted values with embedded quotes and line breaks", func() {
+		cimClassKeyVal := CimClassKeyVal{}
+		err := cimClassKeyVal.UnmarshalJSON([]byte(`"DescriptiveText = \"say \"hello\" = world\nsecond line\" Priority = 10"`))
+		suite.NoError(err)
+		suite.Equal(CimClassKeyVal{
+			"DescriptiveText": "say \"hello\" = world\nsecond line",
+			"Priority":        "10",
+		}, cimClassKeyVal)
+	})
+
+	suite.Run("should unmarshal the json array with multiple elements to key-value map", func() {
+		cimClassKeyVal := CimClassKeyVal{}
+		err := cimClassKeyVal.UnmarshalJSON([]byte(`["MailExchange = \"mail.test.local.\"","Preference = 10"]`))
+		suite.NoError(err)
+		suite.Equal(CimClassKeyVal{
+			"MailExchange": "mail.test.local.",
+			"Preference":   "10",
+		}, cimClassKeyVal)
+	})
+}
+
+func (suite *CimClassKeyValUnitTestSuite) TestMarshalJSON() {
+	suite.T().Parallel()
+
+	suite.Run("should marshal the key-value map to a sorted key-value string", func() {
+		actualResult, err := json.Marshal(CimClassKeyVal{
+			"dynamic":      "True",
+			"provider":     "DnsServerPSProvider",
+			"ClassVersion": "1.0.0",
+		})
+		suite.NoError(err)
+		suite.Equal(`"ClassVersion = \"1.0.0\" dynamic = \"True\" provider = \"DnsServerPSProvider\""`, string(actualResult))
+	})
+
+	suite.Run("should marshal an empty key-value map to an empty string", func() {
+		actualResult, err := json.Marshal(CimClassKeyVal{})
+		suite.NoError(err)
+		suite.Equal(`""`, string(actualResult))
+	})
+
+	suite.Run("should marshal and unmarshal the whole CimClass symmetrically", func() {
+		b, err := json.Marshal(suite.testExpected)
+		suite.Require().NoError(err)
+		actualResult := TestCimClass{}
+		err = json.Unmarshal(b, &actualResult)
+		suite.NoError(err)
+		suite.Equal(suite.testExpected, actualResult)
+	})
 }
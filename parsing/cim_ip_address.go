@@ -12,7 +12,13 @@ type CimIpAddress struct {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for the CimIpAddress type.
+// The input is expected to be the integer representation of an IPv4 address as returned by the .NET IPAddress.Address property.
 func (a *CimIpAddress) UnmarshalJSON(b []byte) error {
+	// Ignore null, like in the main JSON package
+	if string(b) == "null" {
+		return nil
+	}
+
 	// Parse the integer from the JSON input
 	var addr uint32
 	if err := json.Unmarshal(b, &addr); err != nil {
@@ -37,3 +43,22 @@ func (a *CimIpAddress) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements the json.Marshaler interface for the CimIpAddress type.
+// It returns the integer representation of the IPv4 address, the same way it is returned by PowerShell.
+// An invalid address is marshaled to null.
+func (a CimIpAddress) MarshalJSON() ([]byte, error) {
+	if !a.IsValid() {
+		return []byte("null"), nil
+	}
+
+	if !a.Is4() {
+		return nil, fmt.Errorf("parsing.MarshalJSON(CimIpAddress): address is not an IPv4 address: %s", a.String())
+	}
+
+	// Convert IPv4 address to integer
+	ip := a.As4()
+	addr := uint32(ip[0]) | uint32(ip[1])<<8 | uint32(ip[2])<<16 | uint32(ip[3])<<24
+
+	return json.Marshal(addr)
+}
@@ -0,0 +1,58 @@
+package parsing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// CimIpAddressList represents a list of IPv4 and IPv6 addresses.
+type CimIpAddressList []netip.Addr
+
+// cimIpAddressObject is the JSON representation of a .NET IPAddress object.
+type cimIpAddressObject struct {
+	IPAddressToString string `json:"IPAddressToString"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for the CimIpAddressList type.
+// The input can be an array of address strings or .NET IPAddress objects,
+// or a single string that contains the addresses separated by whitespace,
+// which PowerShell returns if the array exceeds the depth of the JSON conversion.
+func (l *CimIpAddressList) UnmarshalJSON(b []byte) error {
+	// Ignore null, like in the main JSON package
+	if string(b) == "null" {
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(b, &items); err != nil {
+		items = []json.RawMessage{b}
+	}
+
+	var addresses CimIpAddressList
+	for _, item := range items {
+		var values []string
+
+		var s string
+		var o cimIpAddressObject
+		if err := json.Unmarshal(item, &s); err == nil {
+			values = strings.Fields(s)
+		} else if err := json.Unmarshal(item, &o); err == nil {
+			values = []string{o.IPAddressToString}
+		} else {
+			return fmt.Errorf("parsing.UnmarshalJSON(CimIpAddressList): failed to parse IP address from JSON: %s", string(item))
+		}
+
+		for _, value := range values {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return fmt.Errorf("parsing.UnmarshalJSON(CimIpAddressList): %w", err)
+			}
+			addresses = append(addresses, addr)
+		}
+	}
+
+	*l = addresses
+	return nil
+}
@@ -0,0 +1,71 @@
+package parsing
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCimIpAddressListUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	expectedList := CimIpAddressList{
+		netip.MustParseAddr("192.168.10.1"),
+		netip.MustParseAddr("fd00::1"),
+	}
+
+	t.Run("StringArray", func(t *testing.T) {
+		jsonData := `["192.168.10.1","fd00::1"]`
+		var l CimIpAddressList
+
+		err := json.Unmarshal([]byte(jsonData), &l)
+		require.NoError(t, err)
+		assert.Equal(t, expectedList, l)
+	})
+
+	t.Run("IPAddressObjectArray", func(t *testing.T) {
+		jsonData := `[{"Address":17475776,"AddressFamily":2,"IPAddressToString":"192.168.10.1"},{"AddressFamily":23,"ScopeId":0,"IPAddressToString":"fd00::1"}]`
+		var l CimIpAddressList
+
+		err := json.Unmarshal([]byte(jsonData), &l)
+		require.NoError(t, err)
+		assert.Equal(t, expectedList, l)
+	})
+
+	t.Run("WhitespaceSeparatedString", func(t *testing.T) {
+		jsonData := `"192.168.10.1 fd00::1"`
+		var l CimIpAddressList
+
+		err := json.Unmarshal([]byte(jsonData), &l)
+		require.NoError(t, err)
+		assert.Equal(t, expectedList, l)
+	})
+
+	t.Run("NullValue", func(t *testing.T) {
+		jsonData := `null`
+		var l CimIpAddressList
+
+		err := json.Unmarshal([]byte(jsonData), &l)
+		require.NoError(t, err)
+		assert.Nil(t, l)
+	})
+
+	t.Run("InvalidAddress", func(t *testing.T) {
+		jsonData := `["notAnAddress"]`
+		var l CimIpAddressList
+
+		err := json.Unmarshal([]byte(jsonData), &l)
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidJSONFormat", func(t *testing.T) {
+		jsonData := `[1]`
+		var l CimIpAddressList
+
+		err := json.Unmarshal([]byte(jsonData), &l)
+		assert.Error(t, err)
+	})
+}
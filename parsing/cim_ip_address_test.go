@@ -63,3 +63,38 @@ func TestCimIpAddressUnmarshalJSON(t *testing.T) {
 		assert.Equal(t, expectedIP, ip)
 	})
 }
+
+func TestCimIpAddressMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ValidIPv4Address", func(t *testing.T) {
+		ip := CimIpAddress{netip.MustParseAddr("10.100.91.51")}
+
+		actualResult, err := json.Marshal(ip)
+		require.NoError(t, err)
+		assert.Equal(t, `861627402`, string(actualResult))
+	})
+
+	t.Run("InvalidAddress", func(t *testing.T) {
+		actualResult, err := json.Marshal(CimIpAddress{})
+		require.NoError(t, err)
+		assert.Equal(t, `null`, string(actualResult))
+	})
+
+	t.Run("IPv6Address", func(t *testing.T) {
+		_, err := json.Marshal(CimIpAddress{netip.MustParseAddr("fe80::1")})
+		assert.Error(t, err)
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		ip := CimIpAddress{netip.MustParseAddr("255.255.255.0")}
+
+		b, err := json.Marshal(ip)
+		require.NoError(t, err)
+
+		var actualIP CimIpAddress
+		err = json.Unmarshal(b, &actualIP)
+		require.NoError(t, err)
+		assert.Equal(t, ip, actualIP)
+	})
+}
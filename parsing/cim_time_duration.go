@@ -15,16 +15,31 @@ type CimTimeDuration struct {
 // json of a CimInstance time duration object.
 // It is used to do the initial unmarshalling of the json block.
 type cimTimeDurationObject struct {
-	Days         int32 `json:"Days"`
-	Hours        int32 `json:"Hours"`
-	Minutes      int32 `json:"Minutes"`
-	Seconds      int32 `json:"Seconds"`
-	MilliSeconds int32 `json:"Milliseconds"`
+	Ticks             *int64  `json:"Ticks,omitempty"`
+	Days              int32   `json:"Days"`
+	Hours             int32   `json:"Hours"`
+	Minutes           int32   `json:"Minutes"`
+	Seconds           int32   `json:"Seconds"`
+	MilliSeconds      int32   `json:"Milliseconds"`
+	TotalDays         float64 `json:"TotalDays"`
+	TotalHours        float64 `json:"TotalHours"`
+	TotalMilliseconds float64 `json:"TotalMilliseconds"`
+	TotalMinutes      float64 `json:"TotalMinutes"`
+	TotalSeconds      float64 `json:"TotalSeconds"`
 }
 
+// durationPerTick is the duration of a single dotnet tick.
+const durationPerTick time.Duration = 100
+
 // UnmarshalJSON implements the json.Unmarshaler interface for the CimTimeDuration type.
 // It parses a JSON-encoded CimInstance time duration JSON block and converts it into a CimTimeDuration object.
+// The Ticks field is preferred if available, because it contains the duration with the full precision.
 func (t *CimTimeDuration) UnmarshalJSON(b []byte) error {
+	// Ignore null, like in the main JSON package
+	if string(b) == "null" {
+		return nil
+	}
+
 	var d cimTimeDurationObject
 
 	// Unmarshal the json block into the cimTimeDurationObject struct.
@@ -32,6 +47,12 @@ func (t *CimTimeDuration) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
+	// Use the ticks if available.
+	if d.Ticks != nil {
+		t.Duration = time.Duration(*d.Ticks) * durationPerTick
+		return nil
+	}
+
 	// Convert the fields into a time.Duration object.
 	duration := time.Duration(d.Days)*24*time.Hour +
 		time.Duration(d.Hours)*time.Hour +
@@ -44,3 +65,23 @@ func (t *CimTimeDuration) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements the json.Marshaler interface for the CimTimeDuration type.
+// It returns a JSON block in the same format as the PowerShell ConvertTo-Json cmdlet returns a time-span.
+func (t CimTimeDuration) MarshalJSON() ([]byte, error) {
+	ticks := int64(t.Duration / durationPerTick)
+
+	return json.Marshal(cimTimeDurationObject{
+		Ticks:             &ticks,
+		Days:              int32(t.Duration / (24 * time.Hour)),
+		Hours:             int32(t.Duration % (24 * time.Hour) / time.Hour),
+		Minutes:           int32(t.Duration % time.Hour / time.Minute),
+		Seconds:           int32(t.Duration % time.Minute / time.Second),
+		MilliSeconds:      int32(t.Duration % time.Second / time.Millisecond),
+		TotalDays:         t.Hours() / 24,
+		TotalHours:        t.Hours(),
+		TotalMilliseconds: float64(t.Duration) / float64(time.Millisecond),
+		TotalMinutes:      t.Minutes(),
+		TotalSeconds:      t.Seconds(),
+	})
+}
@@ -77,6 +77,13 @@ func (suite *CimTimeDurationUnitTestSuite) TestUnmarshalJSON() {
 		suite.Equal(expectedCimTimeDuration, cimTime)
 	})
 
+	suite.Run("should prefer the ticks to keep the full precision", func() {
+		cimTime := CimTimeDuration{}
+		err := cimTime.UnmarshalJSON([]byte(`{"Ticks":10000001,"Days":0,"Hours":0,"Minutes":0,"Seconds":1,"Milliseconds":0}`))
+		suite.NoError(err)
+		suite.Equal(CimTimeDuration{Duration: time.Second + 100*time.Nanosecond}, cimTime)
+	})
+
 	suite.Run("should unmarshal the whole CimTimeDuration correctly", func() {
 		testCimTime := testCimTime{}
 		err := json.Unmarshal([]byte(suite.testJson), &testCimTime)
@@ -84,3 +91,34 @@ func (suite *CimTimeDurationUnitTestSuite) TestUnmarshalJSON() {
 		suite.Equal(suite.testExpected, testCimTime)
 	})
 }
+
+func (suite *CimTimeDurationUnitTestSuite) TestMarshalJSON() {
+	suite.T().Parallel()
+
+	suite.Run("should marshal the CimTimeDuration to a CimInstance duration json", func() {
+		actualResult, err := json.Marshal(CimTimeDuration{Duration: 98*time.Hour + 30*time.Minute + 5*time.Second + 10*time.Millisecond})
+		suite.NoError(err)
+		suite.JSONEq(`{
+			"Ticks": 3546050100000,
+			"Days": 4,
+			"Hours": 2,
+			"Minutes": 30,
+			"Seconds": 5,
+			"Milliseconds": 10,
+			"TotalDays": 4.104224652777778,
+			"TotalHours": 98.50139166666666,
+			"TotalMilliseconds": 354605010,
+			"TotalMinutes": 5910.0835,
+			"TotalSeconds": 354605.01
+		}`, string(actualResult))
+	})
+
+	suite.Run("should marshal and unmarshal the whole CimTimeDuration symmetrically", func() {
+		b, err := json.Marshal(suite.testExpected)
+		suite.Require().NoError(err)
+		actualResult := testCimTime{}
+		err = json.Unmarshal(b, &actualResult)
+		suite.NoError(err)
+		suite.Equal(suite.testExpected, actualResult)
+	})
+}
@@ -1,55 +1,112 @@
 package parsing
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
 	"time"
 )
 
+// dotnetDateRegex matches the dotnet JSON datetime format "/Date(timestamp)/" with an optional timezone offset.
+// The timestamp is the number of milliseconds since the unix epoch and may be negative.
+var dotnetDateRegex = regexp.MustCompile(`^/Date\((-?\d+)([+-]\d{4})?\)/$`)
+
+// iso8601Layouts contains the ISO 8601 layouts that are emitted by the PowerShell 7 ConvertTo-Json cmdlet.
+// Datetimes of kind "Unspecified" are serialized without a timezone and are interpreted as UTC.
+var iso8601Layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
 // DotnetTime is a custom time type that embeds the time.Time type. It is designed to handle
-// the unmarshalling of dotnet JSON datetime strings in the format "\"/Date(timestamp)/\""
-// when used as a field in a struct that is being unmarshalled from JSON.
+// the unmarshalling of dotnet JSON datetime strings when used as a field in a struct that is being unmarshalled from JSON.
+//
+// The following formats are supported:
+//   - "\/Date(timestamp)\/" as emitted by the Windows PowerShell 5.1 ConvertTo-Json cmdlet.
+//   - "\/Date(timestamp+hhmm)\/" with a timezone offset.
+//   - ISO 8601 strings like "2023-11-30T21:25:05.092+01:00" as emitted by the PowerShell 7 ConvertTo-Json cmdlet.
 type DotnetTime struct {
 	time.Time
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for the DotnetTime type.
 // It parses a JSON-encoded dotnet JSON datetime string and converts it into a DotnetTime object.
-// The input byte slice is expected to represent a dotnet JSON datetime string in the format "\"/Date(timestamp)/\"".
+// The timestamp is kept with millisecond precision for the "\/Date(timestamp)\/" format
+// and with the full precision of the input for the ISO 8601 format.
 func (t *DotnetTime) UnmarshalJSON(b []byte) error {
 	// Ignore null, like in the main JSON package
 	if string(b) == "null" || string(b) == `""` {
 		return nil
 	}
 
-	// Check for valid dotnet timestring
-	re, err := regexp.Compile(`^"\\/Date\(\d+\)\\/"$`)
-	if err != nil {
-		return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): %s", err)
+	// The input must be a JSON string.
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): input string is not a dotnet JSON datetime: %s", string(b))
 	}
 
-	if !re.Match(b) {
-		return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): input string is not a dotnet JSON datetime: %s", string(b))
+	// Handle the "/Date(timestamp)/" format.
+	if match := dotnetDateRegex.FindStringSubmatch(s); match != nil {
+		milliseconds, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): %s", err)
+		}
+
+		// The timestamp is always UTC based.
+		// An optional offset only defines the timezone in which the datetime was created.
+		unixTime := time.UnixMilli(milliseconds).UTC()
+		if match[2] != "" {
+			location, err := parseOffset(match[2])
+			if err != nil {
+				return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): %s", err)
+			}
+			unixTime = unixTime.In(location)
+		}
+
+		*t = DotnetTime{unixTime}
+		return nil
 	}
 
-	// Extract timestamp
-	re, err = regexp.Compile(`\d+`)
+	// Handle the ISO 8601 format.
+	for _, layout := range iso8601Layouts {
+		if isoTime, err := time.Parse(layout, s); err == nil {
+			*t = DotnetTime{isoTime}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): input string is not a dotnet JSON datetime: %s", string(b))
+}
+
+// MarshalJSON implements the json.Marshaler interface for the DotnetTime type.
+// It returns the datetime as an ISO 8601 string with nanosecond precision and the timezone offset,
+// which can be read again by the UnmarshalJSON function and by the PowerShell ConvertFrom-Json cmdlet.
+// A zero DotnetTime is marshaled to null, the same way an empty datetime is returned by PowerShell.
+func (t DotnetTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// parseOffset parses a dotnet timezone offset in the format "+hhmm" or "-hhmm" to a fixed time location.
+func parseOffset(offset string) (*time.Location, error) {
+	hours, err := strconv.Atoi(offset[1:3])
 	if err != nil {
-		return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): %s", err)
+		return nil, err
 	}
-	timestamp := re.Find(b)
 
-	// Convert to seconds
-	i, err := strconv.Atoi(string(timestamp))
+	minutes, err := strconv.Atoi(offset[3:5])
 	if err != nil {
-		return fmt.Errorf("parsing.UnmarshalJSON(DotnetTime): %s", err)
+		return nil, err
 	}
-	seconds := int64(i / 1000)
 
-	// Unmarshal unix time into DotnetTime object
-	unixTime := time.Unix(seconds, 0).UTC()
-	*t = DotnetTime{unixTime}
+	seconds := hours*3600 + minutes*60
+	if offset[0] == '-' {
+		seconds = -seconds
+	}
 
-	return nil
+	return time.FixedZone("", seconds), nil
 }
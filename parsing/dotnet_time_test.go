@@ -28,7 +28,7 @@ type TestUnmarshalObject struct {
 func (suite *DotnetTimeUnitTestSuite) SetupSuite() {
 	// Fixtures
 	suite.dotNetDatetime = `"\/Date(1701379505092)\/"`
-	suite.expectedDatetime = time.Date(2023, time.November, 30, 21, 25, 5, 0, time.UTC)
+	suite.expectedDatetime = time.Date(2023, time.November, 30, 21, 25, 5, 92000000, time.UTC)
 	suite.jsonData = `{
     "Name":  "tester",
     "Created":  "\/Date(1701379505092)\/",
@@ -63,6 +63,83 @@ func (suite *DotnetTimeUnitTestSuite) TestUnmarshalJSON() {
 		suite.ErrorContains(err, "parsing.UnmarshalJSON(DotnetTime): input string is not a dotnet JSON datetime")
 	})
 
+	suite.Run("should unmarshal all dotnet datetime formats", func() {
+		tcs := []struct {
+			description  string
+			input        string
+			expectedTime time.Time
+		}{
+			{
+				"escaped dotnet timestring",
+				`"\/Date(1701379505092)\/"`,
+				time.Date(2023, time.November, 30, 21, 25, 5, 92000000, time.UTC),
+			},
+			{
+				"unescaped dotnet timestring",
+				`"/Date(1701379505092)/"`,
+				time.Date(2023, time.November, 30, 21, 25, 5, 92000000, time.UTC),
+			},
+			{
+				"negative dotnet timestring",
+				`"\/Date(-86400001)\/"`,
+				time.Date(1969, time.December, 30, 23, 59, 59, 999000000, time.UTC),
+			},
+			{
+				"dotnet timestring with positive offset",
+				`"\/Date(1700000000000+0100)\/"`,
+				time.Date(2023, time.November, 14, 23, 13, 20, 0, time.FixedZone("", 3600)),
+			},
+			{
+				"dotnet timestring with negative offset",
+				`"\/Date(1700000000000-0530)\/"`,
+				time.Date(2023, time.November, 14, 16, 43, 20, 0, time.FixedZone("", -19800)),
+			},
+			{
+				"ISO 8601 timestring with offset",
+				`"2023-11-30T22:25:05.0920001+01:00"`,
+				time.Date(2023, time.November, 30, 22, 25, 5, 92000100, time.FixedZone("", 3600)),
+			},
+			{
+				"ISO 8601 timestring in UTC",
+				`"2023-11-30T21:25:05.092Z"`,
+				time.Date(2023, time.November, 30, 21, 25, 5, 92000000, time.UTC),
+			},
+			{
+				"ISO 8601 timestring without timezone",
+				`"2023-11-30T21:25:05"`,
+				time.Date(2023, time.November, 30, 21, 25, 5, 0, time.UTC),
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			winTime := DotnetTime{}
+			err := winTime.UnmarshalJSON([]byte(tc.input))
+			suite.NoError(err)
+			suite.True(tc.expectedTime.Equal(winTime.Time), "expected %s, got %s", tc.expectedTime, winTime.Time)
+			_, expectedOffset := tc.expectedTime.Zone()
+			_, actualOffset := winTime.Zone()
+			suite.Equal(expectedOffset, actualOffset)
+		}
+	})
+
+	suite.Run("should return error with invalid dotnet timestrings", func() {
+		for _, input := range []string{`"\/Date(abc)\/"`, `"\/Date(1701379505092+1)\/"`, `"2023-20-10"`, `1701379505092`} {
+			winTime := DotnetTime{}
+			err := winTime.UnmarshalJSON([]byte(input))
+			suite.ErrorContains(err, "parsing.UnmarshalJSON(DotnetTime): input string is not a dotnet JSON datetime")
+		}
+	})
+
+	suite.Run("should ignore null and empty strings", func() {
+		for _, input := range []string{`null`, `""`} {
+			winTime := DotnetTime{}
+			err := winTime.UnmarshalJSON([]byte(input))
+			suite.NoError(err)
+			suite.True(winTime.IsZero())
+		}
+	})
+
 	suite.Run("should unmarshal the whole json object correctly", func() {
 		actualResult := TestUnmarshalObject{}
 		err := json.Unmarshal([]byte(suite.jsonData), &actualResult)
@@ -71,6 +148,32 @@ func (suite *DotnetTimeUnitTestSuite) TestUnmarshalJSON() {
 	})
 }
 
+func (suite *DotnetTimeUnitTestSuite) TestMarshalJSON() {
+	suite.T().Parallel()
+
+	suite.Run("should marshal the DotnetTime object to an ISO 8601 string", func() {
+		dotnetTime := DotnetTime{Time: time.Date(2023, time.November, 30, 22, 25, 5, 92000100, time.FixedZone("", 3600))}
+		actualResult, err := json.Marshal(dotnetTime)
+		suite.NoError(err)
+		suite.Equal(`"2023-11-30T22:25:05.0920001+01:00"`, string(actualResult))
+	})
+
+	suite.Run("should marshal an empty DotnetTime object to null", func() {
+		actualResult, err := json.Marshal(DotnetTime{})
+		suite.NoError(err)
+		suite.Equal("null", string(actualResult))
+	})
+
+	suite.Run("should marshal and unmarshal the whole json object symmetrically", func() {
+		b, err := json.Marshal(suite.expectedUnmarshaledJSON)
+		suite.Require().NoError(err)
+		actualResult := TestUnmarshalObject{}
+		err = json.Unmarshal(b, &actualResult)
+		suite.NoError(err)
+		suite.Equal(suite.expectedUnmarshaledJSON, actualResult)
+	})
+}
+
 func (suite *DotnetTimeUnitTestSuite) TestTimeMethods() {
 	suite.Run("should be able to format dotnet time to RFC3389", func() {
 		dotnetTime := DotnetTime{Time: time.Date(2023, time.November, 30, 21, 25, 5, 0, time.UTC)}
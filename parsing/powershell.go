@@ -3,16 +3,64 @@ package parsing
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"golang.org/x/text/encoding/unicode"
 )
 
-// EncodePwshCmd encodes a powershell command to be executed on a Windows machine.
+// Powershell represents the PowerShell executable that runs the encoded commands on a Windows machine.
+// The zero value uses Windows PowerShell 5.1.
+type Powershell struct {
+	// Executable specifies the name or the full path of the PowerShell executable,
+	// e.g. "powershell.exe", "pwsh.exe" or "C:\Program Files\PowerShell\7\pwsh.exe".
+	Executable string
+
+	// Arguments specifies the arguments that are passed to the executable in front of the encoded command.
+	Arguments []string
+}
+
+// MaxCmdLength is the maximum length of a command line that can be executed by the Windows command shell.
+// Commands that exceed this limit must be staged with the EncodeStagedCmd function.
+//
+// https://learn.microsoft.com/en-us/troubleshoot/windows-client/shell-experience/command-line-string-limitation
+const MaxCmdLength int = 8191
+
+// stagedCmd is the PowerShell command that reads a base64 encoded script from stdin and executes it as a script block.
+// The script is not written to a file, so the execution policy does not apply to it,
+// even if it is enforced by a group policy.
+const stagedCmd string = "$s=[Text.Encoding]::UTF8.GetString([Convert]::FromBase64String([Console]::In.ReadToEnd()))" +
+	";& ([ScriptBlock]::Create($s))"
+
+// pwshPreamble is prepended to every command.
+// It disables unnecessary progress bars which are considered as stderr.
+// It forces the invariant culture, so that numbers, dates and most messages are not localized,
+// and UTF-8 without a byte order mark as output encoding, so that non-ASCII characters survive the transport.
+// Setting the console encoding fails if no console is attached, which is ignored.
+const pwshPreamble string = "$ProgressPreference = 'SilentlyContinue'; " +
+	"[Threading.Thread]::CurrentThread.CurrentCulture = [Globalization.CultureInfo]::InvariantCulture; " +
+	"[Threading.Thread]::CurrentThread.CurrentUICulture = [Globalization.CultureInfo]::InvariantCulture; " +
+	"$OutputEncoding = New-Object Text.UTF8Encoding $false; " +
+	"try { [Console]::OutputEncoding = $OutputEncoding } catch {}; "
+
+var (
+	// WindowsPowershell runs the commands with Windows PowerShell 5.1.
+	WindowsPowershell = Powershell{Executable: "powershell.exe", Arguments: []string{"-NoProfile"}}
+
+	// Pwsh runs the commands with PowerShell 7.
+	Pwsh = Powershell{Executable: "pwsh.exe", Arguments: []string{"-NoProfile"}}
+)
+
+// EncodeCmd encodes a powershell command to be executed on a Windows machine.
 // It encodes the command to UTF-16-LE and then to base64.
-// It returns a valid powershell.exe command with no profile and the encoded command.
-func EncodePwshCmd(cmd string) (string, error) {
-	// Disable unnecessary progress bars which is considered as stderr.
-	cmd = fmt.Sprintf("$ProgressPreference = 'SilentlyContinue'; %s", cmd)
+// It returns a valid command line for the configured executable and arguments with the encoded command.
+func (p Powershell) EncodeCmd(cmd string) (string, error) {
+	// Use Windows PowerShell if no executable is set.
+	if p.Executable == "" {
+		p = WindowsPowershell
+	}
+
+	// Prepend the preamble that disables the progress bars and sets the culture and output encoding.
+	cmd = fmt.Sprintf("%s%s", pwshPreamble, cmd)
 
 	// Encode string to UTF16-LE.
 	encoder := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder()
@@ -24,6 +72,37 @@ func EncodePwshCmd(cmd string) (string, error) {
 	// Finally make it base64 encoded which is required for powershell.
 	cmd = base64.StdEncoding.EncodeToString([]byte(encoded))
 
-	// Specify powershell.exe to run encoded command.
-	return fmt.Sprintf("powershell.exe -NoProfile -EncodedCommand %s", cmd), nil
+	// Quote the executable if the path contains spaces.
+	executable := p.Executable
+	if strings.Contains(executable, " ") {
+		executable = fmt.Sprintf(`"%s"`, executable)
+	}
+
+	// Specify the executable and its arguments to run encoded command.
+	cmdLine := append([]string{executable}, p.Arguments...)
+	cmdLine = append(cmdLine, "-EncodedCommand", cmd)
+
+	return strings.Join(cmdLine, " "), nil
+}
+
+// EncodeStagedCmd prepares a powershell command that is too long to be passed as an encoded command.
+// It returns a short command line for the configured executable and the script that must be streamed via stdin.
+// The script is decoded on the Windows machine and executed as a script block.
+func (p Powershell) EncodeStagedCmd(cmd string) (string, string, error) {
+	// The base64 encoding ensures that the script is not altered by the console input encoding.
+	stdin := base64.StdEncoding.EncodeToString([]byte(cmd))
+
+	pwshCmd, err := p.EncodeCmd(stagedCmd)
+	if err != nil {
+		return "", "", err
+	}
+
+	return pwshCmd, stdin, nil
+}
+
+// EncodePwshCmd encodes a powershell command to be executed on a Windows machine.
+// It encodes the command to UTF-16-LE and then to base64.
+// It returns a valid powershell.exe command with no profile and the encoded command.
+func EncodePwshCmd(cmd string) (string, error) {
+	return WindowsPowershell.EncodeCmd(cmd)
 }
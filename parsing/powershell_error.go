@@ -0,0 +1,55 @@
+package parsing
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// categoryInfoRegex matches the error category of a PowerShell error message,
+	// e.g. "CategoryInfo : ResourceExists: (terratest:root/Microsoft/...)".
+	categoryInfoRegex = regexp.MustCompile(`CategoryInfo\s*:\s*(\w+)\s*:`)
+
+	// errorIdRegex matches the fully qualified error ID of a PowerShell error message,
+	// e.g. "FullyQualifiedErrorId : WIN32 9711,Add-DnsServerResourceRecordA".
+	errorIdRegex = regexp.MustCompile(`FullyQualifiedErrorId\s*:\s*([^\r\n]+)`)
+)
+
+// PwshError represents an error that was returned by a PowerShell command.
+// The message of the error may be localized by the Windows system,
+// whereas the Category and the FullyQualifiedErrorId are culture-neutral
+// and should be used to classify the error.
+type PwshError struct {
+	// Message contains the human-readable PowerShell error message.
+	Message string
+
+	// Category contains the error category, e.g. "ResourceExists" or "ObjectNotFound".
+	Category string
+
+	// FullyQualifiedErrorId contains the ID of the error and the command that caused it,
+	// e.g. "WIN32 9711,Add-DnsServerResourceRecordA".
+	FullyQualifiedErrorId string
+}
+
+// Error implements the error interface.
+// It returns the error message.
+func (e *PwshError) Error() string {
+	return e.Message
+}
+
+// NewPwshError creates a new PwshError from a decoded PowerShell error message.
+// The Category and the FullyQualifiedErrorId are extracted from the message
+// and stay empty if the message does not contain them.
+func NewPwshError(msg string) *PwshError {
+	e := &PwshError{Message: msg}
+
+	if match := categoryInfoRegex.FindStringSubmatch(msg); match != nil {
+		e.Category = match[1]
+	}
+
+	if match := errorIdRegex.FindStringSubmatch(msg); match != nil {
+		e.FullyQualifiedErrorId = strings.TrimSpace(match[1])
+	}
+
+	return e
+}
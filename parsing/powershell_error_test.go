@@ -0,0 +1,85 @@
+package parsing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for the PowerShell error parsing functions
+type PwshErrorUnitTestSuite struct {
+	suite.Suite
+}
+
+func TestPwshErrorUnitTestSuite(t *testing.T) {
+	suite.Run(t, &PwshErrorUnitTestSuite{})
+}
+
+// Fixtures
+const (
+	// Captured from a Windows Server with german locale.
+	cliXmlErrorGerman = `#< CLIXML
+	<Objs Version="1.1.0.1" xmlns="http://schemas.microsoft.com/powershell/2004/04">
+	<S S="Error">Add-DnsServerResourceRecordA : Fehler beim Erstellen des Ressourcendatensatzes "terratest" in der Zone _x000D__x000A_</S>
+	<S S="Error">"test.local" auf dem Server "DC-01"._x000D__x000A_</S><S S="Error">In Zeile:1 Zeichen:43_x000D__x000A_</S>
+	<S S="Error">+ ... yContinue'; Add-DnsServerResourceRecordA -AllowUpdateAny:$false -Crea ..._x000D__x000A_</S>
+	<S S="Error">+                 ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~_x000D__x000A_</S>
+	<S S="Error">    + CategoryInfo          : ResourceExists: (terratest:root/Microsoft/...ResourceRecordA) [Add-DnsServerResourceReco _x000D__x000A_</S>
+	<S S="Error">   rdA], CimException_x000D__x000A_</S>
+	<S S="Error">    + FullyQualifiedErrorId : WIN32 9711,Add-DnsServerResourceRecordA_x000D__x000A_</S><S S="Error"> _x000D__x000A_</S>
+	</Objs>`
+
+	// Synthetic sample of a Windows Server with french locale.
+	// The message is translated by hand, the error record follows the layout of the german capture.
+	cliXmlErrorFrench = `#< CLIXML
+	<Objs Version="1.1.0.1" xmlns="http://schemas.microsoft.com/powershell/2004/04">
+	<S S="Error">Get-LocalUser : L'utilisateur « terratest » est introuvable._x000D__x000A_</S>
+	<S S="Error">Au caractère Ligne:1 : 37_x000D__x000A_</S>
+	<S S="Error">+ $ProgressPreference = 'SilentlyContinue'; Get-LocalUser -Name terratest_x000D__x000A_</S>
+	<S S="Error">+                                           ~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~~_x000D__x000A_</S>
+	<S S="Error">    + CategoryInfo          : ObjectNotFound: (terratest:String) [Get-LocalUser], UserNotFoundException_x000D__x000A_</S>
+	<S S="Error">    + FullyQualifiedErrorId : UserNotFound,Microsoft.PowerShell.Commands.GetLocalUserCommand_x000D__x000A_</S>
+	<S S="Error"> _x000D__x000A_</S>
+	</Objs>`
+)
+
+func (suite *PwshErrorUnitTestSuite) TestNewPwshError() {
+	suite.Run("should extract the culture-neutral error information from localized errors", func() {
+		tcs := []struct {
+			description                   string
+			cliXmlError                   string
+			expectedCategory              string
+			expectedFullyQualifiedErrorId string
+		}{
+			{
+				"german",
+				cliXmlErrorGerman,
+				"ResourceExists",
+				"WIN32 9711,Add-DnsServerResourceRecordA",
+			},
+			{
+				"french",
+				cliXmlErrorFrench,
+				"ObjectNotFound",
+				"UserNotFound,Microsoft.PowerShell.Commands.GetLocalUserCommand",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.Run(tc.description, func() {
+				msg, err := DecodeCliXmlErr(tc.cliXmlError)
+				suite.Require().NoError(err)
+
+				pwshErr := NewPwshError(msg)
+				suite.Equal(msg, pwshErr.Error())
+				suite.Equal(tc.expectedCategory, pwshErr.Category)
+				suite.Equal(tc.expectedFullyQualifiedErrorId, pwshErr.FullyQualifiedErrorId)
+			})
+		}
+	})
+
+	suite.Run("should leave the error information empty for unstructured messages", func() {
+		pwshErr := NewPwshError("test-error")
+		suite.Equal(&PwshError{Message: "test-error"}, pwshErr)
+	})
+}
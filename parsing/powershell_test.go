@@ -1,9 +1,12 @@
 package parsing
 
 import (
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/text/encoding/unicode"
 )
 
 // Unit test suite for all Powershell parsing functions
@@ -18,9 +21,94 @@ func TestPowershellUnitTestSuite(t *testing.T) {
 func (suite *PowershellUnitTestSuite) TestEncodePwshCmd() {
 	suite.Run("should return the correct encoded powershell string", func() {
 		cmd := "Get-LocalUser"
-		expectedPwshCmd := "powershell.exe -NoProfile -EncodedCommand JABQAHIAbwBnAHIAZQBzAHMAUAByAGUAZgBlAHIAZQBuAGMAZQAgAD0AIAAnAFMAaQBsAGUAbgB0AGwAeQBDAG8AbgB0AGkAbgB1AGUAJwA7ACAARwBlAHQALQBMAG8AYwBhAGwAVQBzAGUAcgA="
+		expectedPwshCmd := "powershell.exe -NoProfile -EncodedCommand JABQAHIAbwBnAHIAZQBzAHMAUAByAGUAZgBlAHIAZQBuAGMAZQAgAD0AIAAnAFMAaQBsAGUAbgB0AGwAeQBDAG8AbgB0AGkAbgB1AGUAJwA7ACAAWwBUAGgAcgBlAGEAZABpAG4AZwAuAFQAaAByAGUAYQBkAF0AOgA6AEMAdQByAHIAZQBuAHQAVABoAHIAZQBhAGQALgBDAHUAcgByAGUAbgB0AEMAdQBsAHQAdQByAGUAIAA9ACAAWwBHAGwAbwBiAGEAbABpAHoAYQB0AGkAbwBuAC4AQwB1AGwAdAB1AHIAZQBJAG4AZgBvAF0AOgA6AEkAbgB2AGEAcgBpAGEAbgB0AEMAdQBsAHQAdQByAGUAOwAgAFsAVABoAHIAZQBhAGQAaQBuAGcALgBUAGgAcgBlAGEAZABdADoAOgBDAHUAcgByAGUAbgB0AFQAaAByAGUAYQBkAC4AQwB1AHIAcgBlAG4AdABVAEkAQwB1AGwAdAB1AHIAZQAgAD0AIABbAEcAbABvAGIAYQBsAGkAegBhAHQAaQBvAG4ALgBDAHUAbAB0AHUAcgBlAEkAbgBmAG8AXQA6ADoASQBuAHYAYQByAGkAYQBuAHQAQwB1AGwAdAB1AHIAZQA7ACAAJABPAHUAdABwAHUAdABFAG4AYwBvAGQAaQBuAGcAIAA9ACAATgBlAHcALQBPAGIAagBlAGMAdAAgAFQAZQB4AHQALgBVAFQARgA4AEUAbgBjAG8AZABpAG4AZwAgACQAZgBhAGwAcwBlADsAIAB0AHIAeQAgAHsAIABbAEMAbwBuAHMAbwBsAGUAXQA6ADoATwB1AHQAcAB1AHQARQBuAGMAbwBkAGkAbgBnACAAPQAgACQATwB1AHQAcAB1AHQARQBuAGMAbwBkAGkAbgBnACAAfQAgAGMAYQB0AGMAaAAgAHsAfQA7ACAARwBlAHQALQBMAG8AYwBhAGwAVQBzAGUAcgA="
 		actualPwshCmd, err := EncodePwshCmd(cmd)
 		suite.NoError(err)
 		suite.Equal(expectedPwshCmd, actualPwshCmd)
 	})
 }
+
+func (suite *PowershellUnitTestSuite) TestPowershellEncodeCmd() {
+	suite.Run("should return the correct encoded command for each executable", func() {
+		encodedCmd := "JABQAHIAbwBnAHIAZQBzAHMAUAByAGUAZgBlAHIAZQBuAGMAZQAgAD0AIAAnAFMAaQBsAGUAbgB0AGwAeQBDAG8AbgB0AGkAbgB1AGUAJwA7ACAAWwBUAGgAcgBlAGEAZABpAG4AZwAuAFQAaAByAGUAYQBkAF0AOgA6AEMAdQByAHIAZQBuAHQAVABoAHIAZQBhAGQALgBDAHUAcgByAGUAbgB0AEMAdQBsAHQAdQByAGUAIAA9ACAAWwBHAGwAbwBiAGEAbABpAHoAYQB0AGkAbwBuAC4AQwB1AGwAdAB1AHIAZQBJAG4AZgBvAF0AOgA6AEkAbgB2AGEAcgBpAGEAbgB0AEMAdQBsAHQAdQByAGUAOwAgAFsAVABoAHIAZQBhAGQAaQBuAGcALgBUAGgAcgBlAGEAZABdADoAOgBDAHUAcgByAGUAbgB0AFQAaAByAGUAYQBkAC4AQwB1AHIAcgBlAG4AdABVAEkAQwB1AGwAdAB1AHIAZQAgAD0AIABbAEcAbABvAGIAYQBsAGkAegBhAHQAaQBvAG4ALgBDAHUAbAB0AHUAcgBlAEkAbgBmAG8AXQA6ADoASQBuAHYAYQByAGkAYQBuAHQAQwB1AGwAdAB1AHIAZQA7ACAAJABPAHUAdABwAHUAdABFAG4AYwBvAGQAaQBuAGcAIAA9ACAATgBlAHcALQBPAGIAagBlAGMAdAAgAFQAZQB4AHQALgBVAFQARgA4AEUAbgBjAG8AZABpAG4AZwAgACQAZgBhAGwAcwBlADsAIAB0AHIAeQAgAHsAIABbAEMAbwBuAHMAbwBsAGUAXQA6ADoATwB1AHQAcAB1AHQARQBuAGMAbwBkAGkAbgBnACAAPQAgACQATwB1AHQAcAB1AHQARQBuAGMAbwBkAGkAbgBnACAAfQAgAGMAYQB0AGMAaAAgAHsAfQA7ACAARwBlAHQALQBMAG8AYwBhAGwAVQBzAGUAcgA="
+
+		tcs := []struct {
+			description     string
+			powershell      Powershell
+			expectedPwshCmd string
+		}{
+			{
+				"zero value uses Windows PowerShell",
+				Powershell{},
+				"powershell.exe -NoProfile -EncodedCommand " + encodedCmd,
+			},
+			{
+				"Windows PowerShell",
+				WindowsPowershell,
+				"powershell.exe -NoProfile -EncodedCommand " + encodedCmd,
+			},
+			{
+				"PowerShell 7",
+				Pwsh,
+				"pwsh.exe -NoProfile -EncodedCommand " + encodedCmd,
+			},
+			{
+				"custom path with spaces and arguments",
+				Powershell{Executable: `C:\Program Files\PowerShell\7\pwsh.exe`, Arguments: []string{"-NoProfile", "-NonInteractive"}},
+				`"C:\Program Files\PowerShell\7\pwsh.exe" -NoProfile -NonInteractive -EncodedCommand ` + encodedCmd,
+			},
+			{
+				"custom executable without arguments",
+				Powershell{Executable: "pwsh"},
+				"pwsh -EncodedCommand " + encodedCmd,
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualPwshCmd, err := tc.powershell.EncodeCmd("Get-LocalUser")
+			suite.NoError(err)
+			suite.Equal(tc.expectedPwshCmd, actualPwshCmd)
+		}
+	})
+}
+
+func (suite *PowershellUnitTestSuite) TestPowershellEncodeStagedCmd() {
+	suite.Run("should return a short command and the script for stdin", func() {
+		cmd := strings.Repeat("Add-LocalGroupMember -Name 'Users' -Member 'test';", 500)
+
+		pwshCmd, err := Pwsh.EncodeCmd(cmd)
+		suite.Require().NoError(err)
+		suite.Greater(len(pwshCmd), MaxCmdLength)
+
+		stagedPwshCmd, stdin, err := Pwsh.EncodeStagedCmd(cmd)
+		suite.Require().NoError(err)
+		suite.LessOrEqual(len(stagedPwshCmd), MaxCmdLength)
+		suite.True(strings.HasPrefix(stagedPwshCmd, "pwsh.exe -NoProfile -EncodedCommand "))
+
+		script, err := base64.StdEncoding.DecodeString(stdin)
+		suite.Require().NoError(err)
+		suite.Equal(cmd, string(script))
+	})
+
+	suite.Run("should run the script from stdin as a script block without a temporary file", func() {
+		actualPwshCmd, _, err := WindowsPowershell.EncodeStagedCmd("Get-LocalUser")
+		suite.Require().NoError(err)
+
+		// Decode the command line back to the PowerShell script.
+		encodedCmd, found := strings.CutPrefix(actualPwshCmd, "powershell.exe -NoProfile -EncodedCommand ")
+		suite.Require().True(found)
+		utf16Cmd, err := base64.StdEncoding.DecodeString(encodedCmd)
+		suite.Require().NoError(err)
+		decodedCmd, err := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewDecoder().Bytes(utf16Cmd)
+		suite.Require().NoError(err)
+
+		script, found := strings.CutPrefix(string(decodedCmd), pwshPreamble)
+		suite.Require().True(found)
+		suite.Contains(script, "[Convert]::FromBase64String([Console]::In.ReadToEnd())")
+		suite.Contains(script, "& ([ScriptBlock]::Create($s))")
+		suite.NotContains(script, ".ps1")
+		suite.NotContains(script, "Set-ExecutionPolicy")
+		suite.NotContains(script, "Get-LocalUser")
+	})
+}
@@ -9,15 +9,13 @@ import (
 	"encoding/json"
 	"net/netip"
 
-	"errors"
-
 	"github.com/d-strobel/gowindows/connection"
 	"github.com/d-strobel/gowindows/parsing"
 )
 
 // dhcp is a type constraint for the run function, ensuring it works with specific types.
 type dhcp interface {
-	ScopeV4 | ExclusionRangeV4 | FailoverV4
+	ScopeV4 | ExclusionRangeV4 | FailoverV4 | ReservationV4 | []ReservationV4
 }
 
 // addressString is used to unmarshal the JSON output of an IP address object represented by a string.
@@ -36,6 +34,46 @@ type scopeIdVal struct {
 	Value []addressBytes `json:"value"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface for the scopeIdVal type.
+// Windows PowerShell 5.1 wraps the list of scope IDs into an object with a "value" field,
+// whereas PowerShell 7 returns a plain JSON array.
+func (s *scopeIdVal) UnmarshalJSON(b []byte) error {
+	// Handle the PowerShell 7 output.
+	if len(b) > 0 && b[0] == '[' {
+		return json.Unmarshal(b, &s.Value)
+	}
+
+	// Handle the Windows PowerShell 5.1 output.
+	// The alias type prevents a recursive call of this function.
+	type scopeIdValAlias scopeIdVal
+	return json.Unmarshal(b, (*scopeIdValAlias)(s))
+}
+
+// API defines the DHCP server functions of the Client.
+// Use this interface to replace the Client with a mock in tests.
+type API interface {
+	ScopeV4Read(ctx context.Context, params ScopeV4ReadParams) (ScopeV4, error)
+	ScopeV4Create(ctx context.Context, params ScopeV4CreateParams) (ScopeV4, error)
+	ScopeV4Update(ctx context.Context, params ScopeV4UpdateParams) (ScopeV4, error)
+	ScopeV4Delete(ctx context.Context, params ScopeV4DeleteParams) error
+
+	ExclusionRangeV4Read(ctx context.Context, params ExclusionRangeV4ReadParams) (ExclusionRangeV4, error)
+	ExclusionRangeV4Create(ctx context.Context, params ExclusionRangeV4CreateParams) (ExclusionRangeV4, error)
+	ExclusionRangeV4Delete(ctx context.Context, params ExclusionRangeV4DeleteParams) error
+
+	FailoverV4Read(ctx context.Context, params FailoverV4ReadParams) (FailoverV4, error)
+	FailoverV4Create(ctx context.Context, params FailoverV4CreateParams) (FailoverV4, error)
+
+	ReservationV4Read(ctx context.Context, params ReservationV4ReadParams) (ReservationV4, error)
+	ReservationV4List(ctx context.Context, params ReservationV4ListParams) ([]ReservationV4, error)
+	ReservationV4Create(ctx context.Context, params ReservationV4CreateParams) (ReservationV4, error)
+	ReservationV4Update(ctx context.Context, params ReservationV4UpdateParams) (ReservationV4, error)
+	ReservationV4Delete(ctx context.Context, params ReservationV4DeleteParams) error
+}
+
+// Ensure that the Client implements the API interface.
+var _ API = (*Client)(nil)
+
 // Client represents a client for handling DHCP server functions.
 type Client struct {
 	// Connection represents a connection.Connection object.
@@ -72,7 +110,7 @@ func run[T dhcp](ctx context.Context, c *Client, cmd string, t *T) error {
 			return err
 		}
 
-		return errors.New(stderr)
+		return parsing.NewPwshError(stderr)
 	}
 
 	if result.StdOut == "" {
@@ -51,7 +51,7 @@ func (c *Client) ExclusionRangeV4Read(ctx context.Context, params ExclusionRange
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return s, winerror.Errorf(cmd, "windows.dhcp.ExclusionRangeV4Read: %s", err)
+		return s, winerror.Errorf(cmd, "windows.dhcp.ExclusionRangeV4Read: %w", err)
 	}
 
 	// If the output of the command is empty, return an error.
@@ -99,7 +99,7 @@ func (c *Client) ExclusionRangeV4Create(ctx context.Context, params ExclusionRan
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return s, winerror.Errorf(cmd, "windows.dhcp.ExclusionRangeV4Create: %s", err)
+		return s, winerror.Errorf(cmd, "windows.dhcp.ExclusionRangeV4Create: %w", err)
 	}
 
 	return s, nil
@@ -140,7 +140,7 @@ func (c *Client) ExclusionRangeV4Delete(ctx context.Context, params ExclusionRan
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return winerror.Errorf(cmd, "windows.dhcp.ExclusionRangeV4Delete: %s", err)
+		return winerror.Errorf(cmd, "windows.dhcp.ExclusionRangeV4Delete: %w", err)
 	}
 
 	return nil
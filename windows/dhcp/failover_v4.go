@@ -58,7 +58,7 @@ func (c *Client) FailoverV4Read(ctx context.Context, params FailoverV4ReadParams
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &f); err != nil {
-		return f, winerror.Errorf(cmd, "windows.dhcp.FailoverV4Read: %s", err)
+		return f, winerror.Errorf(cmd, "windows.dhcp.FailoverV4Read: %w", err)
 	}
 
 	return f, nil
@@ -186,7 +186,7 @@ func (c *Client) FailoverV4Create(ctx context.Context, params FailoverV4CreatePa
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &f); err != nil {
-		return f, winerror.Errorf(cmd, "windows.dhcp.FailoverV4Create: %s", err)
+		return f, winerror.Errorf(cmd, "windows.dhcp.FailoverV4Create: %w", err)
 	}
 
 	return f, nil
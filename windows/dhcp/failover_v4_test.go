@@ -57,10 +57,19 @@ var (
 
 // Test the unmarshalJSON functionality.
 func (suite *DhcpServerUnitTestSuite) TestFailoverV4UnmarshalJSON() {
-	var f FailoverV4
-	err := json.Unmarshal([]byte(failoverV4Json), &f)
-	suite.NoError(err)
-	suite.Equal(expectedFailoverV4, f)
+	suite.Run("should unmarshal the Windows PowerShell output", func() {
+		var f FailoverV4
+		err := json.Unmarshal([]byte(failoverV4Json), &f)
+		suite.NoError(err)
+		suite.Equal(expectedFailoverV4, f)
+	})
+
+	suite.Run("should unmarshal the PowerShell 7 scope id array", func() {
+		var s scopeIdVal
+		err := json.Unmarshal([]byte(`[{"Address":698560,"AddressFamily":2},{"Address":1353920,"AddressFamily":2}]`), &s)
+		suite.NoError(err)
+		suite.Equal(expectedFailoverV4.ScopeId, s)
+	})
 }
 
 func (suite *DhcpServerUnitTestSuite) TestFailoverV4ReadPwshCommand() {
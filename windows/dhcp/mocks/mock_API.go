@@ -0,0 +1,807 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dhcp
+
+import (
+	context "context"
+
+	dhcp "github.com/d-strobel/gowindows/windows/dhcp"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAPI is an autogenerated mock type for the API type
+type MockAPI struct {
+	mock.Mock
+}
+
+type MockAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPI) EXPECT() *MockAPI_Expecter {
+	return &MockAPI_Expecter{mock: &_m.Mock}
+}
+
+// ExclusionRangeV4Create provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ExclusionRangeV4Create(ctx context.Context, params dhcp.ExclusionRangeV4CreateParams) (dhcp.ExclusionRangeV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExclusionRangeV4Create")
+	}
+
+	var r0 dhcp.ExclusionRangeV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ExclusionRangeV4CreateParams) (dhcp.ExclusionRangeV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ExclusionRangeV4CreateParams) dhcp.ExclusionRangeV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ExclusionRangeV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ExclusionRangeV4CreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ExclusionRangeV4Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExclusionRangeV4Create'
+type MockAPI_ExclusionRangeV4Create_Call struct {
+	*mock.Call
+}
+
+// ExclusionRangeV4Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ExclusionRangeV4CreateParams
+func (_e *MockAPI_Expecter) ExclusionRangeV4Create(ctx interface{}, params interface{}) *MockAPI_ExclusionRangeV4Create_Call {
+	return &MockAPI_ExclusionRangeV4Create_Call{Call: _e.mock.On("ExclusionRangeV4Create", ctx, params)}
+}
+
+func (_c *MockAPI_ExclusionRangeV4Create_Call) Run(run func(ctx context.Context, params dhcp.ExclusionRangeV4CreateParams)) *MockAPI_ExclusionRangeV4Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ExclusionRangeV4CreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ExclusionRangeV4Create_Call) Return(_a0 dhcp.ExclusionRangeV4, _a1 error) *MockAPI_ExclusionRangeV4Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ExclusionRangeV4Create_Call) RunAndReturn(run func(context.Context, dhcp.ExclusionRangeV4CreateParams) (dhcp.ExclusionRangeV4, error)) *MockAPI_ExclusionRangeV4Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExclusionRangeV4Delete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ExclusionRangeV4Delete(ctx context.Context, params dhcp.ExclusionRangeV4DeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExclusionRangeV4Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ExclusionRangeV4DeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ExclusionRangeV4Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExclusionRangeV4Delete'
+type MockAPI_ExclusionRangeV4Delete_Call struct {
+	*mock.Call
+}
+
+// ExclusionRangeV4Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ExclusionRangeV4DeleteParams
+func (_e *MockAPI_Expecter) ExclusionRangeV4Delete(ctx interface{}, params interface{}) *MockAPI_ExclusionRangeV4Delete_Call {
+	return &MockAPI_ExclusionRangeV4Delete_Call{Call: _e.mock.On("ExclusionRangeV4Delete", ctx, params)}
+}
+
+func (_c *MockAPI_ExclusionRangeV4Delete_Call) Run(run func(ctx context.Context, params dhcp.ExclusionRangeV4DeleteParams)) *MockAPI_ExclusionRangeV4Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ExclusionRangeV4DeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ExclusionRangeV4Delete_Call) Return(_a0 error) *MockAPI_ExclusionRangeV4Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ExclusionRangeV4Delete_Call) RunAndReturn(run func(context.Context, dhcp.ExclusionRangeV4DeleteParams) error) *MockAPI_ExclusionRangeV4Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExclusionRangeV4Read provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ExclusionRangeV4Read(ctx context.Context, params dhcp.ExclusionRangeV4ReadParams) (dhcp.ExclusionRangeV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExclusionRangeV4Read")
+	}
+
+	var r0 dhcp.ExclusionRangeV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ExclusionRangeV4ReadParams) (dhcp.ExclusionRangeV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ExclusionRangeV4ReadParams) dhcp.ExclusionRangeV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ExclusionRangeV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ExclusionRangeV4ReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ExclusionRangeV4Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExclusionRangeV4Read'
+type MockAPI_ExclusionRangeV4Read_Call struct {
+	*mock.Call
+}
+
+// ExclusionRangeV4Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ExclusionRangeV4ReadParams
+func (_e *MockAPI_Expecter) ExclusionRangeV4Read(ctx interface{}, params interface{}) *MockAPI_ExclusionRangeV4Read_Call {
+	return &MockAPI_ExclusionRangeV4Read_Call{Call: _e.mock.On("ExclusionRangeV4Read", ctx, params)}
+}
+
+func (_c *MockAPI_ExclusionRangeV4Read_Call) Run(run func(ctx context.Context, params dhcp.ExclusionRangeV4ReadParams)) *MockAPI_ExclusionRangeV4Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ExclusionRangeV4ReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ExclusionRangeV4Read_Call) Return(_a0 dhcp.ExclusionRangeV4, _a1 error) *MockAPI_ExclusionRangeV4Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ExclusionRangeV4Read_Call) RunAndReturn(run func(context.Context, dhcp.ExclusionRangeV4ReadParams) (dhcp.ExclusionRangeV4, error)) *MockAPI_ExclusionRangeV4Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailoverV4Create provides a mock function with given fields: ctx, params
+func (_m *MockAPI) FailoverV4Create(ctx context.Context, params dhcp.FailoverV4CreateParams) (dhcp.FailoverV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailoverV4Create")
+	}
+
+	var r0 dhcp.FailoverV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.FailoverV4CreateParams) (dhcp.FailoverV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.FailoverV4CreateParams) dhcp.FailoverV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.FailoverV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.FailoverV4CreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_FailoverV4Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailoverV4Create'
+type MockAPI_FailoverV4Create_Call struct {
+	*mock.Call
+}
+
+// FailoverV4Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.FailoverV4CreateParams
+func (_e *MockAPI_Expecter) FailoverV4Create(ctx interface{}, params interface{}) *MockAPI_FailoverV4Create_Call {
+	return &MockAPI_FailoverV4Create_Call{Call: _e.mock.On("FailoverV4Create", ctx, params)}
+}
+
+func (_c *MockAPI_FailoverV4Create_Call) Run(run func(ctx context.Context, params dhcp.FailoverV4CreateParams)) *MockAPI_FailoverV4Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.FailoverV4CreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_FailoverV4Create_Call) Return(_a0 dhcp.FailoverV4, _a1 error) *MockAPI_FailoverV4Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_FailoverV4Create_Call) RunAndReturn(run func(context.Context, dhcp.FailoverV4CreateParams) (dhcp.FailoverV4, error)) *MockAPI_FailoverV4Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailoverV4Read provides a mock function with given fields: ctx, params
+func (_m *MockAPI) FailoverV4Read(ctx context.Context, params dhcp.FailoverV4ReadParams) (dhcp.FailoverV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailoverV4Read")
+	}
+
+	var r0 dhcp.FailoverV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.FailoverV4ReadParams) (dhcp.FailoverV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.FailoverV4ReadParams) dhcp.FailoverV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.FailoverV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.FailoverV4ReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_FailoverV4Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailoverV4Read'
+type MockAPI_FailoverV4Read_Call struct {
+	*mock.Call
+}
+
+// FailoverV4Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.FailoverV4ReadParams
+func (_e *MockAPI_Expecter) FailoverV4Read(ctx interface{}, params interface{}) *MockAPI_FailoverV4Read_Call {
+	return &MockAPI_FailoverV4Read_Call{Call: _e.mock.On("FailoverV4Read", ctx, params)}
+}
+
+func (_c *MockAPI_FailoverV4Read_Call) Run(run func(ctx context.Context, params dhcp.FailoverV4ReadParams)) *MockAPI_FailoverV4Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.FailoverV4ReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_FailoverV4Read_Call) Return(_a0 dhcp.FailoverV4, _a1 error) *MockAPI_FailoverV4Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_FailoverV4Read_Call) RunAndReturn(run func(context.Context, dhcp.FailoverV4ReadParams) (dhcp.FailoverV4, error)) *MockAPI_FailoverV4Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservationV4Create provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ReservationV4Create(ctx context.Context, params dhcp.ReservationV4CreateParams) (dhcp.ReservationV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservationV4Create")
+	}
+
+	var r0 dhcp.ReservationV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4CreateParams) (dhcp.ReservationV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4CreateParams) dhcp.ReservationV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ReservationV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ReservationV4CreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReservationV4Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservationV4Create'
+type MockAPI_ReservationV4Create_Call struct {
+	*mock.Call
+}
+
+// ReservationV4Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ReservationV4CreateParams
+func (_e *MockAPI_Expecter) ReservationV4Create(ctx interface{}, params interface{}) *MockAPI_ReservationV4Create_Call {
+	return &MockAPI_ReservationV4Create_Call{Call: _e.mock.On("ReservationV4Create", ctx, params)}
+}
+
+func (_c *MockAPI_ReservationV4Create_Call) Run(run func(ctx context.Context, params dhcp.ReservationV4CreateParams)) *MockAPI_ReservationV4Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ReservationV4CreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Create_Call) Return(_a0 dhcp.ReservationV4, _a1 error) *MockAPI_ReservationV4Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Create_Call) RunAndReturn(run func(context.Context, dhcp.ReservationV4CreateParams) (dhcp.ReservationV4, error)) *MockAPI_ReservationV4Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservationV4Delete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ReservationV4Delete(ctx context.Context, params dhcp.ReservationV4DeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservationV4Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4DeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ReservationV4Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservationV4Delete'
+type MockAPI_ReservationV4Delete_Call struct {
+	*mock.Call
+}
+
+// ReservationV4Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ReservationV4DeleteParams
+func (_e *MockAPI_Expecter) ReservationV4Delete(ctx interface{}, params interface{}) *MockAPI_ReservationV4Delete_Call {
+	return &MockAPI_ReservationV4Delete_Call{Call: _e.mock.On("ReservationV4Delete", ctx, params)}
+}
+
+func (_c *MockAPI_ReservationV4Delete_Call) Run(run func(ctx context.Context, params dhcp.ReservationV4DeleteParams)) *MockAPI_ReservationV4Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ReservationV4DeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Delete_Call) Return(_a0 error) *MockAPI_ReservationV4Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Delete_Call) RunAndReturn(run func(context.Context, dhcp.ReservationV4DeleteParams) error) *MockAPI_ReservationV4Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservationV4List provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ReservationV4List(ctx context.Context, params dhcp.ReservationV4ListParams) ([]dhcp.ReservationV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservationV4List")
+	}
+
+	var r0 []dhcp.ReservationV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4ListParams) ([]dhcp.ReservationV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4ListParams) []dhcp.ReservationV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dhcp.ReservationV4)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ReservationV4ListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReservationV4List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservationV4List'
+type MockAPI_ReservationV4List_Call struct {
+	*mock.Call
+}
+
+// ReservationV4List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ReservationV4ListParams
+func (_e *MockAPI_Expecter) ReservationV4List(ctx interface{}, params interface{}) *MockAPI_ReservationV4List_Call {
+	return &MockAPI_ReservationV4List_Call{Call: _e.mock.On("ReservationV4List", ctx, params)}
+}
+
+func (_c *MockAPI_ReservationV4List_Call) Run(run func(ctx context.Context, params dhcp.ReservationV4ListParams)) *MockAPI_ReservationV4List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ReservationV4ListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4List_Call) Return(_a0 []dhcp.ReservationV4, _a1 error) *MockAPI_ReservationV4List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4List_Call) RunAndReturn(run func(context.Context, dhcp.ReservationV4ListParams) ([]dhcp.ReservationV4, error)) *MockAPI_ReservationV4List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservationV4Read provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ReservationV4Read(ctx context.Context, params dhcp.ReservationV4ReadParams) (dhcp.ReservationV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservationV4Read")
+	}
+
+	var r0 dhcp.ReservationV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4ReadParams) (dhcp.ReservationV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4ReadParams) dhcp.ReservationV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ReservationV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ReservationV4ReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReservationV4Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservationV4Read'
+type MockAPI_ReservationV4Read_Call struct {
+	*mock.Call
+}
+
+// ReservationV4Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ReservationV4ReadParams
+func (_e *MockAPI_Expecter) ReservationV4Read(ctx interface{}, params interface{}) *MockAPI_ReservationV4Read_Call {
+	return &MockAPI_ReservationV4Read_Call{Call: _e.mock.On("ReservationV4Read", ctx, params)}
+}
+
+func (_c *MockAPI_ReservationV4Read_Call) Run(run func(ctx context.Context, params dhcp.ReservationV4ReadParams)) *MockAPI_ReservationV4Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ReservationV4ReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Read_Call) Return(_a0 dhcp.ReservationV4, _a1 error) *MockAPI_ReservationV4Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Read_Call) RunAndReturn(run func(context.Context, dhcp.ReservationV4ReadParams) (dhcp.ReservationV4, error)) *MockAPI_ReservationV4Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservationV4Update provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ReservationV4Update(ctx context.Context, params dhcp.ReservationV4UpdateParams) (dhcp.ReservationV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservationV4Update")
+	}
+
+	var r0 dhcp.ReservationV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4UpdateParams) (dhcp.ReservationV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ReservationV4UpdateParams) dhcp.ReservationV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ReservationV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ReservationV4UpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ReservationV4Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservationV4Update'
+type MockAPI_ReservationV4Update_Call struct {
+	*mock.Call
+}
+
+// ReservationV4Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ReservationV4UpdateParams
+func (_e *MockAPI_Expecter) ReservationV4Update(ctx interface{}, params interface{}) *MockAPI_ReservationV4Update_Call {
+	return &MockAPI_ReservationV4Update_Call{Call: _e.mock.On("ReservationV4Update", ctx, params)}
+}
+
+func (_c *MockAPI_ReservationV4Update_Call) Run(run func(ctx context.Context, params dhcp.ReservationV4UpdateParams)) *MockAPI_ReservationV4Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ReservationV4UpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Update_Call) Return(_a0 dhcp.ReservationV4, _a1 error) *MockAPI_ReservationV4Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ReservationV4Update_Call) RunAndReturn(run func(context.Context, dhcp.ReservationV4UpdateParams) (dhcp.ReservationV4, error)) *MockAPI_ReservationV4Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScopeV4Create provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ScopeV4Create(ctx context.Context, params dhcp.ScopeV4CreateParams) (dhcp.ScopeV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScopeV4Create")
+	}
+
+	var r0 dhcp.ScopeV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4CreateParams) (dhcp.ScopeV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4CreateParams) dhcp.ScopeV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ScopeV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ScopeV4CreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ScopeV4Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScopeV4Create'
+type MockAPI_ScopeV4Create_Call struct {
+	*mock.Call
+}
+
+// ScopeV4Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ScopeV4CreateParams
+func (_e *MockAPI_Expecter) ScopeV4Create(ctx interface{}, params interface{}) *MockAPI_ScopeV4Create_Call {
+	return &MockAPI_ScopeV4Create_Call{Call: _e.mock.On("ScopeV4Create", ctx, params)}
+}
+
+func (_c *MockAPI_ScopeV4Create_Call) Run(run func(ctx context.Context, params dhcp.ScopeV4CreateParams)) *MockAPI_ScopeV4Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ScopeV4CreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Create_Call) Return(_a0 dhcp.ScopeV4, _a1 error) *MockAPI_ScopeV4Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Create_Call) RunAndReturn(run func(context.Context, dhcp.ScopeV4CreateParams) (dhcp.ScopeV4, error)) *MockAPI_ScopeV4Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScopeV4Delete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ScopeV4Delete(ctx context.Context, params dhcp.ScopeV4DeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScopeV4Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4DeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ScopeV4Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScopeV4Delete'
+type MockAPI_ScopeV4Delete_Call struct {
+	*mock.Call
+}
+
+// ScopeV4Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ScopeV4DeleteParams
+func (_e *MockAPI_Expecter) ScopeV4Delete(ctx interface{}, params interface{}) *MockAPI_ScopeV4Delete_Call {
+	return &MockAPI_ScopeV4Delete_Call{Call: _e.mock.On("ScopeV4Delete", ctx, params)}
+}
+
+func (_c *MockAPI_ScopeV4Delete_Call) Run(run func(ctx context.Context, params dhcp.ScopeV4DeleteParams)) *MockAPI_ScopeV4Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ScopeV4DeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Delete_Call) Return(_a0 error) *MockAPI_ScopeV4Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Delete_Call) RunAndReturn(run func(context.Context, dhcp.ScopeV4DeleteParams) error) *MockAPI_ScopeV4Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScopeV4Read provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ScopeV4Read(ctx context.Context, params dhcp.ScopeV4ReadParams) (dhcp.ScopeV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScopeV4Read")
+	}
+
+	var r0 dhcp.ScopeV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4ReadParams) (dhcp.ScopeV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4ReadParams) dhcp.ScopeV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ScopeV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ScopeV4ReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ScopeV4Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScopeV4Read'
+type MockAPI_ScopeV4Read_Call struct {
+	*mock.Call
+}
+
+// ScopeV4Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ScopeV4ReadParams
+func (_e *MockAPI_Expecter) ScopeV4Read(ctx interface{}, params interface{}) *MockAPI_ScopeV4Read_Call {
+	return &MockAPI_ScopeV4Read_Call{Call: _e.mock.On("ScopeV4Read", ctx, params)}
+}
+
+func (_c *MockAPI_ScopeV4Read_Call) Run(run func(ctx context.Context, params dhcp.ScopeV4ReadParams)) *MockAPI_ScopeV4Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ScopeV4ReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Read_Call) Return(_a0 dhcp.ScopeV4, _a1 error) *MockAPI_ScopeV4Read_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Read_Call) RunAndReturn(run func(context.Context, dhcp.ScopeV4ReadParams) (dhcp.ScopeV4, error)) *MockAPI_ScopeV4Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScopeV4Update provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ScopeV4Update(ctx context.Context, params dhcp.ScopeV4UpdateParams) (dhcp.ScopeV4, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScopeV4Update")
+	}
+
+	var r0 dhcp.ScopeV4
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4UpdateParams) (dhcp.ScopeV4, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dhcp.ScopeV4UpdateParams) dhcp.ScopeV4); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dhcp.ScopeV4)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dhcp.ScopeV4UpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ScopeV4Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScopeV4Update'
+type MockAPI_ScopeV4Update_Call struct {
+	*mock.Call
+}
+
+// ScopeV4Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dhcp.ScopeV4UpdateParams
+func (_e *MockAPI_Expecter) ScopeV4Update(ctx interface{}, params interface{}) *MockAPI_ScopeV4Update_Call {
+	return &MockAPI_ScopeV4Update_Call{Call: _e.mock.On("ScopeV4Update", ctx, params)}
+}
+
+func (_c *MockAPI_ScopeV4Update_Call) Run(run func(ctx context.Context, params dhcp.ScopeV4UpdateParams)) *MockAPI_ScopeV4Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dhcp.ScopeV4UpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Update_Call) Return(_a0 dhcp.ScopeV4, _a1 error) *MockAPI_ScopeV4Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ScopeV4Update_Call) RunAndReturn(run func(context.Context, dhcp.ScopeV4UpdateParams) (dhcp.ScopeV4, error)) *MockAPI_ScopeV4Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPI creates a new instance of MockAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPI {
+	mock := &MockAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
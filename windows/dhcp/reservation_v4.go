@@ -0,0 +1,422 @@
+package dhcp
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ReservationV4 represents an IPv4 DHCP reservation.
+type ReservationV4 struct {
+	IPAddress    addressString `json:"IPAddress"`
+	ScopeId      addressString `json:"ScopeId"`
+	ClientId     string        `json:"ClientId"`
+	Name         string        `json:"Name"`
+	Description  string        `json:"Description"`
+	Type         string        `json:"Type"`
+	AddressState string        `json:"AddressState"`
+}
+
+// parseClientId parses a MAC address and returns it in the format used by the DHCP server, e.g. 00-11-22-33-44-55.
+// The separators ':', '-' and '.' are accepted as well as a MAC address without separators.
+func parseClientId(clientId string) (string, error) {
+	mac, err := net.ParseMAC(clientId)
+	if err != nil {
+		mac, err = hex.DecodeString(clientId)
+	}
+
+	if err != nil || len(mac) != 6 {
+		return "", fmt.Errorf("invalid MAC address '%s'", clientId)
+	}
+
+	return strings.ReplaceAll(mac.String(), ":", "-"), nil
+}
+
+// assertReservationV4Key asserts the parameters that identify a reservation.
+// A reservation is identified by its scope and either its IP address or its client MAC address.
+// It returns the normalized client ID.
+func assertReservationV4Key(scopeId netip.Addr, ipAddress netip.Addr, clientId string) (string, error) {
+	if !scopeId.Is4() {
+		return "", errors.New("reservation parameter 'ScopeId' must be a valid IPv4 address")
+	}
+
+	if ipAddress.IsValid() && clientId != "" {
+		return "", errors.New("reservation parameters 'IPAddress' and 'ClientId' are mutually exclusive")
+	}
+
+	if clientId != "" {
+		clientId, err := parseClientId(clientId)
+		if err != nil {
+			return "", fmt.Errorf("reservation parameter 'ClientId' must be a MAC address: %w", err)
+		}
+		return clientId, nil
+	}
+
+	if !ipAddress.Is4() {
+		return "", errors.New("reservation parameter 'IPAddress' must be a valid IPv4 address or 'ClientId' must be set")
+	}
+
+	return "", nil
+}
+
+// reservationV4Selector returns the PowerShell command to get a reservation by its IP address or client ID.
+// The IP address is filtered within the scope, so an empty output is returned if the reservation does not exist.
+func reservationV4Selector(scopeId netip.Addr, ipAddress netip.Addr, clientId string) string {
+	if clientId != "" {
+		return fmt.Sprintf("Get-DhcpServerv4Reservation -ScopeId '%s' -ClientId '%s'", scopeId, clientId)
+	}
+
+	return fmt.Sprintf(
+		"Get-DhcpServerv4Reservation -ScopeId '%s' | Where-Object {$_.IPAddress.IPAddressToString -eq '%s'}",
+		scopeId,
+		ipAddress,
+	)
+}
+
+// assertReservationV4Type asserts the type of a reservation.
+func assertReservationV4Type(reservationType string) error {
+	if reservationType != "" && reservationType != "Dhcp" && reservationType != "Bootp" && reservationType != "Both" {
+		return errors.New("reservation parameter 'Type' must be one of the following values: 'Dhcp', 'Bootp', 'Both'")
+	}
+	return nil
+}
+
+// ReservationV4ReadParams represents parameters for the IPv4 reservation read function.
+type ReservationV4ReadParams struct {
+	// Specifies the client identifier (ID) of the reservation as MAC address, e.g. 00-11-22-33-44-55.
+	// Either IPAddress or ClientId must be set.
+	ClientId string
+
+	// Specifies the reserved IPv4 address.
+	// Either IPAddress or ClientId must be set.
+	IPAddress netip.Addr
+
+	// Specifies the scope identifier (ID), in IPv4 address format, of the reservation.
+	ScopeId netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to read an IPv4 DHCP reservation.
+func (params ReservationV4ReadParams) pwshCommand() string {
+	return fmt.Sprintf("%s | ConvertTo-Json -Compress", reservationV4Selector(params.ScopeId, params.IPAddress, params.ClientId))
+}
+
+// ReservationV4Read gets an IPv4 DHCP reservation by its IP address or client ID. It returns a ReservationV4 object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ReservationV4Read(ctx context.Context, params ReservationV4ReadParams) (ReservationV4, error) {
+	var r ReservationV4
+
+	// Assert needed parameters
+	clientId, err := assertReservationV4Key(params.ScopeId, params.IPAddress, params.ClientId)
+	if err != nil {
+		return r, fmt.Errorf("windows.dhcp.ReservationV4Read: %w", err)
+	}
+	params.ClientId = clientId
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &r); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dhcp.ReservationV4Read: %w", err)
+	}
+
+	// If the output of the command is empty, return an error.
+	if !r.IPAddress.Address.Is4() {
+		return r, winerror.Errorf(cmd, "windows.dhcp.ReservationV4Read: reservation not found")
+	}
+
+	return r, nil
+}
+
+// ReservationV4ListParams represents parameters for the IPv4 reservation list function.
+type ReservationV4ListParams struct {
+	// Specifies the scope identifier (ID), in IPv4 address format, from which the reservations are returned.
+	ScopeId netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to list the IPv4 DHCP reservations of a scope.
+// The reservations are wrapped into an array, so a single reservation is returned as a list as well.
+func (params ReservationV4ListParams) pwshCommand() string {
+	return fmt.Sprintf(
+		"$r=@(Get-DhcpServerv4Reservation -ScopeId '%s') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+		params.ScopeId,
+	)
+}
+
+// ReservationV4List returns all IPv4 DHCP reservations of a scope.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ReservationV4List(ctx context.Context, params ReservationV4ListParams) ([]ReservationV4, error) {
+	var r []ReservationV4
+
+	// Assert needed parameters
+	if !params.ScopeId.Is4() {
+		return r, errors.New("windows.dhcp.ReservationV4List: reservation parameter 'ScopeId' must be a valid IPv4 address")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &r); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dhcp.ReservationV4List: %w", err)
+	}
+
+	return r, nil
+}
+
+// ReservationV4CreateParams represents parameters for the IPv4 reservation create function.
+type ReservationV4CreateParams struct {
+	// Specifies the client identifier (ID) for the reservation as MAC address, e.g. 00-11-22-33-44-55.
+	// The separators ':', '-' and '.' are accepted as well as a MAC address without separators.
+	ClientId string
+
+	// Specifies the description for the reservation.
+	Description string
+
+	// Specifies the IPv4 address to reserve. The address must be within the range of the scope.
+	IPAddress netip.Addr
+
+	// Specifies the name of the reservation.
+	Name string
+
+	// Specifies the scope identifier (ID), in IPv4 address format, in which the reservation is added.
+	ScopeId netip.Addr
+
+	// Specifies the type of client for the reservation.
+	//
+	// The acceptable values for this parameter are:
+	// "Dhcp", "Bootp", "Both".
+	Type string
+}
+
+// pwshCommand returns the PowerShell command to create an IPv4 DHCP reservation.
+func (params ReservationV4CreateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{
+		fmt.Sprintf("Add-DhcpServerv4Reservation -PassThru -Confirm:$false -ScopeId '%s' -IPAddress '%s' -ClientId '%s'",
+			params.ScopeId,
+			params.IPAddress,
+			params.ClientId,
+		),
+	}
+
+	// Add optional parameters
+	if params.Name != "" {
+		cmd = append(cmd, fmt.Sprintf("-Name '%s'", strings.ReplaceAll(params.Name, "'", "''")))
+	}
+
+	if params.Description != "" {
+		cmd = append(cmd, fmt.Sprintf("-Description '%s'", strings.ReplaceAll(params.Description, "'", "''")))
+	}
+
+	if params.Type != "" {
+		cmd = append(cmd, fmt.Sprintf("-Type '%s'", params.Type))
+	}
+
+	// Convert output to json
+	cmd = append(cmd, "| ConvertTo-Json -Compress")
+
+	// Return the full command
+	return strings.Join(cmd, " ")
+}
+
+// ReservationV4Create creates a new IPv4 DHCP reservation. It returns a ReservationV4 object.
+// The scope is read first to ensure that the IP address is within its range.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ReservationV4Create(ctx context.Context, params ReservationV4CreateParams) (ReservationV4, error) {
+	var r ReservationV4
+
+	// Assert needed parameters
+	if !params.ScopeId.Is4() || !params.IPAddress.Is4() {
+		return r, errors.New("windows.dhcp.ReservationV4Create: reservation parameter 'ScopeId' and 'IPAddress' must be a valid IPv4 address")
+	}
+
+	clientId, err := parseClientId(params.ClientId)
+	if err != nil {
+		return r, fmt.Errorf("windows.dhcp.ReservationV4Create: reservation parameter 'ClientId' must be a MAC address: %w", err)
+	}
+	params.ClientId = clientId
+
+	if err := assertReservationV4Type(params.Type); err != nil {
+		return r, fmt.Errorf("windows.dhcp.ReservationV4Create: %w", err)
+	}
+
+	// Assert that the IP address is within the range of the scope
+	s, err := c.ScopeV4Read(ctx, ScopeV4ReadParams{ScopeId: params.ScopeId})
+	if err != nil {
+		return r, fmt.Errorf("windows.dhcp.ReservationV4Create: %w", err)
+	}
+
+	if params.IPAddress.Less(s.StartRange.Address) || s.EndRange.Address.Less(params.IPAddress) {
+		return r, fmt.Errorf(
+			"windows.dhcp.ReservationV4Create: reservation parameter 'IPAddress' must be within the range %s-%s of the scope %s",
+			s.StartRange.Address,
+			s.EndRange.Address,
+			params.ScopeId,
+		)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &r); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dhcp.ReservationV4Create: %w", err)
+	}
+
+	return r, nil
+}
+
+// ReservationV4UpdateParams represents parameters for the IPv4 reservation update function.
+type ReservationV4UpdateParams struct {
+	// Specifies the client identifier (ID) of the reservation to update as MAC address, e.g. 00-11-22-33-44-55.
+	// Either IPAddress or ClientId must be set.
+	ClientId string
+
+	// Specifies the description for the reservation.
+	// If not provided, the description is not changed.
+	// An empty description removes the current description.
+	Description *string
+
+	// Specifies the reserved IPv4 address of the reservation to update.
+	// Either IPAddress or ClientId must be set.
+	IPAddress netip.Addr
+
+	// Specifies the name of the reservation.
+	// If not provided, the name is not changed.
+	Name *string
+
+	// Specifies the new client identifier (ID) of the reservation as MAC address.
+	// Use this to move the reservation to another client.
+	NewClientId string
+
+	// Specifies the scope identifier (ID), in IPv4 address format, of the reservation.
+	ScopeId netip.Addr
+
+	// Specifies the type of client for the reservation.
+	//
+	// The acceptable values for this parameter are:
+	// "Dhcp", "Bootp", "Both".
+	Type string
+}
+
+// pwshCommand returns the PowerShell command to update an IPv4 DHCP reservation.
+func (params ReservationV4UpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{
+		reservationV4Selector(params.ScopeId, params.IPAddress, params.ClientId),
+		"| Set-DhcpServerv4Reservation -PassThru",
+	}
+
+	// Add optional parameters
+	if params.Description != nil {
+		cmd = append(cmd, fmt.Sprintf("-Description '%s'", strings.ReplaceAll(*params.Description, "'", "''")))
+	}
+
+	if params.Name != nil {
+		cmd = append(cmd, fmt.Sprintf("-Name '%s'", strings.ReplaceAll(*params.Name, "'", "''")))
+	}
+
+	if params.Type != "" {
+		cmd = append(cmd, fmt.Sprintf("-Type '%s'", params.Type))
+	}
+
+	if params.NewClientId != "" {
+		cmd = append(cmd, fmt.Sprintf("-ClientId '%s'", params.NewClientId))
+	}
+
+	// Convert output to json
+	cmd = append(cmd, "| ConvertTo-Json -Compress")
+
+	// Return the full command
+	return strings.Join(cmd, " ")
+}
+
+// ReservationV4Update updates an IPv4 DHCP reservation identified by its IP address or client ID.
+// It returns a ReservationV4 object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ReservationV4Update(ctx context.Context, params ReservationV4UpdateParams) (ReservationV4, error) {
+	var r ReservationV4
+
+	// Assert needed parameters
+	clientId, err := assertReservationV4Key(params.ScopeId, params.IPAddress, params.ClientId)
+	if err != nil {
+		return r, fmt.Errorf("windows.dhcp.ReservationV4Update: %w", err)
+	}
+	params.ClientId = clientId
+
+	// Assert optional parameters
+	if params.NewClientId != "" {
+		newClientId, err := parseClientId(params.NewClientId)
+		if err != nil {
+			return r, fmt.Errorf("windows.dhcp.ReservationV4Update: reservation parameter 'NewClientId' must be a MAC address: %w", err)
+		}
+		params.NewClientId = newClientId
+	}
+
+	if err := assertReservationV4Type(params.Type); err != nil {
+		return r, fmt.Errorf("windows.dhcp.ReservationV4Update: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &r); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dhcp.ReservationV4Update: %w", err)
+	}
+
+	// If the output of the command is empty, return an error.
+	if !r.IPAddress.Address.Is4() {
+		return r, winerror.Errorf(cmd, "windows.dhcp.ReservationV4Update: reservation not found")
+	}
+
+	return r, nil
+}
+
+// ReservationV4DeleteParams represents parameters for the IPv4 reservation delete function.
+type ReservationV4DeleteParams struct {
+	// Specifies the client identifier (ID) of the reservation to delete as MAC address, e.g. 00-11-22-33-44-55.
+	// Either IPAddress or ClientId must be set.
+	ClientId string
+
+	// Specifies the reserved IPv4 address of the reservation to delete.
+	// Either IPAddress or ClientId must be set.
+	IPAddress netip.Addr
+
+	// Specifies the scope identifier (ID), in IPv4 address format, of the reservation.
+	ScopeId netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to delete an IPv4 DHCP reservation.
+// The reservation is selected within the scope and the removed reservation is returned.
+func (params ReservationV4DeleteParams) pwshCommand() string {
+	return fmt.Sprintf(
+		"%s | Remove-DhcpServerv4Reservation -Confirm:$false -PassThru | ConvertTo-Json -Compress",
+		reservationV4Selector(params.ScopeId, params.IPAddress, params.ClientId),
+	)
+}
+
+// ReservationV4Delete removes an IPv4 DHCP reservation identified by its IP address or client ID.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ReservationV4Delete(ctx context.Context, params ReservationV4DeleteParams) error {
+	var r ReservationV4
+
+	// Assert needed parameters
+	clientId, err := assertReservationV4Key(params.ScopeId, params.IPAddress, params.ClientId)
+	if err != nil {
+		return fmt.Errorf("windows.dhcp.ReservationV4Delete: %w", err)
+	}
+	params.ClientId = clientId
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &r); err != nil {
+		return winerror.Errorf(cmd, "windows.dhcp.ReservationV4Delete: %w", err)
+	}
+
+	// If the output of the command is empty, the reservation does not exist in the scope.
+	if !r.IPAddress.Address.Is4() {
+		return winerror.Errorf(cmd, "windows.dhcp.ReservationV4Delete: reservation not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,529 @@
+package dhcp
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	// reservation json output
+	reservationV4Json = `{"IPAddress":{"Address":117967040,"AddressFamily":2,"ScopeId":null,"IsIPv6Multicast":false,"IsIPv6LinkLocal":false,"IsIPv6SiteLocal":false,"IsIPv6Teredo":false,"IsIPv4MappedToIPv6":false,"IPAddressToString":"192.168.10.7"},"ScopeId":{"Address":698560,"AddressFamily":2,"ScopeId":null,"IsIPv6Multicast":false,"IsIPv6LinkLocal":false,"IsIPv6SiteLocal":false,"IsIPv6Teredo":false,"IsIPv4MappedToIPv6":false,"IPAddressToString":"192.168.10.0"},"AddressState":"InactiveReservation","ClientId":"00-15-5d-01-02-03","Description":"Test description","Name":"printer","Type":"Both","PSComputerName":null}`
+)
+
+var (
+	expectedReservationV4 = ReservationV4{
+		IPAddress: addressString{
+			Address: netip.MustParseAddr("192.168.10.7"),
+		},
+		ScopeId: addressString{
+			Address: netip.MustParseAddr("192.168.10.0"),
+		},
+		ClientId:     "00-15-5d-01-02-03",
+		Name:         "printer",
+		Description:  "Test description",
+		Type:         "Both",
+		AddressState: "InactiveReservation",
+	}
+)
+
+// Test the parseClientId function.
+func (suite *DhcpServerUnitTestSuite) TestParseClientId() {
+	suite.T().Parallel()
+
+	suite.Run("should return the normalized MAC address", func() {
+		for _, input := range []string{"00-15-5D-01-02-03", "00:15:5d:01:02:03", "0015.5d01.0203", "00155D010203"} {
+			clientId, err := parseClientId(input)
+			suite.NoError(err)
+			suite.Equal("00-15-5d-01-02-03", clientId)
+		}
+	})
+
+	suite.Run("should return an error for invalid MAC addresses", func() {
+		for _, input := range []string{"", "00-15-5d-01-02", "00155d0102", "00-00-00-00-fe-80-00-00-00-00-00-00-02-00-5e-10-00-00-00-01", "zz-15-5d-01-02-03"} {
+			_, err := parseClientId(input)
+			suite.EqualError(err, "invalid MAC address '"+input+"'")
+		}
+	})
+}
+
+// Test ReservationV4Read related methods.
+func (suite *DhcpServerUnitTestSuite) TestReservationV4ReadPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4ReadParams
+			expectedCmd     string
+		}{
+			{
+				"assert correct command with IP address",
+				ReservationV4ReadParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7")},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.7'} | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with client ID",
+				ReservationV4ReadParams{ScopeId: netip.MustParseAddr("192.168.10.0"), ClientId: "00-15-5d-01-02-03"},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' -ClientId '00-15-5d-01-02-03' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DhcpServerUnitTestSuite) TestReservationV4Read() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct ReservationV4", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' -ClientId '00-15-5d-01-02-03' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: reservationV4Json}, nil)
+		actualReservationV4, err := c.ReservationV4Read(ctx, ReservationV4ReadParams{
+			ScopeId:  netip.MustParseAddr("192.168.10.0"),
+			ClientId: "00:15:5D:01:02:03",
+		})
+		suite.NoError(err)
+		suite.Equal(expectedReservationV4, actualReservationV4)
+	})
+
+	suite.Run("should return an error if the reservation does not exist", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.8'} | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, nil)
+		_, err := c.ReservationV4Read(ctx, ReservationV4ReadParams{
+			ScopeId:   netip.MustParseAddr("192.168.10.0"),
+			IPAddress: netip.MustParseAddr("192.168.10.8"),
+		})
+		suite.EqualError(err, "windows.dhcp.ReservationV4Read: reservation not found")
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4ReadParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty parameters",
+				ReservationV4ReadParams{},
+				"windows.dhcp.ReservationV4Read: reservation parameter 'ScopeId' must be a valid IPv4 address",
+			},
+			{
+				"assert error without IP address and client ID",
+				ReservationV4ReadParams{ScopeId: netip.MustParseAddr("192.168.10.0")},
+				"windows.dhcp.ReservationV4Read: reservation parameter 'IPAddress' must be a valid IPv4 address or 'ClientId' must be set",
+			},
+			{
+				"assert error with IP address and client ID",
+				ReservationV4ReadParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7"), ClientId: "00-15-5d-01-02-03"},
+				"windows.dhcp.ReservationV4Read: reservation parameters 'IPAddress' and 'ClientId' are mutually exclusive",
+			},
+			{
+				"assert error with invalid client ID",
+				ReservationV4ReadParams{ScopeId: netip.MustParseAddr("192.168.10.0"), ClientId: "printer"},
+				"windows.dhcp.ReservationV4Read: reservation parameter 'ClientId' must be a MAC address: invalid MAC address 'printer'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+			}
+			_, err := c.ReservationV4Read(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ReservationV4List related methods.
+func (suite *DhcpServerUnitTestSuite) TestReservationV4List() {
+	suite.T().Parallel()
+
+	suite.Run("should return the reservations of the scope", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=@(Get-DhcpServerv4Reservation -ScopeId '192.168.10.0') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: "[" + reservationV4Json + "]"}, nil)
+		actualReservations, err := c.ReservationV4List(ctx, ReservationV4ListParams{ScopeId: netip.MustParseAddr("192.168.10.0")})
+		suite.NoError(err)
+		suite.Equal([]ReservationV4{expectedReservationV4}, actualReservations)
+	})
+
+	suite.Run("should return an error with an invalid scope ID", func() {
+		c := &Client{Connection: mockConnection.NewMockConnection(suite.T())}
+		_, err := c.ReservationV4List(context.Background(), ReservationV4ListParams{})
+		suite.EqualError(err, "windows.dhcp.ReservationV4List: reservation parameter 'ScopeId' must be a valid IPv4 address")
+	})
+}
+
+// Test ReservationV4Create related methods.
+func (suite *DhcpServerUnitTestSuite) TestReservationV4CreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4CreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert correct command with neccessary parameters",
+				ReservationV4CreateParams{
+					ScopeId:   netip.MustParseAddr("192.168.10.0"),
+					IPAddress: netip.MustParseAddr("192.168.10.7"),
+					ClientId:  "00-15-5d-01-02-03",
+				},
+				"Add-DhcpServerv4Reservation -PassThru -Confirm:$false -ScopeId '192.168.10.0' -IPAddress '192.168.10.7' -ClientId '00-15-5d-01-02-03' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with additional parameters",
+				ReservationV4CreateParams{
+					ScopeId:     netip.MustParseAddr("192.168.10.0"),
+					IPAddress:   netip.MustParseAddr("192.168.10.7"),
+					ClientId:    "00-15-5d-01-02-03",
+					Name:        "printer",
+					Description: "Test description",
+					Type:        "Both",
+				},
+				"Add-DhcpServerv4Reservation -PassThru -Confirm:$false -ScopeId '192.168.10.0' -IPAddress '192.168.10.7' -ClientId '00-15-5d-01-02-03' -Name 'printer' -Description 'Test description' -Type 'Both' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with escaped name and description",
+				ReservationV4CreateParams{
+					ScopeId:     netip.MustParseAddr("192.168.10.0"),
+					IPAddress:   netip.MustParseAddr("192.168.10.7"),
+					ClientId:    "00-15-5d-01-02-03",
+					Name:        "bob's printer",
+					Description: "Bob's description",
+				},
+				"Add-DhcpServerv4Reservation -PassThru -Confirm:$false -ScopeId '192.168.10.0' -IPAddress '192.168.10.7' -ClientId '00-15-5d-01-02-03' -Name 'bob''s printer' -Description 'Bob''s description' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DhcpServerUnitTestSuite) TestReservationV4Create() {
+	suite.T().Parallel()
+
+	suite.Run("should create the reservation within the scope range", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DhcpServerv4Scope -ScopeId '192.168.10.0' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: scopeV4Json}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DhcpServerv4Reservation -PassThru -Confirm:$false -ScopeId '192.168.10.0' -IPAddress '192.168.10.7' -ClientId '00-15-5d-01-02-03' -Name 'printer' -Description 'Test description' -Type 'Both' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: reservationV4Json}, nil)
+		actualReservationV4, err := c.ReservationV4Create(ctx, ReservationV4CreateParams{
+			ScopeId:     netip.MustParseAddr("192.168.10.0"),
+			IPAddress:   netip.MustParseAddr("192.168.10.7"),
+			ClientId:    "00155D010203",
+			Name:        "printer",
+			Description: "Test description",
+			Type:        "Both",
+		})
+		suite.NoError(err)
+		suite.Equal(expectedReservationV4, actualReservationV4)
+	})
+
+	suite.Run("should return an error if the IP address is outside of the scope range", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DhcpServerv4Scope -ScopeId '192.168.10.0' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: scopeV4Json}, nil)
+		_, err := c.ReservationV4Create(ctx, ReservationV4CreateParams{
+			ScopeId:   netip.MustParseAddr("192.168.10.0"),
+			IPAddress: netip.MustParseAddr("192.168.10.20"),
+			ClientId:  "00-15-5d-01-02-03",
+		})
+		suite.EqualError(err, "windows.dhcp.ReservationV4Create: reservation parameter 'IPAddress' must be within the range 192.168.10.5-192.168.10.10 of the scope 192.168.10.0")
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4CreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty parameters",
+				ReservationV4CreateParams{},
+				"windows.dhcp.ReservationV4Create: reservation parameter 'ScopeId' and 'IPAddress' must be a valid IPv4 address",
+			},
+			{
+				"assert error with an IPv6 address",
+				ReservationV4CreateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("fe80::7"), ClientId: "00-15-5d-01-02-03"},
+				"windows.dhcp.ReservationV4Create: reservation parameter 'ScopeId' and 'IPAddress' must be a valid IPv4 address",
+			},
+			{
+				"assert error without client ID",
+				ReservationV4CreateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7")},
+				"windows.dhcp.ReservationV4Create: reservation parameter 'ClientId' must be a MAC address: invalid MAC address ''",
+			},
+			{
+				"assert error with wrong type",
+				ReservationV4CreateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7"), ClientId: "00-15-5d-01-02-03", Type: "dhcp"},
+				"windows.dhcp.ReservationV4Create: reservation parameter 'Type' must be one of the following values: 'Dhcp', 'Bootp', 'Both'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+			}
+			_, err := c.ReservationV4Create(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ReservationV4Update related methods.
+func (suite *DhcpServerUnitTestSuite) TestReservationV4UpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		name := "printer"
+		description := "Test description"
+		emptyDescription := ""
+		escapedName := "bob's printer"
+		escapedDescription := "Bob's description"
+
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4UpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert correct command with IP address",
+				ReservationV4UpdateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7")},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.7'} | Set-DhcpServerv4Reservation -PassThru | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with an empty description",
+				ReservationV4UpdateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7"), Description: &emptyDescription},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.7'} | Set-DhcpServerv4Reservation -PassThru -Description '' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with escaped name and description",
+				ReservationV4UpdateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7"), Name: &escapedName, Description: &escapedDescription},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.7'} | Set-DhcpServerv4Reservation -PassThru -Description 'Bob''s description' -Name 'bob''s printer' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with client ID and additional parameters",
+				ReservationV4UpdateParams{
+					ScopeId:     netip.MustParseAddr("192.168.10.0"),
+					ClientId:    "00-15-5d-01-02-03",
+					Name:        &name,
+					Description: &description,
+					Type:        "Dhcp",
+					NewClientId: "00-15-5d-01-02-04",
+				},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' -ClientId '00-15-5d-01-02-03' | Set-DhcpServerv4Reservation -PassThru -Description 'Test description' -Name 'printer' -Type 'Dhcp' -ClientId '00-15-5d-01-02-04' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DhcpServerUnitTestSuite) TestReservationV4Update() {
+	suite.T().Parallel()
+
+	suite.Run("should return the updated ReservationV4", func() {
+		name := "printer"
+		description := "Test description"
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.7'} | Set-DhcpServerv4Reservation -PassThru -Description 'Test description' -Name 'printer' -Type 'Both' -ClientId '00-15-5d-01-02-03' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: reservationV4Json}, nil)
+		actualReservationV4, err := c.ReservationV4Update(ctx, ReservationV4UpdateParams{
+			ScopeId:     netip.MustParseAddr("192.168.10.0"),
+			IPAddress:   netip.MustParseAddr("192.168.10.7"),
+			Name:        &name,
+			Description: &description,
+			Type:        "Both",
+			NewClientId: "00:15:5d:01:02:03",
+		})
+		suite.NoError(err)
+		suite.Equal(expectedReservationV4, actualReservationV4)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4UpdateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty parameters",
+				ReservationV4UpdateParams{},
+				"windows.dhcp.ReservationV4Update: reservation parameter 'ScopeId' must be a valid IPv4 address",
+			},
+			{
+				"assert error with invalid new client ID",
+				ReservationV4UpdateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7"), NewClientId: "00-15-5d"},
+				"windows.dhcp.ReservationV4Update: reservation parameter 'NewClientId' must be a MAC address: invalid MAC address '00-15-5d'",
+			},
+			{
+				"assert error with wrong type",
+				ReservationV4UpdateParams{ScopeId: netip.MustParseAddr("192.168.10.0"), ClientId: "00-15-5d-01-02-03", Type: "None"},
+				"windows.dhcp.ReservationV4Update: reservation parameter 'Type' must be one of the following values: 'Dhcp', 'Bootp', 'Both'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+			}
+			_, err := c.ReservationV4Update(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ReservationV4Delete related methods.
+func (suite *DhcpServerUnitTestSuite) TestReservationV4DeletePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4DeleteParams
+			expectedCmd     string
+		}{
+			{
+				"assert correct command with IP address",
+				ReservationV4DeleteParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("192.168.10.7")},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' | Where-Object {$_.IPAddress.IPAddressToString -eq '192.168.10.7'} | Remove-DhcpServerv4Reservation -Confirm:$false -PassThru | ConvertTo-Json -Compress",
+			},
+			{
+				"assert correct command with client ID",
+				ReservationV4DeleteParams{ScopeId: netip.MustParseAddr("192.168.10.0"), ClientId: "00-15-5d-01-02-03"},
+				"Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' -ClientId '00-15-5d-01-02-03' | Remove-DhcpServerv4Reservation -Confirm:$false -PassThru | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DhcpServerUnitTestSuite) TestReservationV4Delete() {
+	suite.T().Parallel()
+
+	suite.Run("should delete the reservation without error", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DhcpServerv4Reservation -ScopeId '192.168.10.0' -ClientId '00-15-5d-01-02-03' | Remove-DhcpServerv4Reservation -Confirm:$false -PassThru | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: reservationV4Json}, nil)
+		err := c.ReservationV4Delete(ctx, ReservationV4DeleteParams{
+			ScopeId:  netip.MustParseAddr("192.168.10.0"),
+			ClientId: "0015.5d01.0203",
+		})
+		suite.NoError(err)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ReservationV4DeleteParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty parameters",
+				ReservationV4DeleteParams{},
+				"windows.dhcp.ReservationV4Delete: reservation parameter 'ScopeId' must be a valid IPv4 address",
+			},
+			{
+				"assert error with an IPv6 address",
+				ReservationV4DeleteParams{ScopeId: netip.MustParseAddr("192.168.10.0"), IPAddress: netip.MustParseAddr("fe80::7")},
+				"windows.dhcp.ReservationV4Delete: reservation parameter 'IPAddress' must be a valid IPv4 address or 'ClientId' must be set",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+			}
+			err := c.ReservationV4Delete(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
@@ -55,7 +55,7 @@ func (c *Client) ScopeV4Read(ctx context.Context, params ScopeV4ReadParams) (Sco
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return s, winerror.Errorf(cmd, "windows.dhcp.ScopeV4Read: %s", err)
+		return s, winerror.Errorf(cmd, "windows.dhcp.ScopeV4Read: %w", err)
 	}
 
 	return s, nil
@@ -206,7 +206,7 @@ func (c *Client) ScopeV4Create(ctx context.Context, params ScopeV4CreateParams)
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return s, winerror.Errorf(cmd, "windows.dhcp.ScopeV4Create: %s", err)
+		return s, winerror.Errorf(cmd, "windows.dhcp.ScopeV4Create: %w", err)
 	}
 
 	return s, nil
@@ -361,7 +361,7 @@ func (c *Client) ScopeV4Update(ctx context.Context, params ScopeV4UpdateParams)
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return s, winerror.Errorf(cmd, "windows.dhcp.ScopeV4Update: %s", err)
+		return s, winerror.Errorf(cmd, "windows.dhcp.ScopeV4Update: %w", err)
 	}
 
 	return s, nil
@@ -392,7 +392,7 @@ func (c *Client) ScopeV4Delete(ctx context.Context, params ScopeV4DeleteParams)
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &s); err != nil {
-		return winerror.Errorf(cmd, "windows.dhcp.ScopeV4Delete: %s", err)
+		return winerror.Errorf(cmd, "windows.dhcp.ScopeV4Delete: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,309 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ClientSubnet represents a named group of IPv4 and IPv6 subnets.
+// Query resolution policies use client subnets to identify the clients of a query.
+type ClientSubnet struct {
+	Name        string
+	IPv4Subnets []netip.Prefix
+	IPv6Subnets []netip.Prefix
+}
+
+// clientSubnetObject contains the unmarshaled json of the powershell client subnet object.
+type clientSubnetObject struct {
+	Name       string   `json:"Name"`
+	IPV4Subnet []string `json:"IPV4Subnet"`
+	IPV6Subnet []string `json:"IPV6Subnet"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the clientSubnetObject to a ClientSubnet object.
+func (s *ClientSubnet) convertOutput(o clientSubnetObject) error {
+	s.Name = o.Name
+
+	for _, subnet := range o.IPV4Subnet {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return err
+		}
+		s.IPv4Subnets = append(s.IPv4Subnets, prefix)
+	}
+	for _, subnet := range o.IPV6Subnet {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return err
+		}
+		s.IPv6Subnets = append(s.IPv6Subnets, prefix)
+	}
+
+	return nil
+}
+
+// pwshPrefixList returns the PowerShell array of subnets, e.g. "@('10.0.0.0/8','fd00::/8')".
+func pwshPrefixList(prefixes []netip.Prefix) string {
+	prefixList := []string{}
+	for _, prefix := range prefixes {
+		prefixList = append(prefixList, fmt.Sprintf("'%s'", prefix.Masked().String()))
+	}
+	return fmt.Sprintf("@(%s)", strings.Join(prefixList, ","))
+}
+
+// validateClientSubnets returns an error if a subnet is not valid or is of the wrong address family.
+func validateClientSubnets(ipv4Subnets []netip.Prefix, ipv6Subnets []netip.Prefix) error {
+	if len(ipv4Subnets) == 0 && len(ipv6Subnets) == 0 {
+		return errors.New("client subnet parameters 'IPv4Subnets' or 'IPv6Subnets' must be set")
+	}
+	for _, prefix := range ipv4Subnets {
+		if !prefix.IsValid() || !prefix.Addr().Is4() {
+			return errors.New("client subnet parameter 'IPv4Subnets' must be a list of valid IPv4 subnets")
+		}
+	}
+	for _, prefix := range ipv6Subnets {
+		if !prefix.IsValid() || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+			return errors.New("client subnet parameter 'IPv6Subnets' must be a list of valid IPv6 subnets")
+		}
+	}
+	return nil
+}
+
+// clientSubnetParams returns the subnet parameters of a client subnet command.
+func clientSubnetParams(ipv4Subnets []netip.Prefix, ipv6Subnets []netip.Prefix) []string {
+	cmd := []string{}
+
+	if len(ipv4Subnets) > 0 {
+		cmd = append(cmd, fmt.Sprintf("-IPv4Subnet %s", pwshPrefixList(ipv4Subnets)))
+	}
+	if len(ipv6Subnets) > 0 {
+		cmd = append(cmd, fmt.Sprintf("-IPv6Subnet %s", pwshPrefixList(ipv6Subnets)))
+	}
+
+	return cmd
+}
+
+// ClientSubnetReadParams represents parameters for the ClientSubnetRead function.
+type ClientSubnetReadParams struct {
+	// Specifies the name of the client subnet.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to read a client subnet.
+func (params ClientSubnetReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsServerClientSubnet -Name '%s' | ConvertTo-Json -Compress", params.Name)
+}
+
+// ClientSubnetRead gets a client subnet by its name. It returns a ClientSubnet object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ClientSubnetRead(ctx context.Context, params ClientSubnetReadParams) (ClientSubnet, error) {
+	var s ClientSubnet
+	var o clientSubnetObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return s, errors.New("windows.dns.ClientSubnetRead: client subnet parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetRead: %w", err)
+	}
+
+	// Convert the output to a ClientSubnet object.
+	if err := s.convertOutput(o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetRead: %w", err)
+	}
+
+	return s, nil
+}
+
+// ClientSubnetList gets all client subnets of the DNS server. It returns a list of ClientSubnet objects.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ClientSubnetList(ctx context.Context) ([]ClientSubnet, error) {
+	var s []ClientSubnet
+	var o []clientSubnetObject
+
+	// Run command
+	cmd := "$s=@(Get-DnsServerClientSubnet) ;if($s.Count -ge 2){ConvertTo-Json $s -Compress}else{ConvertTo-Json @($s) -Compress}"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetList: %w", err)
+	}
+
+	// Convert the output to ClientSubnet objects.
+	for _, object := range o {
+		// A server without client subnets returns an empty object.
+		if object.Name == "" {
+			continue
+		}
+
+		var subnet ClientSubnet
+		if err := subnet.convertOutput(object); err != nil {
+			return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetList: %w", err)
+		}
+		s = append(s, subnet)
+	}
+
+	return s, nil
+}
+
+// ClientSubnetCreateParams represents parameters for the ClientSubnetCreate function.
+type ClientSubnetCreateParams struct {
+	// Specifies the name of the client subnet, e.g. "internal".
+	Name string
+
+	// Specifies the IPv4 subnets of the client subnet, e.g. 10.0.0.0/8.
+	IPv4Subnets []netip.Prefix
+
+	// Specifies the IPv6 subnets of the client subnet, e.g. fd00::/8.
+	IPv6Subnets []netip.Prefix
+}
+
+// pwshCommand returns the PowerShell command to create a client subnet.
+func (params ClientSubnetCreateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Add-DnsServerClientSubnet -Name '%s'", params.Name)}
+
+	// Add parameters
+	cmd = append(cmd, clientSubnetParams(params.IPv4Subnets, params.IPv6Subnets)...)
+
+	cmd = append(cmd, "-PassThru -ErrorAction Stop | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// ClientSubnetCreate creates a client subnet. It returns a ClientSubnet object.
+// At least one IPv4 or IPv6 subnet must be set.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ClientSubnetCreate(ctx context.Context, params ClientSubnetCreateParams) (ClientSubnet, error) {
+	var s ClientSubnet
+	var o clientSubnetObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return s, errors.New("windows.dns.ClientSubnetCreate: client subnet parameter 'Name' must be set")
+	}
+	if err := validateClientSubnets(params.IPv4Subnets, params.IPv6Subnets); err != nil {
+		return s, fmt.Errorf("windows.dns.ClientSubnetCreate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetCreate: %w", err)
+	}
+
+	// Convert the output to a ClientSubnet object.
+	if err := s.convertOutput(o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetCreate: %w", err)
+	}
+
+	return s, nil
+}
+
+// ClientSubnetUpdateParams represents parameters for the ClientSubnetUpdate function.
+// The subnets replace the current subnets of the client subnet.
+type ClientSubnetUpdateParams struct {
+	// Specifies the name of the client subnet.
+	Name string
+
+	// Specifies the IPv4 subnets of the client subnet.
+	// If not provided, the current IPv4 subnets are removed.
+	IPv4Subnets []netip.Prefix
+
+	// Specifies the IPv6 subnets of the client subnet.
+	// If not provided, the current IPv6 subnets are removed.
+	IPv6Subnets []netip.Prefix
+}
+
+// pwshCommand returns the PowerShell command to update a client subnet.
+// The subnets of an address family without new subnets are removed after the replacement,
+// so the client subnet contains at least one subnet during the whole update.
+func (params ClientSubnetUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerClientSubnet -Name '%s' -Action 'REPLACE'", params.Name)}
+
+	// Add parameters
+	cmd = append(cmd, clientSubnetParams(params.IPv4Subnets, params.IPv6Subnets)...)
+	cmd = append(cmd, "-ErrorAction Stop")
+
+	// Remove the subnets of the address families without new subnets.
+	if len(params.IPv4Subnets) == 0 || len(params.IPv6Subnets) == 0 {
+		property := "IPv4Subnet"
+		if len(params.IPv6Subnets) == 0 {
+			property = "IPv6Subnet"
+		}
+		cmd = append(cmd, fmt.Sprintf(
+			";$s=Get-DnsServerClientSubnet -Name '%s' ;if($s.%s){Set-DnsServerClientSubnet -Name '%s' -Action 'REMOVE' -%s $s.%s -ErrorAction Stop}",
+			params.Name, property, params.Name, property, property,
+		))
+	}
+
+	cmd = append(cmd, fmt.Sprintf(";Get-DnsServerClientSubnet -Name '%s' | ConvertTo-Json -Compress", params.Name))
+	return strings.Join(cmd, " ")
+}
+
+// ClientSubnetUpdate replaces the subnets of a client subnet. It returns a ClientSubnet object.
+// At least one IPv4 or IPv6 subnet must be set.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ClientSubnetUpdate(ctx context.Context, params ClientSubnetUpdateParams) (ClientSubnet, error) {
+	var s ClientSubnet
+	var o clientSubnetObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return s, errors.New("windows.dns.ClientSubnetUpdate: client subnet parameter 'Name' must be set")
+	}
+	if err := validateClientSubnets(params.IPv4Subnets, params.IPv6Subnets); err != nil {
+		return s, fmt.Errorf("windows.dns.ClientSubnetUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetUpdate: %w", err)
+	}
+
+	// Convert the output to a ClientSubnet object.
+	if err := s.convertOutput(o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ClientSubnetUpdate: %w", err)
+	}
+
+	return s, nil
+}
+
+// ClientSubnetDeleteParams represents parameters for the ClientSubnetDelete function.
+type ClientSubnetDeleteParams struct {
+	// Specifies the name of the client subnet.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to delete a client subnet.
+func (params ClientSubnetDeleteParams) pwshCommand() string {
+	return fmt.Sprintf("Remove-DnsServerClientSubnet -Name '%s' -Force", params.Name)
+}
+
+// ClientSubnetDelete deletes a client subnet.
+// A client subnet that is used by a policy cannot be deleted.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ClientSubnetDelete(ctx context.Context, params ClientSubnetDeleteParams) error {
+	var o clientSubnetObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return errors.New("windows.dns.ClientSubnetDelete: client subnet parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ClientSubnetDelete: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	clientSubnetJson     = `{"Name":"internal","IPV4Subnet":["10.0.0.0/8","192.168.0.0/16"],"IPV6Subnet":["fd00::/8"],"PSComputerName":null}`
+	clientSubnetListJson = `[{"Name":"internal","IPV4Subnet":["10.0.0.0/8","192.168.0.0/16"],"IPV6Subnet":["fd00::/8"],"PSComputerName":null},{"Name":"guests","IPV4Subnet":["172.16.0.0/12"],"IPV6Subnet":null,"PSComputerName":null}]`
+)
+
+var expectedClientSubnet = ClientSubnet{
+	Name:        "internal",
+	IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.168.0.0/16")},
+	IPv6Subnets: []netip.Prefix{netip.MustParsePrefix("fd00::/8")},
+}
+
+// Test ClientSubnetRead related methods.
+func (suite *DnsServerUnitTestSuite) TestClientSubnetRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the client subnet", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerClientSubnet -Name 'internal' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: clientSubnetJson}, nil)
+		actual, err := c.ClientSubnetRead(ctx, ClientSubnetReadParams{Name: "internal"})
+		suite.NoError(err)
+		suite.Equal(expectedClientSubnet, actual)
+	})
+
+	suite.Run("should return error if name is missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ClientSubnetRead(ctx, ClientSubnetReadParams{})
+		suite.EqualError(err, "windows.dns.ClientSubnetRead: client subnet parameter 'Name' must be set")
+	})
+}
+
+// Test ClientSubnetList related methods.
+func (suite *DnsServerUnitTestSuite) TestClientSubnetList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the client subnets", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$s=@(Get-DnsServerClientSubnet) ;if($s.Count -ge 2){ConvertTo-Json $s -Compress}else{ConvertTo-Json @($s) -Compress}").
+			Return(connection.CmdResult{StdOut: clientSubnetListJson}, nil)
+		actual, err := c.ClientSubnetList(ctx)
+		suite.NoError(err)
+		suite.Equal([]ClientSubnet{
+			expectedClientSubnet,
+			{Name: "guests", IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("172.16.0.0/12")}},
+		}, actual)
+	})
+
+	suite.Run("should return no client subnets", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$s=@(Get-DnsServerClientSubnet) ;if($s.Count -ge 2){ConvertTo-Json $s -Compress}else{ConvertTo-Json @($s) -Compress}").
+			Return(connection.CmdResult{StdOut: "[]"}, nil)
+		actual, err := c.ClientSubnetList(ctx)
+		suite.NoError(err)
+		suite.Empty(actual)
+	})
+}
+
+// Test ClientSubnetCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestClientSubnetCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ClientSubnetCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert with ipv4 subnets",
+				ClientSubnetCreateParams{Name: "internal", IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.168.1.5/24")}},
+				"Add-DnsServerClientSubnet -Name 'internal' -IPv4Subnet @('10.0.0.0/8','192.168.1.0/24') -PassThru -ErrorAction Stop | ConvertTo-Json -Compress",
+			},
+			{
+				"assert with ipv4 and ipv6 subnets",
+				ClientSubnetCreateParams{Name: "internal", IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, IPv6Subnets: []netip.Prefix{netip.MustParsePrefix("fd00::/8")}},
+				"Add-DnsServerClientSubnet -Name 'internal' -IPv4Subnet @('10.0.0.0/8') -IPv6Subnet @('fd00::/8') -PassThru -ErrorAction Stop | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestClientSubnetCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should create the client subnet", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerClientSubnet -Name 'internal' -IPv4Subnet @('10.0.0.0/8','192.168.0.0/16') -IPv6Subnet @('fd00::/8') -PassThru -ErrorAction Stop | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: clientSubnetJson}, nil)
+		actual, err := c.ClientSubnetCreate(ctx, ClientSubnetCreateParams{
+			Name:        "internal",
+			IPv4Subnets: expectedClientSubnet.IPv4Subnets,
+			IPv6Subnets: expectedClientSubnet.IPv6Subnets,
+		})
+		suite.NoError(err)
+		suite.Equal(expectedClientSubnet, actual)
+	})
+
+	suite.Run("should return error if parameters are invalid", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		tcs := []struct {
+			description     string
+			inputParameters ClientSubnetCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without subnets",
+				ClientSubnetCreateParams{Name: "internal"},
+				"windows.dns.ClientSubnetCreate: client subnet parameters 'IPv4Subnets' or 'IPv6Subnets' must be set",
+			},
+			{
+				"assert error with ipv6 subnet as ipv4 subnet",
+				ClientSubnetCreateParams{Name: "internal", IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("fd00::/8")}},
+				"windows.dns.ClientSubnetCreate: client subnet parameter 'IPv4Subnets' must be a list of valid IPv4 subnets",
+			},
+			{
+				"assert error with ipv4 subnet as ipv6 subnet",
+				ClientSubnetCreateParams{Name: "internal", IPv6Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}},
+				"windows.dns.ClientSubnetCreate: client subnet parameter 'IPv6Subnets' must be a list of valid IPv6 subnets",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := c.ClientSubnetCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ClientSubnetUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestClientSubnetUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ClientSubnetUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert with ipv4 and ipv6 subnets",
+				ClientSubnetUpdateParams{Name: "internal", IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, IPv6Subnets: []netip.Prefix{netip.MustParsePrefix("fd00::/8")}},
+				"Set-DnsServerClientSubnet -Name 'internal' -Action 'REPLACE' -IPv4Subnet @('10.0.0.0/8') -IPv6Subnet @('fd00::/8') -ErrorAction Stop ;Get-DnsServerClientSubnet -Name 'internal' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert with ipv4 subnets only",
+				ClientSubnetUpdateParams{Name: "internal", IPv4Subnets: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}},
+				"Set-DnsServerClientSubnet -Name 'internal' -Action 'REPLACE' -IPv4Subnet @('10.0.0.0/8') -ErrorAction Stop ;$s=Get-DnsServerClientSubnet -Name 'internal' ;if($s.IPv6Subnet){Set-DnsServerClientSubnet -Name 'internal' -Action 'REMOVE' -IPv6Subnet $s.IPv6Subnet -ErrorAction Stop} ;Get-DnsServerClientSubnet -Name 'internal' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert with ipv6 subnets only",
+				ClientSubnetUpdateParams{Name: "internal", IPv6Subnets: []netip.Prefix{netip.MustParsePrefix("fd00::/8")}},
+				"Set-DnsServerClientSubnet -Name 'internal' -Action 'REPLACE' -IPv6Subnet @('fd00::/8') -ErrorAction Stop ;$s=Get-DnsServerClientSubnet -Name 'internal' ;if($s.IPv4Subnet){Set-DnsServerClientSubnet -Name 'internal' -Action 'REMOVE' -IPv4Subnet $s.IPv4Subnet -ErrorAction Stop} ;Get-DnsServerClientSubnet -Name 'internal' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+// Test ClientSubnetDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestClientSubnetDelete() {
+	suite.T().Parallel()
+
+	suite.Run("should delete the client subnet", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Remove-DnsServerClientSubnet -Name 'internal' -Force").
+			Return(connection.CmdResult{}, nil)
+		err := c.ClientSubnetDelete(ctx, ClientSubnetDeleteParams{Name: "internal"})
+		suite.NoError(err)
+	})
+}
@@ -0,0 +1,302 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// maxForwarderTimeout is the maximum time a DNS server waits for a forwarder to resolve a query.
+// https://learn.microsoft.com/en-us/powershell/module/dnsserver/set-dnsserverforwarder?view=windowsserver2022-ps
+const maxForwarderTimeout time.Duration = 15 * time.Second
+
+// ConditionalForwarder represents a conditional forwarder zone.
+// Queries for the zone are forwarded to the master servers of the zone.
+type ConditionalForwarder struct {
+	DistinguishedName      string
+	ZoneName               string
+	MasterServers          []netip.Addr
+	IsDsIntegrated         bool
+	ReplicationScope       string
+	DirectoryPartitionName string
+	ForwarderTimeout       time.Duration
+}
+
+// conditionalForwarderObject contains the unmarshaled json of the powershell conditional forwarder zone object.
+type conditionalForwarderObject struct {
+	DistinguishedName      string                   `json:"DistinguishedName"`
+	ZoneName               string                   `json:"ZoneName"`
+	ZoneType               string                   `json:"ZoneType"`
+	MasterServers          parsing.CimIpAddressList `json:"MasterServers"`
+	IsDsIntegrated         bool                     `json:"IsDsIntegrated"`
+	ReplicationScope       string                   `json:"ReplicationScope"`
+	DirectoryPartitionName string                   `json:"DirectoryPartitionName"`
+	ForwarderTimeout       uint32                   `json:"ForwarderTimeout"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the conditionalForwarderObject to a ConditionalForwarder object.
+func (f *ConditionalForwarder) convertOutput(o conditionalForwarderObject) error {
+	if o.ZoneType != "Forwarder" {
+		return fmt.Errorf("zone '%s' is not a conditional forwarder, got zone type '%s'", o.ZoneName, o.ZoneType)
+	}
+
+	f.DistinguishedName = o.DistinguishedName
+	f.ZoneName = o.ZoneName
+	f.MasterServers = o.MasterServers
+	f.IsDsIntegrated = o.IsDsIntegrated
+	f.ReplicationScope = o.ReplicationScope
+	f.DirectoryPartitionName = o.DirectoryPartitionName
+	f.ForwarderTimeout = time.Duration(o.ForwarderTimeout) * time.Second
+
+	return nil
+}
+
+// conditionalForwarderParams returns the parameters for the settings of a conditional forwarder zone.
+func conditionalForwarderParams(masterServers []netip.Addr, replicationScope string, directoryPartitionName string, forwarderTimeout time.Duration) []string {
+	cmd := []string{}
+
+	if len(masterServers) > 0 {
+		cmd = append(cmd, fmt.Sprintf("-MasterServers %s", pwshIPAddressList(masterServers)))
+	}
+	if replicationScope != "" {
+		cmd = append(cmd, fmt.Sprintf("-ReplicationScope '%s'", replicationScope))
+	}
+	if directoryPartitionName != "" {
+		cmd = append(cmd, fmt.Sprintf("-DirectoryPartitionName '%s'", directoryPartitionName))
+	}
+	if forwarderTimeout != 0 {
+		cmd = append(cmd, fmt.Sprintf("-ForwarderTimeout %d", int64(forwarderTimeout.Round(time.Second).Seconds())))
+	}
+
+	return cmd
+}
+
+// validateConditionalForwarder returns an error if the settings of a conditional forwarder zone are not valid.
+func validateConditionalForwarder(masterServers []netip.Addr, replicationScope string, directoryPartitionName string, forwarderTimeout time.Duration) error {
+	for _, address := range masterServers {
+		if !address.IsValid() {
+			return errors.New("conditional forwarder parameter 'MasterServers' must be a list of valid IP addresses")
+		}
+	}
+	if directoryPartitionName != "" && replicationScope != "Custom" {
+		return errors.New("conditional forwarder parameter 'DirectoryPartitionName' can only be set with the replication scope 'Custom'")
+	}
+	if forwarderTimeout < 0 || forwarderTimeout > maxForwarderTimeout {
+		return fmt.Errorf("conditional forwarder parameter 'ForwarderTimeout' must not be negative or exceed %s, got %s", maxForwarderTimeout, forwarderTimeout)
+	}
+	return nil
+}
+
+// ConditionalForwarderReadParams represents parameters for the ConditionalForwarderRead function.
+type ConditionalForwarderReadParams struct {
+	// Specifies the name of the conditional forwarder zone.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to read a conditional forwarder zone.
+func (params ConditionalForwarderReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Name)
+}
+
+// ConditionalForwarderRead gets a conditional forwarder zone by its name. It returns a ConditionalForwarder object.
+// It returns an error if the zone exists but is not a conditional forwarder.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ConditionalForwarderRead(ctx context.Context, params ConditionalForwarderReadParams) (ConditionalForwarder, error) {
+	var f ConditionalForwarder
+	var o conditionalForwarderObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return f, errors.New("windows.dns.ConditionalForwarderRead: conditional forwarder parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderRead: %w", err)
+	}
+
+	// Convert the output to a ConditionalForwarder object.
+	if err := f.convertOutput(o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderRead: %w", err)
+	}
+
+	return f, nil
+}
+
+// ConditionalForwarderCreateParams represents parameters for the ConditionalForwarderCreate function.
+type ConditionalForwarderCreateParams struct {
+	// Specifies the name of the conditional forwarder zone, e.g. "partner.example".
+	Name string
+
+	// Specifies the DNS servers to which the queries of the zone are forwarded.
+	MasterServers []netip.Addr
+
+	// Specifies the replication scope of an Active Directory integrated conditional forwarder.
+	// Possible values are "Forest", "Domain", "Legacy" and "Custom".
+	// If not provided, the conditional forwarder is only stored on this server.
+	ReplicationScope string
+
+	// Specifies the directory partition of a conditional forwarder with the replication scope "Custom".
+	DirectoryPartitionName string
+
+	// Specifies the time the server waits for a master server to resolve a query.
+	// The timeout is rounded to seconds and must not exceed 15 seconds.
+	// If not provided, the default is 5 seconds.
+	ForwarderTimeout time.Duration
+}
+
+// pwshCommand returns the PowerShell command to create a conditional forwarder zone.
+func (params ConditionalForwarderCreateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Add-DnsServerConditionalForwarderZone -Name '%s'", params.Name)}
+
+	// Add parameters
+	cmd = append(cmd, conditionalForwarderParams(params.MasterServers, params.ReplicationScope, params.DirectoryPartitionName, params.ForwarderTimeout)...)
+
+	cmd = append(cmd, fmt.Sprintf("-ErrorAction Stop ;Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Name))
+	return strings.Join(cmd, " ")
+}
+
+// ConditionalForwarderCreate creates a conditional forwarder zone. It returns a ConditionalForwarder object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ConditionalForwarderCreate(ctx context.Context, params ConditionalForwarderCreateParams) (ConditionalForwarder, error) {
+	var f ConditionalForwarder
+	var o conditionalForwarderObject
+
+	// Assert needed parameters
+	if params.Name == "" || len(params.MasterServers) == 0 {
+		return f, errors.New("windows.dns.ConditionalForwarderCreate: conditional forwarder parameters 'Name' and 'MasterServers' must be set")
+	}
+
+	if err := validateConditionalForwarder(params.MasterServers, params.ReplicationScope, params.DirectoryPartitionName, params.ForwarderTimeout); err != nil {
+		return f, fmt.Errorf("windows.dns.ConditionalForwarderCreate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle zone already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderCreate: the specified zone already exists")
+		}
+
+		return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderCreate: %w", err)
+	}
+
+	// Convert the output to a ConditionalForwarder object.
+	if err := f.convertOutput(o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderCreate: %w", err)
+	}
+
+	return f, nil
+}
+
+// ConditionalForwarderUpdateParams represents parameters for the ConditionalForwarderUpdate function.
+// Settings that are not set keep their current value.
+type ConditionalForwarderUpdateParams struct {
+	// Specifies the name of the conditional forwarder zone.
+	Name string
+
+	// Specifies the DNS servers to which the queries of the zone are forwarded.
+	// The master servers replace the current master servers.
+	MasterServers []netip.Addr
+
+	// Specifies the replication scope of an Active Directory integrated conditional forwarder.
+	// Possible values are "Forest", "Domain", "Legacy" and "Custom".
+	ReplicationScope string
+
+	// Specifies the directory partition of a conditional forwarder with the replication scope "Custom".
+	DirectoryPartitionName string
+
+	// Specifies the time the server waits for a master server to resolve a query.
+	// The timeout is rounded to seconds and must not exceed 15 seconds.
+	ForwarderTimeout time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update a conditional forwarder zone.
+func (params ConditionalForwarderUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerConditionalForwarderZone -Name '%s'", params.Name)}
+
+	// Add parameters
+	cmd = append(cmd, conditionalForwarderParams(params.MasterServers, params.ReplicationScope, params.DirectoryPartitionName, params.ForwarderTimeout)...)
+
+	cmd = append(cmd, fmt.Sprintf("-ErrorAction Stop ;Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Name))
+	return strings.Join(cmd, " ")
+}
+
+// ConditionalForwarderUpdate updates the settings of a conditional forwarder zone. It returns a ConditionalForwarder object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ConditionalForwarderUpdate(ctx context.Context, params ConditionalForwarderUpdateParams) (ConditionalForwarder, error) {
+	var f ConditionalForwarder
+	var o conditionalForwarderObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return f, errors.New("windows.dns.ConditionalForwarderUpdate: conditional forwarder parameter 'Name' must be set")
+	}
+
+	if err := validateConditionalForwarder(params.MasterServers, params.ReplicationScope, params.DirectoryPartitionName, params.ForwarderTimeout); err != nil {
+		return f, fmt.Errorf("windows.dns.ConditionalForwarderUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderUpdate: %w", err)
+	}
+
+	// Convert the output to a ConditionalForwarder object.
+	if err := f.convertOutput(o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ConditionalForwarderUpdate: %w", err)
+	}
+
+	return f, nil
+}
+
+// ConditionalForwarderDeleteParams represents parameters for the ConditionalForwarderDelete function.
+type ConditionalForwarderDeleteParams struct {
+	// Specifies the name of the conditional forwarder zone.
+	Name string
+}
+
+// notForwarderError is the error that the delete command throws if the zone is not a conditional forwarder zone.
+const notForwarderError string = "the zone is not a conditional forwarder"
+
+// pwshCommand returns the PowerShell command to delete a conditional forwarder zone.
+// The zone type is checked first, so no other zone is deleted by accident.
+func (params ConditionalForwarderDeleteParams) pwshCommand() string {
+	return fmt.Sprintf(`$z=Get-DnsServerZone -Name '%s' -ErrorAction Stop ;if($z.ZoneType -ne 'Forwarder'){throw "%s"} ;$z | Remove-DnsServerZone -Force`, params.Name, notForwarderError)
+}
+
+// ConditionalForwarderDelete deletes a conditional forwarder zone.
+// It returns an error if a zone of another type has the same name, the zone is not deleted in this case.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ConditionalForwarderDelete(ctx context.Context, params ConditionalForwarderDeleteParams) error {
+	var o conditionalForwarderObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return errors.New("windows.dns.ConditionalForwarderDelete: conditional forwarder parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle the error of a zone that is not a conditional forwarder zone.
+		if winerror.FullyQualifiedErrorId(err) == notForwarderError {
+			return winerror.Errorf(cmd, "windows.dns.ConditionalForwarderDelete: zone '%s' is not a conditional forwarder", params.Name)
+		}
+
+		return winerror.Errorf(cmd, "windows.dns.ConditionalForwarderDelete: %w", err)
+	}
+
+	return nil
+}
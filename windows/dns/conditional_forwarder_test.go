@@ -0,0 +1,197 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	conditionalForwarderJson = `{"DistinguishedName":"DC=partner.example,cn=MicrosoftDNS,DC=ForestDnsZones,DC=test,DC=local","ZoneName":"partner.example","ZoneType":"Forwarder","MasterServers":[{"Address":17082560,"AddressFamily":2,"IPAddressToString":"192.168.4.1"},{"Address":33859776,"AddressFamily":2,"IPAddressToString":"192.168.4.2"}],"IsDsIntegrated":true,"ReplicationScope":"Forest","DirectoryPartitionName":"ForestDnsZones.test.local","ForwarderTimeout":5}`
+)
+
+var expectedConditionalForwarder = ConditionalForwarder{
+	DistinguishedName:      "DC=partner.example,cn=MicrosoftDNS,DC=ForestDnsZones,DC=test,DC=local",
+	ZoneName:               "partner.example",
+	MasterServers:          []netip.Addr{netip.MustParseAddr("192.168.4.1"), netip.MustParseAddr("192.168.4.2")},
+	IsDsIntegrated:         true,
+	ReplicationScope:       "Forest",
+	DirectoryPartitionName: "ForestDnsZones.test.local",
+	ForwarderTimeout:       5 * time.Second,
+}
+
+// Test ConditionalForwarderRead related methods.
+func (suite *DnsServerUnitTestSuite) TestConditionalForwarderRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct conditional forwarder", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone -Name 'partner.example' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: conditionalForwarderJson}, nil)
+		actual, err := c.ConditionalForwarderRead(ctx, ConditionalForwarderReadParams{Name: "partner.example"})
+		suite.NoError(err)
+		suite.Equal(expectedConditionalForwarder, actual)
+	})
+
+	suite.Run("should return an error if the zone is not a conditional forwarder", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zone}, nil)
+		_, err := c.ConditionalForwarderRead(ctx, ConditionalForwarderReadParams{Name: "test.local"})
+		suite.EqualError(err, "windows.dns.ConditionalForwarderRead: zone 'test.local' is not a conditional forwarder, got zone type 'Primary'")
+	})
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone -Name 'partner.example' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.ConditionalForwarderRead(ctx, ConditionalForwarderReadParams{Name: "partner.example"})
+		suite.EqualError(err, "windows.dns.ConditionalForwarderRead: test-error")
+	})
+}
+
+// Test ConditionalForwarderCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestConditionalForwarderCreatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ConditionalForwarderCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with master servers",
+				ConditionalForwarderCreateParams{Name: "partner.example", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.4.1")}},
+				"Add-DnsServerConditionalForwarderZone -Name 'partner.example' -MasterServers @('192.168.4.1') -ErrorAction Stop ;Get-DnsServerZone -Name 'partner.example' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with replication scope and timeout",
+				ConditionalForwarderCreateParams{
+					Name:             "partner.example",
+					MasterServers:    []netip.Addr{netip.MustParseAddr("192.168.4.1"), netip.MustParseAddr("fd00::1")},
+					ReplicationScope: "Forest",
+					ForwarderTimeout: 7 * time.Second,
+				},
+				"Add-DnsServerConditionalForwarderZone -Name 'partner.example' -MasterServers @('192.168.4.1','fd00::1') -ReplicationScope 'Forest' -ForwarderTimeout 7 -ErrorAction Stop ;Get-DnsServerZone -Name 'partner.example' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestConditionalForwarderCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ConditionalForwarderCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty parameters",
+				ConditionalForwarderCreateParams{},
+				"windows.dns.ConditionalForwarderCreate: conditional forwarder parameters 'Name' and 'MasterServers' must be set",
+			},
+			{
+				"assert error with an invalid master server",
+				ConditionalForwarderCreateParams{Name: "partner.example", MasterServers: []netip.Addr{{}}},
+				"windows.dns.ConditionalForwarderCreate: conditional forwarder parameter 'MasterServers' must be a list of valid IP addresses",
+			},
+			{
+				"assert error with a directory partition without custom replication scope",
+				ConditionalForwarderCreateParams{Name: "partner.example", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.4.1")}, DirectoryPartitionName: "Partition"},
+				"windows.dns.ConditionalForwarderCreate: conditional forwarder parameter 'DirectoryPartitionName' can only be set with the replication scope 'Custom'",
+			},
+			{
+				"assert error with a too long timeout",
+				ConditionalForwarderCreateParams{Name: "partner.example", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.4.1")}, ForwarderTimeout: time.Minute},
+				"windows.dns.ConditionalForwarderCreate: conditional forwarder parameter 'ForwarderTimeout' must not be negative or exceed 15s, got 1m0s",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.ConditionalForwarderCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ConditionalForwarderUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestConditionalForwarderUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		actualCmd := ConditionalForwarderUpdateParams{Name: "partner.example", ForwarderTimeout: 2 * time.Second}.pwshCommand()
+		suite.Equal("Set-DnsServerConditionalForwarderZone -Name 'partner.example' -ForwarderTimeout 2 -ErrorAction Stop ;Get-DnsServerZone -Name 'partner.example' | ConvertTo-Json -Compress", actualCmd)
+	})
+}
+
+// Test ConditionalForwarderDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestConditionalForwarderDeletePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		actualCmd := ConditionalForwarderDeleteParams{Name: "partner.example"}.pwshCommand()
+		suite.Equal(`$z=Get-DnsServerZone -Name 'partner.example' -ErrorAction Stop ;if($z.ZoneType -ne 'Forwarder'){throw "the zone is not a conditional forwarder"} ;$z | Remove-DnsServerZone -Force`, actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestConditionalForwarderDelete() {
+	suite.T().Parallel()
+
+	suite.Run("should return an error if the zone is not a conditional forwarder", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, `$z=Get-DnsServerZone -Name 'test.local' -ErrorAction Stop ;if($z.ZoneType -ne 'Forwarder'){throw "the zone is not a conditional forwarder"} ;$z | Remove-DnsServerZone -Force`).
+			Return(connection.CmdResult{StdErr: "the zone is not a conditional forwarder\n    + CategoryInfo          : OperationStopped: (the zone is not a conditional forwarder:String) [], RuntimeException\n    + FullyQualifiedErrorId : the zone is not a conditional forwarder"}, nil)
+		err := c.ConditionalForwarderDelete(ctx, ConditionalForwarderDeleteParams{Name: "test.local"})
+		suite.EqualError(err, "windows.dns.ConditionalForwarderDelete: zone 'test.local' is not a conditional forwarder")
+	})
+}
@@ -9,15 +9,13 @@ import (
 	"encoding/json"
 	"time"
 
-	"errors"
-
 	"github.com/d-strobel/gowindows/connection"
 	"github.com/d-strobel/gowindows/parsing"
 )
 
 // dns is a type constraint for the run function, ensuring it works with specific types.
 type dns interface {
-	Zone | []Zone | recordObject | []recordObject
+	zoneObject | []zoneObject | recordObject | []recordObject | []delegationObject | soaObject | conditionalForwarderObject | serverForwarderObject | zoneAgingObject | serverScavengingObject | signingKeyObject | []signingKeyObject | []trustAnchorObject | zoneScopeObject | []zoneScopeObject | clientSubnetObject | []clientSubnetObject | policyObject | []policyObject | serverSettingObject | serverRecursionObject | serverEDnsObject | serverCacheObject | serverStatisticsObject | []resolveAnswerObject
 }
 
 // Default Windows DNS TTL.
@@ -31,13 +29,143 @@ type recordObject struct {
 	RecordData        recordRecordData        `json:"RecordData"`
 	RecordType        string                  `json:"RecordType"`
 	Timestamp         parsing.DotnetTime      `json:"Timestamp"`
-	Type              int8                    `json:"Type"`
+	Type              uint16                  `json:"Type"`
 	TimeToLive        parsing.CimTimeDuration `json:"TimeToLive"`
 }
 type recordRecordData struct {
 	CimInstanceProperties parsing.CimClassKeyVal `json:"CimInstanceProperties"`
 }
 
+// API defines the DNS server functions of the Client.
+// Use this interface to replace the Client with a mock in tests.
+type API interface {
+	ZoneRead(ctx context.Context, params ZoneReadParams) (Zone, error)
+	ZoneList(ctx context.Context) ([]Zone, error)
+	ZoneCreate(ctx context.Context, params ZoneCreateParams) (Zone, error)
+	ZoneUpdate(ctx context.Context, params ZoneUpdateParams) (Zone, error)
+	ZoneDelete(ctx context.Context, params ZoneDeleteParams) error
+
+	RecordARead(ctx context.Context, params RecordAReadParams) (RecordA, error)
+	RecordACreate(ctx context.Context, params RecordACreateParams) (RecordA, error)
+	RecordAUpdate(ctx context.Context, params RecordAUpdateParams) (RecordA, error)
+	RecordADelete(ctx context.Context, params RecordADeleteParams) error
+
+	RecordAAAARead(ctx context.Context, params RecordAAAAReadParams) (RecordAAAA, error)
+	RecordAAAACreate(ctx context.Context, params RecordAAAACreateParams) (RecordAAAA, error)
+	RecordAAAAUpdate(ctx context.Context, params RecordAAAAUpdateParams) (RecordAAAA, error)
+	RecordAAAADelete(ctx context.Context, params RecordAAAADeleteParams) error
+
+	RecordCNameRead(ctx context.Context, params RecordCNameReadParams) (RecordCName, error)
+	RecordCNameCreate(ctx context.Context, params RecordCNameCreateParams) (RecordCName, error)
+	RecordCNameUpdate(ctx context.Context, params RecordCNameUpdateParams) (RecordCName, error)
+	RecordCNameDelete(ctx context.Context, params RecordCNameDeleteParams) error
+
+	RecordPTRRead(ctx context.Context, params RecordPTRReadParams) (RecordPTR, error)
+	RecordPTRCreate(ctx context.Context, params RecordPTRCreateParams) (RecordPTR, error)
+	RecordPTRUpdate(ctx context.Context, params RecordPTRUpdateParams) (RecordPTR, error)
+	RecordPTRDelete(ctx context.Context, params RecordPTRDeleteParams) error
+
+	RecordMXRead(ctx context.Context, params RecordMXReadParams) (RecordMX, error)
+	RecordMXCreate(ctx context.Context, params RecordMXCreateParams) (RecordMX, error)
+	RecordMXUpdate(ctx context.Context, params RecordMXUpdateParams) (RecordMX, error)
+	RecordMXDelete(ctx context.Context, params RecordMXDeleteParams) error
+
+	RecordSRVRead(ctx context.Context, params RecordSRVReadParams) (RecordSRV, error)
+	RecordSRVCreate(ctx context.Context, params RecordSRVCreateParams) (RecordSRV, error)
+	RecordSRVUpdate(ctx context.Context, params RecordSRVUpdateParams) (RecordSRV, error)
+	RecordSRVDelete(ctx context.Context, params RecordSRVDeleteParams) error
+
+	RecordTXTRead(ctx context.Context, params RecordTXTReadParams) (RecordTXT, error)
+	RecordTXTCreate(ctx context.Context, params RecordTXTCreateParams) (RecordTXT, error)
+	RecordTXTUpdate(ctx context.Context, params RecordTXTUpdateParams) (RecordTXT, error)
+	RecordTXTDelete(ctx context.Context, params RecordTXTDeleteParams) error
+
+	RecordNSRead(ctx context.Context, params RecordNSReadParams) (RecordNS, error)
+	RecordNSCreate(ctx context.Context, params RecordNSCreateParams) (RecordNS, error)
+	RecordNSUpdate(ctx context.Context, params RecordNSUpdateParams) (RecordNS, error)
+	RecordNSDelete(ctx context.Context, params RecordNSDeleteParams) error
+
+	ZoneDelegationRead(ctx context.Context, params ZoneDelegationReadParams) (ZoneDelegation, error)
+	ZoneDelegationCreate(ctx context.Context, params ZoneDelegationCreateParams) (ZoneDelegation, error)
+	ZoneDelegationUpdate(ctx context.Context, params ZoneDelegationUpdateParams) (ZoneDelegation, error)
+	ZoneDelegationDelete(ctx context.Context, params ZoneDelegationDeleteParams) error
+
+	RecordSOARead(ctx context.Context, params RecordSOAReadParams) (RecordSOA, error)
+	RecordSOAUpdate(ctx context.Context, params RecordSOAUpdateParams) (RecordSOA, error)
+
+	RecordList(ctx context.Context, params RecordListParams) (Records, error)
+	RecordListFunc(ctx context.Context, params RecordListParams, fn func(Records) error) error
+	ZoneExport(ctx context.Context, params ZoneExportParams) (string, error)
+	ZoneImport(ctx context.Context, params ZoneImportParams) (Records, error)
+	RecordImport(ctx context.Context, params RecordImportParams) ([]RecordImportResult, error)
+
+	ConditionalForwarderRead(ctx context.Context, params ConditionalForwarderReadParams) (ConditionalForwarder, error)
+	ConditionalForwarderCreate(ctx context.Context, params ConditionalForwarderCreateParams) (ConditionalForwarder, error)
+	ConditionalForwarderUpdate(ctx context.Context, params ConditionalForwarderUpdateParams) (ConditionalForwarder, error)
+	ConditionalForwarderDelete(ctx context.Context, params ConditionalForwarderDeleteParams) error
+
+	ServerForwarderRead(ctx context.Context) (ServerForwarder, error)
+	ServerForwarderUpdate(ctx context.Context, params ServerForwarderUpdateParams) (ServerForwarder, error)
+
+	ZoneAgingRead(ctx context.Context, params ZoneAgingReadParams) (ZoneAging, error)
+	ZoneAgingUpdate(ctx context.Context, params ZoneAgingUpdateParams) (ZoneAging, error)
+
+	ServerScavengingRead(ctx context.Context) (ServerScavenging, error)
+	ServerScavengingUpdate(ctx context.Context, params ServerScavengingUpdateParams) (ServerScavenging, error)
+	ServerScavengingStart(ctx context.Context) error
+
+	ServerSettingRead(ctx context.Context) (ServerSetting, error)
+	ServerSettingUpdate(ctx context.Context, params ServerSettingUpdateParams) (ServerSetting, error)
+
+	ServerRecursionRead(ctx context.Context) (ServerRecursion, error)
+	ServerRecursionUpdate(ctx context.Context, params ServerRecursionUpdateParams) (ServerRecursion, error)
+
+	ServerEDnsRead(ctx context.Context) (ServerEDns, error)
+	ServerEDnsUpdate(ctx context.Context, params ServerEDnsUpdateParams) (ServerEDns, error)
+
+	ServerCacheRead(ctx context.Context) (ServerCache, error)
+	ServerCacheUpdate(ctx context.Context, params ServerCacheUpdateParams) (ServerCache, error)
+	ServerCacheRecordList(ctx context.Context) (Records, error)
+	ServerCacheClear(ctx context.Context) error
+
+	ServerStatisticsRead(ctx context.Context) (ServerStatistics, error)
+	ServerStatisticsClear(ctx context.Context) error
+
+	ZoneSign(ctx context.Context, params ZoneSignParams) (Zone, error)
+	ZoneUnsign(ctx context.Context, params ZoneUnsignParams) (Zone, error)
+	ZoneDsRecordList(ctx context.Context, params ZoneDsRecordListParams) ([]DsRecord, error)
+
+	SigningKeyRead(ctx context.Context, params SigningKeyReadParams) (SigningKey, error)
+	SigningKeyList(ctx context.Context, params SigningKeyListParams) ([]SigningKey, error)
+	SigningKeyCreate(ctx context.Context, params SigningKeyCreateParams) (SigningKey, error)
+	SigningKeyUpdate(ctx context.Context, params SigningKeyUpdateParams) (SigningKey, error)
+	SigningKeyDelete(ctx context.Context, params SigningKeyDeleteParams) error
+
+	TrustAnchorList(ctx context.Context, params TrustAnchorListParams) ([]TrustAnchor, error)
+
+	ClientSubnetRead(ctx context.Context, params ClientSubnetReadParams) (ClientSubnet, error)
+	ClientSubnetList(ctx context.Context) ([]ClientSubnet, error)
+	ClientSubnetCreate(ctx context.Context, params ClientSubnetCreateParams) (ClientSubnet, error)
+	ClientSubnetUpdate(ctx context.Context, params ClientSubnetUpdateParams) (ClientSubnet, error)
+	ClientSubnetDelete(ctx context.Context, params ClientSubnetDeleteParams) error
+
+	ZoneScopeRead(ctx context.Context, params ZoneScopeReadParams) (ZoneScope, error)
+	ZoneScopeList(ctx context.Context, params ZoneScopeListParams) ([]ZoneScope, error)
+	ZoneScopeCreate(ctx context.Context, params ZoneScopeCreateParams) (ZoneScope, error)
+	ZoneScopeDelete(ctx context.Context, params ZoneScopeDeleteParams) error
+
+	QueryResolutionPolicyRead(ctx context.Context, params QueryResolutionPolicyReadParams) (QueryResolutionPolicy, error)
+	QueryResolutionPolicyList(ctx context.Context, params QueryResolutionPolicyListParams) ([]QueryResolutionPolicy, error)
+	QueryResolutionPolicyCreate(ctx context.Context, params QueryResolutionPolicyCreateParams) (QueryResolutionPolicy, error)
+	QueryResolutionPolicyUpdate(ctx context.Context, params QueryResolutionPolicyUpdateParams) (QueryResolutionPolicy, error)
+	QueryResolutionPolicyDelete(ctx context.Context, params QueryResolutionPolicyDeleteParams) error
+
+	Resolve(ctx context.Context, params ResolveParams) (Resolution, error)
+}
+
+// Ensure that the Client implements the API interface.
+var _ API = (*Client)(nil)
+
 // Client represents a client for handling DNS server functions.
 type Client struct {
 	// Connection represents a connection.Connection object.
@@ -74,7 +202,7 @@ func run[T dns](ctx context.Context, c *Client, cmd string, d *T) error {
 			return err
 		}
 
-		return errors.New(stderr)
+		return parsing.NewPwshError(stderr)
 	}
 
 	if result.StdOut == "" {
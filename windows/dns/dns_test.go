@@ -45,9 +45,11 @@ func (suite *DnsServerUnitTestSuite) TestDnsServerRun() {
 		mockConn.EXPECT().
 			RunWithPowershell(ctx, cmd).
 			Return(connection.CmdResult{StdOut: zone, StdErr: ""}, nil)
-		var z Zone
-		err := run(ctx, c, cmd, &z)
+		var o zoneObject
+		err := run(ctx, c, cmd, &o)
 		suite.NoError(err)
+		var z Zone
+		z.convertOutput(o)
 		suite.Equal(expectedZone, z)
 	})
 }
@@ -0,0 +1,5266 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dns
+
+import (
+	context "context"
+
+	dns "github.com/d-strobel/gowindows/windows/dns"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAPI is an autogenerated mock type for the API type
+type MockAPI struct {
+	mock.Mock
+}
+
+type MockAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPI) EXPECT() *MockAPI_Expecter {
+	return &MockAPI_Expecter{mock: &_m.Mock}
+}
+
+// ClientSubnetCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ClientSubnetCreate(ctx context.Context, params dns.ClientSubnetCreateParams) (dns.ClientSubnet, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientSubnetCreate")
+	}
+
+	var r0 dns.ClientSubnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetCreateParams) (dns.ClientSubnet, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetCreateParams) dns.ClientSubnet); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ClientSubnet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ClientSubnetCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ClientSubnetCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientSubnetCreate'
+type MockAPI_ClientSubnetCreate_Call struct {
+	*mock.Call
+}
+
+// ClientSubnetCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ClientSubnetCreateParams
+func (_e *MockAPI_Expecter) ClientSubnetCreate(ctx interface{}, params interface{}) *MockAPI_ClientSubnetCreate_Call {
+	return &MockAPI_ClientSubnetCreate_Call{Call: _e.mock.On("ClientSubnetCreate", ctx, params)}
+}
+
+func (_c *MockAPI_ClientSubnetCreate_Call) Run(run func(ctx context.Context, params dns.ClientSubnetCreateParams)) *MockAPI_ClientSubnetCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ClientSubnetCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetCreate_Call) Return(_a0 dns.ClientSubnet, _a1 error) *MockAPI_ClientSubnetCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetCreate_Call) RunAndReturn(run func(context.Context, dns.ClientSubnetCreateParams) (dns.ClientSubnet, error)) *MockAPI_ClientSubnetCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClientSubnetDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ClientSubnetDelete(ctx context.Context, params dns.ClientSubnetDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientSubnetDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ClientSubnetDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientSubnetDelete'
+type MockAPI_ClientSubnetDelete_Call struct {
+	*mock.Call
+}
+
+// ClientSubnetDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ClientSubnetDeleteParams
+func (_e *MockAPI_Expecter) ClientSubnetDelete(ctx interface{}, params interface{}) *MockAPI_ClientSubnetDelete_Call {
+	return &MockAPI_ClientSubnetDelete_Call{Call: _e.mock.On("ClientSubnetDelete", ctx, params)}
+}
+
+func (_c *MockAPI_ClientSubnetDelete_Call) Run(run func(ctx context.Context, params dns.ClientSubnetDeleteParams)) *MockAPI_ClientSubnetDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ClientSubnetDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetDelete_Call) Return(_a0 error) *MockAPI_ClientSubnetDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetDelete_Call) RunAndReturn(run func(context.Context, dns.ClientSubnetDeleteParams) error) *MockAPI_ClientSubnetDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClientSubnetList provides a mock function with given fields: ctx
+func (_m *MockAPI) ClientSubnetList(ctx context.Context) ([]dns.ClientSubnet, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientSubnetList")
+	}
+
+	var r0 []dns.ClientSubnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dns.ClientSubnet, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dns.ClientSubnet); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.ClientSubnet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ClientSubnetList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientSubnetList'
+type MockAPI_ClientSubnetList_Call struct {
+	*mock.Call
+}
+
+// ClientSubnetList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ClientSubnetList(ctx interface{}) *MockAPI_ClientSubnetList_Call {
+	return &MockAPI_ClientSubnetList_Call{Call: _e.mock.On("ClientSubnetList", ctx)}
+}
+
+func (_c *MockAPI_ClientSubnetList_Call) Run(run func(ctx context.Context)) *MockAPI_ClientSubnetList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetList_Call) Return(_a0 []dns.ClientSubnet, _a1 error) *MockAPI_ClientSubnetList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetList_Call) RunAndReturn(run func(context.Context) ([]dns.ClientSubnet, error)) *MockAPI_ClientSubnetList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClientSubnetRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ClientSubnetRead(ctx context.Context, params dns.ClientSubnetReadParams) (dns.ClientSubnet, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientSubnetRead")
+	}
+
+	var r0 dns.ClientSubnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetReadParams) (dns.ClientSubnet, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetReadParams) dns.ClientSubnet); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ClientSubnet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ClientSubnetReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ClientSubnetRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientSubnetRead'
+type MockAPI_ClientSubnetRead_Call struct {
+	*mock.Call
+}
+
+// ClientSubnetRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ClientSubnetReadParams
+func (_e *MockAPI_Expecter) ClientSubnetRead(ctx interface{}, params interface{}) *MockAPI_ClientSubnetRead_Call {
+	return &MockAPI_ClientSubnetRead_Call{Call: _e.mock.On("ClientSubnetRead", ctx, params)}
+}
+
+func (_c *MockAPI_ClientSubnetRead_Call) Run(run func(ctx context.Context, params dns.ClientSubnetReadParams)) *MockAPI_ClientSubnetRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ClientSubnetReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetRead_Call) Return(_a0 dns.ClientSubnet, _a1 error) *MockAPI_ClientSubnetRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetRead_Call) RunAndReturn(run func(context.Context, dns.ClientSubnetReadParams) (dns.ClientSubnet, error)) *MockAPI_ClientSubnetRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClientSubnetUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ClientSubnetUpdate(ctx context.Context, params dns.ClientSubnetUpdateParams) (dns.ClientSubnet, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClientSubnetUpdate")
+	}
+
+	var r0 dns.ClientSubnet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetUpdateParams) (dns.ClientSubnet, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ClientSubnetUpdateParams) dns.ClientSubnet); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ClientSubnet)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ClientSubnetUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ClientSubnetUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClientSubnetUpdate'
+type MockAPI_ClientSubnetUpdate_Call struct {
+	*mock.Call
+}
+
+// ClientSubnetUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ClientSubnetUpdateParams
+func (_e *MockAPI_Expecter) ClientSubnetUpdate(ctx interface{}, params interface{}) *MockAPI_ClientSubnetUpdate_Call {
+	return &MockAPI_ClientSubnetUpdate_Call{Call: _e.mock.On("ClientSubnetUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ClientSubnetUpdate_Call) Run(run func(ctx context.Context, params dns.ClientSubnetUpdateParams)) *MockAPI_ClientSubnetUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ClientSubnetUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetUpdate_Call) Return(_a0 dns.ClientSubnet, _a1 error) *MockAPI_ClientSubnetUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ClientSubnetUpdate_Call) RunAndReturn(run func(context.Context, dns.ClientSubnetUpdateParams) (dns.ClientSubnet, error)) *MockAPI_ClientSubnetUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConditionalForwarderCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ConditionalForwarderCreate(ctx context.Context, params dns.ConditionalForwarderCreateParams) (dns.ConditionalForwarder, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConditionalForwarderCreate")
+	}
+
+	var r0 dns.ConditionalForwarder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderCreateParams) (dns.ConditionalForwarder, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderCreateParams) dns.ConditionalForwarder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ConditionalForwarder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ConditionalForwarderCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ConditionalForwarderCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConditionalForwarderCreate'
+type MockAPI_ConditionalForwarderCreate_Call struct {
+	*mock.Call
+}
+
+// ConditionalForwarderCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ConditionalForwarderCreateParams
+func (_e *MockAPI_Expecter) ConditionalForwarderCreate(ctx interface{}, params interface{}) *MockAPI_ConditionalForwarderCreate_Call {
+	return &MockAPI_ConditionalForwarderCreate_Call{Call: _e.mock.On("ConditionalForwarderCreate", ctx, params)}
+}
+
+func (_c *MockAPI_ConditionalForwarderCreate_Call) Run(run func(ctx context.Context, params dns.ConditionalForwarderCreateParams)) *MockAPI_ConditionalForwarderCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ConditionalForwarderCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderCreate_Call) Return(_a0 dns.ConditionalForwarder, _a1 error) *MockAPI_ConditionalForwarderCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderCreate_Call) RunAndReturn(run func(context.Context, dns.ConditionalForwarderCreateParams) (dns.ConditionalForwarder, error)) *MockAPI_ConditionalForwarderCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConditionalForwarderDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ConditionalForwarderDelete(ctx context.Context, params dns.ConditionalForwarderDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConditionalForwarderDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ConditionalForwarderDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConditionalForwarderDelete'
+type MockAPI_ConditionalForwarderDelete_Call struct {
+	*mock.Call
+}
+
+// ConditionalForwarderDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ConditionalForwarderDeleteParams
+func (_e *MockAPI_Expecter) ConditionalForwarderDelete(ctx interface{}, params interface{}) *MockAPI_ConditionalForwarderDelete_Call {
+	return &MockAPI_ConditionalForwarderDelete_Call{Call: _e.mock.On("ConditionalForwarderDelete", ctx, params)}
+}
+
+func (_c *MockAPI_ConditionalForwarderDelete_Call) Run(run func(ctx context.Context, params dns.ConditionalForwarderDeleteParams)) *MockAPI_ConditionalForwarderDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ConditionalForwarderDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderDelete_Call) Return(_a0 error) *MockAPI_ConditionalForwarderDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderDelete_Call) RunAndReturn(run func(context.Context, dns.ConditionalForwarderDeleteParams) error) *MockAPI_ConditionalForwarderDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConditionalForwarderRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ConditionalForwarderRead(ctx context.Context, params dns.ConditionalForwarderReadParams) (dns.ConditionalForwarder, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConditionalForwarderRead")
+	}
+
+	var r0 dns.ConditionalForwarder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderReadParams) (dns.ConditionalForwarder, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderReadParams) dns.ConditionalForwarder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ConditionalForwarder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ConditionalForwarderReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ConditionalForwarderRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConditionalForwarderRead'
+type MockAPI_ConditionalForwarderRead_Call struct {
+	*mock.Call
+}
+
+// ConditionalForwarderRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ConditionalForwarderReadParams
+func (_e *MockAPI_Expecter) ConditionalForwarderRead(ctx interface{}, params interface{}) *MockAPI_ConditionalForwarderRead_Call {
+	return &MockAPI_ConditionalForwarderRead_Call{Call: _e.mock.On("ConditionalForwarderRead", ctx, params)}
+}
+
+func (_c *MockAPI_ConditionalForwarderRead_Call) Run(run func(ctx context.Context, params dns.ConditionalForwarderReadParams)) *MockAPI_ConditionalForwarderRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ConditionalForwarderReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderRead_Call) Return(_a0 dns.ConditionalForwarder, _a1 error) *MockAPI_ConditionalForwarderRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderRead_Call) RunAndReturn(run func(context.Context, dns.ConditionalForwarderReadParams) (dns.ConditionalForwarder, error)) *MockAPI_ConditionalForwarderRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConditionalForwarderUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ConditionalForwarderUpdate(ctx context.Context, params dns.ConditionalForwarderUpdateParams) (dns.ConditionalForwarder, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConditionalForwarderUpdate")
+	}
+
+	var r0 dns.ConditionalForwarder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderUpdateParams) (dns.ConditionalForwarder, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ConditionalForwarderUpdateParams) dns.ConditionalForwarder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ConditionalForwarder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ConditionalForwarderUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ConditionalForwarderUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConditionalForwarderUpdate'
+type MockAPI_ConditionalForwarderUpdate_Call struct {
+	*mock.Call
+}
+
+// ConditionalForwarderUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ConditionalForwarderUpdateParams
+func (_e *MockAPI_Expecter) ConditionalForwarderUpdate(ctx interface{}, params interface{}) *MockAPI_ConditionalForwarderUpdate_Call {
+	return &MockAPI_ConditionalForwarderUpdate_Call{Call: _e.mock.On("ConditionalForwarderUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ConditionalForwarderUpdate_Call) Run(run func(ctx context.Context, params dns.ConditionalForwarderUpdateParams)) *MockAPI_ConditionalForwarderUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ConditionalForwarderUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderUpdate_Call) Return(_a0 dns.ConditionalForwarder, _a1 error) *MockAPI_ConditionalForwarderUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ConditionalForwarderUpdate_Call) RunAndReturn(run func(context.Context, dns.ConditionalForwarderUpdateParams) (dns.ConditionalForwarder, error)) *MockAPI_ConditionalForwarderUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryResolutionPolicyCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) QueryResolutionPolicyCreate(ctx context.Context, params dns.QueryResolutionPolicyCreateParams) (dns.QueryResolutionPolicy, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryResolutionPolicyCreate")
+	}
+
+	var r0 dns.QueryResolutionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyCreateParams) (dns.QueryResolutionPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyCreateParams) dns.QueryResolutionPolicy); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.QueryResolutionPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.QueryResolutionPolicyCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_QueryResolutionPolicyCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryResolutionPolicyCreate'
+type MockAPI_QueryResolutionPolicyCreate_Call struct {
+	*mock.Call
+}
+
+// QueryResolutionPolicyCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.QueryResolutionPolicyCreateParams
+func (_e *MockAPI_Expecter) QueryResolutionPolicyCreate(ctx interface{}, params interface{}) *MockAPI_QueryResolutionPolicyCreate_Call {
+	return &MockAPI_QueryResolutionPolicyCreate_Call{Call: _e.mock.On("QueryResolutionPolicyCreate", ctx, params)}
+}
+
+func (_c *MockAPI_QueryResolutionPolicyCreate_Call) Run(run func(ctx context.Context, params dns.QueryResolutionPolicyCreateParams)) *MockAPI_QueryResolutionPolicyCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.QueryResolutionPolicyCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyCreate_Call) Return(_a0 dns.QueryResolutionPolicy, _a1 error) *MockAPI_QueryResolutionPolicyCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyCreate_Call) RunAndReturn(run func(context.Context, dns.QueryResolutionPolicyCreateParams) (dns.QueryResolutionPolicy, error)) *MockAPI_QueryResolutionPolicyCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryResolutionPolicyDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) QueryResolutionPolicyDelete(ctx context.Context, params dns.QueryResolutionPolicyDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryResolutionPolicyDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_QueryResolutionPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryResolutionPolicyDelete'
+type MockAPI_QueryResolutionPolicyDelete_Call struct {
+	*mock.Call
+}
+
+// QueryResolutionPolicyDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.QueryResolutionPolicyDeleteParams
+func (_e *MockAPI_Expecter) QueryResolutionPolicyDelete(ctx interface{}, params interface{}) *MockAPI_QueryResolutionPolicyDelete_Call {
+	return &MockAPI_QueryResolutionPolicyDelete_Call{Call: _e.mock.On("QueryResolutionPolicyDelete", ctx, params)}
+}
+
+func (_c *MockAPI_QueryResolutionPolicyDelete_Call) Run(run func(ctx context.Context, params dns.QueryResolutionPolicyDeleteParams)) *MockAPI_QueryResolutionPolicyDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.QueryResolutionPolicyDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyDelete_Call) Return(_a0 error) *MockAPI_QueryResolutionPolicyDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyDelete_Call) RunAndReturn(run func(context.Context, dns.QueryResolutionPolicyDeleteParams) error) *MockAPI_QueryResolutionPolicyDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryResolutionPolicyList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) QueryResolutionPolicyList(ctx context.Context, params dns.QueryResolutionPolicyListParams) ([]dns.QueryResolutionPolicy, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryResolutionPolicyList")
+	}
+
+	var r0 []dns.QueryResolutionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyListParams) ([]dns.QueryResolutionPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyListParams) []dns.QueryResolutionPolicy); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.QueryResolutionPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.QueryResolutionPolicyListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_QueryResolutionPolicyList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryResolutionPolicyList'
+type MockAPI_QueryResolutionPolicyList_Call struct {
+	*mock.Call
+}
+
+// QueryResolutionPolicyList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.QueryResolutionPolicyListParams
+func (_e *MockAPI_Expecter) QueryResolutionPolicyList(ctx interface{}, params interface{}) *MockAPI_QueryResolutionPolicyList_Call {
+	return &MockAPI_QueryResolutionPolicyList_Call{Call: _e.mock.On("QueryResolutionPolicyList", ctx, params)}
+}
+
+func (_c *MockAPI_QueryResolutionPolicyList_Call) Run(run func(ctx context.Context, params dns.QueryResolutionPolicyListParams)) *MockAPI_QueryResolutionPolicyList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.QueryResolutionPolicyListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyList_Call) Return(_a0 []dns.QueryResolutionPolicy, _a1 error) *MockAPI_QueryResolutionPolicyList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyList_Call) RunAndReturn(run func(context.Context, dns.QueryResolutionPolicyListParams) ([]dns.QueryResolutionPolicy, error)) *MockAPI_QueryResolutionPolicyList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryResolutionPolicyRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) QueryResolutionPolicyRead(ctx context.Context, params dns.QueryResolutionPolicyReadParams) (dns.QueryResolutionPolicy, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryResolutionPolicyRead")
+	}
+
+	var r0 dns.QueryResolutionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyReadParams) (dns.QueryResolutionPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyReadParams) dns.QueryResolutionPolicy); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.QueryResolutionPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.QueryResolutionPolicyReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_QueryResolutionPolicyRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryResolutionPolicyRead'
+type MockAPI_QueryResolutionPolicyRead_Call struct {
+	*mock.Call
+}
+
+// QueryResolutionPolicyRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.QueryResolutionPolicyReadParams
+func (_e *MockAPI_Expecter) QueryResolutionPolicyRead(ctx interface{}, params interface{}) *MockAPI_QueryResolutionPolicyRead_Call {
+	return &MockAPI_QueryResolutionPolicyRead_Call{Call: _e.mock.On("QueryResolutionPolicyRead", ctx, params)}
+}
+
+func (_c *MockAPI_QueryResolutionPolicyRead_Call) Run(run func(ctx context.Context, params dns.QueryResolutionPolicyReadParams)) *MockAPI_QueryResolutionPolicyRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.QueryResolutionPolicyReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyRead_Call) Return(_a0 dns.QueryResolutionPolicy, _a1 error) *MockAPI_QueryResolutionPolicyRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyRead_Call) RunAndReturn(run func(context.Context, dns.QueryResolutionPolicyReadParams) (dns.QueryResolutionPolicy, error)) *MockAPI_QueryResolutionPolicyRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryResolutionPolicyUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) QueryResolutionPolicyUpdate(ctx context.Context, params dns.QueryResolutionPolicyUpdateParams) (dns.QueryResolutionPolicy, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryResolutionPolicyUpdate")
+	}
+
+	var r0 dns.QueryResolutionPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyUpdateParams) (dns.QueryResolutionPolicy, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.QueryResolutionPolicyUpdateParams) dns.QueryResolutionPolicy); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.QueryResolutionPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.QueryResolutionPolicyUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_QueryResolutionPolicyUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryResolutionPolicyUpdate'
+type MockAPI_QueryResolutionPolicyUpdate_Call struct {
+	*mock.Call
+}
+
+// QueryResolutionPolicyUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.QueryResolutionPolicyUpdateParams
+func (_e *MockAPI_Expecter) QueryResolutionPolicyUpdate(ctx interface{}, params interface{}) *MockAPI_QueryResolutionPolicyUpdate_Call {
+	return &MockAPI_QueryResolutionPolicyUpdate_Call{Call: _e.mock.On("QueryResolutionPolicyUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_QueryResolutionPolicyUpdate_Call) Run(run func(ctx context.Context, params dns.QueryResolutionPolicyUpdateParams)) *MockAPI_QueryResolutionPolicyUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.QueryResolutionPolicyUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyUpdate_Call) Return(_a0 dns.QueryResolutionPolicy, _a1 error) *MockAPI_QueryResolutionPolicyUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_QueryResolutionPolicyUpdate_Call) RunAndReturn(run func(context.Context, dns.QueryResolutionPolicyUpdateParams) (dns.QueryResolutionPolicy, error)) *MockAPI_QueryResolutionPolicyUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAAAACreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordAAAACreate(ctx context.Context, params dns.RecordAAAACreateParams) (dns.RecordAAAA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAAAACreate")
+	}
+
+	var r0 dns.RecordAAAA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAACreateParams) (dns.RecordAAAA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAACreateParams) dns.RecordAAAA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordAAAA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordAAAACreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordAAAACreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAAAACreate'
+type MockAPI_RecordAAAACreate_Call struct {
+	*mock.Call
+}
+
+// RecordAAAACreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordAAAACreateParams
+func (_e *MockAPI_Expecter) RecordAAAACreate(ctx interface{}, params interface{}) *MockAPI_RecordAAAACreate_Call {
+	return &MockAPI_RecordAAAACreate_Call{Call: _e.mock.On("RecordAAAACreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordAAAACreate_Call) Run(run func(ctx context.Context, params dns.RecordAAAACreateParams)) *MockAPI_RecordAAAACreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordAAAACreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAACreate_Call) Return(_a0 dns.RecordAAAA, _a1 error) *MockAPI_RecordAAAACreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAACreate_Call) RunAndReturn(run func(context.Context, dns.RecordAAAACreateParams) (dns.RecordAAAA, error)) *MockAPI_RecordAAAACreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAAAADelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordAAAADelete(ctx context.Context, params dns.RecordAAAADeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAAAADelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAADeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordAAAADelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAAAADelete'
+type MockAPI_RecordAAAADelete_Call struct {
+	*mock.Call
+}
+
+// RecordAAAADelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordAAAADeleteParams
+func (_e *MockAPI_Expecter) RecordAAAADelete(ctx interface{}, params interface{}) *MockAPI_RecordAAAADelete_Call {
+	return &MockAPI_RecordAAAADelete_Call{Call: _e.mock.On("RecordAAAADelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordAAAADelete_Call) Run(run func(ctx context.Context, params dns.RecordAAAADeleteParams)) *MockAPI_RecordAAAADelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordAAAADeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAADelete_Call) Return(_a0 error) *MockAPI_RecordAAAADelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAADelete_Call) RunAndReturn(run func(context.Context, dns.RecordAAAADeleteParams) error) *MockAPI_RecordAAAADelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAAAARead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordAAAARead(ctx context.Context, params dns.RecordAAAAReadParams) (dns.RecordAAAA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAAAARead")
+	}
+
+	var r0 dns.RecordAAAA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAAReadParams) (dns.RecordAAAA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAAReadParams) dns.RecordAAAA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordAAAA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordAAAAReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordAAAARead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAAAARead'
+type MockAPI_RecordAAAARead_Call struct {
+	*mock.Call
+}
+
+// RecordAAAARead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordAAAAReadParams
+func (_e *MockAPI_Expecter) RecordAAAARead(ctx interface{}, params interface{}) *MockAPI_RecordAAAARead_Call {
+	return &MockAPI_RecordAAAARead_Call{Call: _e.mock.On("RecordAAAARead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordAAAARead_Call) Run(run func(ctx context.Context, params dns.RecordAAAAReadParams)) *MockAPI_RecordAAAARead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordAAAAReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAARead_Call) Return(_a0 dns.RecordAAAA, _a1 error) *MockAPI_RecordAAAARead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAARead_Call) RunAndReturn(run func(context.Context, dns.RecordAAAAReadParams) (dns.RecordAAAA, error)) *MockAPI_RecordAAAARead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAAAAUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordAAAAUpdate(ctx context.Context, params dns.RecordAAAAUpdateParams) (dns.RecordAAAA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAAAAUpdate")
+	}
+
+	var r0 dns.RecordAAAA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAAUpdateParams) (dns.RecordAAAA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAAAAUpdateParams) dns.RecordAAAA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordAAAA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordAAAAUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordAAAAUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAAAAUpdate'
+type MockAPI_RecordAAAAUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordAAAAUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordAAAAUpdateParams
+func (_e *MockAPI_Expecter) RecordAAAAUpdate(ctx interface{}, params interface{}) *MockAPI_RecordAAAAUpdate_Call {
+	return &MockAPI_RecordAAAAUpdate_Call{Call: _e.mock.On("RecordAAAAUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordAAAAUpdate_Call) Run(run func(ctx context.Context, params dns.RecordAAAAUpdateParams)) *MockAPI_RecordAAAAUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordAAAAUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAAUpdate_Call) Return(_a0 dns.RecordAAAA, _a1 error) *MockAPI_RecordAAAAUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordAAAAUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordAAAAUpdateParams) (dns.RecordAAAA, error)) *MockAPI_RecordAAAAUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordACreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordACreate(ctx context.Context, params dns.RecordACreateParams) (dns.RecordA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordACreate")
+	}
+
+	var r0 dns.RecordA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordACreateParams) (dns.RecordA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordACreateParams) dns.RecordA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordACreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordACreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordACreate'
+type MockAPI_RecordACreate_Call struct {
+	*mock.Call
+}
+
+// RecordACreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordACreateParams
+func (_e *MockAPI_Expecter) RecordACreate(ctx interface{}, params interface{}) *MockAPI_RecordACreate_Call {
+	return &MockAPI_RecordACreate_Call{Call: _e.mock.On("RecordACreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordACreate_Call) Run(run func(ctx context.Context, params dns.RecordACreateParams)) *MockAPI_RecordACreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordACreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordACreate_Call) Return(_a0 dns.RecordA, _a1 error) *MockAPI_RecordACreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordACreate_Call) RunAndReturn(run func(context.Context, dns.RecordACreateParams) (dns.RecordA, error)) *MockAPI_RecordACreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordADelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordADelete(ctx context.Context, params dns.RecordADeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordADelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordADeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordADelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordADelete'
+type MockAPI_RecordADelete_Call struct {
+	*mock.Call
+}
+
+// RecordADelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordADeleteParams
+func (_e *MockAPI_Expecter) RecordADelete(ctx interface{}, params interface{}) *MockAPI_RecordADelete_Call {
+	return &MockAPI_RecordADelete_Call{Call: _e.mock.On("RecordADelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordADelete_Call) Run(run func(ctx context.Context, params dns.RecordADeleteParams)) *MockAPI_RecordADelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordADeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordADelete_Call) Return(_a0 error) *MockAPI_RecordADelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordADelete_Call) RunAndReturn(run func(context.Context, dns.RecordADeleteParams) error) *MockAPI_RecordADelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordARead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordARead(ctx context.Context, params dns.RecordAReadParams) (dns.RecordA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordARead")
+	}
+
+	var r0 dns.RecordA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAReadParams) (dns.RecordA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAReadParams) dns.RecordA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordAReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordARead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordARead'
+type MockAPI_RecordARead_Call struct {
+	*mock.Call
+}
+
+// RecordARead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordAReadParams
+func (_e *MockAPI_Expecter) RecordARead(ctx interface{}, params interface{}) *MockAPI_RecordARead_Call {
+	return &MockAPI_RecordARead_Call{Call: _e.mock.On("RecordARead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordARead_Call) Run(run func(ctx context.Context, params dns.RecordAReadParams)) *MockAPI_RecordARead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordAReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordARead_Call) Return(_a0 dns.RecordA, _a1 error) *MockAPI_RecordARead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordARead_Call) RunAndReturn(run func(context.Context, dns.RecordAReadParams) (dns.RecordA, error)) *MockAPI_RecordARead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordAUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordAUpdate(ctx context.Context, params dns.RecordAUpdateParams) (dns.RecordA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAUpdate")
+	}
+
+	var r0 dns.RecordA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAUpdateParams) (dns.RecordA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordAUpdateParams) dns.RecordA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordAUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordAUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAUpdate'
+type MockAPI_RecordAUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordAUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordAUpdateParams
+func (_e *MockAPI_Expecter) RecordAUpdate(ctx interface{}, params interface{}) *MockAPI_RecordAUpdate_Call {
+	return &MockAPI_RecordAUpdate_Call{Call: _e.mock.On("RecordAUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordAUpdate_Call) Run(run func(ctx context.Context, params dns.RecordAUpdateParams)) *MockAPI_RecordAUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordAUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordAUpdate_Call) Return(_a0 dns.RecordA, _a1 error) *MockAPI_RecordAUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordAUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordAUpdateParams) (dns.RecordA, error)) *MockAPI_RecordAUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordCNameCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordCNameCreate(ctx context.Context, params dns.RecordCNameCreateParams) (dns.RecordCName, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCNameCreate")
+	}
+
+	var r0 dns.RecordCName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameCreateParams) (dns.RecordCName, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameCreateParams) dns.RecordCName); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordCName)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordCNameCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordCNameCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCNameCreate'
+type MockAPI_RecordCNameCreate_Call struct {
+	*mock.Call
+}
+
+// RecordCNameCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordCNameCreateParams
+func (_e *MockAPI_Expecter) RecordCNameCreate(ctx interface{}, params interface{}) *MockAPI_RecordCNameCreate_Call {
+	return &MockAPI_RecordCNameCreate_Call{Call: _e.mock.On("RecordCNameCreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordCNameCreate_Call) Run(run func(ctx context.Context, params dns.RecordCNameCreateParams)) *MockAPI_RecordCNameCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordCNameCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameCreate_Call) Return(_a0 dns.RecordCName, _a1 error) *MockAPI_RecordCNameCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameCreate_Call) RunAndReturn(run func(context.Context, dns.RecordCNameCreateParams) (dns.RecordCName, error)) *MockAPI_RecordCNameCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordCNameDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordCNameDelete(ctx context.Context, params dns.RecordCNameDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCNameDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordCNameDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCNameDelete'
+type MockAPI_RecordCNameDelete_Call struct {
+	*mock.Call
+}
+
+// RecordCNameDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordCNameDeleteParams
+func (_e *MockAPI_Expecter) RecordCNameDelete(ctx interface{}, params interface{}) *MockAPI_RecordCNameDelete_Call {
+	return &MockAPI_RecordCNameDelete_Call{Call: _e.mock.On("RecordCNameDelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordCNameDelete_Call) Run(run func(ctx context.Context, params dns.RecordCNameDeleteParams)) *MockAPI_RecordCNameDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordCNameDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameDelete_Call) Return(_a0 error) *MockAPI_RecordCNameDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameDelete_Call) RunAndReturn(run func(context.Context, dns.RecordCNameDeleteParams) error) *MockAPI_RecordCNameDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordCNameRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordCNameRead(ctx context.Context, params dns.RecordCNameReadParams) (dns.RecordCName, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCNameRead")
+	}
+
+	var r0 dns.RecordCName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameReadParams) (dns.RecordCName, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameReadParams) dns.RecordCName); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordCName)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordCNameReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordCNameRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCNameRead'
+type MockAPI_RecordCNameRead_Call struct {
+	*mock.Call
+}
+
+// RecordCNameRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordCNameReadParams
+func (_e *MockAPI_Expecter) RecordCNameRead(ctx interface{}, params interface{}) *MockAPI_RecordCNameRead_Call {
+	return &MockAPI_RecordCNameRead_Call{Call: _e.mock.On("RecordCNameRead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordCNameRead_Call) Run(run func(ctx context.Context, params dns.RecordCNameReadParams)) *MockAPI_RecordCNameRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordCNameReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameRead_Call) Return(_a0 dns.RecordCName, _a1 error) *MockAPI_RecordCNameRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameRead_Call) RunAndReturn(run func(context.Context, dns.RecordCNameReadParams) (dns.RecordCName, error)) *MockAPI_RecordCNameRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordCNameUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordCNameUpdate(ctx context.Context, params dns.RecordCNameUpdateParams) (dns.RecordCName, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCNameUpdate")
+	}
+
+	var r0 dns.RecordCName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameUpdateParams) (dns.RecordCName, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordCNameUpdateParams) dns.RecordCName); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordCName)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordCNameUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordCNameUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCNameUpdate'
+type MockAPI_RecordCNameUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordCNameUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordCNameUpdateParams
+func (_e *MockAPI_Expecter) RecordCNameUpdate(ctx interface{}, params interface{}) *MockAPI_RecordCNameUpdate_Call {
+	return &MockAPI_RecordCNameUpdate_Call{Call: _e.mock.On("RecordCNameUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordCNameUpdate_Call) Run(run func(ctx context.Context, params dns.RecordCNameUpdateParams)) *MockAPI_RecordCNameUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordCNameUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameUpdate_Call) Return(_a0 dns.RecordCName, _a1 error) *MockAPI_RecordCNameUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordCNameUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordCNameUpdateParams) (dns.RecordCName, error)) *MockAPI_RecordCNameUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordImport provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordImport(ctx context.Context, params dns.RecordImportParams) ([]dns.RecordImportResult, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordImport")
+	}
+
+	var r0 []dns.RecordImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordImportParams) ([]dns.RecordImportResult, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordImportParams) []dns.RecordImportResult); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.RecordImportResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordImportParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordImport'
+type MockAPI_RecordImport_Call struct {
+	*mock.Call
+}
+
+// RecordImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordImportParams
+func (_e *MockAPI_Expecter) RecordImport(ctx interface{}, params interface{}) *MockAPI_RecordImport_Call {
+	return &MockAPI_RecordImport_Call{Call: _e.mock.On("RecordImport", ctx, params)}
+}
+
+func (_c *MockAPI_RecordImport_Call) Run(run func(ctx context.Context, params dns.RecordImportParams)) *MockAPI_RecordImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordImportParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordImport_Call) Return(_a0 []dns.RecordImportResult, _a1 error) *MockAPI_RecordImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordImport_Call) RunAndReturn(run func(context.Context, dns.RecordImportParams) ([]dns.RecordImportResult, error)) *MockAPI_RecordImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordList(ctx context.Context, params dns.RecordListParams) (dns.Records, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordList")
+	}
+
+	var r0 dns.Records
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordListParams) (dns.Records, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordListParams) dns.Records); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Records)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordList'
+type MockAPI_RecordList_Call struct {
+	*mock.Call
+}
+
+// RecordList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordListParams
+func (_e *MockAPI_Expecter) RecordList(ctx interface{}, params interface{}) *MockAPI_RecordList_Call {
+	return &MockAPI_RecordList_Call{Call: _e.mock.On("RecordList", ctx, params)}
+}
+
+func (_c *MockAPI_RecordList_Call) Run(run func(ctx context.Context, params dns.RecordListParams)) *MockAPI_RecordList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordList_Call) Return(_a0 dns.Records, _a1 error) *MockAPI_RecordList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordList_Call) RunAndReturn(run func(context.Context, dns.RecordListParams) (dns.Records, error)) *MockAPI_RecordList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordListFunc provides a mock function with given fields: ctx, params, fn
+func (_m *MockAPI) RecordListFunc(ctx context.Context, params dns.RecordListParams, fn func(dns.Records) error) error {
+	ret := _m.Called(ctx, params, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordListFunc")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordListParams, func(dns.Records) error) error); ok {
+		r0 = rf(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordListFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordListFunc'
+type MockAPI_RecordListFunc_Call struct {
+	*mock.Call
+}
+
+// RecordListFunc is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordListParams
+//   - fn func(dns.Records) error
+func (_e *MockAPI_Expecter) RecordListFunc(ctx interface{}, params interface{}, fn interface{}) *MockAPI_RecordListFunc_Call {
+	return &MockAPI_RecordListFunc_Call{Call: _e.mock.On("RecordListFunc", ctx, params, fn)}
+}
+
+func (_c *MockAPI_RecordListFunc_Call) Run(run func(ctx context.Context, params dns.RecordListParams, fn func(dns.Records) error)) *MockAPI_RecordListFunc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordListParams), args[2].(func(dns.Records) error))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordListFunc_Call) Return(_a0 error) *MockAPI_RecordListFunc_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordListFunc_Call) RunAndReturn(run func(context.Context, dns.RecordListParams, func(dns.Records) error) error) *MockAPI_RecordListFunc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordMXCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordMXCreate(ctx context.Context, params dns.RecordMXCreateParams) (dns.RecordMX, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMXCreate")
+	}
+
+	var r0 dns.RecordMX
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXCreateParams) (dns.RecordMX, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXCreateParams) dns.RecordMX); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordMX)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordMXCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordMXCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordMXCreate'
+type MockAPI_RecordMXCreate_Call struct {
+	*mock.Call
+}
+
+// RecordMXCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordMXCreateParams
+func (_e *MockAPI_Expecter) RecordMXCreate(ctx interface{}, params interface{}) *MockAPI_RecordMXCreate_Call {
+	return &MockAPI_RecordMXCreate_Call{Call: _e.mock.On("RecordMXCreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordMXCreate_Call) Run(run func(ctx context.Context, params dns.RecordMXCreateParams)) *MockAPI_RecordMXCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordMXCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordMXCreate_Call) Return(_a0 dns.RecordMX, _a1 error) *MockAPI_RecordMXCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordMXCreate_Call) RunAndReturn(run func(context.Context, dns.RecordMXCreateParams) (dns.RecordMX, error)) *MockAPI_RecordMXCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordMXDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordMXDelete(ctx context.Context, params dns.RecordMXDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMXDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordMXDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordMXDelete'
+type MockAPI_RecordMXDelete_Call struct {
+	*mock.Call
+}
+
+// RecordMXDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordMXDeleteParams
+func (_e *MockAPI_Expecter) RecordMXDelete(ctx interface{}, params interface{}) *MockAPI_RecordMXDelete_Call {
+	return &MockAPI_RecordMXDelete_Call{Call: _e.mock.On("RecordMXDelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordMXDelete_Call) Run(run func(ctx context.Context, params dns.RecordMXDeleteParams)) *MockAPI_RecordMXDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordMXDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordMXDelete_Call) Return(_a0 error) *MockAPI_RecordMXDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordMXDelete_Call) RunAndReturn(run func(context.Context, dns.RecordMXDeleteParams) error) *MockAPI_RecordMXDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordMXRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordMXRead(ctx context.Context, params dns.RecordMXReadParams) (dns.RecordMX, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMXRead")
+	}
+
+	var r0 dns.RecordMX
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXReadParams) (dns.RecordMX, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXReadParams) dns.RecordMX); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordMX)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordMXReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordMXRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordMXRead'
+type MockAPI_RecordMXRead_Call struct {
+	*mock.Call
+}
+
+// RecordMXRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordMXReadParams
+func (_e *MockAPI_Expecter) RecordMXRead(ctx interface{}, params interface{}) *MockAPI_RecordMXRead_Call {
+	return &MockAPI_RecordMXRead_Call{Call: _e.mock.On("RecordMXRead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordMXRead_Call) Run(run func(ctx context.Context, params dns.RecordMXReadParams)) *MockAPI_RecordMXRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordMXReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordMXRead_Call) Return(_a0 dns.RecordMX, _a1 error) *MockAPI_RecordMXRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordMXRead_Call) RunAndReturn(run func(context.Context, dns.RecordMXReadParams) (dns.RecordMX, error)) *MockAPI_RecordMXRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordMXUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordMXUpdate(ctx context.Context, params dns.RecordMXUpdateParams) (dns.RecordMX, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordMXUpdate")
+	}
+
+	var r0 dns.RecordMX
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXUpdateParams) (dns.RecordMX, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordMXUpdateParams) dns.RecordMX); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordMX)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordMXUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordMXUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordMXUpdate'
+type MockAPI_RecordMXUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordMXUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordMXUpdateParams
+func (_e *MockAPI_Expecter) RecordMXUpdate(ctx interface{}, params interface{}) *MockAPI_RecordMXUpdate_Call {
+	return &MockAPI_RecordMXUpdate_Call{Call: _e.mock.On("RecordMXUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordMXUpdate_Call) Run(run func(ctx context.Context, params dns.RecordMXUpdateParams)) *MockAPI_RecordMXUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordMXUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordMXUpdate_Call) Return(_a0 dns.RecordMX, _a1 error) *MockAPI_RecordMXUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordMXUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordMXUpdateParams) (dns.RecordMX, error)) *MockAPI_RecordMXUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNSCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordNSCreate(ctx context.Context, params dns.RecordNSCreateParams) (dns.RecordNS, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNSCreate")
+	}
+
+	var r0 dns.RecordNS
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSCreateParams) (dns.RecordNS, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSCreateParams) dns.RecordNS); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordNS)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordNSCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordNSCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNSCreate'
+type MockAPI_RecordNSCreate_Call struct {
+	*mock.Call
+}
+
+// RecordNSCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordNSCreateParams
+func (_e *MockAPI_Expecter) RecordNSCreate(ctx interface{}, params interface{}) *MockAPI_RecordNSCreate_Call {
+	return &MockAPI_RecordNSCreate_Call{Call: _e.mock.On("RecordNSCreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordNSCreate_Call) Run(run func(ctx context.Context, params dns.RecordNSCreateParams)) *MockAPI_RecordNSCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordNSCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordNSCreate_Call) Return(_a0 dns.RecordNS, _a1 error) *MockAPI_RecordNSCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordNSCreate_Call) RunAndReturn(run func(context.Context, dns.RecordNSCreateParams) (dns.RecordNS, error)) *MockAPI_RecordNSCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNSDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordNSDelete(ctx context.Context, params dns.RecordNSDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNSDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordNSDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNSDelete'
+type MockAPI_RecordNSDelete_Call struct {
+	*mock.Call
+}
+
+// RecordNSDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordNSDeleteParams
+func (_e *MockAPI_Expecter) RecordNSDelete(ctx interface{}, params interface{}) *MockAPI_RecordNSDelete_Call {
+	return &MockAPI_RecordNSDelete_Call{Call: _e.mock.On("RecordNSDelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordNSDelete_Call) Run(run func(ctx context.Context, params dns.RecordNSDeleteParams)) *MockAPI_RecordNSDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordNSDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordNSDelete_Call) Return(_a0 error) *MockAPI_RecordNSDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordNSDelete_Call) RunAndReturn(run func(context.Context, dns.RecordNSDeleteParams) error) *MockAPI_RecordNSDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNSRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordNSRead(ctx context.Context, params dns.RecordNSReadParams) (dns.RecordNS, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNSRead")
+	}
+
+	var r0 dns.RecordNS
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSReadParams) (dns.RecordNS, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSReadParams) dns.RecordNS); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordNS)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordNSReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordNSRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNSRead'
+type MockAPI_RecordNSRead_Call struct {
+	*mock.Call
+}
+
+// RecordNSRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordNSReadParams
+func (_e *MockAPI_Expecter) RecordNSRead(ctx interface{}, params interface{}) *MockAPI_RecordNSRead_Call {
+	return &MockAPI_RecordNSRead_Call{Call: _e.mock.On("RecordNSRead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordNSRead_Call) Run(run func(ctx context.Context, params dns.RecordNSReadParams)) *MockAPI_RecordNSRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordNSReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordNSRead_Call) Return(_a0 dns.RecordNS, _a1 error) *MockAPI_RecordNSRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordNSRead_Call) RunAndReturn(run func(context.Context, dns.RecordNSReadParams) (dns.RecordNS, error)) *MockAPI_RecordNSRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordNSUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordNSUpdate(ctx context.Context, params dns.RecordNSUpdateParams) (dns.RecordNS, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNSUpdate")
+	}
+
+	var r0 dns.RecordNS
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSUpdateParams) (dns.RecordNS, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordNSUpdateParams) dns.RecordNS); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordNS)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordNSUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordNSUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordNSUpdate'
+type MockAPI_RecordNSUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordNSUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordNSUpdateParams
+func (_e *MockAPI_Expecter) RecordNSUpdate(ctx interface{}, params interface{}) *MockAPI_RecordNSUpdate_Call {
+	return &MockAPI_RecordNSUpdate_Call{Call: _e.mock.On("RecordNSUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordNSUpdate_Call) Run(run func(ctx context.Context, params dns.RecordNSUpdateParams)) *MockAPI_RecordNSUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordNSUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordNSUpdate_Call) Return(_a0 dns.RecordNS, _a1 error) *MockAPI_RecordNSUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordNSUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordNSUpdateParams) (dns.RecordNS, error)) *MockAPI_RecordNSUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPTRCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordPTRCreate(ctx context.Context, params dns.RecordPTRCreateParams) (dns.RecordPTR, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPTRCreate")
+	}
+
+	var r0 dns.RecordPTR
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRCreateParams) (dns.RecordPTR, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRCreateParams) dns.RecordPTR); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordPTR)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordPTRCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordPTRCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPTRCreate'
+type MockAPI_RecordPTRCreate_Call struct {
+	*mock.Call
+}
+
+// RecordPTRCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordPTRCreateParams
+func (_e *MockAPI_Expecter) RecordPTRCreate(ctx interface{}, params interface{}) *MockAPI_RecordPTRCreate_Call {
+	return &MockAPI_RecordPTRCreate_Call{Call: _e.mock.On("RecordPTRCreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordPTRCreate_Call) Run(run func(ctx context.Context, params dns.RecordPTRCreateParams)) *MockAPI_RecordPTRCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordPTRCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRCreate_Call) Return(_a0 dns.RecordPTR, _a1 error) *MockAPI_RecordPTRCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRCreate_Call) RunAndReturn(run func(context.Context, dns.RecordPTRCreateParams) (dns.RecordPTR, error)) *MockAPI_RecordPTRCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPTRDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordPTRDelete(ctx context.Context, params dns.RecordPTRDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPTRDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordPTRDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPTRDelete'
+type MockAPI_RecordPTRDelete_Call struct {
+	*mock.Call
+}
+
+// RecordPTRDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordPTRDeleteParams
+func (_e *MockAPI_Expecter) RecordPTRDelete(ctx interface{}, params interface{}) *MockAPI_RecordPTRDelete_Call {
+	return &MockAPI_RecordPTRDelete_Call{Call: _e.mock.On("RecordPTRDelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordPTRDelete_Call) Run(run func(ctx context.Context, params dns.RecordPTRDeleteParams)) *MockAPI_RecordPTRDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordPTRDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRDelete_Call) Return(_a0 error) *MockAPI_RecordPTRDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRDelete_Call) RunAndReturn(run func(context.Context, dns.RecordPTRDeleteParams) error) *MockAPI_RecordPTRDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPTRRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordPTRRead(ctx context.Context, params dns.RecordPTRReadParams) (dns.RecordPTR, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPTRRead")
+	}
+
+	var r0 dns.RecordPTR
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRReadParams) (dns.RecordPTR, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRReadParams) dns.RecordPTR); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordPTR)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordPTRReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordPTRRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPTRRead'
+type MockAPI_RecordPTRRead_Call struct {
+	*mock.Call
+}
+
+// RecordPTRRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordPTRReadParams
+func (_e *MockAPI_Expecter) RecordPTRRead(ctx interface{}, params interface{}) *MockAPI_RecordPTRRead_Call {
+	return &MockAPI_RecordPTRRead_Call{Call: _e.mock.On("RecordPTRRead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordPTRRead_Call) Run(run func(ctx context.Context, params dns.RecordPTRReadParams)) *MockAPI_RecordPTRRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordPTRReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRRead_Call) Return(_a0 dns.RecordPTR, _a1 error) *MockAPI_RecordPTRRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRRead_Call) RunAndReturn(run func(context.Context, dns.RecordPTRReadParams) (dns.RecordPTR, error)) *MockAPI_RecordPTRRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPTRUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordPTRUpdate(ctx context.Context, params dns.RecordPTRUpdateParams) (dns.RecordPTR, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPTRUpdate")
+	}
+
+	var r0 dns.RecordPTR
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRUpdateParams) (dns.RecordPTR, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordPTRUpdateParams) dns.RecordPTR); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordPTR)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordPTRUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordPTRUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPTRUpdate'
+type MockAPI_RecordPTRUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordPTRUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordPTRUpdateParams
+func (_e *MockAPI_Expecter) RecordPTRUpdate(ctx interface{}, params interface{}) *MockAPI_RecordPTRUpdate_Call {
+	return &MockAPI_RecordPTRUpdate_Call{Call: _e.mock.On("RecordPTRUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordPTRUpdate_Call) Run(run func(ctx context.Context, params dns.RecordPTRUpdateParams)) *MockAPI_RecordPTRUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordPTRUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRUpdate_Call) Return(_a0 dns.RecordPTR, _a1 error) *MockAPI_RecordPTRUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordPTRUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordPTRUpdateParams) (dns.RecordPTR, error)) *MockAPI_RecordPTRUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSOARead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordSOARead(ctx context.Context, params dns.RecordSOAReadParams) (dns.RecordSOA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSOARead")
+	}
+
+	var r0 dns.RecordSOA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSOAReadParams) (dns.RecordSOA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSOAReadParams) dns.RecordSOA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordSOA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordSOAReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordSOARead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSOARead'
+type MockAPI_RecordSOARead_Call struct {
+	*mock.Call
+}
+
+// RecordSOARead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordSOAReadParams
+func (_e *MockAPI_Expecter) RecordSOARead(ctx interface{}, params interface{}) *MockAPI_RecordSOARead_Call {
+	return &MockAPI_RecordSOARead_Call{Call: _e.mock.On("RecordSOARead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordSOARead_Call) Run(run func(ctx context.Context, params dns.RecordSOAReadParams)) *MockAPI_RecordSOARead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordSOAReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordSOARead_Call) Return(_a0 dns.RecordSOA, _a1 error) *MockAPI_RecordSOARead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordSOARead_Call) RunAndReturn(run func(context.Context, dns.RecordSOAReadParams) (dns.RecordSOA, error)) *MockAPI_RecordSOARead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSOAUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordSOAUpdate(ctx context.Context, params dns.RecordSOAUpdateParams) (dns.RecordSOA, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSOAUpdate")
+	}
+
+	var r0 dns.RecordSOA
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSOAUpdateParams) (dns.RecordSOA, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSOAUpdateParams) dns.RecordSOA); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordSOA)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordSOAUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordSOAUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSOAUpdate'
+type MockAPI_RecordSOAUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordSOAUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordSOAUpdateParams
+func (_e *MockAPI_Expecter) RecordSOAUpdate(ctx interface{}, params interface{}) *MockAPI_RecordSOAUpdate_Call {
+	return &MockAPI_RecordSOAUpdate_Call{Call: _e.mock.On("RecordSOAUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordSOAUpdate_Call) Run(run func(ctx context.Context, params dns.RecordSOAUpdateParams)) *MockAPI_RecordSOAUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordSOAUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordSOAUpdate_Call) Return(_a0 dns.RecordSOA, _a1 error) *MockAPI_RecordSOAUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordSOAUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordSOAUpdateParams) (dns.RecordSOA, error)) *MockAPI_RecordSOAUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSRVCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordSRVCreate(ctx context.Context, params dns.RecordSRVCreateParams) (dns.RecordSRV, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSRVCreate")
+	}
+
+	var r0 dns.RecordSRV
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVCreateParams) (dns.RecordSRV, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVCreateParams) dns.RecordSRV); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordSRV)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordSRVCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordSRVCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSRVCreate'
+type MockAPI_RecordSRVCreate_Call struct {
+	*mock.Call
+}
+
+// RecordSRVCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordSRVCreateParams
+func (_e *MockAPI_Expecter) RecordSRVCreate(ctx interface{}, params interface{}) *MockAPI_RecordSRVCreate_Call {
+	return &MockAPI_RecordSRVCreate_Call{Call: _e.mock.On("RecordSRVCreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordSRVCreate_Call) Run(run func(ctx context.Context, params dns.RecordSRVCreateParams)) *MockAPI_RecordSRVCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordSRVCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVCreate_Call) Return(_a0 dns.RecordSRV, _a1 error) *MockAPI_RecordSRVCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVCreate_Call) RunAndReturn(run func(context.Context, dns.RecordSRVCreateParams) (dns.RecordSRV, error)) *MockAPI_RecordSRVCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSRVDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordSRVDelete(ctx context.Context, params dns.RecordSRVDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSRVDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordSRVDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSRVDelete'
+type MockAPI_RecordSRVDelete_Call struct {
+	*mock.Call
+}
+
+// RecordSRVDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordSRVDeleteParams
+func (_e *MockAPI_Expecter) RecordSRVDelete(ctx interface{}, params interface{}) *MockAPI_RecordSRVDelete_Call {
+	return &MockAPI_RecordSRVDelete_Call{Call: _e.mock.On("RecordSRVDelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordSRVDelete_Call) Run(run func(ctx context.Context, params dns.RecordSRVDeleteParams)) *MockAPI_RecordSRVDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordSRVDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVDelete_Call) Return(_a0 error) *MockAPI_RecordSRVDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVDelete_Call) RunAndReturn(run func(context.Context, dns.RecordSRVDeleteParams) error) *MockAPI_RecordSRVDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSRVRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordSRVRead(ctx context.Context, params dns.RecordSRVReadParams) (dns.RecordSRV, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSRVRead")
+	}
+
+	var r0 dns.RecordSRV
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVReadParams) (dns.RecordSRV, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVReadParams) dns.RecordSRV); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordSRV)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordSRVReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordSRVRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSRVRead'
+type MockAPI_RecordSRVRead_Call struct {
+	*mock.Call
+}
+
+// RecordSRVRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordSRVReadParams
+func (_e *MockAPI_Expecter) RecordSRVRead(ctx interface{}, params interface{}) *MockAPI_RecordSRVRead_Call {
+	return &MockAPI_RecordSRVRead_Call{Call: _e.mock.On("RecordSRVRead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordSRVRead_Call) Run(run func(ctx context.Context, params dns.RecordSRVReadParams)) *MockAPI_RecordSRVRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordSRVReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVRead_Call) Return(_a0 dns.RecordSRV, _a1 error) *MockAPI_RecordSRVRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVRead_Call) RunAndReturn(run func(context.Context, dns.RecordSRVReadParams) (dns.RecordSRV, error)) *MockAPI_RecordSRVRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSRVUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordSRVUpdate(ctx context.Context, params dns.RecordSRVUpdateParams) (dns.RecordSRV, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSRVUpdate")
+	}
+
+	var r0 dns.RecordSRV
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVUpdateParams) (dns.RecordSRV, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordSRVUpdateParams) dns.RecordSRV); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordSRV)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordSRVUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordSRVUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSRVUpdate'
+type MockAPI_RecordSRVUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordSRVUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordSRVUpdateParams
+func (_e *MockAPI_Expecter) RecordSRVUpdate(ctx interface{}, params interface{}) *MockAPI_RecordSRVUpdate_Call {
+	return &MockAPI_RecordSRVUpdate_Call{Call: _e.mock.On("RecordSRVUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordSRVUpdate_Call) Run(run func(ctx context.Context, params dns.RecordSRVUpdateParams)) *MockAPI_RecordSRVUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordSRVUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVUpdate_Call) Return(_a0 dns.RecordSRV, _a1 error) *MockAPI_RecordSRVUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordSRVUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordSRVUpdateParams) (dns.RecordSRV, error)) *MockAPI_RecordSRVUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordTXTCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordTXTCreate(ctx context.Context, params dns.RecordTXTCreateParams) (dns.RecordTXT, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTXTCreate")
+	}
+
+	var r0 dns.RecordTXT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTCreateParams) (dns.RecordTXT, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTCreateParams) dns.RecordTXT); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordTXT)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordTXTCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordTXTCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTXTCreate'
+type MockAPI_RecordTXTCreate_Call struct {
+	*mock.Call
+}
+
+// RecordTXTCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordTXTCreateParams
+func (_e *MockAPI_Expecter) RecordTXTCreate(ctx interface{}, params interface{}) *MockAPI_RecordTXTCreate_Call {
+	return &MockAPI_RecordTXTCreate_Call{Call: _e.mock.On("RecordTXTCreate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordTXTCreate_Call) Run(run func(ctx context.Context, params dns.RecordTXTCreateParams)) *MockAPI_RecordTXTCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordTXTCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTCreate_Call) Return(_a0 dns.RecordTXT, _a1 error) *MockAPI_RecordTXTCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTCreate_Call) RunAndReturn(run func(context.Context, dns.RecordTXTCreateParams) (dns.RecordTXT, error)) *MockAPI_RecordTXTCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordTXTDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordTXTDelete(ctx context.Context, params dns.RecordTXTDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTXTDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_RecordTXTDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTXTDelete'
+type MockAPI_RecordTXTDelete_Call struct {
+	*mock.Call
+}
+
+// RecordTXTDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordTXTDeleteParams
+func (_e *MockAPI_Expecter) RecordTXTDelete(ctx interface{}, params interface{}) *MockAPI_RecordTXTDelete_Call {
+	return &MockAPI_RecordTXTDelete_Call{Call: _e.mock.On("RecordTXTDelete", ctx, params)}
+}
+
+func (_c *MockAPI_RecordTXTDelete_Call) Run(run func(ctx context.Context, params dns.RecordTXTDeleteParams)) *MockAPI_RecordTXTDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordTXTDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTDelete_Call) Return(_a0 error) *MockAPI_RecordTXTDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTDelete_Call) RunAndReturn(run func(context.Context, dns.RecordTXTDeleteParams) error) *MockAPI_RecordTXTDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordTXTRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordTXTRead(ctx context.Context, params dns.RecordTXTReadParams) (dns.RecordTXT, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTXTRead")
+	}
+
+	var r0 dns.RecordTXT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTReadParams) (dns.RecordTXT, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTReadParams) dns.RecordTXT); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordTXT)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordTXTReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordTXTRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTXTRead'
+type MockAPI_RecordTXTRead_Call struct {
+	*mock.Call
+}
+
+// RecordTXTRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordTXTReadParams
+func (_e *MockAPI_Expecter) RecordTXTRead(ctx interface{}, params interface{}) *MockAPI_RecordTXTRead_Call {
+	return &MockAPI_RecordTXTRead_Call{Call: _e.mock.On("RecordTXTRead", ctx, params)}
+}
+
+func (_c *MockAPI_RecordTXTRead_Call) Run(run func(ctx context.Context, params dns.RecordTXTReadParams)) *MockAPI_RecordTXTRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordTXTReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTRead_Call) Return(_a0 dns.RecordTXT, _a1 error) *MockAPI_RecordTXTRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTRead_Call) RunAndReturn(run func(context.Context, dns.RecordTXTReadParams) (dns.RecordTXT, error)) *MockAPI_RecordTXTRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordTXTUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) RecordTXTUpdate(ctx context.Context, params dns.RecordTXTUpdateParams) (dns.RecordTXT, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTXTUpdate")
+	}
+
+	var r0 dns.RecordTXT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTUpdateParams) (dns.RecordTXT, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.RecordTXTUpdateParams) dns.RecordTXT); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.RecordTXT)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.RecordTXTUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_RecordTXTUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTXTUpdate'
+type MockAPI_RecordTXTUpdate_Call struct {
+	*mock.Call
+}
+
+// RecordTXTUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.RecordTXTUpdateParams
+func (_e *MockAPI_Expecter) RecordTXTUpdate(ctx interface{}, params interface{}) *MockAPI_RecordTXTUpdate_Call {
+	return &MockAPI_RecordTXTUpdate_Call{Call: _e.mock.On("RecordTXTUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_RecordTXTUpdate_Call) Run(run func(ctx context.Context, params dns.RecordTXTUpdateParams)) *MockAPI_RecordTXTUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.RecordTXTUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTUpdate_Call) Return(_a0 dns.RecordTXT, _a1 error) *MockAPI_RecordTXTUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_RecordTXTUpdate_Call) RunAndReturn(run func(context.Context, dns.RecordTXTUpdateParams) (dns.RecordTXT, error)) *MockAPI_RecordTXTUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: ctx, params
+func (_m *MockAPI) Resolve(ctx context.Context, params dns.ResolveParams) (dns.Resolution, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 dns.Resolution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ResolveParams) (dns.Resolution, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ResolveParams) dns.Resolution); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Resolution)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ResolveParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockAPI_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ResolveParams
+func (_e *MockAPI_Expecter) Resolve(ctx interface{}, params interface{}) *MockAPI_Resolve_Call {
+	return &MockAPI_Resolve_Call{Call: _e.mock.On("Resolve", ctx, params)}
+}
+
+func (_c *MockAPI_Resolve_Call) Run(run func(ctx context.Context, params dns.ResolveParams)) *MockAPI_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ResolveParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_Resolve_Call) Return(_a0 dns.Resolution, _a1 error) *MockAPI_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_Resolve_Call) RunAndReturn(run func(context.Context, dns.ResolveParams) (dns.Resolution, error)) *MockAPI_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerCacheClear provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerCacheClear(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerCacheClear")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ServerCacheClear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerCacheClear'
+type MockAPI_ServerCacheClear_Call struct {
+	*mock.Call
+}
+
+// ServerCacheClear is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerCacheClear(ctx interface{}) *MockAPI_ServerCacheClear_Call {
+	return &MockAPI_ServerCacheClear_Call{Call: _e.mock.On("ServerCacheClear", ctx)}
+}
+
+func (_c *MockAPI_ServerCacheClear_Call) Run(run func(ctx context.Context)) *MockAPI_ServerCacheClear_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheClear_Call) Return(_a0 error) *MockAPI_ServerCacheClear_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheClear_Call) RunAndReturn(run func(context.Context) error) *MockAPI_ServerCacheClear_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerCacheRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerCacheRead(ctx context.Context) (dns.ServerCache, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerCacheRead")
+	}
+
+	var r0 dns.ServerCache
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerCache, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerCache); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerCache)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerCacheRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerCacheRead'
+type MockAPI_ServerCacheRead_Call struct {
+	*mock.Call
+}
+
+// ServerCacheRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerCacheRead(ctx interface{}) *MockAPI_ServerCacheRead_Call {
+	return &MockAPI_ServerCacheRead_Call{Call: _e.mock.On("ServerCacheRead", ctx)}
+}
+
+func (_c *MockAPI_ServerCacheRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerCacheRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheRead_Call) Return(_a0 dns.ServerCache, _a1 error) *MockAPI_ServerCacheRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheRead_Call) RunAndReturn(run func(context.Context) (dns.ServerCache, error)) *MockAPI_ServerCacheRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerCacheRecordList provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerCacheRecordList(ctx context.Context) (dns.Records, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerCacheRecordList")
+	}
+
+	var r0 dns.Records
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.Records, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.Records); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.Records)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerCacheRecordList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerCacheRecordList'
+type MockAPI_ServerCacheRecordList_Call struct {
+	*mock.Call
+}
+
+// ServerCacheRecordList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerCacheRecordList(ctx interface{}) *MockAPI_ServerCacheRecordList_Call {
+	return &MockAPI_ServerCacheRecordList_Call{Call: _e.mock.On("ServerCacheRecordList", ctx)}
+}
+
+func (_c *MockAPI_ServerCacheRecordList_Call) Run(run func(ctx context.Context)) *MockAPI_ServerCacheRecordList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheRecordList_Call) Return(_a0 dns.Records, _a1 error) *MockAPI_ServerCacheRecordList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheRecordList_Call) RunAndReturn(run func(context.Context) (dns.Records, error)) *MockAPI_ServerCacheRecordList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerCacheUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerCacheUpdate(ctx context.Context, params dns.ServerCacheUpdateParams) (dns.ServerCache, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerCacheUpdate")
+	}
+
+	var r0 dns.ServerCache
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerCacheUpdateParams) (dns.ServerCache, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerCacheUpdateParams) dns.ServerCache); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ServerCache)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ServerCacheUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerCacheUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerCacheUpdate'
+type MockAPI_ServerCacheUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerCacheUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ServerCacheUpdateParams
+func (_e *MockAPI_Expecter) ServerCacheUpdate(ctx interface{}, params interface{}) *MockAPI_ServerCacheUpdate_Call {
+	return &MockAPI_ServerCacheUpdate_Call{Call: _e.mock.On("ServerCacheUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerCacheUpdate_Call) Run(run func(ctx context.Context, params dns.ServerCacheUpdateParams)) *MockAPI_ServerCacheUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ServerCacheUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheUpdate_Call) Return(_a0 dns.ServerCache, _a1 error) *MockAPI_ServerCacheUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerCacheUpdate_Call) RunAndReturn(run func(context.Context, dns.ServerCacheUpdateParams) (dns.ServerCache, error)) *MockAPI_ServerCacheUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerEDnsRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerEDnsRead(ctx context.Context) (dns.ServerEDns, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerEDnsRead")
+	}
+
+	var r0 dns.ServerEDns
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerEDns, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerEDns); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerEDns)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerEDnsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerEDnsRead'
+type MockAPI_ServerEDnsRead_Call struct {
+	*mock.Call
+}
+
+// ServerEDnsRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerEDnsRead(ctx interface{}) *MockAPI_ServerEDnsRead_Call {
+	return &MockAPI_ServerEDnsRead_Call{Call: _e.mock.On("ServerEDnsRead", ctx)}
+}
+
+func (_c *MockAPI_ServerEDnsRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerEDnsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerEDnsRead_Call) Return(_a0 dns.ServerEDns, _a1 error) *MockAPI_ServerEDnsRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerEDnsRead_Call) RunAndReturn(run func(context.Context) (dns.ServerEDns, error)) *MockAPI_ServerEDnsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerEDnsUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerEDnsUpdate(ctx context.Context, params dns.ServerEDnsUpdateParams) (dns.ServerEDns, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerEDnsUpdate")
+	}
+
+	var r0 dns.ServerEDns
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerEDnsUpdateParams) (dns.ServerEDns, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerEDnsUpdateParams) dns.ServerEDns); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ServerEDns)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ServerEDnsUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerEDnsUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerEDnsUpdate'
+type MockAPI_ServerEDnsUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerEDnsUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ServerEDnsUpdateParams
+func (_e *MockAPI_Expecter) ServerEDnsUpdate(ctx interface{}, params interface{}) *MockAPI_ServerEDnsUpdate_Call {
+	return &MockAPI_ServerEDnsUpdate_Call{Call: _e.mock.On("ServerEDnsUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerEDnsUpdate_Call) Run(run func(ctx context.Context, params dns.ServerEDnsUpdateParams)) *MockAPI_ServerEDnsUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ServerEDnsUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerEDnsUpdate_Call) Return(_a0 dns.ServerEDns, _a1 error) *MockAPI_ServerEDnsUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerEDnsUpdate_Call) RunAndReturn(run func(context.Context, dns.ServerEDnsUpdateParams) (dns.ServerEDns, error)) *MockAPI_ServerEDnsUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerForwarderRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerForwarderRead(ctx context.Context) (dns.ServerForwarder, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerForwarderRead")
+	}
+
+	var r0 dns.ServerForwarder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerForwarder, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerForwarder); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerForwarder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerForwarderRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerForwarderRead'
+type MockAPI_ServerForwarderRead_Call struct {
+	*mock.Call
+}
+
+// ServerForwarderRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerForwarderRead(ctx interface{}) *MockAPI_ServerForwarderRead_Call {
+	return &MockAPI_ServerForwarderRead_Call{Call: _e.mock.On("ServerForwarderRead", ctx)}
+}
+
+func (_c *MockAPI_ServerForwarderRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerForwarderRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerForwarderRead_Call) Return(_a0 dns.ServerForwarder, _a1 error) *MockAPI_ServerForwarderRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerForwarderRead_Call) RunAndReturn(run func(context.Context) (dns.ServerForwarder, error)) *MockAPI_ServerForwarderRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerForwarderUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerForwarderUpdate(ctx context.Context, params dns.ServerForwarderUpdateParams) (dns.ServerForwarder, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerForwarderUpdate")
+	}
+
+	var r0 dns.ServerForwarder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerForwarderUpdateParams) (dns.ServerForwarder, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerForwarderUpdateParams) dns.ServerForwarder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ServerForwarder)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ServerForwarderUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerForwarderUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerForwarderUpdate'
+type MockAPI_ServerForwarderUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerForwarderUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ServerForwarderUpdateParams
+func (_e *MockAPI_Expecter) ServerForwarderUpdate(ctx interface{}, params interface{}) *MockAPI_ServerForwarderUpdate_Call {
+	return &MockAPI_ServerForwarderUpdate_Call{Call: _e.mock.On("ServerForwarderUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerForwarderUpdate_Call) Run(run func(ctx context.Context, params dns.ServerForwarderUpdateParams)) *MockAPI_ServerForwarderUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ServerForwarderUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerForwarderUpdate_Call) Return(_a0 dns.ServerForwarder, _a1 error) *MockAPI_ServerForwarderUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerForwarderUpdate_Call) RunAndReturn(run func(context.Context, dns.ServerForwarderUpdateParams) (dns.ServerForwarder, error)) *MockAPI_ServerForwarderUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerRecursionRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerRecursionRead(ctx context.Context) (dns.ServerRecursion, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerRecursionRead")
+	}
+
+	var r0 dns.ServerRecursion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerRecursion, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerRecursion); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerRecursion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerRecursionRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerRecursionRead'
+type MockAPI_ServerRecursionRead_Call struct {
+	*mock.Call
+}
+
+// ServerRecursionRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerRecursionRead(ctx interface{}) *MockAPI_ServerRecursionRead_Call {
+	return &MockAPI_ServerRecursionRead_Call{Call: _e.mock.On("ServerRecursionRead", ctx)}
+}
+
+func (_c *MockAPI_ServerRecursionRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerRecursionRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerRecursionRead_Call) Return(_a0 dns.ServerRecursion, _a1 error) *MockAPI_ServerRecursionRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerRecursionRead_Call) RunAndReturn(run func(context.Context) (dns.ServerRecursion, error)) *MockAPI_ServerRecursionRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerRecursionUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerRecursionUpdate(ctx context.Context, params dns.ServerRecursionUpdateParams) (dns.ServerRecursion, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerRecursionUpdate")
+	}
+
+	var r0 dns.ServerRecursion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerRecursionUpdateParams) (dns.ServerRecursion, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerRecursionUpdateParams) dns.ServerRecursion); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ServerRecursion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ServerRecursionUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerRecursionUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerRecursionUpdate'
+type MockAPI_ServerRecursionUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerRecursionUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ServerRecursionUpdateParams
+func (_e *MockAPI_Expecter) ServerRecursionUpdate(ctx interface{}, params interface{}) *MockAPI_ServerRecursionUpdate_Call {
+	return &MockAPI_ServerRecursionUpdate_Call{Call: _e.mock.On("ServerRecursionUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerRecursionUpdate_Call) Run(run func(ctx context.Context, params dns.ServerRecursionUpdateParams)) *MockAPI_ServerRecursionUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ServerRecursionUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerRecursionUpdate_Call) Return(_a0 dns.ServerRecursion, _a1 error) *MockAPI_ServerRecursionUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerRecursionUpdate_Call) RunAndReturn(run func(context.Context, dns.ServerRecursionUpdateParams) (dns.ServerRecursion, error)) *MockAPI_ServerRecursionUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerScavengingRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerScavengingRead(ctx context.Context) (dns.ServerScavenging, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerScavengingRead")
+	}
+
+	var r0 dns.ServerScavenging
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerScavenging, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerScavenging); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerScavenging)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerScavengingRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerScavengingRead'
+type MockAPI_ServerScavengingRead_Call struct {
+	*mock.Call
+}
+
+// ServerScavengingRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerScavengingRead(ctx interface{}) *MockAPI_ServerScavengingRead_Call {
+	return &MockAPI_ServerScavengingRead_Call{Call: _e.mock.On("ServerScavengingRead", ctx)}
+}
+
+func (_c *MockAPI_ServerScavengingRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerScavengingRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerScavengingRead_Call) Return(_a0 dns.ServerScavenging, _a1 error) *MockAPI_ServerScavengingRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerScavengingRead_Call) RunAndReturn(run func(context.Context) (dns.ServerScavenging, error)) *MockAPI_ServerScavengingRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerScavengingStart provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerScavengingStart(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerScavengingStart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ServerScavengingStart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerScavengingStart'
+type MockAPI_ServerScavengingStart_Call struct {
+	*mock.Call
+}
+
+// ServerScavengingStart is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerScavengingStart(ctx interface{}) *MockAPI_ServerScavengingStart_Call {
+	return &MockAPI_ServerScavengingStart_Call{Call: _e.mock.On("ServerScavengingStart", ctx)}
+}
+
+func (_c *MockAPI_ServerScavengingStart_Call) Run(run func(ctx context.Context)) *MockAPI_ServerScavengingStart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerScavengingStart_Call) Return(_a0 error) *MockAPI_ServerScavengingStart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ServerScavengingStart_Call) RunAndReturn(run func(context.Context) error) *MockAPI_ServerScavengingStart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerScavengingUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerScavengingUpdate(ctx context.Context, params dns.ServerScavengingUpdateParams) (dns.ServerScavenging, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerScavengingUpdate")
+	}
+
+	var r0 dns.ServerScavenging
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerScavengingUpdateParams) (dns.ServerScavenging, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerScavengingUpdateParams) dns.ServerScavenging); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ServerScavenging)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ServerScavengingUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerScavengingUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerScavengingUpdate'
+type MockAPI_ServerScavengingUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerScavengingUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ServerScavengingUpdateParams
+func (_e *MockAPI_Expecter) ServerScavengingUpdate(ctx interface{}, params interface{}) *MockAPI_ServerScavengingUpdate_Call {
+	return &MockAPI_ServerScavengingUpdate_Call{Call: _e.mock.On("ServerScavengingUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerScavengingUpdate_Call) Run(run func(ctx context.Context, params dns.ServerScavengingUpdateParams)) *MockAPI_ServerScavengingUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ServerScavengingUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerScavengingUpdate_Call) Return(_a0 dns.ServerScavenging, _a1 error) *MockAPI_ServerScavengingUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerScavengingUpdate_Call) RunAndReturn(run func(context.Context, dns.ServerScavengingUpdateParams) (dns.ServerScavenging, error)) *MockAPI_ServerScavengingUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerSettingRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerSettingRead(ctx context.Context) (dns.ServerSetting, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerSettingRead")
+	}
+
+	var r0 dns.ServerSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerSetting, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerSetting); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerSettingRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerSettingRead'
+type MockAPI_ServerSettingRead_Call struct {
+	*mock.Call
+}
+
+// ServerSettingRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerSettingRead(ctx interface{}) *MockAPI_ServerSettingRead_Call {
+	return &MockAPI_ServerSettingRead_Call{Call: _e.mock.On("ServerSettingRead", ctx)}
+}
+
+func (_c *MockAPI_ServerSettingRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerSettingRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerSettingRead_Call) Return(_a0 dns.ServerSetting, _a1 error) *MockAPI_ServerSettingRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerSettingRead_Call) RunAndReturn(run func(context.Context) (dns.ServerSetting, error)) *MockAPI_ServerSettingRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerSettingUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerSettingUpdate(ctx context.Context, params dns.ServerSettingUpdateParams) (dns.ServerSetting, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerSettingUpdate")
+	}
+
+	var r0 dns.ServerSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerSettingUpdateParams) (dns.ServerSetting, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ServerSettingUpdateParams) dns.ServerSetting); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ServerSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ServerSettingUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerSettingUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerSettingUpdate'
+type MockAPI_ServerSettingUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerSettingUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ServerSettingUpdateParams
+func (_e *MockAPI_Expecter) ServerSettingUpdate(ctx interface{}, params interface{}) *MockAPI_ServerSettingUpdate_Call {
+	return &MockAPI_ServerSettingUpdate_Call{Call: _e.mock.On("ServerSettingUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerSettingUpdate_Call) Run(run func(ctx context.Context, params dns.ServerSettingUpdateParams)) *MockAPI_ServerSettingUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ServerSettingUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerSettingUpdate_Call) Return(_a0 dns.ServerSetting, _a1 error) *MockAPI_ServerSettingUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerSettingUpdate_Call) RunAndReturn(run func(context.Context, dns.ServerSettingUpdateParams) (dns.ServerSetting, error)) *MockAPI_ServerSettingUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerStatisticsClear provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerStatisticsClear(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerStatisticsClear")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ServerStatisticsClear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerStatisticsClear'
+type MockAPI_ServerStatisticsClear_Call struct {
+	*mock.Call
+}
+
+// ServerStatisticsClear is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerStatisticsClear(ctx interface{}) *MockAPI_ServerStatisticsClear_Call {
+	return &MockAPI_ServerStatisticsClear_Call{Call: _e.mock.On("ServerStatisticsClear", ctx)}
+}
+
+func (_c *MockAPI_ServerStatisticsClear_Call) Run(run func(ctx context.Context)) *MockAPI_ServerStatisticsClear_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerStatisticsClear_Call) Return(_a0 error) *MockAPI_ServerStatisticsClear_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ServerStatisticsClear_Call) RunAndReturn(run func(context.Context) error) *MockAPI_ServerStatisticsClear_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerStatisticsRead provides a mock function with given fields: ctx
+func (_m *MockAPI) ServerStatisticsRead(ctx context.Context) (dns.ServerStatistics, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerStatisticsRead")
+	}
+
+	var r0 dns.ServerStatistics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dns.ServerStatistics, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dns.ServerStatistics); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dns.ServerStatistics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerStatisticsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerStatisticsRead'
+type MockAPI_ServerStatisticsRead_Call struct {
+	*mock.Call
+}
+
+// ServerStatisticsRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ServerStatisticsRead(ctx interface{}) *MockAPI_ServerStatisticsRead_Call {
+	return &MockAPI_ServerStatisticsRead_Call{Call: _e.mock.On("ServerStatisticsRead", ctx)}
+}
+
+func (_c *MockAPI_ServerStatisticsRead_Call) Run(run func(ctx context.Context)) *MockAPI_ServerStatisticsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerStatisticsRead_Call) Return(_a0 dns.ServerStatistics, _a1 error) *MockAPI_ServerStatisticsRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerStatisticsRead_Call) RunAndReturn(run func(context.Context) (dns.ServerStatistics, error)) *MockAPI_ServerStatisticsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeyCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) SigningKeyCreate(ctx context.Context, params dns.SigningKeyCreateParams) (dns.SigningKey, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeyCreate")
+	}
+
+	var r0 dns.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyCreateParams) (dns.SigningKey, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyCreateParams) dns.SigningKey); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.SigningKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.SigningKeyCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_SigningKeyCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeyCreate'
+type MockAPI_SigningKeyCreate_Call struct {
+	*mock.Call
+}
+
+// SigningKeyCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.SigningKeyCreateParams
+func (_e *MockAPI_Expecter) SigningKeyCreate(ctx interface{}, params interface{}) *MockAPI_SigningKeyCreate_Call {
+	return &MockAPI_SigningKeyCreate_Call{Call: _e.mock.On("SigningKeyCreate", ctx, params)}
+}
+
+func (_c *MockAPI_SigningKeyCreate_Call) Run(run func(ctx context.Context, params dns.SigningKeyCreateParams)) *MockAPI_SigningKeyCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.SigningKeyCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyCreate_Call) Return(_a0 dns.SigningKey, _a1 error) *MockAPI_SigningKeyCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyCreate_Call) RunAndReturn(run func(context.Context, dns.SigningKeyCreateParams) (dns.SigningKey, error)) *MockAPI_SigningKeyCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeyDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) SigningKeyDelete(ctx context.Context, params dns.SigningKeyDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeyDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_SigningKeyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeyDelete'
+type MockAPI_SigningKeyDelete_Call struct {
+	*mock.Call
+}
+
+// SigningKeyDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.SigningKeyDeleteParams
+func (_e *MockAPI_Expecter) SigningKeyDelete(ctx interface{}, params interface{}) *MockAPI_SigningKeyDelete_Call {
+	return &MockAPI_SigningKeyDelete_Call{Call: _e.mock.On("SigningKeyDelete", ctx, params)}
+}
+
+func (_c *MockAPI_SigningKeyDelete_Call) Run(run func(ctx context.Context, params dns.SigningKeyDeleteParams)) *MockAPI_SigningKeyDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.SigningKeyDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyDelete_Call) Return(_a0 error) *MockAPI_SigningKeyDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyDelete_Call) RunAndReturn(run func(context.Context, dns.SigningKeyDeleteParams) error) *MockAPI_SigningKeyDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeyList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) SigningKeyList(ctx context.Context, params dns.SigningKeyListParams) ([]dns.SigningKey, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeyList")
+	}
+
+	var r0 []dns.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyListParams) ([]dns.SigningKey, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyListParams) []dns.SigningKey); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.SigningKeyListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_SigningKeyList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeyList'
+type MockAPI_SigningKeyList_Call struct {
+	*mock.Call
+}
+
+// SigningKeyList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.SigningKeyListParams
+func (_e *MockAPI_Expecter) SigningKeyList(ctx interface{}, params interface{}) *MockAPI_SigningKeyList_Call {
+	return &MockAPI_SigningKeyList_Call{Call: _e.mock.On("SigningKeyList", ctx, params)}
+}
+
+func (_c *MockAPI_SigningKeyList_Call) Run(run func(ctx context.Context, params dns.SigningKeyListParams)) *MockAPI_SigningKeyList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.SigningKeyListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyList_Call) Return(_a0 []dns.SigningKey, _a1 error) *MockAPI_SigningKeyList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyList_Call) RunAndReturn(run func(context.Context, dns.SigningKeyListParams) ([]dns.SigningKey, error)) *MockAPI_SigningKeyList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeyRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) SigningKeyRead(ctx context.Context, params dns.SigningKeyReadParams) (dns.SigningKey, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeyRead")
+	}
+
+	var r0 dns.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyReadParams) (dns.SigningKey, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyReadParams) dns.SigningKey); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.SigningKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.SigningKeyReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_SigningKeyRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeyRead'
+type MockAPI_SigningKeyRead_Call struct {
+	*mock.Call
+}
+
+// SigningKeyRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.SigningKeyReadParams
+func (_e *MockAPI_Expecter) SigningKeyRead(ctx interface{}, params interface{}) *MockAPI_SigningKeyRead_Call {
+	return &MockAPI_SigningKeyRead_Call{Call: _e.mock.On("SigningKeyRead", ctx, params)}
+}
+
+func (_c *MockAPI_SigningKeyRead_Call) Run(run func(ctx context.Context, params dns.SigningKeyReadParams)) *MockAPI_SigningKeyRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.SigningKeyReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyRead_Call) Return(_a0 dns.SigningKey, _a1 error) *MockAPI_SigningKeyRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyRead_Call) RunAndReturn(run func(context.Context, dns.SigningKeyReadParams) (dns.SigningKey, error)) *MockAPI_SigningKeyRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeyUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) SigningKeyUpdate(ctx context.Context, params dns.SigningKeyUpdateParams) (dns.SigningKey, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeyUpdate")
+	}
+
+	var r0 dns.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyUpdateParams) (dns.SigningKey, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.SigningKeyUpdateParams) dns.SigningKey); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.SigningKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.SigningKeyUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_SigningKeyUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeyUpdate'
+type MockAPI_SigningKeyUpdate_Call struct {
+	*mock.Call
+}
+
+// SigningKeyUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.SigningKeyUpdateParams
+func (_e *MockAPI_Expecter) SigningKeyUpdate(ctx interface{}, params interface{}) *MockAPI_SigningKeyUpdate_Call {
+	return &MockAPI_SigningKeyUpdate_Call{Call: _e.mock.On("SigningKeyUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_SigningKeyUpdate_Call) Run(run func(ctx context.Context, params dns.SigningKeyUpdateParams)) *MockAPI_SigningKeyUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.SigningKeyUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyUpdate_Call) Return(_a0 dns.SigningKey, _a1 error) *MockAPI_SigningKeyUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_SigningKeyUpdate_Call) RunAndReturn(run func(context.Context, dns.SigningKeyUpdateParams) (dns.SigningKey, error)) *MockAPI_SigningKeyUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrustAnchorList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) TrustAnchorList(ctx context.Context, params dns.TrustAnchorListParams) ([]dns.TrustAnchor, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrustAnchorList")
+	}
+
+	var r0 []dns.TrustAnchor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.TrustAnchorListParams) ([]dns.TrustAnchor, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.TrustAnchorListParams) []dns.TrustAnchor); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.TrustAnchor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.TrustAnchorListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_TrustAnchorList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrustAnchorList'
+type MockAPI_TrustAnchorList_Call struct {
+	*mock.Call
+}
+
+// TrustAnchorList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.TrustAnchorListParams
+func (_e *MockAPI_Expecter) TrustAnchorList(ctx interface{}, params interface{}) *MockAPI_TrustAnchorList_Call {
+	return &MockAPI_TrustAnchorList_Call{Call: _e.mock.On("TrustAnchorList", ctx, params)}
+}
+
+func (_c *MockAPI_TrustAnchorList_Call) Run(run func(ctx context.Context, params dns.TrustAnchorListParams)) *MockAPI_TrustAnchorList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.TrustAnchorListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_TrustAnchorList_Call) Return(_a0 []dns.TrustAnchor, _a1 error) *MockAPI_TrustAnchorList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_TrustAnchorList_Call) RunAndReturn(run func(context.Context, dns.TrustAnchorListParams) ([]dns.TrustAnchor, error)) *MockAPI_TrustAnchorList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneAgingRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneAgingRead(ctx context.Context, params dns.ZoneAgingReadParams) (dns.ZoneAging, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneAgingRead")
+	}
+
+	var r0 dns.ZoneAging
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneAgingReadParams) (dns.ZoneAging, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneAgingReadParams) dns.ZoneAging); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneAging)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneAgingReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneAgingRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneAgingRead'
+type MockAPI_ZoneAgingRead_Call struct {
+	*mock.Call
+}
+
+// ZoneAgingRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneAgingReadParams
+func (_e *MockAPI_Expecter) ZoneAgingRead(ctx interface{}, params interface{}) *MockAPI_ZoneAgingRead_Call {
+	return &MockAPI_ZoneAgingRead_Call{Call: _e.mock.On("ZoneAgingRead", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneAgingRead_Call) Run(run func(ctx context.Context, params dns.ZoneAgingReadParams)) *MockAPI_ZoneAgingRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneAgingReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneAgingRead_Call) Return(_a0 dns.ZoneAging, _a1 error) *MockAPI_ZoneAgingRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneAgingRead_Call) RunAndReturn(run func(context.Context, dns.ZoneAgingReadParams) (dns.ZoneAging, error)) *MockAPI_ZoneAgingRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneAgingUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneAgingUpdate(ctx context.Context, params dns.ZoneAgingUpdateParams) (dns.ZoneAging, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneAgingUpdate")
+	}
+
+	var r0 dns.ZoneAging
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneAgingUpdateParams) (dns.ZoneAging, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneAgingUpdateParams) dns.ZoneAging); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneAging)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneAgingUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneAgingUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneAgingUpdate'
+type MockAPI_ZoneAgingUpdate_Call struct {
+	*mock.Call
+}
+
+// ZoneAgingUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneAgingUpdateParams
+func (_e *MockAPI_Expecter) ZoneAgingUpdate(ctx interface{}, params interface{}) *MockAPI_ZoneAgingUpdate_Call {
+	return &MockAPI_ZoneAgingUpdate_Call{Call: _e.mock.On("ZoneAgingUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneAgingUpdate_Call) Run(run func(ctx context.Context, params dns.ZoneAgingUpdateParams)) *MockAPI_ZoneAgingUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneAgingUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneAgingUpdate_Call) Return(_a0 dns.ZoneAging, _a1 error) *MockAPI_ZoneAgingUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneAgingUpdate_Call) RunAndReturn(run func(context.Context, dns.ZoneAgingUpdateParams) (dns.ZoneAging, error)) *MockAPI_ZoneAgingUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneCreate(ctx context.Context, params dns.ZoneCreateParams) (dns.Zone, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneCreate")
+	}
+
+	var r0 dns.Zone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneCreateParams) (dns.Zone, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneCreateParams) dns.Zone); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Zone)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneCreate'
+type MockAPI_ZoneCreate_Call struct {
+	*mock.Call
+}
+
+// ZoneCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneCreateParams
+func (_e *MockAPI_Expecter) ZoneCreate(ctx interface{}, params interface{}) *MockAPI_ZoneCreate_Call {
+	return &MockAPI_ZoneCreate_Call{Call: _e.mock.On("ZoneCreate", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneCreate_Call) Run(run func(ctx context.Context, params dns.ZoneCreateParams)) *MockAPI_ZoneCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneCreate_Call) Return(_a0 dns.Zone, _a1 error) *MockAPI_ZoneCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneCreate_Call) RunAndReturn(run func(context.Context, dns.ZoneCreateParams) (dns.Zone, error)) *MockAPI_ZoneCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneDelegationCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneDelegationCreate(ctx context.Context, params dns.ZoneDelegationCreateParams) (dns.ZoneDelegation, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneDelegationCreate")
+	}
+
+	var r0 dns.ZoneDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationCreateParams) (dns.ZoneDelegation, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationCreateParams) dns.ZoneDelegation); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneDelegation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneDelegationCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneDelegationCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneDelegationCreate'
+type MockAPI_ZoneDelegationCreate_Call struct {
+	*mock.Call
+}
+
+// ZoneDelegationCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneDelegationCreateParams
+func (_e *MockAPI_Expecter) ZoneDelegationCreate(ctx interface{}, params interface{}) *MockAPI_ZoneDelegationCreate_Call {
+	return &MockAPI_ZoneDelegationCreate_Call{Call: _e.mock.On("ZoneDelegationCreate", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneDelegationCreate_Call) Run(run func(ctx context.Context, params dns.ZoneDelegationCreateParams)) *MockAPI_ZoneDelegationCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneDelegationCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationCreate_Call) Return(_a0 dns.ZoneDelegation, _a1 error) *MockAPI_ZoneDelegationCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationCreate_Call) RunAndReturn(run func(context.Context, dns.ZoneDelegationCreateParams) (dns.ZoneDelegation, error)) *MockAPI_ZoneDelegationCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneDelegationDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneDelegationDelete(ctx context.Context, params dns.ZoneDelegationDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneDelegationDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ZoneDelegationDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneDelegationDelete'
+type MockAPI_ZoneDelegationDelete_Call struct {
+	*mock.Call
+}
+
+// ZoneDelegationDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneDelegationDeleteParams
+func (_e *MockAPI_Expecter) ZoneDelegationDelete(ctx interface{}, params interface{}) *MockAPI_ZoneDelegationDelete_Call {
+	return &MockAPI_ZoneDelegationDelete_Call{Call: _e.mock.On("ZoneDelegationDelete", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneDelegationDelete_Call) Run(run func(ctx context.Context, params dns.ZoneDelegationDeleteParams)) *MockAPI_ZoneDelegationDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneDelegationDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationDelete_Call) Return(_a0 error) *MockAPI_ZoneDelegationDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationDelete_Call) RunAndReturn(run func(context.Context, dns.ZoneDelegationDeleteParams) error) *MockAPI_ZoneDelegationDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneDelegationRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneDelegationRead(ctx context.Context, params dns.ZoneDelegationReadParams) (dns.ZoneDelegation, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneDelegationRead")
+	}
+
+	var r0 dns.ZoneDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationReadParams) (dns.ZoneDelegation, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationReadParams) dns.ZoneDelegation); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneDelegation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneDelegationReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneDelegationRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneDelegationRead'
+type MockAPI_ZoneDelegationRead_Call struct {
+	*mock.Call
+}
+
+// ZoneDelegationRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneDelegationReadParams
+func (_e *MockAPI_Expecter) ZoneDelegationRead(ctx interface{}, params interface{}) *MockAPI_ZoneDelegationRead_Call {
+	return &MockAPI_ZoneDelegationRead_Call{Call: _e.mock.On("ZoneDelegationRead", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneDelegationRead_Call) Run(run func(ctx context.Context, params dns.ZoneDelegationReadParams)) *MockAPI_ZoneDelegationRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneDelegationReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationRead_Call) Return(_a0 dns.ZoneDelegation, _a1 error) *MockAPI_ZoneDelegationRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationRead_Call) RunAndReturn(run func(context.Context, dns.ZoneDelegationReadParams) (dns.ZoneDelegation, error)) *MockAPI_ZoneDelegationRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneDelegationUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneDelegationUpdate(ctx context.Context, params dns.ZoneDelegationUpdateParams) (dns.ZoneDelegation, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneDelegationUpdate")
+	}
+
+	var r0 dns.ZoneDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationUpdateParams) (dns.ZoneDelegation, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDelegationUpdateParams) dns.ZoneDelegation); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneDelegation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneDelegationUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneDelegationUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneDelegationUpdate'
+type MockAPI_ZoneDelegationUpdate_Call struct {
+	*mock.Call
+}
+
+// ZoneDelegationUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneDelegationUpdateParams
+func (_e *MockAPI_Expecter) ZoneDelegationUpdate(ctx interface{}, params interface{}) *MockAPI_ZoneDelegationUpdate_Call {
+	return &MockAPI_ZoneDelegationUpdate_Call{Call: _e.mock.On("ZoneDelegationUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneDelegationUpdate_Call) Run(run func(ctx context.Context, params dns.ZoneDelegationUpdateParams)) *MockAPI_ZoneDelegationUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneDelegationUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationUpdate_Call) Return(_a0 dns.ZoneDelegation, _a1 error) *MockAPI_ZoneDelegationUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelegationUpdate_Call) RunAndReturn(run func(context.Context, dns.ZoneDelegationUpdateParams) (dns.ZoneDelegation, error)) *MockAPI_ZoneDelegationUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneDelete(ctx context.Context, params dns.ZoneDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ZoneDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneDelete'
+type MockAPI_ZoneDelete_Call struct {
+	*mock.Call
+}
+
+// ZoneDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneDeleteParams
+func (_e *MockAPI_Expecter) ZoneDelete(ctx interface{}, params interface{}) *MockAPI_ZoneDelete_Call {
+	return &MockAPI_ZoneDelete_Call{Call: _e.mock.On("ZoneDelete", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneDelete_Call) Run(run func(ctx context.Context, params dns.ZoneDeleteParams)) *MockAPI_ZoneDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelete_Call) Return(_a0 error) *MockAPI_ZoneDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ZoneDelete_Call) RunAndReturn(run func(context.Context, dns.ZoneDeleteParams) error) *MockAPI_ZoneDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneDsRecordList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneDsRecordList(ctx context.Context, params dns.ZoneDsRecordListParams) ([]dns.DsRecord, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneDsRecordList")
+	}
+
+	var r0 []dns.DsRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDsRecordListParams) ([]dns.DsRecord, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneDsRecordListParams) []dns.DsRecord); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.DsRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneDsRecordListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneDsRecordList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneDsRecordList'
+type MockAPI_ZoneDsRecordList_Call struct {
+	*mock.Call
+}
+
+// ZoneDsRecordList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneDsRecordListParams
+func (_e *MockAPI_Expecter) ZoneDsRecordList(ctx interface{}, params interface{}) *MockAPI_ZoneDsRecordList_Call {
+	return &MockAPI_ZoneDsRecordList_Call{Call: _e.mock.On("ZoneDsRecordList", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneDsRecordList_Call) Run(run func(ctx context.Context, params dns.ZoneDsRecordListParams)) *MockAPI_ZoneDsRecordList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneDsRecordListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneDsRecordList_Call) Return(_a0 []dns.DsRecord, _a1 error) *MockAPI_ZoneDsRecordList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneDsRecordList_Call) RunAndReturn(run func(context.Context, dns.ZoneDsRecordListParams) ([]dns.DsRecord, error)) *MockAPI_ZoneDsRecordList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneExport provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneExport(ctx context.Context, params dns.ZoneExportParams) (string, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneExport")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneExportParams) (string, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneExportParams) string); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneExportParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneExport'
+type MockAPI_ZoneExport_Call struct {
+	*mock.Call
+}
+
+// ZoneExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneExportParams
+func (_e *MockAPI_Expecter) ZoneExport(ctx interface{}, params interface{}) *MockAPI_ZoneExport_Call {
+	return &MockAPI_ZoneExport_Call{Call: _e.mock.On("ZoneExport", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneExport_Call) Run(run func(ctx context.Context, params dns.ZoneExportParams)) *MockAPI_ZoneExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneExportParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneExport_Call) Return(_a0 string, _a1 error) *MockAPI_ZoneExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneExport_Call) RunAndReturn(run func(context.Context, dns.ZoneExportParams) (string, error)) *MockAPI_ZoneExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneImport provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneImport(ctx context.Context, params dns.ZoneImportParams) (dns.Records, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneImport")
+	}
+
+	var r0 dns.Records
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneImportParams) (dns.Records, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneImportParams) dns.Records); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Records)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneImportParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneImport'
+type MockAPI_ZoneImport_Call struct {
+	*mock.Call
+}
+
+// ZoneImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneImportParams
+func (_e *MockAPI_Expecter) ZoneImport(ctx interface{}, params interface{}) *MockAPI_ZoneImport_Call {
+	return &MockAPI_ZoneImport_Call{Call: _e.mock.On("ZoneImport", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneImport_Call) Run(run func(ctx context.Context, params dns.ZoneImportParams)) *MockAPI_ZoneImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneImportParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneImport_Call) Return(_a0 dns.Records, _a1 error) *MockAPI_ZoneImport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneImport_Call) RunAndReturn(run func(context.Context, dns.ZoneImportParams) (dns.Records, error)) *MockAPI_ZoneImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneList provides a mock function with given fields: ctx
+func (_m *MockAPI) ZoneList(ctx context.Context) ([]dns.Zone, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneList")
+	}
+
+	var r0 []dns.Zone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dns.Zone, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dns.Zone); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.Zone)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneList'
+type MockAPI_ZoneList_Call struct {
+	*mock.Call
+}
+
+// ZoneList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) ZoneList(ctx interface{}) *MockAPI_ZoneList_Call {
+	return &MockAPI_ZoneList_Call{Call: _e.mock.On("ZoneList", ctx)}
+}
+
+func (_c *MockAPI_ZoneList_Call) Run(run func(ctx context.Context)) *MockAPI_ZoneList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneList_Call) Return(_a0 []dns.Zone, _a1 error) *MockAPI_ZoneList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneList_Call) RunAndReturn(run func(context.Context) ([]dns.Zone, error)) *MockAPI_ZoneList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneRead(ctx context.Context, params dns.ZoneReadParams) (dns.Zone, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneRead")
+	}
+
+	var r0 dns.Zone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneReadParams) (dns.Zone, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneReadParams) dns.Zone); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Zone)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneRead'
+type MockAPI_ZoneRead_Call struct {
+	*mock.Call
+}
+
+// ZoneRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneReadParams
+func (_e *MockAPI_Expecter) ZoneRead(ctx interface{}, params interface{}) *MockAPI_ZoneRead_Call {
+	return &MockAPI_ZoneRead_Call{Call: _e.mock.On("ZoneRead", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneRead_Call) Run(run func(ctx context.Context, params dns.ZoneReadParams)) *MockAPI_ZoneRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneRead_Call) Return(_a0 dns.Zone, _a1 error) *MockAPI_ZoneRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneRead_Call) RunAndReturn(run func(context.Context, dns.ZoneReadParams) (dns.Zone, error)) *MockAPI_ZoneRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneScopeCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneScopeCreate(ctx context.Context, params dns.ZoneScopeCreateParams) (dns.ZoneScope, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneScopeCreate")
+	}
+
+	var r0 dns.ZoneScope
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeCreateParams) (dns.ZoneScope, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeCreateParams) dns.ZoneScope); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneScope)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneScopeCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneScopeCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneScopeCreate'
+type MockAPI_ZoneScopeCreate_Call struct {
+	*mock.Call
+}
+
+// ZoneScopeCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneScopeCreateParams
+func (_e *MockAPI_Expecter) ZoneScopeCreate(ctx interface{}, params interface{}) *MockAPI_ZoneScopeCreate_Call {
+	return &MockAPI_ZoneScopeCreate_Call{Call: _e.mock.On("ZoneScopeCreate", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneScopeCreate_Call) Run(run func(ctx context.Context, params dns.ZoneScopeCreateParams)) *MockAPI_ZoneScopeCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneScopeCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeCreate_Call) Return(_a0 dns.ZoneScope, _a1 error) *MockAPI_ZoneScopeCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeCreate_Call) RunAndReturn(run func(context.Context, dns.ZoneScopeCreateParams) (dns.ZoneScope, error)) *MockAPI_ZoneScopeCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneScopeDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneScopeDelete(ctx context.Context, params dns.ZoneScopeDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneScopeDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_ZoneScopeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneScopeDelete'
+type MockAPI_ZoneScopeDelete_Call struct {
+	*mock.Call
+}
+
+// ZoneScopeDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneScopeDeleteParams
+func (_e *MockAPI_Expecter) ZoneScopeDelete(ctx interface{}, params interface{}) *MockAPI_ZoneScopeDelete_Call {
+	return &MockAPI_ZoneScopeDelete_Call{Call: _e.mock.On("ZoneScopeDelete", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneScopeDelete_Call) Run(run func(ctx context.Context, params dns.ZoneScopeDeleteParams)) *MockAPI_ZoneScopeDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneScopeDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeDelete_Call) Return(_a0 error) *MockAPI_ZoneScopeDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeDelete_Call) RunAndReturn(run func(context.Context, dns.ZoneScopeDeleteParams) error) *MockAPI_ZoneScopeDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneScopeList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneScopeList(ctx context.Context, params dns.ZoneScopeListParams) ([]dns.ZoneScope, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneScopeList")
+	}
+
+	var r0 []dns.ZoneScope
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeListParams) ([]dns.ZoneScope, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeListParams) []dns.ZoneScope); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dns.ZoneScope)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneScopeListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneScopeList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneScopeList'
+type MockAPI_ZoneScopeList_Call struct {
+	*mock.Call
+}
+
+// ZoneScopeList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneScopeListParams
+func (_e *MockAPI_Expecter) ZoneScopeList(ctx interface{}, params interface{}) *MockAPI_ZoneScopeList_Call {
+	return &MockAPI_ZoneScopeList_Call{Call: _e.mock.On("ZoneScopeList", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneScopeList_Call) Run(run func(ctx context.Context, params dns.ZoneScopeListParams)) *MockAPI_ZoneScopeList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneScopeListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeList_Call) Return(_a0 []dns.ZoneScope, _a1 error) *MockAPI_ZoneScopeList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeList_Call) RunAndReturn(run func(context.Context, dns.ZoneScopeListParams) ([]dns.ZoneScope, error)) *MockAPI_ZoneScopeList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneScopeRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneScopeRead(ctx context.Context, params dns.ZoneScopeReadParams) (dns.ZoneScope, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneScopeRead")
+	}
+
+	var r0 dns.ZoneScope
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeReadParams) (dns.ZoneScope, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneScopeReadParams) dns.ZoneScope); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.ZoneScope)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneScopeReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneScopeRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneScopeRead'
+type MockAPI_ZoneScopeRead_Call struct {
+	*mock.Call
+}
+
+// ZoneScopeRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneScopeReadParams
+func (_e *MockAPI_Expecter) ZoneScopeRead(ctx interface{}, params interface{}) *MockAPI_ZoneScopeRead_Call {
+	return &MockAPI_ZoneScopeRead_Call{Call: _e.mock.On("ZoneScopeRead", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneScopeRead_Call) Run(run func(ctx context.Context, params dns.ZoneScopeReadParams)) *MockAPI_ZoneScopeRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneScopeReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeRead_Call) Return(_a0 dns.ZoneScope, _a1 error) *MockAPI_ZoneScopeRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneScopeRead_Call) RunAndReturn(run func(context.Context, dns.ZoneScopeReadParams) (dns.ZoneScope, error)) *MockAPI_ZoneScopeRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneSign provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneSign(ctx context.Context, params dns.ZoneSignParams) (dns.Zone, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneSign")
+	}
+
+	var r0 dns.Zone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneSignParams) (dns.Zone, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneSignParams) dns.Zone); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Zone)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneSignParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneSign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneSign'
+type MockAPI_ZoneSign_Call struct {
+	*mock.Call
+}
+
+// ZoneSign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneSignParams
+func (_e *MockAPI_Expecter) ZoneSign(ctx interface{}, params interface{}) *MockAPI_ZoneSign_Call {
+	return &MockAPI_ZoneSign_Call{Call: _e.mock.On("ZoneSign", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneSign_Call) Run(run func(ctx context.Context, params dns.ZoneSignParams)) *MockAPI_ZoneSign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneSignParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneSign_Call) Return(_a0 dns.Zone, _a1 error) *MockAPI_ZoneSign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneSign_Call) RunAndReturn(run func(context.Context, dns.ZoneSignParams) (dns.Zone, error)) *MockAPI_ZoneSign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneUnsign provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneUnsign(ctx context.Context, params dns.ZoneUnsignParams) (dns.Zone, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneUnsign")
+	}
+
+	var r0 dns.Zone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneUnsignParams) (dns.Zone, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneUnsignParams) dns.Zone); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Zone)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneUnsignParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneUnsign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneUnsign'
+type MockAPI_ZoneUnsign_Call struct {
+	*mock.Call
+}
+
+// ZoneUnsign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneUnsignParams
+func (_e *MockAPI_Expecter) ZoneUnsign(ctx interface{}, params interface{}) *MockAPI_ZoneUnsign_Call {
+	return &MockAPI_ZoneUnsign_Call{Call: _e.mock.On("ZoneUnsign", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneUnsign_Call) Run(run func(ctx context.Context, params dns.ZoneUnsignParams)) *MockAPI_ZoneUnsign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneUnsignParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneUnsign_Call) Return(_a0 dns.Zone, _a1 error) *MockAPI_ZoneUnsign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneUnsign_Call) RunAndReturn(run func(context.Context, dns.ZoneUnsignParams) (dns.Zone, error)) *MockAPI_ZoneUnsign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ZoneUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ZoneUpdate(ctx context.Context, params dns.ZoneUpdateParams) (dns.Zone, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ZoneUpdate")
+	}
+
+	var r0 dns.Zone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneUpdateParams) (dns.Zone, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dns.ZoneUpdateParams) dns.Zone); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dns.Zone)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dns.ZoneUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ZoneUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ZoneUpdate'
+type MockAPI_ZoneUpdate_Call struct {
+	*mock.Call
+}
+
+// ZoneUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dns.ZoneUpdateParams
+func (_e *MockAPI_Expecter) ZoneUpdate(ctx interface{}, params interface{}) *MockAPI_ZoneUpdate_Call {
+	return &MockAPI_ZoneUpdate_Call{Call: _e.mock.On("ZoneUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ZoneUpdate_Call) Run(run func(ctx context.Context, params dns.ZoneUpdateParams)) *MockAPI_ZoneUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dns.ZoneUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ZoneUpdate_Call) Return(_a0 dns.Zone, _a1 error) *MockAPI_ZoneUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ZoneUpdate_Call) RunAndReturn(run func(context.Context, dns.ZoneUpdateParams) (dns.Zone, error)) *MockAPI_ZoneUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPI creates a new instance of MockAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPI {
+	mock := &MockAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,477 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// QueryResolutionPolicy represents a policy that controls how the DNS server answers queries.
+// A zone level policy answers the queries that match its criteria from the records of its zone scopes.
+// A server level policy applies to all zones of the server.
+type QueryResolutionPolicy struct {
+	Name            string
+	Zone            string
+	Action          string
+	ProcessingOrder uint32
+	IsEnabled       bool
+	Condition       string
+	Criteria        QueryResolutionPolicyCriteria
+	ZoneScopes      []QueryResolutionPolicyZoneScope
+}
+
+// QueryResolutionPolicyCriteria represents the criteria of a query resolution policy.
+// Every criteria consists of an operator and a comma separated list of values, e.g. "EQ,internal" or "NE,tcp".
+// The operator "EQ" matches any of the values, the operator "NE" matches none of the values.
+// Both operators can be combined with a semicolon, e.g. "EQ,internal;NE,guests".
+// Criteria that are not set are not checked.
+type QueryResolutionPolicyCriteria struct {
+	// Specifies the client subnets of the query, e.g. "EQ,internal".
+	ClientSubnet string
+
+	// Specifies the transport protocol of the query, e.g. "EQ,TCP".
+	TransportProtocol string
+
+	// Specifies the internet protocol of the query, e.g. "EQ,IPv6".
+	InternetProtocol string
+
+	// Specifies the interface of the server that received the query, e.g. "EQ,10.0.0.1".
+	ServerInterfaceIP string
+
+	// Specifies the names of the query, wildcards are allowed, e.g. "EQ,*.test.local".
+	FQDN string
+
+	// Specifies the record types of the query, e.g. "EQ,A,AAAA".
+	QType string
+
+	// Specifies the local time of the server, e.g. "EQ,08:00-18:00".
+	TimeOfDay string
+}
+
+// QueryResolutionPolicyZoneScope represents a zone scope that answers the queries of a policy.
+// Queries are distributed between multiple zone scopes according to their weights.
+type QueryResolutionPolicyZoneScope struct {
+	Name   string
+	Weight uint32
+}
+
+// Query resolution policy actions.
+const (
+	QueryResolutionPolicyActionAllow  string = "Allow"
+	QueryResolutionPolicyActionDeny   string = "Deny"
+	QueryResolutionPolicyActionIgnore string = "Ignore"
+)
+
+// policyObject contains the unmarshaled json of the powershell query resolution policy object.
+type policyObject struct {
+	Name            string                  `json:"Name"`
+	Action          string                  `json:"Action"`
+	ProcessingOrder uint32                  `json:"ProcessingOrder"`
+	IsEnabled       bool                    `json:"IsEnabled"`
+	Condition       string                  `json:"Condition"`
+	Criteria        []policyCriteriaObject  `json:"Criteria"`
+	Content         []policyZoneScopeObject `json:"Content"`
+}
+type policyCriteriaObject struct {
+	Type     string `json:"Type"`
+	Criteria string `json:"Criteria"`
+}
+type policyZoneScopeObject struct {
+	ScopeName string `json:"ScopeName"`
+	Weight    uint32 `json:"Weight"`
+}
+
+// policyProjection reduces the query resolution policy objects to their settings.
+// The enums are converted to their names, because ConvertTo-Json returns their numeric values.
+const policyProjection string = `ForEach-Object{[pscustomobject]@{Name=$_.Name;Action="$($_.Action)";ProcessingOrder=$_.ProcessingOrder;IsEnabled=$_.IsEnabled;Condition="$($_.Condition)";Criteria=@($_.Criteria | ForEach-Object{[pscustomobject]@{Type="$($_.CriteriaType)";Criteria=$_.Criteria}});Content=@($_.Content | ForEach-Object{[pscustomobject]@{ScopeName=$_.ScopeName;Weight=$_.Weight}})}}`
+
+// convertOutput converts the unmarshaled JSON output from the policyObject to a QueryResolutionPolicy object.
+func (p *QueryResolutionPolicy) convertOutput(o policyObject, zone string) error {
+	p.Name = o.Name
+	p.Zone = zone
+	p.Action = o.Action
+	p.ProcessingOrder = o.ProcessingOrder
+	p.IsEnabled = o.IsEnabled
+	p.Condition = o.Condition
+
+	for _, criteria := range o.Criteria {
+		switch strings.ToLower(criteria.Type) {
+		case "clientsubnet":
+			p.Criteria.ClientSubnet = criteria.Criteria
+		case "transportprotocol":
+			p.Criteria.TransportProtocol = criteria.Criteria
+		case "internetprotocol":
+			p.Criteria.InternetProtocol = criteria.Criteria
+		case "serverinterfaceip":
+			p.Criteria.ServerInterfaceIP = criteria.Criteria
+		case "fqdn":
+			p.Criteria.FQDN = criteria.Criteria
+		case "qtype":
+			p.Criteria.QType = criteria.Criteria
+		case "timeofday":
+			p.Criteria.TimeOfDay = criteria.Criteria
+		default:
+			return fmt.Errorf("unsupported policy criteria type '%s'", criteria.Type)
+		}
+	}
+
+	for _, content := range o.Content {
+		p.ZoneScopes = append(p.ZoneScopes, QueryResolutionPolicyZoneScope{Name: content.ScopeName, Weight: content.Weight})
+	}
+
+	return nil
+}
+
+// params returns the parameters of the criteria that are set.
+func (c QueryResolutionPolicyCriteria) params() []string {
+	cmd := []string{}
+
+	for _, criteria := range []struct{ name, value string }{
+		{"ClientSubnet", c.ClientSubnet},
+		{"TransportProtocol", c.TransportProtocol},
+		{"InternetProtocol", c.InternetProtocol},
+		{"ServerInterfaceIP", c.ServerInterfaceIP},
+		{"FQDN", c.FQDN},
+		{"QType", c.QType},
+		{"TimeOfDay", c.TimeOfDay},
+	} {
+		if criteria.value != "" {
+			cmd = append(cmd, fmt.Sprintf("-%s '%s'", criteria.name, criteria.value))
+		}
+	}
+
+	return cmd
+}
+
+// pwshZoneScopeList returns the zone scopes of a policy as PowerShell string, e.g. "'internal,1;external,2'".
+// Zone scopes without a weight get the weight 1.
+func pwshZoneScopeList(zoneScopes []QueryResolutionPolicyZoneScope) string {
+	scopeList := []string{}
+	for _, scope := range zoneScopes {
+		weight := scope.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		scopeList = append(scopeList, fmt.Sprintf("%s,%d", scope.Name, weight))
+	}
+	return fmt.Sprintf("'%s'", strings.Join(scopeList, ";"))
+}
+
+// pwshPolicyZoneName returns the zone parameter of a policy command.
+// Server level policies don't have a zone parameter.
+func pwshPolicyZoneName(zone string) string {
+	if zone == "" {
+		return ""
+	}
+	return fmt.Sprintf(" -ZoneName '%s'", zone)
+}
+
+// validatePolicyCondition returns an error if the condition of a policy is not valid.
+func validatePolicyCondition(condition string) error {
+	if condition != "" && !strings.EqualFold(condition, "AND") && !strings.EqualFold(condition, "OR") {
+		return fmt.Errorf("query resolution policy parameter 'Condition' must be 'AND' or 'OR', got '%s'", condition)
+	}
+	return nil
+}
+
+// QueryResolutionPolicyReadParams represents parameters for the QueryResolutionPolicyRead function.
+type QueryResolutionPolicyReadParams struct {
+	// Specifies the name of the policy.
+	Name string
+
+	// Specifies the zone of a zone level policy.
+	// If not provided, the server level policy is read.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to read a query resolution policy.
+func (params QueryResolutionPolicyReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsServerQueryResolutionPolicy -Name '%s'%s | %s | ConvertTo-Json -Compress", params.Name, pwshPolicyZoneName(params.Zone), policyProjection)
+}
+
+// QueryResolutionPolicyRead gets a query resolution policy by its name. It returns a QueryResolutionPolicy object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) QueryResolutionPolicyRead(ctx context.Context, params QueryResolutionPolicyReadParams) (QueryResolutionPolicy, error) {
+	var p QueryResolutionPolicy
+	var o policyObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return p, errors.New("windows.dns.QueryResolutionPolicyRead: query resolution policy parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyRead: %w", err)
+	}
+
+	// Convert the output to a QueryResolutionPolicy object.
+	if err := p.convertOutput(o, params.Zone); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyRead: %w", err)
+	}
+
+	return p, nil
+}
+
+// QueryResolutionPolicyListParams represents parameters for the QueryResolutionPolicyList function.
+type QueryResolutionPolicyListParams struct {
+	// Specifies the zone of the zone level policies.
+	// If not provided, the server level policies are listed.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to list the query resolution policies.
+func (params QueryResolutionPolicyListParams) pwshCommand() string {
+	return fmt.Sprintf("$p=@(Get-DnsServerQueryResolutionPolicy%s | %s) ;if($p.Count -ge 2){ConvertTo-Json $p -Compress}else{ConvertTo-Json @($p) -Compress}", pwshPolicyZoneName(params.Zone), policyProjection)
+}
+
+// QueryResolutionPolicyList gets the query resolution policies of a zone or the server.
+// It returns a list of QueryResolutionPolicy objects in their processing order.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) QueryResolutionPolicyList(ctx context.Context, params QueryResolutionPolicyListParams) ([]QueryResolutionPolicy, error) {
+	var p []QueryResolutionPolicy
+	var o []policyObject
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyList: %w", err)
+	}
+
+	// Convert the output to QueryResolutionPolicy objects.
+	for _, object := range o {
+		// A zone or server without policies returns an empty object.
+		if object.Name == "" {
+			continue
+		}
+
+		var policy QueryResolutionPolicy
+		if err := policy.convertOutput(object, params.Zone); err != nil {
+			return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyList: %w", err)
+		}
+		p = append(p, policy)
+	}
+
+	return p, nil
+}
+
+// QueryResolutionPolicyCreateParams represents parameters for the QueryResolutionPolicyCreate function.
+type QueryResolutionPolicyCreateParams struct {
+	// Specifies the name of the policy.
+	Name string
+
+	// Specifies the zone of a zone level policy.
+	// If not provided, a server level policy is created.
+	Zone string
+
+	// Specifies the action of the policy for matching queries.
+	// Possible values are "Allow", "Deny" and "Ignore".
+	// If not provided, the default is "Allow".
+	Action string
+
+	// Specifies the position of the policy in the order in which the policies are evaluated.
+	// If not provided, the policy is evaluated after the existing policies.
+	ProcessingOrder uint32
+
+	// Specifies whether the policy is created disabled.
+	Disable bool
+
+	// Specifies how the criteria are combined.
+	// Possible values are "AND" and "OR".
+	// If not provided, the default is "AND".
+	Condition string
+
+	// Specifies the criteria of the queries to which the policy applies.
+	Criteria QueryResolutionPolicyCriteria
+
+	// Specifies the zone scopes that answer the queries of a zone level policy with the action "Allow".
+	ZoneScopes []QueryResolutionPolicyZoneScope
+}
+
+// pwshCommand returns the PowerShell command to create a query resolution policy.
+func (params QueryResolutionPolicyCreateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Add-DnsServerQueryResolutionPolicy -Name '%s'%s", params.Name, pwshPolicyZoneName(params.Zone))}
+
+	// Add parameters
+	if params.Action != "" {
+		cmd = append(cmd, fmt.Sprintf("-Action '%s'", strings.ToUpper(params.Action)))
+	}
+	if params.ProcessingOrder != 0 {
+		cmd = append(cmd, fmt.Sprintf("-ProcessingOrder %d", params.ProcessingOrder))
+	}
+	if params.Disable {
+		cmd = append(cmd, "-Disable")
+	}
+	if params.Condition != "" {
+		cmd = append(cmd, fmt.Sprintf("-Condition '%s'", strings.ToUpper(params.Condition)))
+	}
+	cmd = append(cmd, params.Criteria.params()...)
+	if len(params.ZoneScopes) > 0 {
+		cmd = append(cmd, fmt.Sprintf("-ZoneScope %s", pwshZoneScopeList(params.ZoneScopes)))
+	}
+
+	cmd = append(cmd, fmt.Sprintf("-PassThru -ErrorAction Stop | %s | ConvertTo-Json -Compress", policyProjection))
+	return strings.Join(cmd, " ")
+}
+
+// QueryResolutionPolicyCreate creates a query resolution policy. It returns a QueryResolutionPolicy object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) QueryResolutionPolicyCreate(ctx context.Context, params QueryResolutionPolicyCreateParams) (QueryResolutionPolicy, error) {
+	var p QueryResolutionPolicy
+	var o policyObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return p, errors.New("windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'Name' must be set")
+	}
+	switch strings.ToLower(params.Action) {
+	case "", "allow":
+	case "deny", "ignore":
+		if len(params.ZoneScopes) > 0 {
+			return p, fmt.Errorf("windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'ZoneScopes' can only be set with the action 'Allow', got '%s'", params.Action)
+		}
+	default:
+		return p, fmt.Errorf("windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'Action' must be 'Allow', 'Deny' or 'Ignore', got '%s'", params.Action)
+	}
+	if len(params.ZoneScopes) > 0 && params.Zone == "" {
+		return p, errors.New("windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'ZoneScopes' can only be set for zone level policies")
+	}
+	if err := validatePolicyCondition(params.Condition); err != nil {
+		return p, fmt.Errorf("windows.dns.QueryResolutionPolicyCreate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyCreate: %w", err)
+	}
+
+	// Convert the output to a QueryResolutionPolicy object.
+	if err := p.convertOutput(o, params.Zone); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyCreate: %w", err)
+	}
+
+	return p, nil
+}
+
+// QueryResolutionPolicyUpdateParams represents parameters for the QueryResolutionPolicyUpdate function.
+// The settings that are not set keep their current value.
+// The action and the zone scopes of a policy cannot be changed, the policy must be deleted and created again.
+type QueryResolutionPolicyUpdateParams struct {
+	// Specifies the name of the policy.
+	Name string
+
+	// Specifies the zone of a zone level policy.
+	// If not provided, the server level policy is updated.
+	Zone string
+
+	// Specifies the position of the policy in the order in which the policies are evaluated.
+	ProcessingOrder uint32
+
+	// Specifies whether the policy is enabled.
+	// If not provided, the state of the policy is not changed.
+	IsEnabled *bool
+
+	// Specifies how the criteria are combined.
+	// Possible values are "AND" and "OR".
+	Condition string
+
+	// Specifies the criteria of the queries to which the policy applies.
+	// Every criteria that is set replaces the current criteria of its type.
+	Criteria QueryResolutionPolicyCriteria
+}
+
+// pwshCommand returns the PowerShell command to update a query resolution policy.
+func (params QueryResolutionPolicyUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerQueryResolutionPolicy -Name '%s'%s", params.Name, pwshPolicyZoneName(params.Zone))}
+
+	// Add parameters
+	if params.ProcessingOrder != 0 {
+		cmd = append(cmd, fmt.Sprintf("-ProcessingOrder %d", params.ProcessingOrder))
+	}
+	if params.IsEnabled != nil {
+		if *params.IsEnabled {
+			cmd = append(cmd, "-State 'Enable'")
+		} else {
+			cmd = append(cmd, "-State 'Disable'")
+		}
+	}
+	if params.Condition != "" {
+		cmd = append(cmd, fmt.Sprintf("-Condition '%s'", strings.ToUpper(params.Condition)))
+	}
+	if criteria := params.Criteria.params(); len(criteria) > 0 {
+		cmd = append(cmd, "-Action 'REPLACE'")
+		cmd = append(cmd, criteria...)
+	}
+
+	cmd = append(cmd, fmt.Sprintf("-PassThru -ErrorAction Stop | %s | ConvertTo-Json -Compress", policyProjection))
+	return strings.Join(cmd, " ")
+}
+
+// QueryResolutionPolicyUpdate updates a query resolution policy. It returns a QueryResolutionPolicy object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) QueryResolutionPolicyUpdate(ctx context.Context, params QueryResolutionPolicyUpdateParams) (QueryResolutionPolicy, error) {
+	var p QueryResolutionPolicy
+	var o policyObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return p, errors.New("windows.dns.QueryResolutionPolicyUpdate: query resolution policy parameter 'Name' must be set")
+	}
+	if err := validatePolicyCondition(params.Condition); err != nil {
+		return p, fmt.Errorf("windows.dns.QueryResolutionPolicyUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyUpdate: %w", err)
+	}
+
+	// Convert the output to a QueryResolutionPolicy object.
+	if err := p.convertOutput(o, params.Zone); err != nil {
+		return p, winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyUpdate: %w", err)
+	}
+
+	return p, nil
+}
+
+// QueryResolutionPolicyDeleteParams represents parameters for the QueryResolutionPolicyDelete function.
+type QueryResolutionPolicyDeleteParams struct {
+	// Specifies the name of the policy.
+	Name string
+
+	// Specifies the zone of a zone level policy.
+	// If not provided, the server level policy is deleted.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to delete a query resolution policy.
+func (params QueryResolutionPolicyDeleteParams) pwshCommand() string {
+	return fmt.Sprintf("Remove-DnsServerQueryResolutionPolicy -Name '%s'%s -Force", params.Name, pwshPolicyZoneName(params.Zone))
+}
+
+// QueryResolutionPolicyDelete deletes a query resolution policy.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) QueryResolutionPolicyDelete(ctx context.Context, params QueryResolutionPolicyDeleteParams) error {
+	var o policyObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return errors.New("windows.dns.QueryResolutionPolicyDelete: query resolution policy parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.QueryResolutionPolicyDelete: %w", err)
+	}
+
+	return nil
+}
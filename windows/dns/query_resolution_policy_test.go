@@ -0,0 +1,262 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	queryResolutionPolicyJson = `{"Name":"internal","Action":"Allow","ProcessingOrder":1,"IsEnabled":true,"Condition":"And","Criteria":[{"Type":"ClientSubnet","Criteria":"EQ,internal"},{"Type":"QType","Criteria":"EQ,A,AAAA"}],"Content":[{"ScopeName":"internal","Weight":1}]}`
+)
+
+var expectedQueryResolutionPolicy = QueryResolutionPolicy{
+	Name:            "internal",
+	Zone:            "test.local",
+	Action:          QueryResolutionPolicyActionAllow,
+	ProcessingOrder: 1,
+	IsEnabled:       true,
+	Condition:       "And",
+	Criteria:        QueryResolutionPolicyCriteria{ClientSubnet: "EQ,internal", QType: "EQ,A,AAAA"},
+	ZoneScopes:      []QueryResolutionPolicyZoneScope{{Name: "internal", Weight: 1}},
+}
+
+// Test QueryResolutionPolicyRead related methods.
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyReadPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters QueryResolutionPolicyReadParams
+			expectedCmd     string
+		}{
+			{
+				"assert zone level policy",
+				QueryResolutionPolicyReadParams{Name: "internal", Zone: "test.local"},
+				"Get-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+			{
+				"assert server level policy",
+				QueryResolutionPolicyReadParams{Name: "blocklist"},
+				"Get-DnsServerQueryResolutionPolicy -Name 'blocklist' | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the policy", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' | "+policyProjection+" | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: queryResolutionPolicyJson}, nil)
+		actual, err := c.QueryResolutionPolicyRead(ctx, QueryResolutionPolicyReadParams{Name: "internal", Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedQueryResolutionPolicy, actual)
+	})
+
+	suite.Run("should return error if the criteria type is not supported", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerQueryResolutionPolicy -Name 'internal' | "+policyProjection+" | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: `{"Name":"internal","Action":"Deny","Criteria":[{"Type":"Unknown","Criteria":"EQ,x"}]}`}, nil)
+		_, err := c.QueryResolutionPolicyRead(ctx, QueryResolutionPolicyReadParams{Name: "internal"})
+		suite.ErrorContains(err, "windows.dns.QueryResolutionPolicyRead: unsupported policy criteria type 'Unknown'")
+	})
+}
+
+// Test QueryResolutionPolicyList related methods.
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the policies of the zone", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$p=@(Get-DnsServerQueryResolutionPolicy -ZoneName 'test.local' | "+policyProjection+") ;if($p.Count -ge 2){ConvertTo-Json $p -Compress}else{ConvertTo-Json @($p) -Compress}").
+			Return(connection.CmdResult{StdOut: "[" + queryResolutionPolicyJson + "]"}, nil)
+		actual, err := c.QueryResolutionPolicyList(ctx, QueryResolutionPolicyListParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal([]QueryResolutionPolicy{expectedQueryResolutionPolicy}, actual)
+	})
+}
+
+// Test QueryResolutionPolicyCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters QueryResolutionPolicyCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert zone level policy with zone scopes",
+				QueryResolutionPolicyCreateParams{
+					Name:       "internal",
+					Zone:       "test.local",
+					Criteria:   QueryResolutionPolicyCriteria{ClientSubnet: "EQ,internal"},
+					ZoneScopes: []QueryResolutionPolicyZoneScope{{Name: "internal"}, {Name: "test.local", Weight: 3}},
+				},
+				"Add-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' -ClientSubnet 'EQ,internal' -ZoneScope 'internal,1;test.local,3' -PassThru -ErrorAction Stop | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+			{
+				"assert server level policy with all settings",
+				QueryResolutionPolicyCreateParams{
+					Name:            "blocklist",
+					Action:          QueryResolutionPolicyActionDeny,
+					ProcessingOrder: 2,
+					Disable:         true,
+					Condition:       "or",
+					Criteria:        QueryResolutionPolicyCriteria{FQDN: "EQ,*.blocked.example", TransportProtocol: "EQ,TCP"},
+				},
+				"Add-DnsServerQueryResolutionPolicy -Name 'blocklist' -Action 'DENY' -ProcessingOrder 2 -Disable -Condition 'OR' -TransportProtocol 'EQ,TCP' -FQDN 'EQ,*.blocked.example' -PassThru -ErrorAction Stop | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return error if parameters are invalid", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		tcs := []struct {
+			description     string
+			inputParameters QueryResolutionPolicyCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without name",
+				QueryResolutionPolicyCreateParams{Zone: "test.local"},
+				"windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'Name' must be set",
+			},
+			{
+				"assert error with invalid action",
+				QueryResolutionPolicyCreateParams{Name: "internal", Action: "Drop"},
+				"windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'Action' must be 'Allow', 'Deny' or 'Ignore', got 'Drop'",
+			},
+			{
+				"assert error with zone scopes and deny action",
+				QueryResolutionPolicyCreateParams{Name: "internal", Zone: "test.local", Action: "Deny", ZoneScopes: []QueryResolutionPolicyZoneScope{{Name: "internal"}}},
+				"windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'ZoneScopes' can only be set with the action 'Allow', got 'Deny'",
+			},
+			{
+				"assert error with zone scopes on server level",
+				QueryResolutionPolicyCreateParams{Name: "internal", ZoneScopes: []QueryResolutionPolicyZoneScope{{Name: "internal"}}},
+				"windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'ZoneScopes' can only be set for zone level policies",
+			},
+			{
+				"assert error with invalid condition",
+				QueryResolutionPolicyCreateParams{Name: "internal", Condition: "XOR"},
+				"windows.dns.QueryResolutionPolicyCreate: query resolution policy parameter 'Condition' must be 'AND' or 'OR', got 'XOR'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := c.QueryResolutionPolicyCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test QueryResolutionPolicyUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		isEnabled := true
+		isDisabled := false
+
+		tcs := []struct {
+			description     string
+			inputParameters QueryResolutionPolicyUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert without settings",
+				QueryResolutionPolicyUpdateParams{Name: "internal", Zone: "test.local"},
+				"Set-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' -PassThru -ErrorAction Stop | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+			{
+				"assert disable policy",
+				QueryResolutionPolicyUpdateParams{Name: "internal", Zone: "test.local", IsEnabled: &isDisabled},
+				"Set-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' -State 'Disable' -PassThru -ErrorAction Stop | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+			{
+				"assert replace criteria",
+				QueryResolutionPolicyUpdateParams{
+					Name:            "internal",
+					Zone:            "test.local",
+					ProcessingOrder: 1,
+					IsEnabled:       &isEnabled,
+					Criteria:        QueryResolutionPolicyCriteria{ClientSubnet: "EQ,internal,vpn"},
+				},
+				"Set-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' -ProcessingOrder 1 -State 'Enable' -Action 'REPLACE' -ClientSubnet 'EQ,internal,vpn' -PassThru -ErrorAction Stop | " + policyProjection + " | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+// Test QueryResolutionPolicyDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestQueryResolutionPolicyDelete() {
+	suite.T().Parallel()
+
+	suite.Run("should delete the policy", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Remove-DnsServerQueryResolutionPolicy -Name 'internal' -ZoneName 'test.local' -Force").
+			Return(connection.CmdResult{}, nil)
+		err := c.QueryResolutionPolicyDelete(ctx, QueryResolutionPolicyDeleteParams{Name: "internal", Zone: "test.local"})
+		suite.NoError(err)
+	})
+}
@@ -18,6 +18,10 @@ type RecordA struct {
 	Addresses         []netip.Addr
 	Timestamp         time.Time
 	TimeToLive        time.Duration
+
+	// MissingReverseZones contains the reverse lookup zones that are missing for the PTR-Records of the addresses.
+	// It is only set by the create and update functions if the PTR-Records are managed.
+	MissingReverseZones []string
 }
 
 // convertOutput converts the unmarshaled JSON output from the recordObject to a RecordA object.
@@ -61,6 +65,10 @@ type RecordAReadParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
 }
 
 // pwshCommand returns the PowerShell command to read an A-Record.
@@ -70,7 +78,7 @@ func (params RecordAReadParams) pwshCommand() string {
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Ensure output is always an array.
 	cmd = append(cmd, ";if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
@@ -91,7 +99,7 @@ func (c *Client) RecordARead(ctx context.Context, params RecordAReadParams) (Rec
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordARead: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordARead: %w", err)
 	}
 
 	// Convert the output to a RecordA object.
@@ -110,6 +118,10 @@ type RecordACreateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the IPv4 addresses of the record.
 	Addresses []netip.Addr
 
@@ -117,6 +129,15 @@ type RecordACreateParams struct {
 	// If not provided, the default is 86400 seconds.
 	// A TTL of 0 is not allowed.
 	TimeToLive time.Duration
+
+	// Specifies whether the record ages like a dynamically updated record.
+	// Aging records get a timestamp and are removed by the scavenging if they are not refreshed.
+	// If not provided, a static record without timestamp is created.
+	AgeRecord bool
+
+	// Specifies whether the PTR-Records of the addresses are created in the matching reverse lookup zones.
+	// Addresses without a reverse lookup zone are reported in the MissingReverseZones of the record.
+	ManagePtr bool
 }
 
 // pwshCommand returns the PowerShell command to create a new A-Record.
@@ -124,11 +145,11 @@ func (params RecordACreateParams) pwshCommand() string {
 	addressList := []string{}
 
 	// Base command
-	cmd := []string{"$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru"}
+	cmd := []string{fmt.Sprintf("$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$%t -Confirm:$false -PassThru", params.AgeRecord)}
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Set default TTL if not provided.
 	if params.TimeToLive == 0 {
@@ -151,6 +172,7 @@ func (params RecordACreateParams) pwshCommand() string {
 }
 
 // RecordACreate creates a new A-Record. It returns a RecordA object.
+// If the PTR-Records of the addresses cannot be created, the record is removed again.
 // It returns a *winerror.WinError if the windows client returns an error.
 func (c *Client) RecordACreate(ctx context.Context, params RecordACreateParams) (RecordA, error) {
 	var r RecordA
@@ -172,11 +194,11 @@ func (c *Client) RecordACreate(ctx context.Context, params RecordACreateParams)
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
 		// Handle record already exists error.
-		if strings.Contains(err.Error(), "ResourceExists") {
+		if winerror.Category(err) == winerror.CategoryResourceExists {
 			return r, winerror.Errorf(cmd, "windows.dns.RecordACreate: the specified record already exists")
 		}
 
-		return r, winerror.Errorf(cmd, "windows.dns.RecordACreate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordACreate: %w", err)
 	}
 
 	// Convert the output to a RecordA object.
@@ -184,11 +206,26 @@ func (c *Client) RecordACreate(ctx context.Context, params RecordACreateParams)
 		return r, fmt.Errorf(cmd, "windows.dns.RecordACreate: failed to convert output to RecordA object: %s", err)
 	}
 
+	// Create the PTR-Records of the addresses.
+	if params.ManagePtr {
+		missing, err := c.updatePtrRecords(ctx, params.Name, params.Zone, r.Addresses, nil, params.TimeToLive, params.AgeRecord)
+		if err != nil {
+			// Remove the record, so a failed creation leaves no record without its PTR-Records behind.
+			cmd := RecordADeleteParams{Name: params.Name, Zone: params.Zone, ZoneScope: params.ZoneScope}.pwshCommand()
+			if deleteErr := run(ctx, c, cmd, &o); deleteErr != nil {
+				return r, fmt.Errorf("windows.dns.RecordACreate: %w, the record could not be removed: %w", err, deleteErr)
+			}
+
+			return r, fmt.Errorf("windows.dns.RecordACreate: %w", err)
+		}
+		r.MissingReverseZones = missing
+	}
+
 	return r, nil
 }
 
 // RecordAUpdateParams represents parameters for the A-Record update function.
-// Only the TimeToLive can be updated.
+// The TimeToLive and the Addresses can be updated.
 type RecordAUpdateParams struct {
 	// Specifies the name of the Record.
 	Name string
@@ -196,10 +233,23 @@ type RecordAUpdateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the time to live (TTL) of the record in seconds.
 	// If not provided, the default TTL is 86400 seconds.
 	// A TTL of 0 is not allowed.
 	TimeToLive time.Duration
+
+	// Specifies the new IPv4 addresses of the record.
+	// Addresses that are not part of the record are added and addresses that are not specified are removed.
+	// If not provided, the addresses are not changed.
+	Addresses []netip.Addr
+
+	// Specifies whether the PTR-Records of the added addresses are created and the PTR-Records of the removed addresses are removed.
+	// Addresses without a reverse lookup zone are reported in the MissingReverseZones of the record.
+	ManagePtr bool
 }
 
 // pwshCommand returns the PowerShell command to update an A-Record.
@@ -217,9 +267,9 @@ func (params RecordAUpdateParams) pwshCommand() string {
 
 	// Add parameters and logic for handling the TTL update.
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 	cmd = append(cmd, fmt.Sprintf("| ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
-	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName '%s' -PassThru}", params.Zone))
+	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru}", pwshZoneName(params.Zone, params.ZoneScope)))
 	cmd = append(cmd, ";if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}")
 
 	// Return the full command.
@@ -237,10 +287,32 @@ func (c *Client) RecordAUpdate(ctx context.Context, params RecordAUpdateParams)
 		return r, errors.New("windows.dns.RecordAUpdate: record parameters 'Name', 'Zone' and 'TimeToLive' must be set")
 	}
 
-	// Run command
+	// Assert IPv4 addresses
+	for _, address := range params.Addresses {
+		if !address.Is4() {
+			return r, errors.New("windows.dns.RecordAUpdate: record parameter 'Addresses' must be a list of IPv4 addresses")
+		}
+	}
+
 	cmd := params.pwshCommand()
+
+	// Replace the addresses before the TTL update, so the TTL is also set on the new records.
+	var added, removed []netip.Addr
+	var ageRecord bool
+	if len(params.Addresses) > 0 {
+		current, err := c.RecordARead(ctx, RecordAReadParams{Name: params.Name, Zone: params.Zone, ZoneScope: params.ZoneScope})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.RecordAUpdate: %w", err)
+		}
+
+		added, removed = addressChanges(current.Addresses, params.Addresses)
+		ageRecord = !current.Timestamp.IsZero()
+		cmd = strings.Join(append(addressReplaceCommand("A", params.Name, params.Zone, params.ZoneScope, params.TimeToLive, ageRecord, added, removed), cmd), " ;")
+	}
+
+	// Run command
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordAUpdate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordAUpdate: %w", err)
 	}
 
 	// Convert the output to a RecordA object.
@@ -248,6 +320,15 @@ func (c *Client) RecordAUpdate(ctx context.Context, params RecordAUpdateParams)
 		return r, fmt.Errorf(cmd, "windows.dns.RecordAUpdate: failed to convert output to RecordA object: %s", err)
 	}
 
+	// Update the PTR-Records of the replaced addresses.
+	if params.ManagePtr {
+		missing, err := c.updatePtrRecords(ctx, params.Name, params.Zone, added, removed, params.TimeToLive, ageRecord)
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.RecordAUpdate: %w", err)
+		}
+		r.MissingReverseZones = missing
+	}
+
 	return r, nil
 }
 
@@ -258,12 +339,19 @@ type RecordADeleteParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies whether the PTR-Records of the addresses are removed from the reverse lookup zones.
+	ManagePtr bool
 }
 
 // pwshCommand returns the PowerShell command to delete an A-Record.
 func (params RecordADeleteParams) pwshCommand() string {
 	// Base command
-	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'A' -Force -Name '%s' -ZoneName '%s'", params.Name, params.Zone)
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'A' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
 }
 
 // RecordADelete deletes an A-Record.
@@ -276,10 +364,22 @@ func (c *Client) RecordADelete(ctx context.Context, params RecordADeleteParams)
 		return errors.New("windows.dns.RecordADelete: record parameters 'Name' and 'Zone' must be set")
 	}
 
+	// Remove the PTR-Records of the current addresses before the record.
+	if params.ManagePtr {
+		current, err := c.RecordARead(ctx, RecordAReadParams{Name: params.Name, Zone: params.Zone, ZoneScope: params.ZoneScope})
+		if err != nil {
+			return fmt.Errorf("windows.dns.RecordADelete: %w", err)
+		}
+
+		if _, err := c.updatePtrRecords(ctx, params.Name, params.Zone, nil, current.Addresses, 0, false); err != nil {
+			return fmt.Errorf("windows.dns.RecordADelete: %w", err)
+		}
+	}
+
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return winerror.Errorf(cmd, "windows.dns.RecordADelete: %s", err)
+		return winerror.Errorf(cmd, "windows.dns.RecordADelete: %w", err)
 	}
 
 	return nil
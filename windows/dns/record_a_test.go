@@ -125,6 +125,11 @@ func (suite *DnsServerUnitTestSuite) TestRecordAReadPwshCommand() {
 				RecordAReadParams{Name: "test", Zone: "test.local"},
 				"$r=Get-DnsServerResourceRecord -RRType 'A' -Node -Name 'test' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
 			},
+			{
+				"assert correct command A-Record read in zone scope",
+				RecordAReadParams{Name: "test", Zone: "test.local", ZoneScope: "internal"},
+				"$r=Get-DnsServerResourceRecord -RRType 'A' -Node -Name 'test' -ZoneName 'test.local' -ZoneScope 'internal' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
 		}
 
 		for _, tc := range tcs {
@@ -213,6 +218,16 @@ func (suite *DnsServerUnitTestSuite) TestRecordACreatePwshCommand() {
 				RecordACreateParams{Name: "test", Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("1.1.1.1")}, TimeToLive: time.Second * 3600},
 				"$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv4Address @('1.1.1.1') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
 			},
+			{
+				"assert with age record parameter",
+				RecordACreateParams{Name: "test", Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("1.1.1.1")}, AgeRecord: true},
+				"$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$true -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -IPv4Address @('1.1.1.1') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with zone scope parameter",
+				RecordACreateParams{Name: "test", Zone: "test.local", ZoneScope: "internal", Addresses: []netip.Addr{netip.MustParseAddr("10.0.0.1")}},
+				"$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -ZoneScope 'internal' -TimeToLive $(New-TimeSpan -Seconds 86400) -IPv4Address @('10.0.0.1') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
 		}
 
 		for _, tc := range tcs {
@@ -312,6 +327,36 @@ func (suite *DnsServerUnitTestSuite) TestRecordAUpdate() {
 		suite.NoError(err)
 		suite.Equal(expectedRecordA, actualRecord)
 	})
+
+	suite.Run("should add the new addresses before removing the old addresses", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'A' -Node -Name 'test' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordAJson}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv4Address @('1.1.1.1') -ErrorAction Stop ;Remove-DnsServerResourceRecord -RRType 'A' -Force -Name 'test' -ZoneName 'test.local' -RecordData '2.2.2.2' -ErrorAction Stop ;$nr=@();Get-DnsServerResourceRecord -RRType 'A' -Node -Name 'test' -ZoneName 'test.local' | ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds 3600 ;$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru} ;if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}").
+			Return(connection.CmdResult{StdOut: recordAJson}, nil)
+		_, err := c.RecordAUpdate(ctx, RecordAUpdateParams{Name: "test", Zone: "test.local", TimeToLive: time.Second * 3600, Addresses: []netip.Addr{netip.MustParseAddr("1.1.1.1")}})
+		suite.NoError(err)
+	})
+
+	suite.Run("should return an error if the addresses are not IPv4 addresses", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.RecordAUpdate(ctx, RecordAUpdateParams{Name: "test", Zone: "test.local", TimeToLive: time.Second * 3600, Addresses: []netip.Addr{netip.MustParseAddr("2001:db8::1")}})
+		suite.EqualError(err, "windows.dns.RecordAUpdate: record parameter 'Addresses' must be a list of IPv4 addresses")
+	})
 }
 
 // Test RecordADelete related methods.
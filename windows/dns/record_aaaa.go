@@ -18,6 +18,10 @@ type RecordAAAA struct {
 	Addresses         []netip.Addr
 	Timestamp         time.Time
 	TimeToLive        time.Duration
+
+	// MissingReverseZones contains the reverse lookup zones that are missing for the PTR-Records of the addresses.
+	// It is only set by the create and update functions if the PTR-Records are managed.
+	MissingReverseZones []string
 }
 
 // convertOutput converts the unmarshaled JSON output from the recordObject to a RecordAAAA object.
@@ -61,6 +65,10 @@ type RecordAAAAReadParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
 }
 
 // pwshCommand returns the PowerShell command to read an AAAA-Record.
@@ -70,7 +78,7 @@ func (params RecordAAAAReadParams) pwshCommand() string {
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Ensure output is always an array.
 	cmd = append(cmd, ";if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
@@ -91,7 +99,7 @@ func (c *Client) RecordAAAARead(ctx context.Context, params RecordAAAAReadParams
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordAAAARead: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordAAAARead: %w", err)
 	}
 
 	// Convert the output to a RecordAAAA object.
@@ -110,6 +118,10 @@ type RecordAAAACreateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the IPv6 addresses of the record.
 	Addresses []netip.Addr
 
@@ -117,6 +129,15 @@ type RecordAAAACreateParams struct {
 	// If not provided, the default is 86400 seconds.
 	// A TTL of 0 is not allowed.
 	TimeToLive time.Duration
+
+	// Specifies whether the record ages like a dynamically updated record.
+	// Aging records get a timestamp and are removed by the scavenging if they are not refreshed.
+	// If not provided, a static record without timestamp is created.
+	AgeRecord bool
+
+	// Specifies whether the PTR-Records of the addresses are created in the matching reverse lookup zones.
+	// Addresses without a reverse lookup zone are reported in the MissingReverseZones of the record.
+	ManagePtr bool
 }
 
 // pwshCommand returns the PowerShell command to create a new AAAA-Record.
@@ -124,11 +145,11 @@ func (params RecordAAAACreateParams) pwshCommand() string {
 	addressList := []string{}
 
 	// Base command
-	cmd := []string{"$r=Add-DnsServerResourceRecordAAAA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru"}
+	cmd := []string{fmt.Sprintf("$r=Add-DnsServerResourceRecordAAAA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$%t -Confirm:$false -PassThru", params.AgeRecord)}
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Set default TTL if not provided.
 	// New-TimeSpan only allows int32 values.
@@ -150,6 +171,7 @@ func (params RecordAAAACreateParams) pwshCommand() string {
 }
 
 // RecordAAAACreate creates an AAAA-Record. It returns a RecordAAAA object.
+// If the PTR-Records of the addresses cannot be created, the record is removed again.
 // It returns a *winerror.WinError if the windows client returns an error.
 func (c *Client) RecordAAAACreate(ctx context.Context, params RecordAAAACreateParams) (RecordAAAA, error) {
 	var r RecordAAAA
@@ -171,11 +193,11 @@ func (c *Client) RecordAAAACreate(ctx context.Context, params RecordAAAACreatePa
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
 		// Handle record already exists error.
-		if strings.Contains(err.Error(), "ResourceExists") {
+		if winerror.Category(err) == winerror.CategoryResourceExists {
 			return r, winerror.Errorf(cmd, "windows.dns.RecordAAAACreate: the specified record already exists")
 		}
 
-		return r, winerror.Errorf(cmd, "windows.dns.RecordAAAACreate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordAAAACreate: %w", err)
 	}
 
 	// Convert the output to a RecordAAAA object.
@@ -183,11 +205,26 @@ func (c *Client) RecordAAAACreate(ctx context.Context, params RecordAAAACreatePa
 		return r, fmt.Errorf(cmd, "windows.dns.RecordAAAACreate: failed to convert output to RecordAAAA object: %s", err)
 	}
 
+	// Create the PTR-Records of the addresses.
+	if params.ManagePtr {
+		missing, err := c.updatePtrRecords(ctx, params.Name, params.Zone, r.Addresses, nil, params.TimeToLive, params.AgeRecord)
+		if err != nil {
+			// Remove the record, so a failed creation leaves no record without its PTR-Records behind.
+			cmd := RecordAAAADeleteParams{Name: params.Name, Zone: params.Zone, ZoneScope: params.ZoneScope}.pwshCommand()
+			if deleteErr := run(ctx, c, cmd, &o); deleteErr != nil {
+				return r, fmt.Errorf("windows.dns.RecordAAAACreate: %w, the record could not be removed: %w", err, deleteErr)
+			}
+
+			return r, fmt.Errorf("windows.dns.RecordAAAACreate: %w", err)
+		}
+		r.MissingReverseZones = missing
+	}
+
 	return r, nil
 }
 
-// RecordAAAAUpdateParams represents parameters for the A-Record update function.
-// Only the TimeToLive can be updated.
+// RecordAAAAUpdateParams represents parameters for the AAAA-Record update function.
+// The TimeToLive and the Addresses can be updated.
 type RecordAAAAUpdateParams struct {
 	// Specifies the name of the Record.
 	Name string
@@ -195,10 +232,23 @@ type RecordAAAAUpdateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the time to live (TTL) of the record in seconds.
 	// If not provided, the default TTL is 86400 seconds.
 	// A TTL of 0 is not allowed.
 	TimeToLive time.Duration
+
+	// Specifies the new IPv6 addresses of the record.
+	// Addresses that are not part of the record are added and addresses that are not specified are removed.
+	// If not provided, the addresses are not changed.
+	Addresses []netip.Addr
+
+	// Specifies whether the PTR-Records of the added addresses are created and the PTR-Records of the removed addresses are removed.
+	// Addresses without a reverse lookup zone are reported in the MissingReverseZones of the record.
+	ManagePtr bool
 }
 
 // pwshCommand returns the PowerShell command to update an AAAA-Record.
@@ -216,9 +266,9 @@ func (params RecordAAAAUpdateParams) pwshCommand() string {
 
 	// Add parameters and logic for handling the TTL update.
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 	cmd = append(cmd, fmt.Sprintf("| ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
-	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName '%s' -PassThru}", params.Zone))
+	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru}", pwshZoneName(params.Zone, params.ZoneScope)))
 	cmd = append(cmd, ";if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}")
 
 	// Return the full command.
@@ -236,10 +286,32 @@ func (c *Client) RecordAAAAUpdate(ctx context.Context, params RecordAAAAUpdatePa
 		return r, errors.New("windows.dns.RecordAAAAUpdate: record parameters 'Name', 'Zone' and 'TimeToLive' must be set")
 	}
 
-	// Run command
+	// Assert IPv6 addresses
+	for _, address := range params.Addresses {
+		if !address.Is6() {
+			return r, errors.New("windows.dns.RecordAAAAUpdate: record parameter 'Addresses' must be a list of IPv6 addresses")
+		}
+	}
+
 	cmd := params.pwshCommand()
+
+	// Replace the addresses before the TTL update, so the TTL is also set on the new records.
+	var added, removed []netip.Addr
+	var ageRecord bool
+	if len(params.Addresses) > 0 {
+		current, err := c.RecordAAAARead(ctx, RecordAAAAReadParams{Name: params.Name, Zone: params.Zone, ZoneScope: params.ZoneScope})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.RecordAAAAUpdate: %w", err)
+		}
+
+		added, removed = addressChanges(current.Addresses, params.Addresses)
+		ageRecord = !current.Timestamp.IsZero()
+		cmd = strings.Join(append(addressReplaceCommand("AAAA", params.Name, params.Zone, params.ZoneScope, params.TimeToLive, ageRecord, added, removed), cmd), " ;")
+	}
+
+	// Run command
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordAAAAUpdate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordAAAAUpdate: %w", err)
 	}
 
 	// Convert the output to a RecordAAAA object.
@@ -247,6 +319,15 @@ func (c *Client) RecordAAAAUpdate(ctx context.Context, params RecordAAAAUpdatePa
 		return r, fmt.Errorf(cmd, "windows.dns.RecordAAAAUpdate: failed to convert output to RecordAAAA object: %s", err)
 	}
 
+	// Update the PTR-Records of the replaced addresses.
+	if params.ManagePtr {
+		missing, err := c.updatePtrRecords(ctx, params.Name, params.Zone, added, removed, params.TimeToLive, ageRecord)
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.RecordAAAAUpdate: %w", err)
+		}
+		r.MissingReverseZones = missing
+	}
+
 	return r, nil
 }
 
@@ -257,12 +338,19 @@ type RecordAAAADeleteParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies whether the PTR-Records of the addresses are removed from the reverse lookup zones.
+	ManagePtr bool
 }
 
 // pwshCommand returns the PowerShell command to delete an AAAA-Record.
 func (params RecordAAAADeleteParams) pwshCommand() string {
 	// Base command
-	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'AAAA' -Force -Name '%s' -ZoneName '%s'", params.Name, params.Zone)
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'AAAA' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
 }
 
 // RecordAAAADelete deletes an AAAA-Record.
@@ -275,10 +363,22 @@ func (c *Client) RecordAAAADelete(ctx context.Context, params RecordAAAADeletePa
 		return errors.New("windows.dns.RecordAAAADelete: record parameters 'Name' and 'Zone' must be set")
 	}
 
+	// Remove the PTR-Records of the current addresses before the record.
+	if params.ManagePtr {
+		current, err := c.RecordAAAARead(ctx, RecordAAAAReadParams{Name: params.Name, Zone: params.Zone, ZoneScope: params.ZoneScope})
+		if err != nil {
+			return fmt.Errorf("windows.dns.RecordAAAADelete: %w", err)
+		}
+
+		if _, err := c.updatePtrRecords(ctx, params.Name, params.Zone, nil, current.Addresses, 0, false); err != nil {
+			return fmt.Errorf("windows.dns.RecordAAAADelete: %w", err)
+		}
+	}
+
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return winerror.Errorf(cmd, "windows.dns.RecordAAAADelete: %s", err)
+		return winerror.Errorf(cmd, "windows.dns.RecordAAAADelete: %w", err)
 	}
 
 	return nil
@@ -213,6 +213,11 @@ func (suite *DnsServerUnitTestSuite) TestRecordAAAACreatePwshCommand() {
 				RecordAAAACreateParams{Name: "test", Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("2001:db8::1")}, TimeToLive: time.Second * 3600},
 				"$r=Add-DnsServerResourceRecordAAAA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv6Address @('2001:db8::1') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
 			},
+			{
+				"assert with age record parameter",
+				RecordAAAACreateParams{Name: "test", Zone: "test.local", Addresses: []netip.Addr{netip.MustParseAddr("2001:db8::1")}, AgeRecord: true},
+				"$r=Add-DnsServerResourceRecordAAAA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$true -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -IPv6Address @('2001:db8::1') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
 		}
 
 		for _, tc := range tcs {
@@ -298,6 +303,36 @@ func (suite *DnsServerUnitTestSuite) TestRecordAAAAUpdate() {
 		suite.NoError(err)
 		suite.Equal(expectedRecordAAAA, actualRecord)
 	})
+
+	suite.Run("should add the new addresses before removing the old addresses", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'AAAA' -Node -Name 'test' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordAAAAJson}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerResourceRecordAAAA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv6Address @('2001:db8::2') -ErrorAction Stop ;Remove-DnsServerResourceRecord -RRType 'AAAA' -Force -Name 'test' -ZoneName 'test.local' -RecordData '2001:db8::1' -ErrorAction Stop ;$nr=@();Get-DnsServerResourceRecord -RRType 'AAAA' -Node -Name 'test' -ZoneName 'test.local' | ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds 3600 ;$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru} ;if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}").
+			Return(connection.CmdResult{StdOut: recordAAAAJson}, nil)
+		_, err := c.RecordAAAAUpdate(ctx, RecordAAAAUpdateParams{Name: "test", Zone: "test.local", TimeToLive: time.Second * 3600, Addresses: []netip.Addr{netip.MustParseAddr("2001:db8::2")}})
+		suite.NoError(err)
+	})
+
+	suite.Run("should return an error if the addresses are not IPv6 addresses", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.RecordAAAAUpdate(ctx, RecordAAAAUpdateParams{Name: "test", Zone: "test.local", TimeToLive: time.Second * 3600, Addresses: []netip.Addr{netip.MustParseAddr("1.1.1.1")}})
+		suite.EqualError(err, "windows.dns.RecordAAAAUpdate: record parameter 'Addresses' must be a list of IPv6 addresses")
+	})
 }
 
 // Test RecordAAAADelete related methods.
@@ -0,0 +1,65 @@
+package dns
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+)
+
+// addressChanges returns the addresses that must be added and removed to replace the current addresses of a record.
+// Addresses that are part of both lists are kept, so their records are not touched.
+func addressChanges(current []netip.Addr, desired []netip.Addr) (added []netip.Addr, removed []netip.Addr) {
+	for _, address := range desired {
+		if !slices.Contains(current, address) && !slices.Contains(added, address) {
+			added = append(added, address)
+		}
+	}
+	for _, address := range current {
+		if !slices.Contains(desired, address) {
+			removed = append(removed, address)
+		}
+	}
+	return added, removed
+}
+
+// addressReplaceCommand returns the PowerShell calls to replace the addresses of an A- or AAAA-Record.
+// The new addresses are added before the old addresses are removed,
+// so the name resolves to at least one address during the whole update.
+// The new records age like the current records, so ageRecord should be set if the record has a timestamp.
+// The ErrorAction stops the command at the first call that fails.
+func addressReplaceCommand(recordType string, name string, zone string, zoneScope string, timeToLive time.Duration, ageRecord bool, added []netip.Addr, removed []netip.Addr) []string {
+	cmd := []string{}
+
+	property := "IPv4Address"
+	if recordType == "AAAA" {
+		property = "IPv6Address"
+	}
+
+	if len(added) > 0 {
+		// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+		// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+		cmd = append(cmd, fmt.Sprintf(
+			"Add-DnsServerResourceRecord%s -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$%t -Confirm:$false -Name '%s' %s -TimeToLive $(New-TimeSpan -Seconds %d) -%s %s -ErrorAction Stop",
+			recordType,
+			ageRecord,
+			name,
+			pwshZoneName(zone, zoneScope),
+			int32(timeToLive.Round(time.Second).Seconds()),
+			property,
+			pwshIPAddressList(added),
+		))
+	}
+
+	for _, address := range removed {
+		cmd = append(cmd, fmt.Sprintf(
+			"Remove-DnsServerResourceRecord -RRType '%s' -Force -Name '%s' %s -RecordData '%s' -ErrorAction Stop",
+			recordType,
+			name,
+			pwshZoneName(zone, zoneScope),
+			address.String(),
+		))
+	}
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package dns
+
+import (
+	"net/netip"
+	"time"
+)
+
+// Test the address replacement of A- and AAAA-Records.
+func (suite *DnsServerUnitTestSuite) TestAddressChanges() {
+	suite.Run("should return the added and removed addresses", func() {
+		tcs := []struct {
+			description     string
+			current         []netip.Addr
+			desired         []netip.Addr
+			expectedAdded   []netip.Addr
+			expectedRemoved []netip.Addr
+		}{
+			{
+				"assert no changes with the same addresses in another order",
+				[]netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("2.2.2.2")},
+				[]netip.Addr{netip.MustParseAddr("2.2.2.2"), netip.MustParseAddr("1.1.1.1")},
+				nil,
+				nil,
+			},
+			{
+				"assert added and removed addresses",
+				[]netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("2.2.2.2")},
+				[]netip.Addr{netip.MustParseAddr("2.2.2.2"), netip.MustParseAddr("3.3.3.3"), netip.MustParseAddr("3.3.3.3")},
+				[]netip.Addr{netip.MustParseAddr("3.3.3.3")},
+				[]netip.Addr{netip.MustParseAddr("1.1.1.1")},
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			added, removed := addressChanges(tc.current, tc.desired)
+			suite.Equal(tc.expectedAdded, added)
+			suite.Equal(tc.expectedRemoved, removed)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestAddressReplaceCommand() {
+	suite.Run("should return the correct commands", func() {
+		actualCmd := addressReplaceCommand(
+			"AAAA",
+			"test",
+			"test.local",
+			"",
+			time.Hour,
+			false,
+			[]netip.Addr{netip.MustParseAddr("2001:db8::2"), netip.MustParseAddr("2001:db8::3")},
+			[]netip.Addr{netip.MustParseAddr("2001:db8::1")},
+		)
+		suite.Equal([]string{
+			"Add-DnsServerResourceRecordAAAA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv6Address @('2001:db8::2','2001:db8::3') -ErrorAction Stop",
+			"Remove-DnsServerResourceRecord -RRType 'AAAA' -Force -Name 'test' -ZoneName 'test.local' -RecordData '2001:db8::1' -ErrorAction Stop",
+		}, actualCmd)
+	})
+}
@@ -35,6 +35,10 @@ type RecordCNameReadParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
 }
 
 // pwshCommand returns the PowerShell command to read a CName-Record.
@@ -44,7 +48,7 @@ func (params RecordCNameReadParams) pwshCommand() string {
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Ensure Json Output
 	cmd = append(cmd, "| ConvertTo-Json -Compress")
@@ -65,7 +69,7 @@ func (c *Client) RecordCNameRead(ctx context.Context, params RecordCNameReadPara
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordCNameRead: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordCNameRead: %w", err)
 	}
 
 	// Convert the output to a RecordCName object.
@@ -82,6 +86,10 @@ type RecordCNameCreateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the CName of the record.
 	CName string
 
@@ -98,7 +106,7 @@ func (params RecordCNameCreateParams) pwshCommand() string {
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 	cmd = append(cmd, fmt.Sprintf("-HostNameAlias '%s'", params.CName))
 
 	// Set default TTL if not provided.
@@ -131,11 +139,11 @@ func (c *Client) RecordCNameCreate(ctx context.Context, params RecordCNameCreate
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
 		// Handle record already exists error.
-		if strings.Contains(err.Error(), "ResourceExists") {
+		if winerror.Category(err) == winerror.CategoryResourceExists {
 			return r, winerror.Errorf(cmd, "windows.dns.RecordCNameCreate: the specified record already exists")
 		}
 
-		return r, winerror.Errorf(cmd, "windows.dns.RecordCNameCreate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordCNameCreate: %w", err)
 	}
 
 	// Convert the output to a RecordCName object.
@@ -153,6 +161,10 @@ type RecordCNameUpdateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the CName of the record.
 	CName string
 
@@ -175,13 +187,13 @@ func (params RecordCNameUpdateParams) pwshCommand() string {
 	// Get command
 	cmd := []string{"$r=Get-DnsServerResourceRecord -RRType 'CName' -Node"}
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Add logic for handling TTL and CName update.
 	cmd = append(cmd, ";$n=[ciminstance]::new($r)")
 	cmd = append(cmd, fmt.Sprintf(";$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
 	cmd = append(cmd, fmt.Sprintf(";$n.RecordData.HostNameAlias='%s'", params.CName))
-	cmd = append(cmd, fmt.Sprintf(";Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName '%s' -PassThru", params.Zone))
+	cmd = append(cmd, fmt.Sprintf(";Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru", pwshZoneName(params.Zone, params.ZoneScope)))
 
 	// Ensure Json Output
 	cmd = append(cmd, "| ConvertTo-Json -Compress")
@@ -204,7 +216,7 @@ func (c *Client) RecordCNameUpdate(ctx context.Context, params RecordCNameUpdate
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordCNameUpdate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordCNameUpdate: %w", err)
 	}
 
 	// Convert the output to a RecordCName object.
@@ -220,12 +232,16 @@ type RecordCNameDeleteParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
 }
 
 // pwshCommand returns the PowerShell command to delete a CName-Record.
 func (params RecordCNameDeleteParams) pwshCommand() string {
 	// Base command
-	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'CName' -Force -Name '%s' -ZoneName '%s'", params.Name, params.Zone)
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'CName' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
 }
 
 // RecordCNameDelete deletes a CName-Record.
@@ -241,7 +257,7 @@ func (c *Client) RecordCNameDelete(ctx context.Context, params RecordCNameDelete
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return winerror.Errorf(cmd, "windows.dns.RecordCNameDelete: %s", err)
+		return winerror.Errorf(cmd, "windows.dns.RecordCNameDelete: %w", err)
 	}
 
 	return nil
@@ -231,6 +231,11 @@ func (suite *DnsServerUnitTestSuite) TestRecordCNameDeletePwshCommand() {
 				RecordCNameDeleteParams{Name: "test", Zone: "test.local"},
 				"Remove-DnsServerResourceRecord -RRType 'CName' -Force -Name 'test' -ZoneName 'test.local'",
 			},
+			{
+				"assert with zone scope",
+				RecordCNameDeleteParams{Name: "test", Zone: "test.local", ZoneScope: "internal"},
+				"Remove-DnsServerResourceRecord -RRType 'CName' -Force -Name 'test' -ZoneName 'test.local' -ZoneScope 'internal'",
+			},
 		}
 
 		for _, tc := range tcs {
@@ -0,0 +1,579 @@
+package dns
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+	"gopkg.in/yaml.v3"
+)
+
+// Actions of the RecordImport function.
+const (
+	RecordImportActionCreate    string = "Create"
+	RecordImportActionUpdate    string = "Update"
+	RecordImportActionUnchanged string = "Unchanged"
+)
+
+// recordImportColumns contains the columns of a record import. Only the TTL column is optional.
+var recordImportColumns = []string{"name", "zone", "type", "data", "ttl"}
+
+// RecordImportRow represents a single record of a record import.
+type RecordImportRow struct {
+	// Line is the line of the row in the CSV or YAML document.
+	// It is 0 if the row was not parsed from a document.
+	Line int
+
+	// Name is the name of the record relative to the zone, e.g. "www", or "@" for the zone itself.
+	// Fully qualified names with a trailing dot are allowed as well.
+	Name string
+
+	// Zone is the zone in which the record is located.
+	Zone string
+
+	// RecordType is the type of the record, e.g. "A" or "MX".
+	RecordType string
+
+	// Data is the record data in the master file format of RFC 1035, e.g. "10 mail" for a MX-Record.
+	// Domain names in the data are relative to the zone unless they end with a dot.
+	// The data of a TXT-Record is used as a single value unless it starts with a quote.
+	Data string
+
+	// TimeToLive is the time to live (TTL) of the record.
+	// If not provided, the default TTL is 86400 seconds.
+	TimeToLive time.Duration
+}
+
+// newRecordImportRow returns a row of a record import.
+// The TTL is parsed in seconds or in the BIND format with units, e.g. "1h30m".
+func newRecordImportRow(line int, name string, zone string, recordType string, data string, ttl string) (RecordImportRow, error) {
+	row := RecordImportRow{
+		Line:       line,
+		Name:       strings.TrimSpace(name),
+		Zone:       strings.TrimSpace(zone),
+		RecordType: strings.ToUpper(strings.TrimSpace(recordType)),
+		Data:       strings.TrimSpace(data),
+	}
+
+	if ttl = strings.TrimSpace(ttl); ttl != "" {
+		value, ok := parseZoneFileTTL(ttl)
+		if !ok {
+			return row, fmt.Errorf("line %d: invalid TTL '%s'", line, ttl)
+		}
+		row.TimeToLive = value
+	}
+
+	return row, nil
+}
+
+// ParseRecordImportCSV parses the rows of a record import from a CSV document.
+// The first line must contain the column names "name", "zone", "type", "data" and optionally "ttl".
+// The column names are case-insensitive, other columns and rows without values are skipped.
+func ParseRecordImportCSV(r io.Reader) ([]RecordImportRow, error) {
+	var rows []RecordImportRow
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return rows, nil
+	}
+	if err != nil {
+		return rows, fmt.Errorf("windows.dns.ParseRecordImportCSV: %w", err)
+	}
+
+	// Spreadsheet applications may write a byte order mark in front of the first column name.
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))] = i
+	}
+	for _, column := range recordImportColumns[:4] {
+		if _, ok := columns[column]; !ok {
+			return rows, fmt.Errorf("windows.dns.ParseRecordImportCSV: missing column '%s'", column)
+		}
+	}
+
+	field := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return rows, fmt.Errorf("windows.dns.ParseRecordImportCSV: %w", err)
+		}
+
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		row, err := newRecordImportRow(line, field(record, "name"), field(record, "zone"), field(record, "type"), field(record, "data"), field(record, "ttl"))
+		if err != nil {
+			return rows, fmt.Errorf("windows.dns.ParseRecordImportCSV: %w", err)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// recordImportYamlRow contains the unmarshaled YAML of a row of a record import.
+type recordImportYamlRow struct {
+	Name string `yaml:"name"`
+	Zone string `yaml:"zone"`
+	Type string `yaml:"type"`
+	Data string `yaml:"data"`
+	TTL  string `yaml:"ttl"`
+}
+
+// ParseRecordImportYAML parses the rows of a record import from a YAML document.
+// The document must be a list of records with the keys "name", "zone", "type", "data" and optionally "ttl".
+// Other keys are skipped.
+func ParseRecordImportYAML(r io.Reader) ([]RecordImportRow, error) {
+	var rows []RecordImportRow
+
+	var document yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		return rows, fmt.Errorf("windows.dns.ParseRecordImportYAML: %w", err)
+	}
+
+	list := document.Content[0]
+	if list.Kind != yaml.SequenceNode {
+		return rows, fmt.Errorf("windows.dns.ParseRecordImportYAML: line %d: document must be a list of records", list.Line)
+	}
+
+	for _, item := range list.Content {
+		var o recordImportYamlRow
+		if err := item.Decode(&o); err != nil {
+			return rows, fmt.Errorf("windows.dns.ParseRecordImportYAML: line %d: %w", item.Line, err)
+		}
+
+		row, err := newRecordImportRow(item.Line, o.Name, o.Zone, o.Type, o.Data, o.TTL)
+		if err != nil {
+			return rows, fmt.Errorf("windows.dns.ParseRecordImportYAML: %w", err)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// RecordImportParams represents parameters for the RecordImport function.
+type RecordImportParams struct {
+	// Specifies the rows to import, e.g. the rows returned by the ParseRecordImportCSV function.
+	Rows []RecordImportRow
+
+	// Specifies whether the rows are only validated and the actions are reported without changing any record.
+	DryRun bool
+}
+
+// RecordImportResult represents the result of a row of the RecordImport function.
+type RecordImportResult struct {
+	Row RecordImportRow
+
+	// Action is the action for the records of the name and record type of the row, e.g. "Create".
+	// It is empty if the row is invalid.
+	Action string
+
+	// Err is the error of the row if the row is invalid or the records could not be changed.
+	Err error
+}
+
+// recordImportSet contains the rows of a zone with the same name and record type.
+type recordImportSet struct {
+	zone       string
+	name       string
+	recordType string
+	rows       []int
+	keys       []string
+	parser     *zoneFileParser
+	action     string
+
+	// ttlOnly is true if the existing records only differ in the TTL.
+	ttlOnly bool
+}
+
+// recordImportKey returns the record type and data of a record for comparisons.
+// Domain names are case-insensitive, the values of TXT-Records are not.
+func recordImportKey(line zoneFileLine) string {
+	if line.recordType == "TXT" {
+		return line.recordType + " " + line.data
+	}
+	return line.recordType + " " + strings.ToLower(line.data)
+}
+
+// recordImportEntry returns the zone file entry of a row.
+func recordImportEntry(row RecordImportRow) (zoneFileEntry, error) {
+	if row.Name == "" || row.Zone == "" || row.RecordType == "" || row.Data == "" {
+		return zoneFileEntry{}, errors.New("row values 'name', 'zone', 'type' and 'data' must be set")
+	}
+	if strings.HasPrefix(row.Name, "$") || strings.ContainsAny(row.Name, " \t;()\"") {
+		return zoneFileEntry{}, fmt.Errorf("invalid name '%s'", row.Name)
+	}
+	if !slices.Contains(recordListTypes, strings.ToUpper(row.RecordType)) {
+		return zoneFileEntry{}, fmt.Errorf("record type must be one of %s, got '%s'", strings.Join(recordListTypes, ", "), row.RecordType)
+	}
+
+	ttl := row.TimeToLive
+	if ttl == 0 {
+		ttl = defaultTimeToLive
+	}
+	data := row.Data
+	if strings.EqualFold(row.RecordType, "TXT") && !strings.HasPrefix(data, `"`) {
+		data = zoneFileString(data)
+	}
+
+	entries, err := splitZoneFile(fmt.Sprintf("%s %d IN %s %s", row.Name, int64(ttl.Round(time.Second).Seconds()), strings.ToUpper(row.RecordType), data))
+	if err != nil {
+		return zoneFileEntry{}, err
+	}
+	if len(entries) != 1 {
+		return zoneFileEntry{}, fmt.Errorf("invalid data '%s'", row.Data)
+	}
+
+	return entries[0], nil
+}
+
+// conflict returns an error if the records of the set can not be imported together with the other sets
+// or the existing records of the zone. A name with a CNAME-Record must not have other records.
+func (set *recordImportSet) conflict(sets []*recordImportSet, existing []zoneFileLine) error {
+	if set.recordType == "NS" && set.name == "@" {
+		return errors.New("NS-Records of the zone itself are managed by the zone")
+	}
+	if (set.recordType == "CNAME" || set.recordType == "PTR") && len(set.rows) > 1 {
+		return fmt.Errorf("name '%s' must have a single %s-Record", set.name, set.recordType)
+	}
+
+	for _, other := range sets {
+		if other == set || other.zone != set.zone || !strings.EqualFold(other.name, set.name) {
+			continue
+		}
+		if set.recordType == "CNAME" || other.recordType == "CNAME" {
+			return fmt.Errorf("%s-Record of name '%s' conflicts with the %s-Record of the import", set.recordType, set.name, other.recordType)
+		}
+	}
+
+	for _, line := range existing {
+		if !strings.EqualFold(line.name, set.name) || line.recordType == set.recordType {
+			continue
+		}
+		if set.recordType == "CNAME" || line.recordType == "CNAME" {
+			return fmt.Errorf("%s-Record of name '%s' conflicts with the existing %s-Record", set.recordType, set.name, line.recordType)
+		}
+	}
+
+	return nil
+}
+
+// plan sets the action of the set by comparing the imported records with the existing records of the zone.
+func (set *recordImportSet) plan(existing []zoneFileLine) {
+	var existingKeys []string
+	var existingTTL []time.Duration
+	for _, line := range existing {
+		if strings.EqualFold(line.name, set.name) && line.recordType == set.recordType {
+			existingKeys = append(existingKeys, recordImportKey(line))
+			existingTTL = append(existingTTL, line.ttl)
+		}
+	}
+
+	if len(existingKeys) == 0 {
+		set.action = RecordImportActionCreate
+		return
+	}
+
+	importedKeys := slices.Clone(set.keys)
+	slices.Sort(importedKeys)
+	slices.Sort(existingKeys)
+	sameData := slices.Equal(importedKeys, existingKeys)
+
+	ttl := set.parser.records.zoneFileLines()[0].ttl
+	sameTTL := !slices.ContainsFunc(existingTTL, func(t time.Duration) bool { return t != ttl })
+
+	switch {
+	case sameData && sameTTL:
+		set.action = RecordImportActionUnchanged
+	case sameData:
+		set.action = RecordImportActionUpdate
+		set.ttlOnly = true
+	default:
+		set.action = RecordImportActionUpdate
+	}
+}
+
+// recordImportSets validates the rows of the results and returns the record sets to import.
+// The errors of invalid rows are set in the results.
+func (c *Client) recordImportSets(ctx context.Context, results []RecordImportResult) ([]*recordImportSet, error) {
+	zones, err := c.ZoneList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zoneNames := map[string]string{}
+	for _, z := range zones {
+		zoneNames[strings.ToLower(strings.TrimSuffix(z.ZoneName, "."))] = z.ZoneName
+	}
+
+	var sets []*recordImportSet
+	index := map[string]*recordImportSet{}
+	existing := map[string][]zoneFileLine{}
+
+	for i := range results {
+		row := results[i].Row
+
+		entry, err := recordImportEntry(row)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		zone, ok := zoneNames[strings.ToLower(strings.TrimSuffix(row.Zone, "."))]
+		if !ok {
+			results[i].Err = fmt.Errorf("zone '%s' does not exist", row.Zone)
+			continue
+		}
+		if _, ok := existing[zone]; !ok {
+			records, err := c.RecordList(ctx, RecordListParams{Zone: zone})
+			if err != nil {
+				return nil, err
+			}
+			existing[zone] = records.zoneFileLines()
+		}
+
+		// Parse the row on its own to get the name and the data of the record.
+		p := &zoneFileParser{zone: zone + ".", origin: zone + ".", index: map[string]int{}}
+		if err := p.parseEntry(entry); err != nil {
+			results[i].Err = err
+			continue
+		}
+		line := p.records.zoneFileLines()[0]
+
+		key := strings.ToLower(zone) + " " + line.recordType + " " + strings.ToLower(line.name)
+		set, ok := index[key]
+		if !ok {
+			set = &recordImportSet{
+				zone:       zone,
+				name:       line.name,
+				recordType: line.recordType,
+				parser:     &zoneFileParser{zone: zone + ".", origin: zone + ".", index: map[string]int{}},
+			}
+			index[key] = set
+			sets = append(sets, set)
+		}
+		if slices.Contains(set.keys, recordImportKey(line)) {
+			results[i].Err = fmt.Errorf("duplicate %s-Record '%s' of name '%s'", line.recordType, line.data, line.name)
+			continue
+		}
+
+		if err := set.parser.parseEntry(entry); err != nil {
+			results[i].Err = err
+			continue
+		}
+		set.rows = append(set.rows, i)
+		set.keys = append(set.keys, recordImportKey(line))
+	}
+
+	for _, set := range sets {
+		if err := set.conflict(sets, existing[set.zone]); err != nil {
+			for _, i := range set.rows {
+				results[i].Err = err
+			}
+			continue
+		}
+
+		set.plan(existing[set.zone])
+		for _, i := range set.rows {
+			results[i].Action = set.action
+		}
+	}
+
+	return sets, nil
+}
+
+// recordImportErr returns an error with the number of failed rows and the first error of the results.
+// It returns nil if no row failed.
+func recordImportErr(results []RecordImportResult) error {
+	var failed []RecordImportResult
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d of %d rows failed, line %d: %w", len(failed), len(results), failed[0].Row.Line, failed[0].Err)
+}
+
+// pwshReplaceEach returns the PowerShell command that replaces the records of a name and record type
+// with the records of the add calls.
+// The existing records are removed before the records are added, so records with the same data can be added again.
+// If a record cannot be added, the records that were already added are removed and the removed records are added again.
+func pwshReplaceEach(recordType string, name string, adds []string, zone string, zoneScope string) string {
+	return fmt.Sprintf(
+		"$o=@(Get-DnsServerResourceRecord -RRType '%s' -Name '%s' %s -ErrorAction SilentlyContinue) ;$r=@() ;try{$o|Remove-DnsServerResourceRecord %s -Force -ErrorAction Stop ;$r+=%s}catch{$r|Remove-DnsServerResourceRecord %s -Force -ErrorAction SilentlyContinue ;$o|Add-DnsServerResourceRecord %s -ErrorAction SilentlyContinue ;throw $_}",
+		recordType,
+		name,
+		pwshZoneName(zone, zoneScope),
+		pwshZoneName(zone, zoneScope),
+		strings.Join(adds, ";$r+="),
+		pwshZoneName(zone, zoneScope),
+		pwshZoneName(zone, zoneScope),
+	)
+}
+
+// replaceRecords replaces the records of a name and record type with the records of the add calls in a single command.
+// The existing records are kept if a record cannot be added.
+func (c *Client) replaceRecords(ctx context.Context, recordType string, name string, zone string, adds []string) error {
+	var o []recordObject
+
+	cmd := pwshReplaceEach(recordType, name, adds, zone, "")
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "failed to replace %s-Records of name '%s': %w", recordType, name, err)
+	}
+
+	return nil
+}
+
+// applyRecordImportSet creates or updates the records of a set with the functions of its record type.
+// Record types whose update function only updates the TTL are replaced by a single command,
+// which keeps the existing records if the new records cannot be added.
+func (c *Client) applyRecordImportSet(ctx context.Context, set *recordImportSet) error {
+	r := set.parser.records
+	create := set.action == RecordImportActionCreate
+
+	var err error
+	switch set.recordType {
+	case "A":
+		if create {
+			_, err = c.RecordACreate(ctx, RecordACreateParams{Name: set.name, Zone: set.zone, Addresses: r.A[0].Addresses, TimeToLive: r.A[0].TimeToLive})
+		} else {
+			_, err = c.RecordAUpdate(ctx, RecordAUpdateParams{Name: set.name, Zone: set.zone, Addresses: r.A[0].Addresses, TimeToLive: r.A[0].TimeToLive})
+		}
+	case "AAAA":
+		if create {
+			_, err = c.RecordAAAACreate(ctx, RecordAAAACreateParams{Name: set.name, Zone: set.zone, Addresses: r.AAAA[0].Addresses, TimeToLive: r.AAAA[0].TimeToLive})
+		} else {
+			_, err = c.RecordAAAAUpdate(ctx, RecordAAAAUpdateParams{Name: set.name, Zone: set.zone, Addresses: r.AAAA[0].Addresses, TimeToLive: r.AAAA[0].TimeToLive})
+		}
+	case "CNAME":
+		if create {
+			_, err = c.RecordCNameCreate(ctx, RecordCNameCreateParams{Name: set.name, Zone: set.zone, CName: r.CName[0].CName, TimeToLive: r.CName[0].TimeToLive})
+		} else {
+			_, err = c.RecordCNameUpdate(ctx, RecordCNameUpdateParams{Name: set.name, Zone: set.zone, CName: r.CName[0].CName, TimeToLive: r.CName[0].TimeToLive})
+		}
+	case "PTR":
+		if create {
+			_, err = c.RecordPTRCreate(ctx, RecordPTRCreateParams{Name: set.name, Zone: set.zone, PTR: r.PTR[0].PTR, TimeToLive: r.PTR[0].TimeToLive})
+		} else {
+			_, err = c.RecordPTRUpdate(ctx, RecordPTRUpdateParams{Name: set.name, Zone: set.zone, PTR: r.PTR[0].PTR, TimeToLive: r.PTR[0].TimeToLive})
+		}
+	case "MX":
+		params := RecordMXCreateParams{Name: set.name, Zone: set.zone, MailExchangers: r.MX[0].MailExchangers, TimeToLive: r.MX[0].TimeToLive}
+		switch {
+		case set.ttlOnly:
+			_, err = c.RecordMXUpdate(ctx, RecordMXUpdateParams{Name: set.name, Zone: set.zone, TimeToLive: params.TimeToLive})
+		case create:
+			_, err = c.RecordMXCreate(ctx, params)
+		default:
+			err = c.replaceRecords(ctx, "MX", set.name, set.zone, params.pwshAdds())
+		}
+	case "SRV":
+		params := RecordSRVCreateParams{Name: set.name, Zone: set.zone, Targets: r.SRV[0].Targets, TimeToLive: r.SRV[0].TimeToLive}
+		switch {
+		case set.ttlOnly:
+			_, err = c.RecordSRVUpdate(ctx, RecordSRVUpdateParams{Name: set.name, Zone: set.zone, TimeToLive: params.TimeToLive})
+		case create:
+			_, err = c.RecordSRVCreate(ctx, params)
+		default:
+			err = c.replaceRecords(ctx, "SRV", set.name, set.zone, params.pwshAdds())
+		}
+	case "TXT":
+		params := RecordTXTCreateParams{Name: set.name, Zone: set.zone, Values: r.TXT[0].Values, TimeToLive: r.TXT[0].TimeToLive}
+		switch {
+		case set.ttlOnly:
+			_, err = c.RecordTXTUpdate(ctx, RecordTXTUpdateParams{Name: set.name, Zone: set.zone, TimeToLive: params.TimeToLive})
+		case create:
+			_, err = c.RecordTXTCreate(ctx, params)
+		default:
+			err = c.replaceRecords(ctx, "TXT", set.name, set.zone, params.pwshAdds())
+		}
+	case "NS":
+		params := RecordNSCreateParams{Name: set.name, Zone: set.zone, NameServers: r.NS[0].NameServers, TimeToLive: r.NS[0].TimeToLive}
+		switch {
+		case set.ttlOnly:
+			_, err = c.RecordNSUpdate(ctx, RecordNSUpdateParams{Name: set.name, Zone: set.zone, TimeToLive: params.TimeToLive})
+		case create:
+			_, err = c.RecordNSCreate(ctx, params)
+		default:
+			err = c.replaceRecords(ctx, "NS", set.name, set.zone, params.pwshAdds())
+		}
+	}
+
+	return err
+}
+
+// RecordImport creates and updates records of multiple zones. It returns a result per row.
+// The rows of a zone with the same name and record type are combined to a record set,
+// which replaces the existing records of the name and record type.
+// Records of other record types and names are kept.
+//
+// All rows are validated before any record is changed: the zone must exist, the data must be valid
+// for the record type, e.g. an IPv4 address for A-Records, and a name with a CNAME-Record must not have other records.
+// If a row is invalid, no record is changed and the actions of the valid rows are reported like a dry-run.
+// Record sets that can not be changed are reported with their error and the import continues with the next record set.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordImport(ctx context.Context, params RecordImportParams) ([]RecordImportResult, error) {
+	results := make([]RecordImportResult, len(params.Rows))
+	for i, row := range params.Rows {
+		results[i].Row = row
+	}
+
+	// Validate all rows before any record is changed.
+	sets, err := c.recordImportSets(ctx, results)
+	if err != nil {
+		return results, fmt.Errorf("windows.dns.RecordImport: %w", err)
+	}
+	if err := recordImportErr(results); err != nil {
+		return results, fmt.Errorf("windows.dns.RecordImport: no records were changed: %w", err)
+	}
+
+	if params.DryRun {
+		return results, nil
+	}
+
+	for _, set := range sets {
+		if set.action == RecordImportActionUnchanged {
+			continue
+		}
+		if err := c.applyRecordImportSet(ctx, set); err != nil {
+			for _, i := range set.rows {
+				results[i].Err = err
+			}
+		}
+	}
+
+	if err := recordImportErr(results); err != nil {
+		return results, fmt.Errorf("windows.dns.RecordImport: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,224 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	recordImportCsv = "\ufeffName,Zone,Type,Data,TTL,Owner\n" +
+		"web,test.local,A,192.168.10.1,1h,network\n" +
+		",,,,,\n" +
+		"_ldap._tcp,test.local,srv,0 100 389 dc01,,network\n" +
+		"txt,test.local,TXT,\"v=spf1 mx -all\",300\n"
+
+	recordImportYaml = `- name: web
+  zone: test.local
+  type: A
+  data: 192.168.10.1
+  ttl: 1h
+- name: mail
+  zone: test.local
+  type: mx
+  data: 10 mail.example.com.
+  ttl: 300
+  owner: network
+`
+)
+
+// Test the ParseRecordImportCSV function.
+func (suite *DnsServerUnitTestSuite) TestParseRecordImportCSV() {
+	suite.T().Parallel()
+
+	suite.Run("should parse the rows", func() {
+		rows, err := ParseRecordImportCSV(strings.NewReader(recordImportCsv))
+		suite.Require().NoError(err)
+		suite.Equal([]RecordImportRow{
+			{Line: 2, Name: "web", Zone: "test.local", RecordType: "A", Data: "192.168.10.1", TimeToLive: time.Hour},
+			{Line: 4, Name: "_ldap._tcp", Zone: "test.local", RecordType: "SRV", Data: "0 100 389 dc01"},
+			{Line: 5, Name: "txt", Zone: "test.local", RecordType: "TXT", Data: "v=spf1 mx -all", TimeToLive: time.Minute * 5},
+		}, rows)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description string
+			input       string
+			expectedErr string
+		}{
+			{
+				"assert error with a missing column",
+				"name,zone,type\nweb,test.local,A\n",
+				"windows.dns.ParseRecordImportCSV: missing column 'data'",
+			},
+			{
+				"assert error with an invalid TTL",
+				"name,zone,type,data,ttl\nweb,test.local,A,192.168.10.1,1y\n",
+				"windows.dns.ParseRecordImportCSV: line 2: invalid TTL '1y'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := ParseRecordImportCSV(strings.NewReader(tc.input))
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test the ParseRecordImportYAML function.
+func (suite *DnsServerUnitTestSuite) TestParseRecordImportYAML() {
+	suite.T().Parallel()
+
+	suite.Run("should parse the rows", func() {
+		rows, err := ParseRecordImportYAML(strings.NewReader(recordImportYaml))
+		suite.Require().NoError(err)
+		suite.Equal([]RecordImportRow{
+			{Line: 1, Name: "web", Zone: "test.local", RecordType: "A", Data: "192.168.10.1", TimeToLive: time.Hour},
+			{Line: 6, Name: "mail", Zone: "test.local", RecordType: "MX", Data: "10 mail.example.com.", TimeToLive: time.Minute * 5},
+		}, rows)
+	})
+
+	suite.Run("should return an empty list for an empty document", func() {
+		rows, err := ParseRecordImportYAML(strings.NewReader(""))
+		suite.NoError(err)
+		suite.Empty(rows)
+	})
+
+	suite.Run("should return an error if the document is not a list", func() {
+		_, err := ParseRecordImportYAML(strings.NewReader("name: web\n"))
+		suite.EqualError(err, "windows.dns.ParseRecordImportYAML: line 1: document must be a list of records")
+	})
+}
+
+// Test the pwshReplaceEach function.
+func (suite *DnsServerUnitTestSuite) TestPwshReplaceEach() {
+	suite.Run("should return the command that replaces the records and restores them on failure", func() {
+		adds := RecordMXCreateParams{
+			Name:           "@",
+			Zone:           "test.local",
+			MailExchangers: []MailExchanger{{MailExchange: "mail1.test.local.", Preference: 10}, {MailExchange: "mail2.test.local.", Preference: 20}},
+			TimeToLive:     time.Hour,
+		}.pwshAdds()
+		suite.Equal(
+			"$o=@(Get-DnsServerResourceRecord -RRType 'MX' -Name '@' -ZoneName 'test.local' -ErrorAction SilentlyContinue) ;$r=@() ;try{$o|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction Stop ;$r+="+
+				"Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -MailExchange 'mail1.test.local.' -Preference 10;$r+="+
+				"Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -MailExchange 'mail2.test.local.' -Preference 20}"+
+				"catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;$o|Add-DnsServerResourceRecord -ZoneName 'test.local' -ErrorAction SilentlyContinue ;throw $_}",
+			pwshReplaceEach("MX", "@", adds, "test.local", ""),
+		)
+	})
+}
+
+// Test the RecordImport function.
+func (suite *DnsServerUnitTestSuite) TestRecordImport() {
+	suite.T().Parallel()
+
+	suite.Run("should report the actions without changing records in a dry-run", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneList}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordListParams{Zone: "test.local"}.pwshCommand()).
+			Return(connection.CmdResult{StdOut: recordListJson}, nil)
+		results, err := c.RecordImport(ctx, RecordImportParams{
+			DryRun: true,
+			Rows: []RecordImportRow{
+				{Line: 1, Name: "web", Zone: "test.local", RecordType: "A", Data: "192.168.10.1", TimeToLive: time.Hour},
+				{Line: 2, Name: "WEB", Zone: "TEST.LOCAL", RecordType: "A", Data: "192.168.10.2", TimeToLive: time.Hour},
+				{Line: 3, Name: "web", Zone: "test.local", RecordType: "AAAA", Data: "fd00::1"},
+				{Line: 4, Name: "www", Zone: "test.local", RecordType: "CNAME", Data: "web", TimeToLive: time.Minute * 5},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(RecordImportActionUnchanged, results[0].Action)
+		suite.Equal(RecordImportActionUnchanged, results[1].Action)
+		suite.Equal(RecordImportActionCreate, results[2].Action)
+		suite.Equal(RecordImportActionUpdate, results[3].Action)
+	})
+
+	suite.Run("should create the records", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneList}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordListParams{Zone: "test.local"}.pwshCommand()).
+			Return(connection.CmdResult{StdOut: recordListJson}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordACreateParams{
+				Name:       "test",
+				Zone:       "test.local",
+				Addresses:  []netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("2.2.2.2")},
+				TimeToLive: time.Hour,
+			}.pwshCommand()).
+			Return(connection.CmdResult{StdOut: recordAJson}, nil)
+		results, err := c.RecordImport(ctx, RecordImportParams{
+			Rows: []RecordImportRow{
+				{Line: 1, Name: "test", Zone: "test.local", RecordType: "A", Data: "1.1.1.1", TimeToLive: time.Hour},
+				{Line: 2, Name: "test.test.local.", Zone: "test.local", RecordType: "A", Data: "2.2.2.2", TimeToLive: time.Hour},
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Equal(RecordImportActionCreate, results[0].Action)
+		suite.Equal(RecordImportActionCreate, results[1].Action)
+	})
+
+	suite.Run("should return the errors of invalid rows without changing records", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneList}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordListParams{Zone: "test.local"}.pwshCommand()).
+			Return(connection.CmdResult{StdOut: recordListJson}, nil)
+		results, err := c.RecordImport(ctx, RecordImportParams{
+			Rows: []RecordImportRow{
+				{Line: 1, Name: "test", Zone: "notexist.local", RecordType: "A", Data: "1.1.1.1"},
+				{Line: 2, Name: "test", Zone: "test.local", RecordType: "A", Data: "fd00::1"},
+				{Line: 3, Name: "web", Zone: "test.local", RecordType: "CNAME", Data: "test"},
+				{Line: 4, Name: "www", Zone: "test.local", RecordType: "TXT", Data: "hello"},
+				{Line: 5, Name: "mail", Zone: "test.local", RecordType: "A", Data: "1.1.1.1"},
+				{Line: 6, Name: "mail", Zone: "test.local", RecordType: "A", Data: "1.1.1.1"},
+				{Line: 7, Name: "@", Zone: "test.local", RecordType: "NS", Data: "ns1"},
+				{Line: 8, Name: "test", Zone: "test.local", RecordType: "SOA", Data: "ns1 hostmaster 1 2 3 4 5"},
+				{Line: 9, Name: "new", Zone: "test.local", RecordType: "A", Data: "1.1.1.1"},
+			},
+		})
+		suite.EqualError(err, "windows.dns.RecordImport: no records were changed: 7 of 9 rows failed, line 1: zone 'notexist.local' does not exist")
+		suite.EqualError(results[1].Err, "invalid address 'fd00::1' of A-Record")
+		suite.EqualError(results[2].Err, "CNAME-Record of name 'web' conflicts with the existing A-Record")
+		suite.EqualError(results[3].Err, "TXT-Record of name 'www' conflicts with the existing CNAME-Record")
+		suite.NoError(results[4].Err)
+		suite.EqualError(results[5].Err, "duplicate A-Record '1.1.1.1' of name 'mail'")
+		suite.EqualError(results[6].Err, "NS-Records of the zone itself are managed by the zone")
+		suite.EqualError(results[7].Err, "record type must be one of A, AAAA, CNAME, PTR, MX, SRV, TXT, NS, got 'SOA'")
+		suite.Equal(RecordImportActionCreate, results[8].Action)
+	})
+}
@@ -0,0 +1,346 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// Timestamp filters of the RecordList function.
+// Static records have no timestamp, dynamic records have a timestamp that is refreshed by the clients.
+const (
+	RecordTimestampStatic  string = "Static"
+	RecordTimestampDynamic string = "Dynamic"
+)
+
+// recordListTypes contains the record types that are supported by the RecordList function.
+var recordListTypes = []string{"A", "AAAA", "CNAME", "PTR", "MX", "SRV", "TXT", "NS"}
+
+// Records contains the records of a zone grouped by their record type.
+// Records with the same name and type are combined like the read function of the record type does.
+type Records struct {
+	A     []RecordA
+	AAAA  []RecordAAAA
+	CName []RecordCName
+	PTR   []RecordPTR
+	MX    []RecordMX
+	SRV   []RecordSRV
+	TXT   []RecordTXT
+	NS    []RecordNS
+}
+
+// convertOutput converts the unmarshaled JSON output from the recordObjects to a Records object.
+// The record objects must be grouped by their record type and name.
+func (r *Records) convertOutput(groups [][]recordObject) error {
+	for _, o := range groups {
+		switch strings.ToUpper(o[0].RecordType) {
+		case "A":
+			var record RecordA
+			if err := record.convertOutput(o); err != nil {
+				return err
+			}
+			r.A = append(r.A, record)
+		case "AAAA":
+			var record RecordAAAA
+			if err := record.convertOutput(o); err != nil {
+				return err
+			}
+			r.AAAA = append(r.AAAA, record)
+		case "CNAME":
+			for _, object := range o {
+				var record RecordCName
+				record.convertOutput(object)
+				r.CName = append(r.CName, record)
+			}
+		case "PTR":
+			for _, object := range o {
+				var record RecordPTR
+				record.convertOutput(object)
+				r.PTR = append(r.PTR, record)
+			}
+		case "MX":
+			var record RecordMX
+			if err := record.convertOutput(o); err != nil {
+				return err
+			}
+			r.MX = append(r.MX, record)
+		case "SRV":
+			var record RecordSRV
+			if err := record.convertOutput(o); err != nil {
+				return err
+			}
+			r.SRV = append(r.SRV, record)
+		case "TXT":
+			var record RecordTXT
+			if err := record.convertOutput(o); err != nil {
+				return err
+			}
+			r.TXT = append(r.TXT, record)
+		case "NS":
+			var record RecordNS
+			if err := record.convertOutput(o); err != nil {
+				return err
+			}
+			r.NS = append(r.NS, record)
+		}
+	}
+
+	return nil
+}
+
+// recordGroupKey returns the key of the group of a record object, which consists of its record type and name.
+func recordGroupKey(record recordObject) string {
+	return strings.ToUpper(record.RecordType) + " " + strings.ToLower(record.Name)
+}
+
+// groupRecords groups the record objects by their record type and name and keeps the order of the first occurrence.
+// Record types that are not supported by the RecordList function are skipped.
+func groupRecords(o []recordObject) [][]recordObject {
+	var groups [][]recordObject
+	index := map[string]int{}
+
+	for _, record := range o {
+		if !slices.Contains(recordListTypes, strings.ToUpper(record.RecordType)) {
+			continue
+		}
+
+		key := recordGroupKey(record)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], record)
+	}
+
+	return groups
+}
+
+// RecordListParams represents parameters for the RecordList function.
+// All filters are optional and are combined, so a record must match all of them.
+type RecordListParams struct {
+	// Specifies the zone of the records.
+	Zone string
+
+	// Specifies the zone scope of the records.
+	// If not provided, the records of the default zone scope are returned.
+	ZoneScope string
+
+	// Specifies the prefix of the record names, e.g. "web" for "web01" and "web02".
+	// The comparison is case-insensitive.
+	NamePrefix string
+
+	// Specifies the suffix of the record names, e.g. ".dev" for "web01.dev".
+	// The comparison is case-insensitive.
+	NameSuffix string
+
+	// Specifies the record type, e.g. "A" or "MX".
+	// If not provided, all supported record types are returned.
+	RecordType string
+
+	// Specifies whether only static (RecordTimestampStatic) or dynamic (RecordTimestampDynamic) records are returned.
+	// If not provided, static and dynamic records are returned.
+	Timestamp string
+
+	// Specifies the minimum age of the timestamp of dynamic records.
+	// Records that have not been refreshed within this duration are considered stale.
+	// Static records are never stale.
+	StaleAge time.Duration
+}
+
+// pwshCommand returns the PowerShell command to list the records of a zone.
+// Each record is converted to a single JSON line, which is decoded by the runRecordLines function.
+func (params RecordListParams) pwshCommand() string {
+	return fmt.Sprintf("%s | ForEach-Object{ConvertTo-Json $_ -Compress}", params.pwshFilterCommand())
+}
+
+// pwshSortedCommand returns the PowerShell command to list the records of a zone sorted by their name and record type.
+// The records of a name and record type are returned one after another, so they can be combined while they are decoded.
+func (params RecordListParams) pwshSortedCommand() string {
+	return fmt.Sprintf("%s | Sort-Object -Property HostName,RecordType | ForEach-Object{ConvertTo-Json $_ -Compress}", params.pwshFilterCommand())
+}
+
+// pwshFilterCommand returns the PowerShell command to get the records of a zone that match the filters of the parameters.
+func (params RecordListParams) pwshFilterCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Get-DnsServerResourceRecord %s", pwshZoneName(params.Zone, params.ZoneScope))}
+	if params.RecordType != "" {
+		cmd = append(cmd, fmt.Sprintf("-RRType '%s'", strings.ToUpper(params.RecordType)))
+	}
+
+	// Add filters
+	filters := []string{}
+	if params.NamePrefix != "" {
+		filters = append(filters, fmt.Sprintf("$_.HostName.StartsWith('%s','OrdinalIgnoreCase')", strings.ReplaceAll(params.NamePrefix, "'", "''")))
+	}
+	if params.NameSuffix != "" {
+		filters = append(filters, fmt.Sprintf("$_.HostName.EndsWith('%s','OrdinalIgnoreCase')", strings.ReplaceAll(params.NameSuffix, "'", "''")))
+	}
+	if params.Timestamp == RecordTimestampStatic {
+		filters = append(filters, "$_.Timestamp -eq $null")
+	}
+	if params.Timestamp == RecordTimestampDynamic || params.StaleAge != 0 {
+		filters = append(filters, "$_.Timestamp -ne $null")
+	}
+	if params.StaleAge != 0 {
+		filters = append(filters, fmt.Sprintf("$_.Timestamp -lt (Get-Date).AddSeconds(-%d)", int64(params.StaleAge.Round(time.Second).Seconds())))
+	}
+	if len(filters) > 0 {
+		cmd = append(cmd, fmt.Sprintf("| Where-Object{%s}", strings.Join(filters, " -and ")))
+	}
+
+	return strings.Join(cmd, " ")
+}
+
+// validate returns an error if the parameters of the RecordList function are invalid.
+func (params RecordListParams) validate() error {
+	if params.Zone == "" {
+		return errors.New("record parameter 'Zone' must be set")
+	}
+	if params.RecordType != "" && !slices.Contains(recordListTypes, strings.ToUpper(params.RecordType)) {
+		return fmt.Errorf("record parameter 'RecordType' must be one of %s, got '%s'", strings.Join(recordListTypes, ", "), params.RecordType)
+	}
+	if params.Timestamp != "" && params.Timestamp != RecordTimestampStatic && params.Timestamp != RecordTimestampDynamic {
+		return fmt.Errorf("record parameter 'Timestamp' must be '%s' or '%s', got '%s'", RecordTimestampStatic, RecordTimestampDynamic, params.Timestamp)
+	}
+	if params.Timestamp == RecordTimestampStatic && params.StaleAge != 0 {
+		return errors.New("record parameter 'StaleAge' can not be used for static records")
+	}
+	return nil
+}
+
+// RecordList lists the records of a zone that match the filters of the parameters.
+// It returns the records of all supported record types, other record types are skipped.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordList(ctx context.Context, params RecordListParams) (Records, error) {
+	var r Records
+	var o []recordObject
+
+	// Assert needed parameters
+	if err := params.validate(); err != nil {
+		return r, fmt.Errorf("windows.dns.RecordList: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := runRecordLines(ctx, c, cmd, func(record recordObject) error {
+		o = append(o, record)
+		return nil
+	}); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordList: %w", err)
+	}
+
+	// Convert the output to a Records object.
+	if err := r.convertOutput(groupRecords(o)); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordList: failed to convert output to Records object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordListFunc lists the records of a zone like the RecordList function,
+// but passes the records to fn while the output is decoded instead of returning all records at once.
+// Each call of fn receives the records of a single name and record type,
+// so the decoded records of large zones are not kept in memory together.
+// The connection still returns the complete output of the command before it is decoded.
+// If fn returns an error, the listing stops and the error is returned unchanged.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordListFunc(ctx context.Context, params RecordListParams, fn func(Records) error) error {
+	var group []recordObject
+	var fnErr error
+
+	// Assert needed parameters
+	if err := params.validate(); err != nil {
+		return fmt.Errorf("windows.dns.RecordListFunc: %w", err)
+	}
+
+	// flush converts the current group to a Records object and passes it to fn.
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+
+		var r Records
+		if err := r.convertOutput([][]recordObject{group}); err != nil {
+			return fmt.Errorf("failed to convert output to Records object: %w", err)
+		}
+		group = nil
+
+		fnErr = fn(r)
+		return fnErr
+	}
+
+	// Run command
+	// The records are sorted, so the records of a group are decoded one after another.
+	cmd := params.pwshSortedCommand()
+	err := runRecordLines(ctx, c, cmd, func(record recordObject) error {
+		if !slices.Contains(recordListTypes, strings.ToUpper(record.RecordType)) {
+			return nil
+		}
+
+		if len(group) > 0 && recordGroupKey(group[0]) != recordGroupKey(record) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		group = append(group, record)
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return winerror.Errorf(cmd, "windows.dns.RecordListFunc: %w", err)
+	}
+
+	return nil
+}
+
+// runRecordLines runs a command that returns a JSON object per line and passes each decoded record object to fn.
+// The output of the command is read completely by the connection before it is decoded.
+// The decoding stops at the first error that is returned by fn.
+func runRecordLines(ctx context.Context, c *Client, cmd string, fn func(recordObject) error) error {
+	// Run the command
+	result, err := c.Connection.RunWithPowershell(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	// Handle stderr
+	if result.StdErr != "" {
+		stderr, err := c.decodeCliXmlErr(result.StdErr)
+		if err != nil {
+			return err
+		}
+
+		return parsing.NewPwshError(stderr)
+	}
+
+	// Decode stdout
+	decoder := json.NewDecoder(strings.NewReader(result.StdOut))
+	for {
+		var record recordObject
+		if err := decoder.Decode(&record); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// Fixtures
+const (
+	recordListJson = `{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"SOA","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"ExpireLimit = 1.00:00:00 MinimumTimeToLive = 01:00:00 PrimaryServer = \"winsrv.test.local.\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":6}
+{"DistinguishedName":"DC=web,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"web","RecordType":"A","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"IPv4Address = \"192.168.10.1\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":1}
+{"DistinguishedName":"DC=www,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"www","RecordType":"CNAME","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"HostNameAlias = \"web.test.local.\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":5}
+{"DistinguishedName":"DC=web,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"web","RecordType":"A","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"IPv4Address = \"192.168.10.2\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":1}
+{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"WINS","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"WinsServers = \"192.168.10.1\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":65281}
+`
+)
+
+// Test the groupRecords function.
+func (suite *DnsServerUnitTestSuite) TestGroupRecords() {
+	suite.Run("should group the records by type and name and skip unsupported record types", func() {
+		o := []recordObject{
+			{Name: "web", RecordType: "A"},
+			{Name: "@", RecordType: "SOA"},
+			{Name: "WEB", RecordType: "AAAA"},
+			{Name: "Web", RecordType: "A"},
+		}
+
+		groups := groupRecords(o)
+		suite.Equal([][]recordObject{
+			{{Name: "web", RecordType: "A"}, {Name: "Web", RecordType: "A"}},
+			{{Name: "WEB", RecordType: "AAAA"}},
+		}, groups)
+	})
+}
+
+// Test the convertOutput method.
+func (suite *DnsServerUnitTestSuite) TestRecordsConvertOutput() {
+	suite.Run("should return an error for invalid record data", func() {
+		o := [][]recordObject{
+			{{Name: "web", RecordType: "A", RecordData: recordRecordData{CimInstanceProperties: parsing.CimClassKeyVal{"IPv4Address": "invalid"}}}},
+		}
+
+		r := Records{}
+		suite.Error(r.convertOutput(o))
+	})
+}
+
+// Test RecordList related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordListPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordListParams
+			expectedCmd     string
+		}{
+			{
+				"assert without filters",
+				RecordListParams{Zone: "test.local"},
+				"Get-DnsServerResourceRecord -ZoneName 'test.local' | ForEach-Object{ConvertTo-Json $_ -Compress}",
+			},
+			{
+				"assert with zone scope",
+				RecordListParams{Zone: "test.local", ZoneScope: "internal"},
+				"Get-DnsServerResourceRecord -ZoneName 'test.local' -ZoneScope 'internal' | ForEach-Object{ConvertTo-Json $_ -Compress}",
+			},
+			{
+				"assert with name and record type filters",
+				RecordListParams{Zone: "test.local", NamePrefix: "web", NameSuffix: ".dev", RecordType: "a"},
+				"Get-DnsServerResourceRecord -ZoneName 'test.local' -RRType 'A' | Where-Object{$_.HostName.StartsWith('web','OrdinalIgnoreCase') -and $_.HostName.EndsWith('.dev','OrdinalIgnoreCase')} | ForEach-Object{ConvertTo-Json $_ -Compress}",
+			},
+			{
+				"assert with quotes in the name filters",
+				RecordListParams{Zone: "test.local", NamePrefix: "o'web", NameSuffix: "'dev"},
+				"Get-DnsServerResourceRecord -ZoneName 'test.local' | Where-Object{$_.HostName.StartsWith('o''web','OrdinalIgnoreCase') -and $_.HostName.EndsWith('''dev','OrdinalIgnoreCase')} | ForEach-Object{ConvertTo-Json $_ -Compress}",
+			},
+			{
+				"assert with static timestamp filter",
+				RecordListParams{Zone: "test.local", Timestamp: RecordTimestampStatic},
+				"Get-DnsServerResourceRecord -ZoneName 'test.local' | Where-Object{$_.Timestamp -eq $null} | ForEach-Object{ConvertTo-Json $_ -Compress}",
+			},
+			{
+				"assert with stale age filter",
+				RecordListParams{Zone: "test.local", StaleAge: time.Hour * 168},
+				"Get-DnsServerResourceRecord -ZoneName 'test.local' | Where-Object{$_.Timestamp -ne $null -and $_.Timestamp -lt (Get-Date).AddSeconds(-604800)} | ForEach-Object{ConvertTo-Json $_ -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the records of the zone", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerResourceRecord -ZoneName 'test.local' | ForEach-Object{ConvertTo-Json $_ -Compress}").
+			Return(connection.CmdResult{StdOut: recordListJson}, nil)
+		actualRecords, err := c.RecordList(ctx, RecordListParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Len(actualRecords.A, 1)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("192.168.10.2")}, actualRecords.A[0].Addresses)
+		suite.Len(actualRecords.CName, 1)
+		suite.Equal("web.test.local.", actualRecords.CName[0].CName)
+		suite.Empty(actualRecords.MX)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordListParams
+			expectedErr     string
+		}{
+			{
+				"assert error without zone",
+				RecordListParams{},
+				"windows.dns.RecordList: record parameter 'Zone' must be set",
+			},
+			{
+				"assert error with an unsupported record type",
+				RecordListParams{Zone: "test.local", RecordType: "SOA"},
+				"windows.dns.RecordList: record parameter 'RecordType' must be one of A, AAAA, CNAME, PTR, MX, SRV, TXT, NS, got 'SOA'",
+			},
+			{
+				"assert error with an invalid timestamp filter",
+				RecordListParams{Zone: "test.local", Timestamp: "Aged"},
+				"windows.dns.RecordList: record parameter 'Timestamp' must be 'Static' or 'Dynamic', got 'Aged'",
+			},
+			{
+				"assert error with stale age on static records",
+				RecordListParams{Zone: "test.local", Timestamp: RecordTimestampStatic, StaleAge: time.Hour},
+				"windows.dns.RecordList: record parameter 'StaleAge' can not be used for static records",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.RecordList(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test RecordListFunc related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordListSortedPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordListParams{Zone: "test.local", RecordType: "A", Timestamp: RecordTimestampDynamic}.pwshSortedCommand()
+		suite.Equal("Get-DnsServerResourceRecord -ZoneName 'test.local' -RRType 'A' | Where-Object{$_.Timestamp -ne $null} | Sort-Object -Property HostName,RecordType | ForEach-Object{ConvertTo-Json $_ -Compress}", actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordListFunc() {
+	suite.T().Parallel()
+
+	// The sorted output of the fixture: SOA, WINS, A, A, CNAME.
+	lines := strings.Split(recordListJson, "\n")
+	recordListSortedJson := strings.Join([]string{lines[0], lines[4], lines[1], lines[3], lines[2]}, "\n")
+
+	suite.Run("should pass the records of each name and type to the function", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerResourceRecord -ZoneName 'test.local' | Sort-Object -Property HostName,RecordType | ForEach-Object{ConvertTo-Json $_ -Compress}").
+			Return(connection.CmdResult{StdOut: recordListSortedJson}, nil)
+
+		var actualRecords []Records
+		err := c.RecordListFunc(ctx, RecordListParams{Zone: "test.local"}, func(r Records) error {
+			actualRecords = append(actualRecords, r)
+			return nil
+		})
+		suite.NoError(err)
+		suite.Require().Len(actualRecords, 2)
+		suite.Len(actualRecords[0].A, 1)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("192.168.10.2")}, actualRecords[0].A[0].Addresses)
+		suite.Len(actualRecords[1].CName, 1)
+		suite.Equal("web.test.local.", actualRecords[1].CName[0].CName)
+	})
+
+	suite.Run("should stop and return the error of the function", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerResourceRecord -ZoneName 'test.local' | Sort-Object -Property HostName,RecordType | ForEach-Object{ConvertTo-Json $_ -Compress}").
+			Return(connection.CmdResult{StdOut: recordListSortedJson}, nil)
+
+		errStop := errors.New("stop")
+		calls := 0
+		err := c.RecordListFunc(ctx, RecordListParams{Zone: "test.local"}, func(r Records) error {
+			calls++
+			return errStop
+		})
+		suite.ErrorIs(err, errStop)
+		suite.Equal(1, calls)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		err := c.RecordListFunc(ctx, RecordListParams{}, func(r Records) error { return nil })
+		suite.EqualError(err, "windows.dns.RecordListFunc: record parameter 'Zone' must be set")
+	})
+}
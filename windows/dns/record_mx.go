@@ -0,0 +1,327 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// RecordMX represents a DNS MX-Record.
+type RecordMX struct {
+	DistinguishedName string
+	Name              string
+	MailExchangers    []MailExchanger
+	Timestamp         time.Time
+	TimeToLive        time.Duration
+}
+
+// MailExchanger represents a single mail exchanger entry of a MX-Record.
+type MailExchanger struct {
+	// Specifies the fully qualified domain name of the mail exchanger.
+	MailExchange string
+
+	// Specifies the priority of the mail exchanger.
+	// A lower value indicates a higher priority.
+	Preference uint16
+}
+
+// convertOutput converts the unmarshaled JSON output from the recordObject to a RecordMX object.
+func (r *RecordMX) convertOutput(o []recordObject) error {
+	if len(o) == 0 {
+		return errors.New("record not found")
+	}
+
+	// Set the values of the first object to the RecordMX object.
+	r.DistinguishedName = o[0].DistinguishedName
+	r.Name = o[0].Name
+	r.Timestamp = o[0].Timestamp.Time
+	r.TimeToLive = o[0].TimeToLive.Duration
+
+	// Set the mail exchangers and the lowest TTL.
+	for _, record := range o {
+		preference, err := strconv.ParseUint(record.RecordData.CimInstanceProperties["Preference"], 10, 16)
+		if err != nil {
+			return err
+		}
+		r.MailExchangers = append(r.MailExchangers, MailExchanger{
+			MailExchange: record.RecordData.CimInstanceProperties["MailExchange"],
+			Preference:   uint16(preference),
+		})
+
+		// Set the lowest TTL to be RFC2181 compliant.
+		// https://www.rfc-editor.org/rfc/rfc2181#section-5.2
+		if record.TimeToLive.Duration < r.TimeToLive {
+			r.TimeToLive = record.TimeToLive.Duration
+		}
+	}
+
+	return nil
+}
+
+// RecordMXReadParams represents parameters for the MX-Record read function.
+type RecordMXReadParams struct {
+	// Specifies the name of the record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to read a MX-Record.
+func (params RecordMXReadParams) pwshCommand() string {
+	// Base command
+	cmd := []string{"$r=Get-DnsServerResourceRecord -RRType 'MX' -Node"}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+
+	// Ensure output is always an array.
+	cmd = append(cmd, ";if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
+	return strings.Join(cmd, " ")
+}
+
+// RecordMXRead gets a MX-Record by Name and Zone. It returns a RecordMX object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordMXRead(ctx context.Context, params RecordMXReadParams) (RecordMX, error) {
+	var r RecordMX
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return r, errors.New("windows.dns.RecordMXRead: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordMXRead: %w", err)
+	}
+
+	// Convert the output to a RecordMX object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordMXRead: failed to convert output to RecordMX object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordMXCreateParams represents parameters for the MX-Record create function.
+type RecordMXCreateParams struct {
+	// Specifies the name of the Record.
+	// Use "@" to create the record for the zone itself.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the mail exchangers of the record.
+	MailExchangers []MailExchanger
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to create a new MX-Record.
+func (params RecordMXCreateParams) pwshCommand() string {
+	return pwshAddEach(params.pwshAdds(), params.Zone, params.ZoneScope)
+}
+
+// pwshAdds returns the add calls of the records of a new MX-Record.
+func (params RecordMXCreateParams) pwshAdds() []string {
+	// Set default TTL if not provided.
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+
+	// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// The cmdlet only accepts a single mail exchanger, so a record is added for each mail exchanger.
+	adds := []string{}
+	for _, mx := range params.MailExchangers {
+		adds = append(adds, fmt.Sprintf(
+			"Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '%s' %s -TimeToLive $(New-TimeSpan -Seconds %d) -MailExchange '%s' -Preference %d",
+			params.Name,
+			pwshZoneName(params.Zone, params.ZoneScope),
+			seconds,
+			strings.ReplaceAll(mx.MailExchange, "'", "''"),
+			mx.Preference,
+		))
+	}
+
+	return adds
+}
+
+// pwshAddEach returns the PowerShell command that runs the add calls of a record set one after another
+// and converts the added records to a JSON array.
+// The ErrorAction Stop of the calls stops the command at the first record that cannot be added.
+// The records that were already added by the command are removed again before the error is thrown,
+// so a failed create does not leave a partial record set on the server.
+func pwshAddEach(adds []string, zone string, zoneScope string) string {
+	return fmt.Sprintf(
+		"$r=@() ;try{$r+=%s}catch{$r|Remove-DnsServerResourceRecord %s -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+		strings.Join(adds, ";$r+="),
+		pwshZoneName(zone, zoneScope),
+	)
+}
+
+// RecordMXCreate creates a new MX-Record. It returns a RecordMX object.
+// If a mail exchanger cannot be added, the mail exchangers that were already added are removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordMXCreate(ctx context.Context, params RecordMXCreateParams) (RecordMX, error) {
+	var r RecordMX
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || len(params.MailExchangers) == 0 {
+		return r, errors.New("windows.dns.RecordMXCreate: record parameters 'Name', 'Zone' and 'MailExchangers' must be set")
+	}
+
+	// Assert mail exchangers
+	for _, mx := range params.MailExchangers {
+		if mx.MailExchange == "" {
+			return r, errors.New("windows.dns.RecordMXCreate: record parameter 'MailExchange' must be set for all mail exchangers")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle record already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return r, winerror.Errorf(cmd, "windows.dns.RecordMXCreate: the specified record already exists")
+		}
+
+		return r, winerror.Errorf(cmd, "windows.dns.RecordMXCreate: %w", err)
+	}
+
+	// Convert the output to a RecordMX object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordMXCreate: failed to convert output to RecordMX object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordMXUpdateParams represents parameters for the MX-Record update function.
+// Only the TimeToLive can be updated.
+type RecordMXUpdateParams struct {
+	// Specifies the name of the Record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default TTL is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update a MX-Record.
+func (params RecordMXUpdateParams) pwshCommand() string {
+	// Update to default TTL if not provided.
+	// New-TimeSpan only allows int32 values.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// Base command
+	cmd := []string{"$nr=@();Get-DnsServerResourceRecord -RRType 'MX' -Node"}
+
+	// Add parameters and logic for handling the TTL update.
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+	cmd = append(cmd, fmt.Sprintf("| ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
+	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru}", pwshZoneName(params.Zone, params.ZoneScope)))
+	cmd = append(cmd, ";if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}")
+
+	// Return the full command.
+	return strings.Join(cmd, " ")
+}
+
+// RecordMXUpdate updates a MX-Record. It returns a RecordMX object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordMXUpdate(ctx context.Context, params RecordMXUpdateParams) (RecordMX, error) {
+	var r RecordMX
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || params.TimeToLive == 0 {
+		return r, errors.New("windows.dns.RecordMXUpdate: record parameters 'Name', 'Zone' and 'TimeToLive' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordMXUpdate: %w", err)
+	}
+
+	// Convert the output to a RecordMX object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordMXUpdate: failed to convert output to RecordMX object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordMXDeleteParams represents parameters for the MX-Record delete function.
+type RecordMXDeleteParams struct {
+	// Specifies the name of the Record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to delete a MX-Record.
+func (params RecordMXDeleteParams) pwshCommand() string {
+	// Base command
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'MX' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
+}
+
+// RecordMXDelete deletes a MX-Record.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordMXDelete(ctx context.Context, params RecordMXDeleteParams) error {
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return errors.New("windows.dns.RecordMXDelete: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.RecordMXDelete: %w", err)
+	}
+
+	return nil
+}
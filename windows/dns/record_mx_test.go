@@ -0,0 +1,222 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// Fixtures
+const (
+	recordMXJson = `[{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"MX","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordMX","CimInstanceProperties":"MailExchange = \"mail1.test.local.\" Preference = 10","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":15},{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"MX","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordMX","CimInstanceProperties":"MailExchange = \"mail2.test.local.\" Preference = 20","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":15}]`
+)
+
+var (
+	expectedRecordMX = RecordMX{
+		DistinguishedName: "DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+		Name:              "@",
+		Timestamp:         time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		TimeToLive:        time.Second * 3600,
+		MailExchangers: []MailExchanger{
+			{MailExchange: "mail1.test.local.", Preference: 10},
+			{MailExchange: "mail2.test.local.", Preference: 20},
+		},
+	}
+)
+
+// Test the convertOutput method.
+func (suite *DnsServerUnitTestSuite) TestRecordMXConvertOutput() {
+	suite.Run("should return the correct RecordMX object with multiple mail exchangers and the lowest TTL", func() {
+		o := []recordObject{
+			{
+				DistinguishedName: "DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+				Name:              "@",
+				RecordType:        "MX",
+				TimeToLive:        parsing.CimTimeDuration{Duration: time.Second * 3600},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"MailExchange": "mail1.test.local.", "Preference": "10"},
+				},
+			},
+			{
+				DistinguishedName: "DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+				Name:              "@",
+				RecordType:        "MX",
+				TimeToLive:        parsing.CimTimeDuration{Duration: time.Second * 60},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"MailExchange": "mail2.test.local.", "Preference": "20"},
+				},
+			},
+		}
+
+		r := RecordMX{}
+		err := r.convertOutput(o)
+		suite.NoError(err)
+		suite.Equal(time.Second*60, r.TimeToLive)
+		suite.Equal(expectedRecordMX.MailExchangers, r.MailExchangers)
+	})
+
+	suite.Run("should return an error for an invalid preference", func() {
+		o := []recordObject{
+			{
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"MailExchange": "mail1.test.local.", "Preference": "invalid"},
+				},
+			},
+		}
+
+		r := RecordMX{}
+		suite.Error(r.convertOutput(o))
+	})
+
+	suite.Run("should return an error for an empty output", func() {
+		r := RecordMX{}
+		suite.EqualError(r.convertOutput([]recordObject{}), "record not found")
+	})
+}
+
+// Test RecordMXRead related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordMXReadPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordMXReadParams{Name: "@", Zone: "test.local"}.pwshCommand()
+		suite.Equal("$r=Get-DnsServerResourceRecord -RRType 'MX' -Node -Name '@' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}", actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordMXRead() {
+	suite.Run("should return the correct MX-Record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'MX' -Node -Name '@' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordMXJson}, nil)
+		actualRecordMX, err := c.RecordMXRead(ctx, RecordMXReadParams{Name: "@", Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedRecordMX, actualRecordMX)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		_, err := c.RecordMXRead(ctx, RecordMXReadParams{Name: "@"})
+		suite.EqualError(err, "windows.dns.RecordMXRead: record parameters 'Name' and 'Zone' must be set")
+	})
+}
+
+// Test RecordMXCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordMXCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordMXCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert without ttl parameter",
+				RecordMXCreateParams{Name: "@", Zone: "test.local", MailExchangers: []MailExchanger{{MailExchange: "mail1.test.local", Preference: 10}}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -MailExchange 'mail1.test.local' -Preference 10}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with multiple mail exchangers and ttl parameter",
+				RecordMXCreateParams{Name: "@", Zone: "test.local", TimeToLive: time.Second * 3600, MailExchangers: []MailExchanger{{MailExchange: "mail1.test.local", Preference: 10}, {MailExchange: "mail2.test.local", Preference: 20}}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -MailExchange 'mail1.test.local' -Preference 10;$r+=Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -MailExchange 'mail2.test.local' -Preference 20}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with escaped mail exchange",
+				RecordMXCreateParams{Name: "@", Zone: "test.local", MailExchangers: []MailExchanger{{MailExchange: "mail'1.test.local", Preference: 10}}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -MailExchange 'mail''1.test.local' -Preference 10}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordMXCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=@() ;try{$r+=Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -MailExchange 'mail1.test.local' -Preference 10;$r+=Add-DnsServerResourceRecordMX -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -MailExchange 'mail2.test.local' -Preference 20}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordMXJson}, nil)
+		actualRecord, err := c.RecordMXCreate(ctx, RecordMXCreateParams{
+			Name:           "@",
+			Zone:           "test.local",
+			TimeToLive:     time.Second * 3600,
+			MailExchangers: []MailExchanger{{MailExchange: "mail1.test.local", Preference: 10}, {MailExchange: "mail2.test.local", Preference: 20}},
+		})
+		suite.NoError(err)
+		suite.Equal(expectedRecordMX, actualRecord)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordMXCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without mail exchangers",
+				RecordMXCreateParams{Name: "@", Zone: "test.local"},
+				"windows.dns.RecordMXCreate: record parameters 'Name', 'Zone' and 'MailExchangers' must be set",
+			},
+			{
+				"assert error with an empty mail exchange",
+				RecordMXCreateParams{Name: "@", Zone: "test.local", MailExchangers: []MailExchanger{{Preference: 10}}},
+				"windows.dns.RecordMXCreate: record parameter 'MailExchange' must be set for all mail exchangers",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.RecordMXCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test RecordMXUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordMXUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordMXUpdateParams{Name: "@", Zone: "test.local", TimeToLive: time.Second * 3600}.pwshCommand()
+		suite.Equal("$nr=@();Get-DnsServerResourceRecord -RRType 'MX' -Node -Name '@' -ZoneName 'test.local' | ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds 3600 ;$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru} ;if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}", actualCmd)
+	})
+}
+
+// Test RecordMXDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordMXDeletePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordMXDeleteParams{Name: "@", Zone: "test.local"}.pwshCommand()
+		suite.Equal("Remove-DnsServerResourceRecord -RRType 'MX' -Force -Name '@' -ZoneName 'test.local'", actualCmd)
+	})
+}
@@ -0,0 +1,295 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// RecordNS represents a DNS NS-Record.
+type RecordNS struct {
+	DistinguishedName string
+	Name              string
+	NameServers       []string
+	Timestamp         time.Time
+	TimeToLive        time.Duration
+}
+
+// convertOutput converts the unmarshaled JSON output from the recordObject to a RecordNS object.
+func (r *RecordNS) convertOutput(o []recordObject) error {
+	if len(o) == 0 {
+		return errors.New("record not found")
+	}
+
+	// Set the values of the first object to the RecordNS object.
+	r.DistinguishedName = o[0].DistinguishedName
+	r.Name = o[0].Name
+	r.Timestamp = o[0].Timestamp.Time
+	r.TimeToLive = o[0].TimeToLive.Duration
+
+	// Set the name servers and the lowest TTL.
+	for _, record := range o {
+		r.NameServers = append(r.NameServers, record.RecordData.CimInstanceProperties["NameServer"])
+
+		// Set the lowest TTL to be RFC2181 compliant.
+		// https://www.rfc-editor.org/rfc/rfc2181#section-5.2
+		if record.TimeToLive.Duration < r.TimeToLive {
+			r.TimeToLive = record.TimeToLive.Duration
+		}
+	}
+
+	return nil
+}
+
+// RecordNSReadParams represents parameters for the NS-Record read function.
+type RecordNSReadParams struct {
+	// Specifies the name of the record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to read an NS-Record.
+func (params RecordNSReadParams) pwshCommand() string {
+	// Base command
+	cmd := []string{"$r=Get-DnsServerResourceRecord -RRType 'NS' -Node"}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+
+	// Ensure output is always an array.
+	cmd = append(cmd, ";if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
+	return strings.Join(cmd, " ")
+}
+
+// RecordNSRead gets an NS-Record by Name and Zone. It returns a RecordNS object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordNSRead(ctx context.Context, params RecordNSReadParams) (RecordNS, error) {
+	var r RecordNS
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return r, errors.New("windows.dns.RecordNSRead: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordNSRead: %w", err)
+	}
+
+	// Convert the output to a RecordNS object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordNSRead: failed to convert output to RecordNS object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordNSCreateParams represents parameters for the NS-Record create function.
+type RecordNSCreateParams struct {
+	// Specifies the name of the Record.
+	// Use "@" to create the record for the zone itself or the name of a subdomain to delegate it.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the fully qualified domain names of the name servers.
+	NameServers []string
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to create a new NS-Record.
+func (params RecordNSCreateParams) pwshCommand() string {
+	return pwshAddEach(params.pwshAdds(), params.Zone, params.ZoneScope)
+}
+
+// pwshAdds returns the add calls of the records of a new NS-Record.
+func (params RecordNSCreateParams) pwshAdds() []string {
+	// Set default TTL if not provided.
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+
+	// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// The cmdlet only accepts a single name server, so a record is added for each name server.
+	adds := []string{}
+	for _, nameServer := range params.NameServers {
+		adds = append(adds, fmt.Sprintf(
+			"Add-DnsServerResourceRecord -NS -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '%s' %s -TimeToLive $(New-TimeSpan -Seconds %d) -NameServer '%s'",
+			params.Name,
+			pwshZoneName(params.Zone, params.ZoneScope),
+			seconds,
+			nameServer,
+		))
+	}
+
+	return adds
+}
+
+// RecordNSCreate creates a new NS-Record. It returns a RecordNS object.
+// If a name server cannot be added, the name servers that were already added are removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordNSCreate(ctx context.Context, params RecordNSCreateParams) (RecordNS, error) {
+	var r RecordNS
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || len(params.NameServers) == 0 {
+		return r, errors.New("windows.dns.RecordNSCreate: record parameters 'Name', 'Zone' and 'NameServers' must be set")
+	}
+
+	// Assert name servers
+	for _, nameServer := range params.NameServers {
+		if nameServer == "" {
+			return r, errors.New("windows.dns.RecordNSCreate: record parameter 'NameServers' must not contain empty values")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle record already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return r, winerror.Errorf(cmd, "windows.dns.RecordNSCreate: the specified record already exists")
+		}
+
+		return r, winerror.Errorf(cmd, "windows.dns.RecordNSCreate: %w", err)
+	}
+
+	// Convert the output to a RecordNS object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordNSCreate: failed to convert output to RecordNS object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordNSUpdateParams represents parameters for the NS-Record update function.
+// Only the TimeToLive can be updated.
+type RecordNSUpdateParams struct {
+	// Specifies the name of the Record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default TTL is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update an NS-Record.
+func (params RecordNSUpdateParams) pwshCommand() string {
+	// Update to default TTL if not provided.
+	// New-TimeSpan only allows int32 values.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// Base command
+	cmd := []string{"$nr=@();Get-DnsServerResourceRecord -RRType 'NS' -Node"}
+
+	// Add parameters and logic for handling the TTL update.
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+	cmd = append(cmd, fmt.Sprintf("| ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
+	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru}", pwshZoneName(params.Zone, params.ZoneScope)))
+	cmd = append(cmd, ";if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}")
+
+	// Return the full command.
+	return strings.Join(cmd, " ")
+}
+
+// RecordNSUpdate updates an NS-Record. It returns a RecordNS object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordNSUpdate(ctx context.Context, params RecordNSUpdateParams) (RecordNS, error) {
+	var r RecordNS
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || params.TimeToLive == 0 {
+		return r, errors.New("windows.dns.RecordNSUpdate: record parameters 'Name', 'Zone' and 'TimeToLive' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordNSUpdate: %w", err)
+	}
+
+	// Convert the output to a RecordNS object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordNSUpdate: failed to convert output to RecordNS object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordNSDeleteParams represents parameters for the NS-Record delete function.
+type RecordNSDeleteParams struct {
+	// Specifies the name of the Record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to delete an NS-Record.
+func (params RecordNSDeleteParams) pwshCommand() string {
+	// Base command
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'NS' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
+}
+
+// RecordNSDelete deletes an NS-Record.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordNSDelete(ctx context.Context, params RecordNSDeleteParams) error {
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return errors.New("windows.dns.RecordNSDelete: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.RecordNSDelete: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// Fixtures
+const (
+	recordNSJson = `[{"DistinguishedName":"DC=child,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"child","RecordType":"NS","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordNS","CimInstanceProperties":"NameServer = \"ns1.child.test.local.\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":2},{"DistinguishedName":"DC=child,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"child","RecordType":"NS","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordNS","CimInstanceProperties":"NameServer = \"ns2.child.test.local.\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":2}]`
+)
+
+var (
+	expectedRecordNS = RecordNS{
+		DistinguishedName: "DC=child,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+		Name:              "child",
+		Timestamp:         time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		TimeToLive:        time.Second * 3600,
+		NameServers:       []string{"ns1.child.test.local.", "ns2.child.test.local."},
+	}
+)
+
+// Test the convertOutput method.
+func (suite *DnsServerUnitTestSuite) TestRecordNSConvertOutput() {
+	suite.Run("should return the correct RecordNS object with multiple name servers and the lowest TTL", func() {
+		o := []recordObject{
+			{
+				Name:       "child",
+				TimeToLive: parsing.CimTimeDuration{Duration: time.Second * 3600},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"NameServer": "ns1.child.test.local."},
+				},
+			},
+			{
+				Name:       "child",
+				TimeToLive: parsing.CimTimeDuration{Duration: time.Second * 60},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"NameServer": "ns2.child.test.local."},
+				},
+			},
+		}
+
+		r := RecordNS{}
+		err := r.convertOutput(o)
+		suite.NoError(err)
+		suite.Equal(time.Second*60, r.TimeToLive)
+		suite.Equal(expectedRecordNS.NameServers, r.NameServers)
+	})
+
+	suite.Run("should return an error for an empty output", func() {
+		r := RecordNS{}
+		suite.EqualError(r.convertOutput([]recordObject{}), "record not found")
+	})
+}
+
+// Test RecordNSRead related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordNSReadPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordNSReadParams{Name: "child", Zone: "test.local"}.pwshCommand()
+		suite.Equal("$r=Get-DnsServerResourceRecord -RRType 'NS' -Node -Name 'child' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}", actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordNSRead() {
+	suite.Run("should return the correct NS-Record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'NS' -Node -Name 'child' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordNSJson}, nil)
+		actualRecordNS, err := c.RecordNSRead(ctx, RecordNSReadParams{Name: "child", Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedRecordNS, actualRecordNS)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		_, err := c.RecordNSRead(ctx, RecordNSReadParams{Name: "child"})
+		suite.EqualError(err, "windows.dns.RecordNSRead: record parameters 'Name' and 'Zone' must be set")
+	})
+}
+
+// Test RecordNSCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordNSCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordNSCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert without ttl parameter",
+				RecordNSCreateParams{Name: "child", Zone: "test.local", NameServers: []string{"ns1.child.test.local"}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -NS -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name 'child' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -NameServer 'ns1.child.test.local'}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with multiple name servers and ttl parameter",
+				RecordNSCreateParams{Name: "child", Zone: "test.local", TimeToLive: time.Second * 3600, NameServers: []string{"ns1.child.test.local", "ns2.child.test.local"}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -NS -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name 'child' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -NameServer 'ns1.child.test.local';$r+=Add-DnsServerResourceRecord -NS -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name 'child' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -NameServer 'ns2.child.test.local'}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordNSCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordNSCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without name servers",
+				RecordNSCreateParams{Name: "child", Zone: "test.local"},
+				"windows.dns.RecordNSCreate: record parameters 'Name', 'Zone' and 'NameServers' must be set",
+			},
+			{
+				"assert error with an empty name server",
+				RecordNSCreateParams{Name: "child", Zone: "test.local", NameServers: []string{""}},
+				"windows.dns.RecordNSCreate: record parameter 'NameServers' must not contain empty values",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.RecordNSCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test RecordNSUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordNSUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordNSUpdateParams{Name: "child", Zone: "test.local", TimeToLive: time.Second * 3600}.pwshCommand()
+		suite.Equal("$nr=@();Get-DnsServerResourceRecord -RRType 'NS' -Node -Name 'child' -ZoneName 'test.local' | ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds 3600 ;$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru} ;if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}", actualCmd)
+	})
+}
+
+// Test RecordNSDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordNSDeletePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordNSDeleteParams{Name: "child", Zone: "test.local"}.pwshCommand()
+		suite.Equal("Remove-DnsServerResourceRecord -RRType 'NS' -Force -Name 'child' -ZoneName 'test.local'", actualCmd)
+	})
+}
@@ -35,6 +35,10 @@ type RecordPTRReadParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
 }
 
 // pwshCommand returns the PowerShell command to read a PTR-Record.
@@ -44,7 +48,7 @@ func (params RecordPTRReadParams) pwshCommand() string {
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Ensure Json Output
 	cmd = append(cmd, "| ConvertTo-Json -Compress")
@@ -65,7 +69,7 @@ func (c *Client) RecordPTRRead(ctx context.Context, params RecordPTRReadParams)
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordPTRRead: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordPTRRead: %w", err)
 	}
 
 	// Convert the output to a RecordPTR object.
@@ -82,6 +86,10 @@ type RecordPTRCreateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the canonical name this record will point to.
 	PTR string
 
@@ -98,7 +106,7 @@ func (params RecordPTRCreateParams) pwshCommand() string {
 
 	// Add parameters
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 	cmd = append(cmd, fmt.Sprintf("-PtrDomainName '%s'", params.PTR))
 
 	// Set default TTL if not provided.
@@ -131,11 +139,11 @@ func (c *Client) RecordPTRCreate(ctx context.Context, params RecordPTRCreatePara
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
 		// Handle record already exists error.
-		if strings.Contains(err.Error(), "ResourceExists") {
+		if winerror.Category(err) == winerror.CategoryResourceExists {
 			return r, winerror.Errorf(cmd, "windows.dns.RecordPTRCreate: the specified record already exists")
 		}
 
-		return r, winerror.Errorf(cmd, "windows.dns.RecordPTRCreate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordPTRCreate: %w", err)
 	}
 
 	// Convert the output to a RecordPTR object.
@@ -153,6 +161,10 @@ type RecordPTRUpdateParams struct {
 	// Specifies the zone in which the record is located.
 	Zone string
 
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
 	// Specifies the canonical name this record will point to.
 	PTR string
 
@@ -175,13 +187,13 @@ func (params RecordPTRUpdateParams) pwshCommand() string {
 	// Get command
 	cmd := []string{"$r=Get-DnsServerResourceRecord -RRType 'PTR' -Node"}
 	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
-	cmd = append(cmd, fmt.Sprintf("-ZoneName '%s'", params.Zone))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
 
 	// Add logic for handling TTL and PTR update.
 	cmd = append(cmd, ";$n=[ciminstance]::new($r)")
 	cmd = append(cmd, fmt.Sprintf(";$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
 	cmd = append(cmd, fmt.Sprintf(";$n.RecordData.PtrDomainName='%s'", params.PTR))
-	cmd = append(cmd, fmt.Sprintf(";Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName '%s' -PassThru", params.Zone))
+	cmd = append(cmd, fmt.Sprintf(";Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru", pwshZoneName(params.Zone, params.ZoneScope)))
 
 	// Ensure Json Output
 	cmd = append(cmd, "| ConvertTo-Json -Compress")
@@ -204,7 +216,7 @@ func (c *Client) RecordPTRUpdate(ctx context.Context, params RecordPTRUpdatePara
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return r, winerror.Errorf(cmd, "windows.dns.RecordPTRUpdate: %s", err)
+		return r, winerror.Errorf(cmd, "windows.dns.RecordPTRUpdate: %w", err)
 	}
 
 	// Convert the output to a RecordPTR object.
@@ -220,12 +232,16 @@ type RecordPTRDeleteParams struct {
 
 	// Specifies the zone in which the record is located.
 	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
 }
 
 // pwshCommand returns the PowerShell command to delete a PTR-Record.
 func (params RecordPTRDeleteParams) pwshCommand() string {
 	// Base command
-	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'PTR' -Force -Name '%s' -ZoneName '%s'", params.Name, params.Zone)
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'PTR' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
 }
 
 // RecordPTRDelete deletes a PTR-Record.
@@ -241,7 +257,7 @@ func (c *Client) RecordPTRDelete(ctx context.Context, params RecordPTRDeletePara
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &o); err != nil {
-		return winerror.Errorf(cmd, "windows.dns.RecordPTRDelete: %s", err)
+		return winerror.Errorf(cmd, "windows.dns.RecordPTRDelete: %w", err)
 	}
 
 	return nil
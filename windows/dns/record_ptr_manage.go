@@ -0,0 +1,154 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ptrTarget represents the PTR-Record of an address in its reverse lookup zone.
+type ptrTarget struct {
+	Name string
+	Zone string
+}
+
+// ptrDomainName returns the fully qualified domain name to which the PTR-Records of a record point.
+func ptrDomainName(name string, zone string) string {
+	if name == "@" {
+		return zone + "."
+	}
+	return name + "." + zone + "."
+}
+
+// matchReverseZones returns the PTR-Records of the addresses in the given reverse lookup zones.
+// The zone with the longest match is used, e.g. "10.168.192.in-addr.arpa" before "168.192.in-addr.arpa".
+// It also returns the reverse lookup zones that are missing for the other addresses.
+// Missing zones are named after the /24 network of IPv4 addresses and the /64 network of IPv6 addresses.
+func matchReverseZones(zones []Zone, addresses []netip.Addr) ([]ptrTarget, []string) {
+	var targets []ptrTarget
+	var missing []string
+
+	for _, address := range addresses {
+		address = address.Unmap()
+
+		// The reverse name of the host prefix is the fully qualified name of the PTR-Record.
+		fqdn, err := reverseZoneName(netip.PrefixFrom(address, address.BitLen()))
+		if err != nil {
+			continue
+		}
+
+		var target ptrTarget
+		for _, zone := range zones {
+			zoneName := strings.ToLower(zone.ZoneName)
+			if zone.IsReverseLookupZone && strings.HasSuffix(fqdn, "."+zoneName) && len(zoneName) > len(target.Zone) {
+				target = ptrTarget{Name: strings.TrimSuffix(fqdn, "."+zoneName), Zone: zone.ZoneName}
+			}
+		}
+
+		if target.Zone != "" {
+			targets = append(targets, target)
+			continue
+		}
+
+		bits := 24
+		if address.Is6() {
+			bits = 64
+		}
+		zoneName, _ := reverseZoneName(netip.PrefixFrom(address, bits))
+		if !slices.Contains(missing, zoneName) {
+			missing = append(missing, zoneName)
+		}
+	}
+
+	return targets, missing
+}
+
+// ptrAddCommand returns the PowerShell command to create the PTR-Record of a record in its reverse lookup zone.
+func ptrAddCommand(domainName string, target ptrTarget, timeToLive time.Duration, ageRecord bool) string {
+	// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	if timeToLive == 0 {
+		timeToLive = defaultTimeToLive
+	}
+	seconds := int32(timeToLive.Round(time.Second).Seconds())
+
+	return fmt.Sprintf(
+		"Add-DnsServerResourceRecordPTR -AllowUpdateAny:$false -AgeRecord:$%t -Confirm:$false -Name '%s' -ZoneName '%s' -PtrDomainName '%s' -TimeToLive $(New-TimeSpan -Seconds %d) -ErrorAction Stop",
+		ageRecord,
+		target.Name,
+		target.Zone,
+		domainName,
+		seconds,
+	)
+}
+
+// ptrRemoveCommand returns the PowerShell command to remove the PTR-Records of a record.
+// PTR-Records that do not exist are skipped.
+func ptrRemoveCommand(domainName string, removed []ptrTarget) string {
+	cmd := []string{}
+
+	for _, target := range removed {
+		cmd = append(cmd, fmt.Sprintf(
+			"Remove-DnsServerResourceRecord -RRType 'PTR' -Force -Name '%s' -ZoneName '%s' -RecordData '%s' -ErrorAction SilentlyContinue",
+			target.Name,
+			target.Zone,
+			domainName,
+		))
+	}
+
+	return strings.Join(cmd, " ;")
+}
+
+// updatePtrRecords creates the PTR-Records of the added addresses and removes the PTR-Records of the removed addresses
+// of an A- or AAAA-Record. The reverse lookup zones are derived from the existing zones of the server.
+// The new PTR-Records age like the record if ageRecord is set.
+// The PTR-Records are created before the old PTR-Records are removed, so a failed creation keeps the old PTR-Records.
+// The new PTR-Records that were already created are removed again if a PTR-Record cannot be created.
+// It returns the reverse lookup zones that are missing for the added addresses.
+func (c *Client) updatePtrRecords(ctx context.Context, name string, zone string, added []netip.Addr, removed []netip.Addr, timeToLive time.Duration, ageRecord bool) ([]string, error) {
+	var o []recordObject
+
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, nil
+	}
+
+	// Find the reverse lookup zones of the addresses.
+	zones, err := c.ZoneList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addedTargets, missing := matchReverseZones(zones, added)
+	removedTargets, _ := matchReverseZones(zones, removed)
+
+	if len(addedTargets) == 0 && len(removedTargets) == 0 {
+		return missing, nil
+	}
+
+	domainName := ptrDomainName(name, zone)
+
+	// Create the new PTR-Records one by one, so the error names the PTR-Record that failed.
+	for i, target := range addedTargets {
+		cmd := ptrAddCommand(domainName, target, timeToLive, ageRecord)
+		if err := run(ctx, c, cmd, &o); err != nil {
+			if i > 0 {
+				_ = run(ctx, c, ptrRemoveCommand(domainName, addedTargets[:i]), &o)
+			}
+			return missing, winerror.Errorf(cmd, "failed to create PTR-Record '%s' in zone '%s': %w", target.Name, target.Zone, err)
+		}
+	}
+
+	// Remove the old PTR-Records.
+	if len(removedTargets) > 0 {
+		cmd := ptrRemoveCommand(domainName, removedTargets)
+		if err := run(ctx, c, cmd, &o); err != nil {
+			return missing, winerror.Errorf(cmd, "failed to remove PTR-Records: %w", err)
+		}
+	}
+
+	return missing, nil
+}
@@ -0,0 +1,93 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Test the PTR-Record management of A- and AAAA-Records.
+func (suite *DnsServerUnitTestSuite) TestMatchReverseZones() {
+	suite.T().Parallel()
+
+	zones := []Zone{
+		{ZoneName: "test.local"},
+		{ZoneName: "168.192.in-addr.arpa", IsReverseLookupZone: true},
+		{ZoneName: "10.168.192.in-addr.arpa", IsReverseLookupZone: true},
+		{ZoneName: "0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", IsReverseLookupZone: true},
+	}
+
+	suite.Run("should return the PTR-Records in the zones with the longest match", func() {
+		targets, missing := matchReverseZones(zones, []netip.Addr{
+			netip.MustParseAddr("192.168.10.5"),
+			netip.MustParseAddr("192.168.20.5"),
+			netip.MustParseAddr("2001:db8::1"),
+		})
+		suite.Equal([]ptrTarget{
+			{Name: "5", Zone: "10.168.192.in-addr.arpa"},
+			{Name: "5.20", Zone: "168.192.in-addr.arpa"},
+			{Name: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0", Zone: "0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"},
+		}, targets)
+		suite.Empty(missing)
+	})
+
+	suite.Run("should return the missing reverse zones once", func() {
+		targets, missing := matchReverseZones(zones, []netip.Addr{
+			netip.MustParseAddr("10.0.0.1"),
+			netip.MustParseAddr("10.0.0.2"),
+			netip.MustParseAddr("fd00::1"),
+		})
+		suite.Empty(targets)
+		suite.Equal([]string{"0.0.10.in-addr.arpa", "0.0.0.0.0.0.0.0.0.0.0.0.0.0.d.f.ip6.arpa"}, missing)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestPtrCommands() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		actualCmd := ptrAddCommand("www.test.local.", ptrTarget{Name: "6", Zone: "10.168.192.in-addr.arpa"}, time.Hour, false)
+		suite.Equal("Add-DnsServerResourceRecordPTR -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -Name '6' -ZoneName '10.168.192.in-addr.arpa' -PtrDomainName 'www.test.local.' -TimeToLive $(New-TimeSpan -Seconds 3600) -ErrorAction Stop", actualCmd)
+
+		actualCmd = ptrRemoveCommand("www.test.local.", []ptrTarget{{Name: "5", Zone: "10.168.192.in-addr.arpa"}, {Name: "4", Zone: "10.168.192.in-addr.arpa"}})
+		suite.Equal("Remove-DnsServerResourceRecord -RRType 'PTR' -Force -Name '5' -ZoneName '10.168.192.in-addr.arpa' -RecordData 'www.test.local.' -ErrorAction SilentlyContinue ;Remove-DnsServerResourceRecord -RRType 'PTR' -Force -Name '4' -ZoneName '10.168.192.in-addr.arpa' -RecordData 'www.test.local.' -ErrorAction SilentlyContinue", actualCmd)
+	})
+
+	suite.Run("should return the domain name of the record", func() {
+		suite.Equal("www.test.local.", ptrDomainName("www", "test.local"))
+		suite.Equal("test.local.", ptrDomainName("@", "test.local"))
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordACreateManagePtr() {
+	suite.T().Parallel()
+
+	suite.Run("should create the PTR-Records and report the missing reverse zones", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv4Address @('2.2.2.2') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordAJson}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneList}, nil)
+		actualRecord, err := c.RecordACreate(ctx, RecordACreateParams{
+			Name:       "test",
+			Zone:       "test.local",
+			Addresses:  []netip.Addr{netip.MustParseAddr("2.2.2.2")},
+			TimeToLive: time.Hour,
+			ManagePtr:  true,
+		})
+		suite.NoError(err)
+		suite.Equal([]string{"2.2.2.in-addr.arpa"}, actualRecord.MissingReverseZones)
+	})
+}
@@ -0,0 +1,194 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// RecordSOA represents the DNS SOA-Record of a zone.
+type RecordSOA struct {
+	DistinguishedName string
+	Name              string
+	PrimaryServer     string
+	ResponsiblePerson string
+	SerialNumber      uint32
+	RefreshInterval   time.Duration
+	RetryDelay        time.Duration
+	ExpireLimit       time.Duration
+	MinimumTimeToLive time.Duration
+	TimeToLive        time.Duration
+}
+
+// soaObject contains the unmarshaled json of the projected powershell SOA-Record object.
+type soaObject struct {
+	DistinguishedName string                  `json:"DistinguishedName"`
+	Name              string                  `json:"HostName"`
+	PrimaryServer     string                  `json:"PrimaryServer"`
+	ResponsiblePerson string                  `json:"ResponsiblePerson"`
+	SerialNumber      uint32                  `json:"SerialNumber"`
+	RefreshInterval   parsing.CimTimeDuration `json:"RefreshInterval"`
+	RetryDelay        parsing.CimTimeDuration `json:"RetryDelay"`
+	ExpireLimit       parsing.CimTimeDuration `json:"ExpireLimit"`
+	MinimumTimeToLive parsing.CimTimeDuration `json:"MinimumTimeToLive"`
+	TimeToLive        parsing.CimTimeDuration `json:"TimeToLive"`
+}
+
+// soaJsonCommand is the PowerShell command that converts the SOA-Record in $r to JSON.
+// The record data is flattened, because its timers are not serialized by the CimInstance properties.
+const soaJsonCommand string = "[pscustomobject]@{DistinguishedName=$r.DistinguishedName;HostName=$r.HostName;TimeToLive=$r.TimeToLive;PrimaryServer=$r.RecordData.PrimaryServer;ResponsiblePerson=$r.RecordData.ResponsiblePerson;SerialNumber=$r.RecordData.SerialNumber;RefreshInterval=$r.RecordData.RefreshInterval;RetryDelay=$r.RecordData.RetryDelay;ExpireLimit=$r.RecordData.ExpireLimit;MinimumTimeToLive=$r.RecordData.MinimumTimeToLive} | ConvertTo-Json -Compress"
+
+// convertOutput converts the unmarshaled JSON output from the soaObject to a RecordSOA object.
+func (r *RecordSOA) convertOutput(o soaObject) {
+	r.DistinguishedName = o.DistinguishedName
+	r.Name = o.Name
+	r.PrimaryServer = o.PrimaryServer
+	r.ResponsiblePerson = o.ResponsiblePerson
+	r.SerialNumber = o.SerialNumber
+	r.RefreshInterval = o.RefreshInterval.Duration
+	r.RetryDelay = o.RetryDelay.Duration
+	r.ExpireLimit = o.ExpireLimit.Duration
+	r.MinimumTimeToLive = o.MinimumTimeToLive.Duration
+	r.TimeToLive = o.TimeToLive.Duration
+}
+
+// serialNotGreaterError is the start of the error that the update command throws
+// if the new serial number is not greater than the current serial number.
+const serialNotGreaterError string = "record parameter 'SerialNumber' must be greater than the current serial number"
+
+// RecordSOAReadParams represents parameters for the SOA-Record read function.
+type RecordSOAReadParams struct {
+	// Specifies the zone of the SOA-Record.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to read a SOA-Record.
+func (params RecordSOAReadParams) pwshCommand() string {
+	return fmt.Sprintf("$r=Get-DnsServerResourceRecord -RRType 'SOA' -Name '@' -ZoneName '%s' ;%s", params.Zone, soaJsonCommand)
+}
+
+// RecordSOARead gets the SOA-Record of a zone. It returns a RecordSOA object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordSOARead(ctx context.Context, params RecordSOAReadParams) (RecordSOA, error) {
+	var r RecordSOA
+	var o soaObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return r, errors.New("windows.dns.RecordSOARead: record parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSOARead: %w", err)
+	}
+
+	// Convert the output to a RecordSOA object.
+	r.convertOutput(o)
+
+	return r, nil
+}
+
+// RecordSOAUpdateParams represents parameters for the SOA-Record update function.
+// Timers that are not set keep their current value.
+type RecordSOAUpdateParams struct {
+	// Specifies the zone of the SOA-Record.
+	Zone string
+
+	// Specifies the new serial number of the zone.
+	// The serial number must be greater than the current serial number in the sense of RFC 1982.
+	// If not provided, the current serial number is incremented by one.
+	SerialNumber *uint32
+
+	// Specifies the time a secondary server waits before it checks the primary server for zone changes.
+	RefreshInterval time.Duration
+
+	// Specifies the time a secondary server waits before it retries a failed zone transfer.
+	RetryDelay time.Duration
+
+	// Specifies the time a secondary server answers queries without a successful zone transfer.
+	ExpireLimit time.Duration
+
+	// Specifies the time resolvers cache negative answers of the zone.
+	MinimumTimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update a SOA-Record.
+func (params RecordSOAUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("$r=Get-DnsServerResourceRecord -RRType 'SOA' -Name '@' -ZoneName '%s'", params.Zone)}
+	cmd = append(cmd, "$n=[ciminstance]::new($r)")
+
+	// Increment or validate the serial number against the current serial number within the same command,
+	// so a concurrent update between reading and writing the SOA-Record cannot move the serial number backwards.
+	// Serial numbers are compared with the sequence space arithmetic, so they can wrap around.
+	// https://www.rfc-editor.org/rfc/rfc1982#section-3.2
+	cmd = append(cmd, "$s=[int64]$r.RecordData.SerialNumber")
+	if params.SerialNumber == nil {
+		cmd = append(cmd, "$n.RecordData.SerialNumber=[uint32](($s+1)%4294967296)")
+	} else {
+		cmd = append(cmd, fmt.Sprintf("$d=(%d-$s+4294967296)%%4294967296", *params.SerialNumber))
+		cmd = append(cmd, fmt.Sprintf(`if($d -eq 0 -or $d -ge 2147483648){throw "%s $s, got %d"}`, serialNotGreaterError, *params.SerialNumber))
+		cmd = append(cmd, fmt.Sprintf("$n.RecordData.SerialNumber=%d", *params.SerialNumber))
+	}
+
+	// Add the timers that are set.
+	// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	for _, timer := range []struct {
+		property string
+		duration time.Duration
+	}{
+		{"RefreshInterval", params.RefreshInterval},
+		{"RetryDelay", params.RetryDelay},
+		{"ExpireLimit", params.ExpireLimit},
+		{"MinimumTimeToLive", params.MinimumTimeToLive},
+	} {
+		if timer.duration != 0 {
+			cmd = append(cmd, fmt.Sprintf("$n.RecordData.%s=New-TimeSpan -Seconds %d", timer.property, int32(timer.duration.Round(time.Second).Seconds())))
+		}
+	}
+
+	cmd = append(cmd, fmt.Sprintf("$r=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName '%s' -PassThru", params.Zone))
+	cmd = append(cmd, soaJsonCommand)
+
+	// Return the full command.
+	return strings.Join(cmd, " ;")
+}
+
+// RecordSOAUpdate updates the SOA-Record of a zone. It returns a RecordSOA object.
+// The new serial number is checked against the current serial number on the server before the record is set.
+// The check is best-effort: it is not atomic with the update,
+// so a concurrent change between the read and the set of the record is not detected.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordSOAUpdate(ctx context.Context, params RecordSOAUpdateParams) (RecordSOA, error) {
+	var r RecordSOA
+	var o soaObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return r, errors.New("windows.dns.RecordSOAUpdate: record parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle the error of a serial number that is not greater than the current serial number.
+		if errorId := winerror.FullyQualifiedErrorId(err); strings.HasPrefix(errorId, serialNotGreaterError) {
+			return r, winerror.Errorf(cmd, "windows.dns.RecordSOAUpdate: %s", errorId)
+		}
+
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSOAUpdate: %w", err)
+	}
+
+	// Convert the output to a RecordSOA object.
+	r.convertOutput(o)
+
+	return r, nil
+}
@@ -0,0 +1,144 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	recordSOAJson    = `{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"PrimaryServer":"winsrv.test.local.","ResponsiblePerson":"hostmaster.test.local.","SerialNumber":42,"RefreshInterval":{"Ticks":9000000000,"Days":0,"Hours":0,"Milliseconds":0,"Minutes":15,"Seconds":0,"TotalDays":0.010416666666666666,"TotalHours":0.25,"TotalMilliseconds":900000,"TotalMinutes":15,"TotalSeconds":900},"RetryDelay":{"Ticks":6000000000,"Days":0,"Hours":0,"Milliseconds":0,"Minutes":10,"Seconds":0,"TotalDays":0.006944444444444444,"TotalHours":0.16666666666666666,"TotalMilliseconds":600000,"TotalMinutes":10,"TotalSeconds":600},"ExpireLimit":{"Ticks":864000000000,"Days":1,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":1,"TotalHours":24,"TotalMilliseconds":86400000,"TotalMinutes":1440,"TotalSeconds":86400},"MinimumTimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600}}`
+	recordSOAReadCmd = "$r=Get-DnsServerResourceRecord -RRType 'SOA' -Name '@' -ZoneName 'test.local' ;[pscustomobject]@{DistinguishedName=$r.DistinguishedName;HostName=$r.HostName;TimeToLive=$r.TimeToLive;PrimaryServer=$r.RecordData.PrimaryServer;ResponsiblePerson=$r.RecordData.ResponsiblePerson;SerialNumber=$r.RecordData.SerialNumber;RefreshInterval=$r.RecordData.RefreshInterval;RetryDelay=$r.RecordData.RetryDelay;ExpireLimit=$r.RecordData.ExpireLimit;MinimumTimeToLive=$r.RecordData.MinimumTimeToLive} | ConvertTo-Json -Compress"
+)
+
+var (
+	expectedRecordSOA = RecordSOA{
+		DistinguishedName: "DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+		Name:              "@",
+		PrimaryServer:     "winsrv.test.local.",
+		ResponsiblePerson: "hostmaster.test.local.",
+		SerialNumber:      42,
+		RefreshInterval:   time.Minute * 15,
+		RetryDelay:        time.Minute * 10,
+		ExpireLimit:       time.Hour * 24,
+		MinimumTimeToLive: time.Hour,
+		TimeToLive:        time.Hour,
+	}
+)
+
+// Test RecordSOARead related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordSOAReadPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordSOAReadParams{Zone: "test.local"}.pwshCommand()
+		suite.Equal(recordSOAReadCmd, actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordSOARead() {
+	suite.Run("should return the correct SOA-Record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, recordSOAReadCmd).
+			Return(connection.CmdResult{StdOut: recordSOAJson}, nil)
+		actualRecordSOA, err := c.RecordSOARead(ctx, RecordSOAReadParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedRecordSOA, actualRecordSOA)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		_, err := c.RecordSOARead(ctx, RecordSOAReadParams{})
+		suite.EqualError(err, "windows.dns.RecordSOARead: record parameter 'Zone' must be set")
+	})
+}
+
+// Test RecordSOAUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordSOAUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		serialNumber := uint32(43)
+		tcs := []struct {
+			description     string
+			inputParameters RecordSOAUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert incremented serial number",
+				RecordSOAUpdateParams{Zone: "test.local"},
+				"$r=Get-DnsServerResourceRecord -RRType 'SOA' -Name '@' -ZoneName 'test.local' ;$n=[ciminstance]::new($r) ;$s=[int64]$r.RecordData.SerialNumber ;$n.RecordData.SerialNumber=[uint32](($s+1)%4294967296) ;$r=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru ;" + soaJsonCommand,
+			},
+			{
+				"assert serial number only",
+				RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber},
+				"$r=Get-DnsServerResourceRecord -RRType 'SOA' -Name '@' -ZoneName 'test.local' ;$n=[ciminstance]::new($r) ;$s=[int64]$r.RecordData.SerialNumber ;$d=(43-$s+4294967296)%4294967296 ;if($d -eq 0 -or $d -ge 2147483648){throw \"record parameter 'SerialNumber' must be greater than the current serial number $s, got 43\"} ;$n.RecordData.SerialNumber=43 ;$r=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru ;[pscustomobject]@{DistinguishedName=$r.DistinguishedName;HostName=$r.HostName;TimeToLive=$r.TimeToLive;PrimaryServer=$r.RecordData.PrimaryServer;ResponsiblePerson=$r.RecordData.ResponsiblePerson;SerialNumber=$r.RecordData.SerialNumber;RefreshInterval=$r.RecordData.RefreshInterval;RetryDelay=$r.RecordData.RetryDelay;ExpireLimit=$r.RecordData.ExpireLimit;MinimumTimeToLive=$r.RecordData.MinimumTimeToLive} | ConvertTo-Json -Compress",
+			},
+			{
+				"assert all timers",
+				RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber, RefreshInterval: time.Minute * 30, RetryDelay: time.Minute * 5, ExpireLimit: time.Hour * 168, MinimumTimeToLive: time.Minute * 5},
+				"$r=Get-DnsServerResourceRecord -RRType 'SOA' -Name '@' -ZoneName 'test.local' ;$n=[ciminstance]::new($r) ;$s=[int64]$r.RecordData.SerialNumber ;$d=(43-$s+4294967296)%4294967296 ;if($d -eq 0 -or $d -ge 2147483648){throw \"record parameter 'SerialNumber' must be greater than the current serial number $s, got 43\"} ;$n.RecordData.SerialNumber=43 ;$n.RecordData.RefreshInterval=New-TimeSpan -Seconds 1800 ;$n.RecordData.RetryDelay=New-TimeSpan -Seconds 300 ;$n.RecordData.ExpireLimit=New-TimeSpan -Seconds 604800 ;$n.RecordData.MinimumTimeToLive=New-TimeSpan -Seconds 300 ;$r=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru ;[pscustomobject]@{DistinguishedName=$r.DistinguishedName;HostName=$r.HostName;TimeToLive=$r.TimeToLive;PrimaryServer=$r.RecordData.PrimaryServer;ResponsiblePerson=$r.RecordData.ResponsiblePerson;SerialNumber=$r.RecordData.SerialNumber;RefreshInterval=$r.RecordData.RefreshInterval;RetryDelay=$r.RecordData.RetryDelay;ExpireLimit=$r.RecordData.ExpireLimit;MinimumTimeToLive=$r.RecordData.MinimumTimeToLive} | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordSOAUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should increment the serial number within the update command", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordSOAUpdateParams{Zone: "test.local"}.pwshCommand()).
+			Return(connection.CmdResult{StdOut: recordSOAJson}, nil)
+		actualRecordSOA, err := c.RecordSOAUpdate(ctx, RecordSOAUpdateParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedRecordSOA, actualRecordSOA)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.RecordSOAUpdate(ctx, RecordSOAUpdateParams{})
+		suite.EqualError(err, "windows.dns.RecordSOAUpdate: record parameter 'Zone' must be set")
+
+		serialNumber := uint32(41)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber}.pwshCommand()).
+			Return(connection.CmdResult{StdErr: "record parameter 'SerialNumber' must be greater than the current serial number 42, got 41\r\n" +
+				"At line:1 char:1\r\n" +
+				"    + CategoryInfo          : OperationStopped: (record paramete...number 42, got 41:String) [], RuntimeException\r\n" +
+				"    + FullyQualifiedErrorId : record parameter 'SerialNumber' must be greater than the current serial number 42, got 41\r\n"}, nil)
+		_, err = c.RecordSOAUpdate(ctx, RecordSOAUpdateParams{Zone: "test.local", SerialNumber: &serialNumber})
+		suite.EqualError(err, "windows.dns.RecordSOAUpdate: record parameter 'SerialNumber' must be greater than the current serial number 42, got 41")
+	})
+}
@@ -0,0 +1,365 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// srvNameRegex matches the name of a SRV-Record in the format "_service._proto" with an optional subdomain,
+// e.g. "_ldap._tcp" or "_ldap._tcp.dc._msdcs".
+var srvNameRegex = regexp.MustCompile(`^(_[A-Za-z0-9-]+)\.(_[A-Za-z0-9-]+)(?:\.[^.]+)*$`)
+
+// RecordSRV represents a DNS SRV-Record.
+type RecordSRV struct {
+	DistinguishedName string
+	Name              string
+	Service           string
+	Protocol          string
+	Targets           []SRVTarget
+	Timestamp         time.Time
+	TimeToLive        time.Duration
+}
+
+// SRVTarget represents a single target entry of a SRV-Record.
+type SRVTarget struct {
+	// Specifies the fully qualified domain name of the host that provides the service.
+	Target string
+
+	// Specifies the priority of the target.
+	// A lower value indicates a higher priority.
+	Priority uint16
+
+	// Specifies the relative weight of targets with the same priority.
+	Weight uint16
+
+	// Specifies the port on which the service is provided.
+	Port uint16
+}
+
+// validateSRVName returns an error if the name of a SRV-Record is not in the format "_service._proto".
+func validateSRVName(name string) error {
+	if !srvNameRegex.MatchString(name) {
+		return fmt.Errorf("record parameter 'Name' must be in the format '_service._proto', got '%s'", name)
+	}
+	return nil
+}
+
+// convertOutput converts the unmarshaled JSON output from the recordObject to a RecordSRV object.
+func (r *RecordSRV) convertOutput(o []recordObject) error {
+	if len(o) == 0 {
+		return errors.New("record not found")
+	}
+
+	// Set the values of the first object to the RecordSRV object.
+	r.DistinguishedName = o[0].DistinguishedName
+	r.Name = o[0].Name
+	r.Timestamp = o[0].Timestamp.Time
+	r.TimeToLive = o[0].TimeToLive.Duration
+
+	// Set the service and protocol from the name.
+	if match := srvNameRegex.FindStringSubmatch(r.Name); match != nil {
+		r.Service = match[1]
+		r.Protocol = match[2]
+	}
+
+	// Set the targets and the lowest TTL.
+	for _, record := range o {
+		var target SRVTarget
+		target.Target = record.RecordData.CimInstanceProperties["DomainName"]
+
+		for property, value := range map[string]*uint16{
+			"Priority": &target.Priority,
+			"Weight":   &target.Weight,
+			"Port":     &target.Port,
+		} {
+			v, err := strconv.ParseUint(record.RecordData.CimInstanceProperties[property], 10, 16)
+			if err != nil {
+				return err
+			}
+			*value = uint16(v)
+		}
+		r.Targets = append(r.Targets, target)
+
+		// Set the lowest TTL to be RFC2181 compliant.
+		// https://www.rfc-editor.org/rfc/rfc2181#section-5.2
+		if record.TimeToLive.Duration < r.TimeToLive {
+			r.TimeToLive = record.TimeToLive.Duration
+		}
+	}
+
+	return nil
+}
+
+// RecordSRVReadParams represents parameters for the SRV-Record read function.
+type RecordSRVReadParams struct {
+	// Specifies the name of the record in the format "_service._proto", e.g. "_ldap._tcp".
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to read a SRV-Record.
+func (params RecordSRVReadParams) pwshCommand() string {
+	// Base command
+	cmd := []string{"$r=Get-DnsServerResourceRecord -RRType 'SRV' -Node"}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+
+	// Ensure output is always an array.
+	cmd = append(cmd, ";if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
+	return strings.Join(cmd, " ")
+}
+
+// RecordSRVRead gets a SRV-Record by Name and Zone. It returns a RecordSRV object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordSRVRead(ctx context.Context, params RecordSRVReadParams) (RecordSRV, error) {
+	var r RecordSRV
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return r, errors.New("windows.dns.RecordSRVRead: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	if err := validateSRVName(params.Name); err != nil {
+		return r, fmt.Errorf("windows.dns.RecordSRVRead: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSRVRead: %w", err)
+	}
+
+	// Convert the output to a RecordSRV object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSRVRead: failed to convert output to RecordSRV object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordSRVCreateParams represents parameters for the SRV-Record create function.
+type RecordSRVCreateParams struct {
+	// Specifies the name of the record in the format "_service._proto", e.g. "_ldap._tcp".
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the targets of the record.
+	Targets []SRVTarget
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to create a new SRV-Record.
+func (params RecordSRVCreateParams) pwshCommand() string {
+	return pwshAddEach(params.pwshAdds(), params.Zone, params.ZoneScope)
+}
+
+// pwshAdds returns the add calls of the records of a new SRV-Record.
+func (params RecordSRVCreateParams) pwshAdds() []string {
+	// Set default TTL if not provided.
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+
+	// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// The cmdlet only accepts a single target, so a record is added for each target.
+	adds := []string{}
+	for _, target := range params.Targets {
+		adds = append(adds, fmt.Sprintf(
+			"Add-DnsServerResourceRecord -Srv -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '%s' %s -TimeToLive $(New-TimeSpan -Seconds %d) -DomainName '%s' -Priority %d -Weight %d -Port %d",
+			params.Name,
+			pwshZoneName(params.Zone, params.ZoneScope),
+			seconds,
+			strings.ReplaceAll(target.Target, "'", "''"),
+			target.Priority,
+			target.Weight,
+			target.Port,
+		))
+	}
+
+	return adds
+}
+
+// RecordSRVCreate creates a new SRV-Record. It returns a RecordSRV object.
+// If a target cannot be added, the targets that were already added are removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordSRVCreate(ctx context.Context, params RecordSRVCreateParams) (RecordSRV, error) {
+	var r RecordSRV
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || len(params.Targets) == 0 {
+		return r, errors.New("windows.dns.RecordSRVCreate: record parameters 'Name', 'Zone' and 'Targets' must be set")
+	}
+
+	if err := validateSRVName(params.Name); err != nil {
+		return r, fmt.Errorf("windows.dns.RecordSRVCreate: %w", err)
+	}
+
+	// Assert targets
+	for _, target := range params.Targets {
+		if target.Target == "" || target.Port == 0 {
+			return r, errors.New("windows.dns.RecordSRVCreate: record parameters 'Target' and 'Port' must be set for all targets")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle record already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return r, winerror.Errorf(cmd, "windows.dns.RecordSRVCreate: the specified record already exists")
+		}
+
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSRVCreate: %w", err)
+	}
+
+	// Convert the output to a RecordSRV object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSRVCreate: failed to convert output to RecordSRV object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordSRVUpdateParams represents parameters for the SRV-Record update function.
+// Only the TimeToLive can be updated.
+type RecordSRVUpdateParams struct {
+	// Specifies the name of the record in the format "_service._proto", e.g. "_ldap._tcp".
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default TTL is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update a SRV-Record.
+func (params RecordSRVUpdateParams) pwshCommand() string {
+	// Update to default TTL if not provided.
+	// New-TimeSpan only allows int32 values.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// Base command
+	cmd := []string{"$nr=@();Get-DnsServerResourceRecord -RRType 'SRV' -Node"}
+
+	// Add parameters and logic for handling the TTL update.
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+	cmd = append(cmd, fmt.Sprintf("| ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
+	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru}", pwshZoneName(params.Zone, params.ZoneScope)))
+	cmd = append(cmd, ";if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}")
+
+	// Return the full command.
+	return strings.Join(cmd, " ")
+}
+
+// RecordSRVUpdate updates a SRV-Record. It returns a RecordSRV object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordSRVUpdate(ctx context.Context, params RecordSRVUpdateParams) (RecordSRV, error) {
+	var r RecordSRV
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || params.TimeToLive == 0 {
+		return r, errors.New("windows.dns.RecordSRVUpdate: record parameters 'Name', 'Zone' and 'TimeToLive' must be set")
+	}
+
+	if err := validateSRVName(params.Name); err != nil {
+		return r, fmt.Errorf("windows.dns.RecordSRVUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSRVUpdate: %w", err)
+	}
+
+	// Convert the output to a RecordSRV object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordSRVUpdate: failed to convert output to RecordSRV object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordSRVDeleteParams represents parameters for the SRV-Record delete function.
+type RecordSRVDeleteParams struct {
+	// Specifies the name of the record in the format "_service._proto", e.g. "_ldap._tcp".
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to delete a SRV-Record.
+func (params RecordSRVDeleteParams) pwshCommand() string {
+	// Base command
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'SRV' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
+}
+
+// RecordSRVDelete deletes a SRV-Record.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordSRVDelete(ctx context.Context, params RecordSRVDeleteParams) error {
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return errors.New("windows.dns.RecordSRVDelete: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	if err := validateSRVName(params.Name); err != nil {
+		return fmt.Errorf("windows.dns.RecordSRVDelete: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.RecordSRVDelete: %w", err)
+	}
+
+	return nil
+}
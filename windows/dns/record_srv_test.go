@@ -0,0 +1,266 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// Fixtures
+const (
+	recordSRVJson = `[{"DistinguishedName":"DC=_ldap._tcp,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"_ldap._tcp","RecordType":"SRV","Timestamp":null,"TimeToLive":{"Ticks":6000000000,"Days":0,"Hours":0,"Milliseconds":0,"Minutes":10,"Seconds":0,"TotalDays":0.006944444444444444,"TotalHours":0.16666666666666666,"TotalMilliseconds":600000,"TotalMinutes":10,"TotalSeconds":600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordSRV","CimInstanceProperties":"DomainName = \"dc01.test.local.\" Port = 389 Priority = 0 Weight = 100","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":33}]`
+)
+
+var (
+	expectedRecordSRV = RecordSRV{
+		DistinguishedName: "DC=_ldap._tcp,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+		Name:              "_ldap._tcp",
+		Service:           "_ldap",
+		Protocol:          "_tcp",
+		Timestamp:         time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		TimeToLive:        time.Second * 600,
+		Targets:           []SRVTarget{{Target: "dc01.test.local.", Priority: 0, Weight: 100, Port: 389}},
+	}
+)
+
+func (suite *DnsServerUnitTestSuite) TestValidateSRVName() {
+	suite.Run("should validate the SRV-Record names", func() {
+		tcs := []struct {
+			name  string
+			valid bool
+		}{
+			{"_ldap._tcp", true},
+			{"_kerberos._udp", true},
+			{"_ldap._tcp.dc._msdcs", true},
+			{"ldap._tcp", false},
+			{"_ldap.tcp", false},
+			{"_ldap", false},
+			{"www", false},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.name)
+			err := validateSRVName(tc.name)
+			if tc.valid {
+				suite.NoError(err)
+			} else {
+				suite.EqualError(err, "record parameter 'Name' must be in the format '_service._proto', got '"+tc.name+"'")
+			}
+		}
+	})
+}
+
+// Test the convertOutput method.
+func (suite *DnsServerUnitTestSuite) TestRecordSRVConvertOutput() {
+	suite.Run("should return the correct RecordSRV object with multiple targets and the lowest TTL", func() {
+		o := []recordObject{
+			{
+				Name:       "_ldap._tcp.dc._msdcs",
+				RecordType: "SRV",
+				TimeToLive: parsing.CimTimeDuration{Duration: time.Second * 600},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"DomainName": "dc01.test.local.", "Port": "389", "Priority": "0", "Weight": "100"},
+				},
+			},
+			{
+				Name:       "_ldap._tcp.dc._msdcs",
+				RecordType: "SRV",
+				TimeToLive: parsing.CimTimeDuration{Duration: time.Second * 300},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"DomainName": "dc02.test.local.", "Port": "389", "Priority": "10", "Weight": "50"},
+				},
+			},
+		}
+
+		r := RecordSRV{}
+		err := r.convertOutput(o)
+		suite.NoError(err)
+		suite.Equal("_ldap", r.Service)
+		suite.Equal("_tcp", r.Protocol)
+		suite.Equal(time.Second*300, r.TimeToLive)
+		suite.Equal([]SRVTarget{
+			{Target: "dc01.test.local.", Priority: 0, Weight: 100, Port: 389},
+			{Target: "dc02.test.local.", Priority: 10, Weight: 50, Port: 389},
+		}, r.Targets)
+	})
+
+	suite.Run("should return an error for an empty output", func() {
+		r := RecordSRV{}
+		suite.EqualError(r.convertOutput([]recordObject{}), "record not found")
+	})
+}
+
+// Test RecordSRVRead related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordSRVRead() {
+	suite.Run("should return the correct SRV-Record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'SRV' -Node -Name '_ldap._tcp' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordSRVJson}, nil)
+		actualRecordSRV, err := c.RecordSRVRead(ctx, RecordSRVReadParams{Name: "_ldap._tcp", Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedRecordSRV, actualRecordSRV)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordSRVReadParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty parameters",
+				RecordSRVReadParams{},
+				"windows.dns.RecordSRVRead: record parameters 'Name' and 'Zone' must be set",
+			},
+			{
+				"assert error with invalid name",
+				RecordSRVReadParams{Name: "ldap", Zone: "test.local"},
+				"windows.dns.RecordSRVRead: record parameter 'Name' must be in the format '_service._proto', got 'ldap'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+			}
+			_, err := c.RecordSRVRead(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test RecordSRVCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordSRVCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordSRVCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert without ttl parameter",
+				RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local", Targets: []SRVTarget{{Target: "dc01.test.local", Weight: 100, Port: 389}}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -Srv -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '_ldap._tcp' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -DomainName 'dc01.test.local' -Priority 0 -Weight 100 -Port 389}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with multiple targets and ttl parameter",
+				RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local", TimeToLive: time.Second * 600, Targets: []SRVTarget{{Target: "dc01.test.local", Weight: 100, Port: 389}, {Target: "dc02.test.local", Priority: 10, Port: 389}}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -Srv -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '_ldap._tcp' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 600) -DomainName 'dc01.test.local' -Priority 0 -Weight 100 -Port 389;$r+=Add-DnsServerResourceRecord -Srv -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '_ldap._tcp' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 600) -DomainName 'dc02.test.local' -Priority 10 -Weight 0 -Port 389}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with escaped target",
+				RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local", Targets: []SRVTarget{{Target: "dc'01.test.local", Weight: 100, Port: 389}}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -Srv -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '_ldap._tcp' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -DomainName 'dc''01.test.local' -Priority 0 -Weight 100 -Port 389}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordSRVCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=@() ;try{$r+=Add-DnsServerResourceRecord -Srv -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '_ldap._tcp' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 600) -DomainName 'dc01.test.local' -Priority 0 -Weight 100 -Port 389}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordSRVJson}, nil)
+		actualRecord, err := c.RecordSRVCreate(ctx, RecordSRVCreateParams{
+			Name:       "_ldap._tcp",
+			Zone:       "test.local",
+			TimeToLive: time.Second * 600,
+			Targets:    []SRVTarget{{Target: "dc01.test.local", Weight: 100, Port: 389}},
+		})
+		suite.NoError(err)
+		suite.Equal(expectedRecordSRV, actualRecord)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordSRVCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without targets",
+				RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local"},
+				"windows.dns.RecordSRVCreate: record parameters 'Name', 'Zone' and 'Targets' must be set",
+			},
+			{
+				"assert error with invalid name",
+				RecordSRVCreateParams{Name: "ldap", Zone: "test.local", Targets: []SRVTarget{{Target: "dc01.test.local", Port: 389}}},
+				"windows.dns.RecordSRVCreate: record parameter 'Name' must be in the format '_service._proto', got 'ldap'",
+			},
+			{
+				"assert error with target without port",
+				RecordSRVCreateParams{Name: "_ldap._tcp", Zone: "test.local", Targets: []SRVTarget{{Target: "dc01.test.local"}}},
+				"windows.dns.RecordSRVCreate: record parameters 'Target' and 'Port' must be set for all targets",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.RecordSRVCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test RecordSRVUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordSRVUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordSRVUpdateParams{Name: "_ldap._tcp", Zone: "test.local", TimeToLive: time.Second * 600}.pwshCommand()
+		suite.Equal("$nr=@();Get-DnsServerResourceRecord -RRType 'SRV' -Node -Name '_ldap._tcp' -ZoneName 'test.local' | ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds 600 ;$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru} ;if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}", actualCmd)
+	})
+}
+
+// Test RecordSRVDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordSRVDelete() {
+	suite.Run("should delete the record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Remove-DnsServerResourceRecord -RRType 'SRV' -Force -Name '_ldap._tcp' -ZoneName 'test.local'").
+			Return(connection.CmdResult{}, nil)
+		err := c.RecordSRVDelete(ctx, RecordSRVDeleteParams{Name: "_ldap._tcp", Zone: "test.local"})
+		suite.NoError(err)
+	})
+}
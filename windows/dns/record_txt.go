@@ -0,0 +1,342 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// maxTXTStringLength is the maximum length in bytes of a single character string of a TXT-Record.
+// https://www.rfc-editor.org/rfc/rfc1035#section-3.3.14
+const maxTXTStringLength int = 255
+
+// RecordTXT represents a DNS TXT-Record.
+type RecordTXT struct {
+	DistinguishedName string
+	Name              string
+	Values            []string
+	Timestamp         time.Time
+	TimeToLive        time.Duration
+}
+
+// convertOutput converts the unmarshaled JSON output from the recordObject to a RecordTXT object.
+func (r *RecordTXT) convertOutput(o []recordObject) error {
+	if len(o) == 0 {
+		return errors.New("record not found")
+	}
+
+	// Set the values of the first object to the RecordTXT object.
+	r.DistinguishedName = o[0].DistinguishedName
+	r.Name = o[0].Name
+	r.Timestamp = o[0].Timestamp.Time
+	r.TimeToLive = o[0].TimeToLive.Duration
+
+	// Set the values and the lowest TTL.
+	for _, record := range o {
+		r.Values = append(r.Values, decodeTXTValue(record.RecordData.CimInstanceProperties["DescriptiveText"]))
+
+		// Set the lowest TTL to be RFC2181 compliant.
+		// https://www.rfc-editor.org/rfc/rfc2181#section-5.2
+		if record.TimeToLive.Duration < r.TimeToLive {
+			r.TimeToLive = record.TimeToLive.Duration
+		}
+	}
+
+	return nil
+}
+
+// decodeTXTValue returns the value of a TXT-Record from its descriptive text.
+// The Windows DNS server separates the character strings of a record with line breaks,
+// which are concatenated to a single value like SPF and DKIM clients do.
+func decodeTXTValue(text string) string {
+	return strings.NewReplacer("\r\n", "", "\n", "").Replace(text)
+}
+
+// splitTXTValue splits the value of a TXT-Record into character strings of at most 255 bytes.
+// The value is split at the last rune that fits into a character string.
+func splitTXTValue(value string) []string {
+	var chunks []string
+	for value != "" {
+		end := min(len(value), maxTXTStringLength)
+		for end < len(value) && !utf8.RuneStart(value[end]) {
+			end--
+		}
+
+		chunks = append(chunks, value[:end])
+		value = value[end:]
+	}
+
+	return chunks
+}
+
+// pwshTXTValue returns the PowerShell expression for the descriptive text of a TXT-Record.
+// Values longer than 255 bytes are split into multiple character strings that are joined with line breaks.
+// Single quotes are escaped, so the value can contain any quotes.
+func pwshTXTValue(value string) string {
+	var chunks []string
+	for _, chunk := range splitTXTValue(value) {
+		chunks = append(chunks, fmt.Sprintf("'%s'", strings.ReplaceAll(chunk, "'", "''")))
+	}
+
+	if len(chunks) == 1 {
+		return chunks[0]
+	}
+
+	return fmt.Sprintf("(%s -join \"`n\")", strings.Join(chunks, ","))
+}
+
+// RecordTXTReadParams represents parameters for the TXT-Record read function.
+type RecordTXTReadParams struct {
+	// Specifies the name of the record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to read a TXT-Record.
+func (params RecordTXTReadParams) pwshCommand() string {
+	// Base command
+	cmd := []string{"$r=Get-DnsServerResourceRecord -RRType 'TXT' -Node"}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+
+	// Ensure output is always an array.
+	cmd = append(cmd, ";if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
+	return strings.Join(cmd, " ")
+}
+
+// RecordTXTRead gets a TXT-Record by Name and Zone. It returns a RecordTXT object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordTXTRead(ctx context.Context, params RecordTXTReadParams) (RecordTXT, error) {
+	var r RecordTXT
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return r, errors.New("windows.dns.RecordTXTRead: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordTXTRead: %w", err)
+	}
+
+	// Convert the output to a RecordTXT object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordTXTRead: failed to convert output to RecordTXT object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordTXTCreateParams represents parameters for the TXT-Record create function.
+type RecordTXTCreateParams struct {
+	// Specifies the name of the Record.
+	// Use "@" to create the record for the zone itself.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the values of the record.
+	// A separate record is created for each value.
+	// Values longer than 255 bytes are split into multiple character strings.
+	Values []string
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to create a new TXT-Record.
+func (params RecordTXTCreateParams) pwshCommand() string {
+	return pwshAddEach(params.pwshAdds(), params.Zone, params.ZoneScope)
+}
+
+// pwshAdds returns the add calls of the records of a new TXT-Record.
+func (params RecordTXTCreateParams) pwshAdds() []string {
+	// Set default TTL if not provided.
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+
+	// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// The cmdlet only accepts a single value, so a record is added for each value.
+	adds := []string{}
+	for _, value := range params.Values {
+		adds = append(adds, fmt.Sprintf(
+			"Add-DnsServerResourceRecord -Txt -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '%s' %s -TimeToLive $(New-TimeSpan -Seconds %d) -DescriptiveText %s",
+			params.Name,
+			pwshZoneName(params.Zone, params.ZoneScope),
+			seconds,
+			pwshTXTValue(value),
+		))
+	}
+
+	return adds
+}
+
+// RecordTXTCreate creates a new TXT-Record. It returns a RecordTXT object.
+// If a value cannot be added, the values that were already added are removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordTXTCreate(ctx context.Context, params RecordTXTCreateParams) (RecordTXT, error) {
+	var r RecordTXT
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || len(params.Values) == 0 {
+		return r, errors.New("windows.dns.RecordTXTCreate: record parameters 'Name', 'Zone' and 'Values' must be set")
+	}
+
+	// Assert values
+	for _, value := range params.Values {
+		if value == "" {
+			return r, errors.New("windows.dns.RecordTXTCreate: record parameter 'Values' must not contain empty values")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle record already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return r, winerror.Errorf(cmd, "windows.dns.RecordTXTCreate: the specified record already exists")
+		}
+
+		return r, winerror.Errorf(cmd, "windows.dns.RecordTXTCreate: %w", err)
+	}
+
+	// Convert the output to a RecordTXT object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordTXTCreate: failed to convert output to RecordTXT object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordTXTUpdateParams represents parameters for the TXT-Record update function.
+// Only the TimeToLive can be updated.
+type RecordTXTUpdateParams struct {
+	// Specifies the name of the Record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the time to live (TTL) of the record in seconds.
+	// If not provided, the default TTL is 86400 seconds.
+	// A TTL of 0 is not allowed.
+	TimeToLive time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update a TXT-Record.
+func (params RecordTXTUpdateParams) pwshCommand() string {
+	// Update to default TTL if not provided.
+	// New-TimeSpan only allows int32 values.
+	// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+	if params.TimeToLive == 0 {
+		params.TimeToLive = defaultTimeToLive
+	}
+	seconds := int32(params.TimeToLive.Round(time.Second).Seconds())
+
+	// Base command
+	cmd := []string{"$nr=@();Get-DnsServerResourceRecord -RRType 'TXT' -Node"}
+
+	// Add parameters and logic for handling the TTL update.
+	cmd = append(cmd, fmt.Sprintf("-Name '%s'", params.Name))
+	cmd = append(cmd, pwshZoneName(params.Zone, params.ZoneScope))
+	cmd = append(cmd, fmt.Sprintf("| ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds %d", seconds))
+	cmd = append(cmd, fmt.Sprintf(";$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n %s -PassThru}", pwshZoneName(params.Zone, params.ZoneScope)))
+	cmd = append(cmd, ";if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}")
+
+	// Return the full command.
+	return strings.Join(cmd, " ")
+}
+
+// RecordTXTUpdate updates a TXT-Record. It returns a RecordTXT object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordTXTUpdate(ctx context.Context, params RecordTXTUpdateParams) (RecordTXT, error) {
+	var r RecordTXT
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" || params.TimeToLive == 0 {
+		return r, errors.New("windows.dns.RecordTXTUpdate: record parameters 'Name', 'Zone' and 'TimeToLive' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordTXTUpdate: %w", err)
+	}
+
+	// Convert the output to a RecordTXT object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.RecordTXTUpdate: failed to convert output to RecordTXT object: %w", err)
+	}
+
+	return r, nil
+}
+
+// RecordTXTDeleteParams represents parameters for the TXT-Record delete function.
+type RecordTXTDeleteParams struct {
+	// Specifies the name of the Record.
+	Name string
+
+	// Specifies the zone in which the record is located.
+	Zone string
+
+	// Specifies the zone scope in which the record is located.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to delete a TXT-Record.
+func (params RecordTXTDeleteParams) pwshCommand() string {
+	// Base command
+	return fmt.Sprintf("Remove-DnsServerResourceRecord -RRType 'TXT' -Force -Name '%s' %s", params.Name, pwshZoneName(params.Zone, params.ZoneScope))
+}
+
+// RecordTXTDelete deletes a TXT-Record.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) RecordTXTDelete(ctx context.Context, params RecordTXTDeleteParams) error {
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Name == "" || params.Zone == "" {
+		return errors.New("windows.dns.RecordTXTDelete: record parameters 'Name' and 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.RecordTXTDelete: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package dns
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// Fixtures
+const (
+	recordTXTJson = `[{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"TXT","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordTxt","CimInstanceProperties":"DescriptiveText = \"v=spf1 include:\"spf.test.local\" -all\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":16},{"DistinguishedName":"DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"TXT","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordTxt","CimInstanceProperties":"DescriptiveText = \"first-part\nsecond-part\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":16}]`
+)
+
+var (
+	expectedRecordTXT = RecordTXT{
+		DistinguishedName: "DC=@,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+		Name:              "@",
+		Timestamp:         time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC),
+		TimeToLive:        time.Second * 3600,
+		Values:            []string{`v=spf1 include:"spf.test.local" -all`, "first-partsecond-part"},
+	}
+)
+
+func (suite *DnsServerUnitTestSuite) TestPwshTXTValue() {
+	suite.Run("should return the correct PowerShell expression", func() {
+		tcs := []struct {
+			description string
+			inputValue  string
+			expected    string
+		}{
+			{
+				"assert short value",
+				"v=spf1 -all",
+				"'v=spf1 -all'",
+			},
+			{
+				"assert value with quotes",
+				`it's "quoted"`,
+				`'it''s "quoted"'`,
+			},
+			{
+				"assert value longer than 255 bytes",
+				strings.Repeat("a", 300),
+				"('" + strings.Repeat("a", 255) + "','" + strings.Repeat("a", 45) + "' -join \"`n\")",
+			},
+			{
+				"assert multi-byte runes are not split",
+				strings.Repeat("a", 254) + "ä",
+				"('" + strings.Repeat("a", 254) + "','ä' -join \"`n\")",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expected, pwshTXTValue(tc.inputValue))
+		}
+	})
+}
+
+// Test the convertOutput method.
+func (suite *DnsServerUnitTestSuite) TestRecordTXTConvertOutput() {
+	suite.Run("should return the correct RecordTXT object with multiple values and the lowest TTL", func() {
+		o := []recordObject{
+			{
+				Name:       "@",
+				RecordType: "TXT",
+				TimeToLive: parsing.CimTimeDuration{Duration: time.Second * 3600},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"DescriptiveText": "v=spf1 -all"},
+				},
+			},
+			{
+				Name:       "@",
+				RecordType: "TXT",
+				TimeToLive: parsing.CimTimeDuration{Duration: time.Second * 60},
+				RecordData: recordRecordData{
+					CimInstanceProperties: parsing.CimClassKeyVal{"DescriptiveText": "v=DKIM1; p=abc\r\ndef"},
+				},
+			},
+		}
+
+		r := RecordTXT{}
+		err := r.convertOutput(o)
+		suite.NoError(err)
+		suite.Equal(time.Second*60, r.TimeToLive)
+		suite.Equal([]string{"v=spf1 -all", "v=DKIM1; p=abcdef"}, r.Values)
+	})
+
+	suite.Run("should return an error for an empty output", func() {
+		r := RecordTXT{}
+		suite.EqualError(r.convertOutput([]recordObject{}), "record not found")
+	})
+}
+
+// Test RecordTXTRead related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordTXTRead() {
+	suite.Run("should return the correct TXT-Record", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'TXT' -Node -Name '@' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordTXTJson}, nil)
+		actualRecordTXT, err := c.RecordTXTRead(ctx, RecordTXTReadParams{Name: "@", Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedRecordTXT, actualRecordTXT)
+	})
+}
+
+// Test RecordTXTCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordTXTCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordTXTCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert without ttl parameter",
+				RecordTXTCreateParams{Name: "@", Zone: "test.local", Values: []string{"v=spf1 -all"}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -Txt -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 86400) -DescriptiveText 'v=spf1 -all'}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert with multiple values and ttl parameter",
+				RecordTXTCreateParams{Name: "@", Zone: "test.local", TimeToLive: time.Second * 3600, Values: []string{"v=spf1 -all", "token's"}},
+				"$r=@() ;try{$r+=Add-DnsServerResourceRecord -Txt -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -DescriptiveText 'v=spf1 -all';$r+=Add-DnsServerResourceRecord -Txt -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -ErrorAction Stop -Name '@' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -DescriptiveText 'token''s'}catch{$r|Remove-DnsServerResourceRecord -ZoneName 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestRecordTXTCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters RecordTXTCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without values",
+				RecordTXTCreateParams{Name: "@", Zone: "test.local"},
+				"windows.dns.RecordTXTCreate: record parameters 'Name', 'Zone' and 'Values' must be set",
+			},
+			{
+				"assert error with an empty value",
+				RecordTXTCreateParams{Name: "@", Zone: "test.local", Values: []string{""}},
+				"windows.dns.RecordTXTCreate: record parameter 'Values' must not contain empty values",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.RecordTXTCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test RecordTXTUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordTXTUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordTXTUpdateParams{Name: "@", Zone: "test.local", TimeToLive: time.Second * 3600}.pwshCommand()
+		suite.Equal("$nr=@();Get-DnsServerResourceRecord -RRType 'TXT' -Node -Name '@' -ZoneName 'test.local' | ForEach-Object{$r=$_;$n=[ciminstance]::new($r);$n.TimeToLive=New-TimeSpan -Seconds 3600 ;$nr+=Set-DnsServerResourceRecord -OldInputObject $r -NewInputObject $n -ZoneName 'test.local' -PassThru} ;if($nr.Count -ge 2){ConvertTo-Json $nr -Compress}else{ConvertTo-Json @($nr) -Compress}", actualCmd)
+	})
+}
+
+// Test RecordTXTDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestRecordTXTDeletePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := RecordTXTDeleteParams{Name: "@", Zone: "test.local"}.pwshCommand()
+		suite.Equal("Remove-DnsServerResourceRecord -RRType 'TXT' -Force -Name '@' -ZoneName 'test.local'", actualCmd)
+	})
+}
@@ -0,0 +1,320 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// resolveRecordTypes maps the numeric record types of the Resolve-DnsName answers to the supported record types.
+// https://www.iana.org/assignments/dns-parameters/dns-parameters.xhtml#dns-parameters-4
+var resolveRecordTypes = map[uint16]string{
+	1:  "A",
+	2:  "NS",
+	5:  "CNAME",
+	12: "PTR",
+	15: "MX",
+	16: "TXT",
+	28: "AAAA",
+	33: "SRV",
+}
+
+// resolveNameErrorId is the prefix of the error ID of Resolve-DnsName if the queried name does not exist.
+const resolveNameErrorId string = "DNS_ERROR_RCODE_NAME_ERROR,"
+
+// Resolution represents the answer of a DNS server to a query from the point of view of the Windows host.
+type Resolution struct {
+	Name       string
+	RecordType string
+	Server     string
+
+	// NameExists is false if the server answered that the name does not exist (NXDOMAIN).
+	NameExists bool
+
+	// Answers contains the records of the answer section with fully qualified names, e.g. "www.example.com".
+	// If the name is an alias, the answers contain the CNAME-Record and the records of its target.
+	Answers Records
+
+	// Missing contains the stored records of the name that are not part of the answers, e.g. "A 192.168.10.1".
+	// It is only set if the records are compared with a zone.
+	Missing []string
+
+	// Unexpected contains the answered records of the name that are not stored in the zone, e.g. "A 192.168.10.2".
+	// It is only set if the records are compared with a zone.
+	Unexpected []string
+}
+
+// resolveAnswerObject contains the unmarshaled json of a powershell Resolve-DnsName record object.
+type resolveAnswerObject struct {
+	Name         string   `json:"Name"`
+	Type         uint16   `json:"Type"`
+	TTL          uint32   `json:"TTL"`
+	IP4Address   string   `json:"IP4Address"`
+	IP6Address   string   `json:"IP6Address"`
+	NameHost     string   `json:"NameHost"`
+	NameExchange string   `json:"NameExchange"`
+	Preference   uint16   `json:"Preference"`
+	NameTarget   string   `json:"NameTarget"`
+	Priority     uint16   `json:"Priority"`
+	Weight       uint16   `json:"Weight"`
+	Port         uint16   `json:"Port"`
+	Strings      []string `json:"Strings"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the resolveAnswerObjects to the answers of a Resolution object.
+// Answers with the same name and type are combined like the RecordList function does, other record types are skipped.
+func (r *Resolution) convertOutput(o []resolveAnswerObject) error {
+	var groups [][]resolveAnswerObject
+	index := map[string]int{}
+
+	for _, answer := range o {
+		recordType, ok := resolveRecordTypes[answer.Type]
+		if !ok {
+			continue
+		}
+
+		key := recordType + " " + strings.ToLower(answer.Name)
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], answer)
+	}
+
+	for _, g := range groups {
+		name := g[0].Name
+		ttl := time.Duration(g[0].TTL) * time.Second
+
+		switch resolveRecordTypes[g[0].Type] {
+		case "A":
+			record := RecordA{Name: name, TimeToLive: ttl}
+			for _, answer := range g {
+				address, err := netip.ParseAddr(answer.IP4Address)
+				if err != nil {
+					return err
+				}
+				record.Addresses = append(record.Addresses, address)
+			}
+			r.Answers.A = append(r.Answers.A, record)
+		case "AAAA":
+			record := RecordAAAA{Name: name, TimeToLive: ttl}
+			for _, answer := range g {
+				address, err := netip.ParseAddr(answer.IP6Address)
+				if err != nil {
+					return err
+				}
+				record.Addresses = append(record.Addresses, address)
+			}
+			r.Answers.AAAA = append(r.Answers.AAAA, record)
+		case "CNAME":
+			for _, answer := range g {
+				r.Answers.CName = append(r.Answers.CName, RecordCName{Name: name, CName: answer.NameHost, TimeToLive: ttl})
+			}
+		case "PTR":
+			for _, answer := range g {
+				r.Answers.PTR = append(r.Answers.PTR, RecordPTR{Name: name, PTR: answer.NameHost, TimeToLive: ttl})
+			}
+		case "MX":
+			record := RecordMX{Name: name, TimeToLive: ttl}
+			for _, answer := range g {
+				record.MailExchangers = append(record.MailExchangers, MailExchanger{MailExchange: answer.NameExchange, Preference: answer.Preference})
+			}
+			r.Answers.MX = append(r.Answers.MX, record)
+		case "SRV":
+			record := RecordSRV{Name: name, TimeToLive: ttl}
+			for _, answer := range g {
+				record.Targets = append(record.Targets, SRVTarget{Target: answer.NameTarget, Priority: answer.Priority, Weight: answer.Weight, Port: answer.Port})
+			}
+			r.Answers.SRV = append(r.Answers.SRV, record)
+		case "TXT":
+			record := RecordTXT{Name: name, TimeToLive: ttl}
+			for _, answer := range g {
+				record.Values = append(record.Values, strings.Join(answer.Strings, ""))
+			}
+			r.Answers.TXT = append(r.Answers.TXT, record)
+		case "NS":
+			record := RecordNS{Name: name, TimeToLive: ttl}
+			for _, answer := range g {
+				record.NameServers = append(record.NameServers, answer.NameHost)
+			}
+			r.Answers.NS = append(r.Answers.NS, record)
+		}
+	}
+
+	return nil
+}
+
+// ResolveParams represents parameters for the Resolve function.
+type ResolveParams struct {
+	// Specifies the fully qualified domain name to query, e.g. "www.example.com".
+	Name string
+
+	// Specifies the record type to query, e.g. "A" or "MX".
+	// If not provided, A-Records are queried.
+	RecordType string
+
+	// Specifies the DNS server to query by IP address or name.
+	Server string
+
+	// Specifies whether the server is asked to answer without recursion,
+	// so only the authoritative data and the cache of the server are used.
+	NoRecursion bool
+
+	// Specifies the zone whose stored records are compared with the answers.
+	// If not provided, the answers are not compared.
+	Zone string
+
+	// Specifies the zone scope whose stored records are compared with the answers.
+	// If not provided, the records of the default zone scope are compared.
+	ZoneScope string
+}
+
+// pwshCommand returns the PowerShell command to query a name from the Windows host.
+// Only the records of the answer section are returned.
+func (params ResolveParams) pwshCommand() string {
+	// Set default record type if not provided.
+	if params.RecordType == "" {
+		params.RecordType = "A"
+	}
+
+	// Base command
+	cmd := []string{fmt.Sprintf("$r=@(Resolve-DnsName -Name '%s'", params.Name)}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Type '%s'", strings.ToUpper(params.RecordType)))
+	cmd = append(cmd, fmt.Sprintf("-Server '%s'", params.Server))
+	cmd = append(cmd, "-DnsOnly")
+	if params.NoRecursion {
+		cmd = append(cmd, "-NoRecursion")
+	}
+
+	cmd = append(cmd, "| Where-Object{$_.Section -eq 'Answer'}) ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}")
+	return strings.Join(cmd, " ")
+}
+
+// Resolve queries a name from the Windows host against a DNS server. It returns a Resolution object.
+// An answer that the name does not exist is returned as a Resolution without answers instead of an error.
+// If a zone is provided, the answers for the name are compared with the stored records of the name in the zone.
+// The time to live is not compared, because cached answers count down the time to live.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) Resolve(ctx context.Context, params ResolveParams) (Resolution, error) {
+	var r Resolution
+	var o []resolveAnswerObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return r, errors.New("windows.dns.Resolve: resolve parameter 'Name' must be set")
+	}
+	if params.Server == "" {
+		return r, errors.New("windows.dns.Resolve: resolve parameter 'Server' must be set")
+	}
+	if params.RecordType != "" && !slices.Contains(recordListTypes, strings.ToUpper(params.RecordType)) {
+		return r, fmt.Errorf("windows.dns.Resolve: resolve parameter 'RecordType' must be one of %s, got '%s'", strings.Join(recordListTypes, ", "), params.RecordType)
+	}
+	relativeName, ok := zoneRelativeName(params.Name, params.Zone)
+	if params.Zone != "" && !ok {
+		return r, fmt.Errorf("windows.dns.Resolve: resolve parameter 'Name' must be in zone '%s', got '%s'", params.Zone, params.Name)
+	}
+
+	// Set default record type if not provided.
+	if params.RecordType == "" {
+		params.RecordType = "A"
+	}
+
+	r.Name = params.Name
+	r.RecordType = strings.ToUpper(params.RecordType)
+	r.Server = params.Server
+	r.NameExists = true
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		if !strings.HasPrefix(winerror.FullyQualifiedErrorId(err), resolveNameErrorId) {
+			return r, winerror.Errorf(cmd, "windows.dns.Resolve: %w", err)
+		}
+		r.NameExists = false
+	}
+
+	// Convert the output to the answers of the Resolution object.
+	if err := r.convertOutput(o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.Resolve: failed to convert output to Resolution object: %w", err)
+	}
+
+	if params.Zone == "" {
+		return r, nil
+	}
+
+	// Compare the answers with the stored records of the name.
+	stored, err := c.RecordList(ctx, RecordListParams{Zone: params.Zone, ZoneScope: params.ZoneScope, NamePrefix: relativeName})
+	if err != nil {
+		return r, fmt.Errorf("windows.dns.Resolve: %w", err)
+	}
+	r.Missing, r.Unexpected = compareResolution(stored.zoneFileLines(), relativeName, r.Answers.zoneFileLines(), params.Name, r.RecordType)
+
+	return r, nil
+}
+
+// zoneRelativeName returns the name relative to the zone, "@" for the zone itself.
+// It returns false if the name is not in the zone.
+func zoneRelativeName(name string, zone string) (string, bool) {
+	name = strings.TrimSuffix(name, ".")
+	zone = strings.TrimSuffix(zone, ".")
+
+	if strings.EqualFold(name, zone) {
+		return "@", true
+	}
+	if zone == "" || !strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(zone)) {
+		return "", false
+	}
+	return name[:len(name)-len(zone)-1], true
+}
+
+// compareResolution returns the stored and the answered records of the name that are missing on the other side.
+// Only the records of the queried record type and CNAME-Records are compared, because an alias is answered for every record type.
+func compareResolution(stored []zoneFileLine, storedName string, answered []zoneFileLine, answeredName string, recordType string) ([]string, []string) {
+	keys := func(lines []zoneFileLine, name string) []string {
+		var k []string
+		for _, line := range lines {
+			if !strings.EqualFold(strings.TrimSuffix(line.name, "."), strings.TrimSuffix(name, ".")) {
+				continue
+			}
+			if line.recordType != recordType && line.recordType != "CNAME" {
+				continue
+			}
+
+			// Domain names are case-insensitive, the values of TXT-Records are not.
+			data := line.data
+			if line.recordType != "TXT" {
+				data = strings.ToLower(data)
+			}
+			k = append(k, line.recordType+" "+data)
+		}
+		slices.Sort(k)
+		return slices.Compact(k)
+	}
+
+	storedKeys := keys(stored, storedName)
+	answeredKeys := keys(answered, answeredName)
+
+	var missing, unexpected []string
+	for _, k := range storedKeys {
+		if !slices.Contains(answeredKeys, k) {
+			missing = append(missing, k)
+		}
+	}
+	for _, k := range answeredKeys {
+		if !slices.Contains(storedKeys, k) {
+			unexpected = append(unexpected, k)
+		}
+	}
+
+	return missing, unexpected
+}
@@ -0,0 +1,259 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	resolveJson = `[{"Address":"192.168.10.20","IPAddress":"192.168.10.20","QueryType":1,"IP4Address":"192.168.10.20","Name":"web.test.local","Type":1,"CharacterSet":1,"Section":1,"DataLength":4,"TTL":3600},{"Address":"192.168.10.21","IPAddress":"192.168.10.21","QueryType":1,"IP4Address":"192.168.10.21","Name":"web.test.local","Type":1,"CharacterSet":1,"Section":1,"DataLength":4,"TTL":3600}]`
+
+	resolveCNameJson = `[{"NameHost":"web.test.local","Name":"www.test.local","Type":5,"CharacterSet":1,"Section":1,"DataLength":8,"TTL":300},{"IP4Address":"192.168.10.20","Name":"web.test.local","Type":1,"CharacterSet":1,"Section":1,"DataLength":4,"TTL":3600}]`
+
+	resolveMixedJson = `[{"NameExchange":"mail.test.local","Preference":10,"Name":"test.local","Type":15,"CharacterSet":1,"Section":1,"DataLength":8,"TTL":3600},{"Strings":["v=spf1 ","mx -all"],"Name":"test.local","Type":16,"CharacterSet":1,"Section":1,"DataLength":16,"TTL":3600},{"NameTarget":"dc01.test.local","Priority":0,"Weight":100,"Port":389,"Name":"_ldap._tcp.test.local","Type":33,"CharacterSet":1,"Section":1,"DataLength":8,"TTL":600},{"IP6Address":"fd00::20","Name":"web.test.local","Type":28,"CharacterSet":1,"Section":1,"DataLength":16,"TTL":3600},{"NameHost":"dc01.test.local","Name":"test.local","Type":2,"CharacterSet":1,"Section":1,"DataLength":8,"TTL":3600},{"PrimaryServer":"dc01.test.local","Name":"test.local","Type":6,"CharacterSet":1,"Section":1,"DataLength":8,"TTL":3600}]`
+
+	resolveStoredJson = `{"DistinguishedName":"DC=web,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"web","RecordType":"A","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"IPv4Address = \"192.168.10.20\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":1}
+{"DistinguishedName":"DC=web,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"web","RecordType":"A","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"IPv4Address = \"192.168.10.22\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":1}
+{"DistinguishedName":"DC=web01,DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"web01","RecordType":"A","Timestamp":null,"TimeToLive":{"Ticks":36000000000},"RecordData":{"CimInstanceProperties":"IPv4Address = \"192.168.10.30\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":1}
+`
+
+	resolveNameErr = `nx.test.local : DNS name does not exist
+        At line:1 char:6
+        + $r=@(Resolve-DnsName -Name 'nx.test.local' -Type 'A' -Server '192.168.10.10' -DnsOnly ...
+        CategoryInfo          : ResourceUnavailable: (nx.test.local:String) [Resolve-DnsName], Win32Exception
+        FullyQualifiedErrorId : DNS_ERROR_RCODE_NAME_ERROR,Microsoft.DnsClient.Commands.ResolveDnsName
+	`
+
+	resolveTimeoutErr = `web.test.local : This operation returned because the timeout period expired
+        CategoryInfo          : OperationTimeout: (web.test.local:String) [Resolve-DnsName], Win32Exception
+        FullyQualifiedErrorId : ERROR_TIMEOUT,Microsoft.DnsClient.Commands.ResolveDnsName
+	`
+)
+
+// Test Resolve related methods.
+func (suite *DnsServerUnitTestSuite) TestResolvePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ResolveParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with default record type",
+				ResolveParams{Name: "web.test.local", Server: "192.168.10.10"},
+				"$r=@(Resolve-DnsName -Name 'web.test.local' -Type 'A' -Server '192.168.10.10' -DnsOnly | Where-Object{$_.Section -eq 'Answer'}) ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+			{
+				"assert command with record type and without recursion",
+				ResolveParams{Name: "test.local", RecordType: "mx", Server: "dc01.test.local", NoRecursion: true},
+				"$r=@(Resolve-DnsName -Name 'test.local' -Type 'MX' -Server 'dc01.test.local' -DnsOnly -NoRecursion | Where-Object{$_.Section -eq 'Answer'}) ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestResolve() {
+	suite.T().Parallel()
+
+	suite.Run("should return the answers", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ResolveParams{Name: "web.test.local", Server: "192.168.10.10"}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: resolveJson}, nil)
+		actual, err := c.Resolve(ctx, params)
+		suite.NoError(err)
+		suite.Equal(Resolution{
+			Name:       "web.test.local",
+			RecordType: "A",
+			Server:     "192.168.10.10",
+			NameExists: true,
+			Answers: Records{
+				A: []RecordA{{Name: "web.test.local", TimeToLive: time.Hour, Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.20"), netip.MustParseAddr("192.168.10.21")}}},
+			},
+		}, actual)
+	})
+
+	suite.Run("should return the alias and the records of its target", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ResolveParams{Name: "www.test.local", Server: "192.168.10.10"}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: resolveCNameJson}, nil)
+		actual, err := c.Resolve(ctx, params)
+		suite.NoError(err)
+		suite.Equal([]RecordCName{{Name: "www.test.local", CName: "web.test.local", TimeToLive: 5 * time.Minute}}, actual.Answers.CName)
+		suite.Equal([]RecordA{{Name: "web.test.local", TimeToLive: time.Hour, Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.20")}}}, actual.Answers.A)
+	})
+
+	suite.Run("should convert all supported record types and skip the others", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ResolveParams{Name: "test.local", RecordType: "MX", Server: "192.168.10.10"}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: resolveMixedJson}, nil)
+		actual, err := c.Resolve(ctx, params)
+		suite.NoError(err)
+		suite.Equal(Records{
+			AAAA: []RecordAAAA{{Name: "web.test.local", TimeToLive: time.Hour, Addresses: []netip.Addr{netip.MustParseAddr("fd00::20")}}},
+			MX:   []RecordMX{{Name: "test.local", TimeToLive: time.Hour, MailExchangers: []MailExchanger{{MailExchange: "mail.test.local", Preference: 10}}}},
+			SRV:  []RecordSRV{{Name: "_ldap._tcp.test.local", TimeToLive: 10 * time.Minute, Targets: []SRVTarget{{Target: "dc01.test.local", Weight: 100, Port: 389}}}},
+			TXT:  []RecordTXT{{Name: "test.local", TimeToLive: time.Hour, Values: []string{"v=spf1 mx -all"}}},
+			NS:   []RecordNS{{Name: "test.local", TimeToLive: time.Hour, NameServers: []string{"dc01.test.local"}}},
+		}, actual.Answers)
+	})
+
+	suite.Run("should return a resolution without answers if the name does not exist", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ResolveParams{Name: "nx.test.local", Server: "192.168.10.10"}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdErr: resolveNameErr}, nil)
+		actual, err := c.Resolve(ctx, params)
+		suite.NoError(err)
+		suite.False(actual.NameExists)
+		suite.Equal(Records{}, actual.Answers)
+	})
+
+	suite.Run("should return other errors of the query", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ResolveParams{Name: "web.test.local", Server: "192.168.10.10"}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdErr: resolveTimeoutErr}, nil)
+		_, err := c.Resolve(ctx, params)
+		suite.ErrorContains(err, "windows.dns.Resolve: ")
+		suite.ErrorContains(err, "timeout period expired")
+	})
+
+	suite.Run("should compare the answers with the stored records", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ResolveParams{Name: "WEB.test.local.", Server: "192.168.10.10", Zone: "test.local"}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: resolveJson}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, RecordListParams{Zone: "test.local", NamePrefix: "WEB"}.pwshCommand()).
+			Return(connection.CmdResult{StdOut: resolveStoredJson}, nil)
+		actual, err := c.Resolve(ctx, params)
+		suite.NoError(err)
+		suite.Equal([]string{"A 192.168.10.22"}, actual.Missing)
+		suite.Equal([]string{"A 192.168.10.21"}, actual.Unexpected)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		tcs := []struct {
+			description     string
+			inputParameters ResolveParams
+			expectedErr     string
+		}{
+			{
+				"assert error with empty name",
+				ResolveParams{Server: "192.168.10.10"},
+				"windows.dns.Resolve: resolve parameter 'Name' must be set",
+			},
+			{
+				"assert error with empty server",
+				ResolveParams{Name: "web.test.local"},
+				"windows.dns.Resolve: resolve parameter 'Server' must be set",
+			},
+			{
+				"assert error with unsupported record type",
+				ResolveParams{Name: "test.local", RecordType: "SOA", Server: "192.168.10.10"},
+				"windows.dns.Resolve: resolve parameter 'RecordType' must be one of A, AAAA, CNAME, PTR, MX, SRV, TXT, NS, got 'SOA'",
+			},
+			{
+				"assert error with name outside of the zone",
+				ResolveParams{Name: "web.example.com", Server: "192.168.10.10", Zone: "test.local"},
+				"windows.dns.Resolve: resolve parameter 'Name' must be in zone 'test.local', got 'web.example.com'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := c.Resolve(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneRelativeName() {
+	suite.T().Parallel()
+
+	suite.Run("should return the name relative to the zone", func() {
+		tcs := []struct {
+			name         string
+			zone         string
+			expectedName string
+			expectedOk   bool
+		}{
+			{"web.test.local", "test.local", "web", true},
+			{"web.dev.test.local.", "test.local.", "web.dev", true},
+			{"TEST.local", "test.local", "@", true},
+			{"webtest.local", "test.local", "", false},
+			{"web.test.local", "", "", false},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s in %s", tc.name, tc.zone)
+			name, ok := zoneRelativeName(tc.name, tc.zone)
+			suite.Equal(tc.expectedName, name)
+			suite.Equal(tc.expectedOk, ok)
+		}
+	})
+}
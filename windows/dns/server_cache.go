@@ -0,0 +1,189 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// Default cache settings of a DNS server.
+// https://learn.microsoft.com/en-us/powershell/module/dnsserver/set-dnsservercache
+const (
+	defaultCacheMaxTTL         time.Duration = 24 * time.Hour
+	defaultCacheMaxNegativeTTL time.Duration = 15 * time.Minute
+	defaultCacheLockingPercent uint32        = 100
+)
+
+// ServerCache represents the cache settings of a DNS server.
+// The cache contains the records that the server resolved recursively.
+type ServerCache struct {
+	MaxTTL                           time.Duration
+	MaxNegativeTTL                   time.Duration
+	MaxKBSize                        uint32
+	LockingPercent                   uint32
+	EnablePollutionProtection        bool
+	StoreEmptyAuthenticationResponse bool
+}
+
+// serverCacheObject contains the unmarshaled json of the powershell cache object.
+type serverCacheObject struct {
+	MaxTTL                           parsing.CimTimeDuration `json:"MaxTTL"`
+	MaxNegativeTtl                   parsing.CimTimeDuration `json:"MaxNegativeTtl"`
+	MaxKBSize                        uint32                  `json:"MaxKBSize"`
+	LockingPercent                   uint32                  `json:"LockingPercent"`
+	EnablePollutionProtection        bool                    `json:"EnablePollutionProtection"`
+	StoreEmptyAuthenticationResponse bool                    `json:"StoreEmptyAuthenticationResponse"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverCacheObject to a ServerCache object.
+func (s *ServerCache) convertOutput(o serverCacheObject) {
+	s.MaxTTL = o.MaxTTL.Duration
+	s.MaxNegativeTTL = o.MaxNegativeTtl.Duration
+	s.MaxKBSize = o.MaxKBSize
+	s.LockingPercent = o.LockingPercent
+	s.EnablePollutionProtection = o.EnablePollutionProtection
+	s.StoreEmptyAuthenticationResponse = o.StoreEmptyAuthenticationResponse
+}
+
+// ServerCacheRead gets the cache settings of the DNS server. It returns a ServerCache object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerCacheRead(ctx context.Context) (ServerCache, error) {
+	var s ServerCache
+	var o serverCacheObject
+
+	// Run command
+	cmd := "Get-DnsServerCache | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerCacheRead: %w", err)
+	}
+
+	// Convert the output to a ServerCache object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ServerCacheUpdateParams represents parameters for the ServerCacheUpdate function.
+// All settings are applied, so the parameters describe the complete cache configuration.
+type ServerCacheUpdateParams struct {
+	// Specifies the maximum time a record is kept in the cache.
+	// If not provided, the default is 1 day.
+	MaxTTL time.Duration
+
+	// Specifies the maximum time a negative response is kept in the cache.
+	// If not provided, the default is 15 minutes.
+	MaxNegativeTTL time.Duration
+
+	// Specifies the maximum size of the cache in kilobytes.
+	// If not provided, the size of the cache is not limited.
+	MaxKBSize uint32
+
+	// Specifies the percentage of the TTL of a cached record during which the record cannot be overwritten.
+	// If not provided, the default is 100 percent.
+	LockingPercent uint32
+
+	// Specifies whether the server ignores records of unrelated domains in referrals.
+	EnablePollutionProtection bool
+
+	// Specifies whether the server caches empty responses of DNSSEC authenticated queries.
+	StoreEmptyAuthenticationResponse bool
+}
+
+// pwshCommand returns the PowerShell command to update the cache settings of the server.
+func (params ServerCacheUpdateParams) pwshCommand() string {
+	// Set defaults if not provided.
+	if params.MaxTTL == 0 {
+		params.MaxTTL = defaultCacheMaxTTL
+	}
+	if params.MaxNegativeTTL == 0 {
+		params.MaxNegativeTTL = defaultCacheMaxNegativeTTL
+	}
+	if params.LockingPercent == 0 {
+		params.LockingPercent = defaultCacheLockingPercent
+	}
+
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerCache -MaxTTL %s", pwshTimeSpan(params.MaxTTL))}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-MaxNegativeTtl %s", pwshTimeSpan(params.MaxNegativeTTL)))
+	cmd = append(cmd, fmt.Sprintf("-MaxKBSize %d", params.MaxKBSize))
+	cmd = append(cmd, fmt.Sprintf("-LockingPercent %d", params.LockingPercent))
+	cmd = append(cmd, fmt.Sprintf("-EnablePollutionProtection $%t", params.EnablePollutionProtection))
+	cmd = append(cmd, fmt.Sprintf("-StoreEmptyAuthenticationResponse $%t", params.StoreEmptyAuthenticationResponse))
+
+	cmd = append(cmd, "-ErrorAction Stop ;Get-DnsServerCache | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// ServerCacheUpdate updates the cache settings of the DNS server. It returns a ServerCache object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerCacheUpdate(ctx context.Context, params ServerCacheUpdateParams) (ServerCache, error) {
+	var s ServerCache
+	var o serverCacheObject
+
+	// Assert parameters
+	if params.MaxTTL < 0 || params.MaxNegativeTTL < 0 {
+		return s, errors.New("windows.dns.ServerCacheUpdate: cache parameters 'MaxTTL' and 'MaxNegativeTTL' must not be negative")
+	}
+	if exceedsTimeSpan(params.MaxTTL, params.MaxNegativeTTL) {
+		return s, fmt.Errorf("windows.dns.ServerCacheUpdate: cache parameters 'MaxTTL' and 'MaxNegativeTTL' must not exceed %s", maxTimeSpan)
+	}
+	if params.LockingPercent > 100 {
+		return s, fmt.Errorf("windows.dns.ServerCacheUpdate: cache parameter 'LockingPercent' must not exceed 100, got %d", params.LockingPercent)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerCacheUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerCache object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ServerCacheRecordList lists the records in the cache of the DNS server.
+// It returns the records of all supported record types like the RecordList function, other record types are skipped.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerCacheRecordList(ctx context.Context) (Records, error) {
+	var r Records
+	var o []recordObject
+
+	// Run command
+	cmd := "Show-DnsServerCache | ForEach-Object{ConvertTo-Json $_ -Compress}"
+	if err := runRecordLines(ctx, c, cmd, func(record recordObject) error {
+		o = append(o, record)
+		return nil
+	}); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.ServerCacheRecordList: %w", err)
+	}
+
+	// Convert the output to a Records object.
+	if err := r.convertOutput(groupRecords(o)); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.ServerCacheRecordList: failed to convert output to Records object: %w", err)
+	}
+
+	return r, nil
+}
+
+// ServerCacheClear removes all records from the cache of the DNS server.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerCacheClear(ctx context.Context) error {
+	var o serverCacheObject
+
+	// Run command
+	cmd := "Clear-DnsServerCache -Force"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ServerCacheClear: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverCacheJson           = `{"EnablePollutionProtection":true,"IgnorePolicies":false,"LockingPercent":100,"MaxKBSize":0,"MaxNegativeTtl":{"Ticks":9000000000,"Days":0,"Hours":0,"Milliseconds":0,"Minutes":15,"Seconds":0,"TotalDays":0.010416666666666666,"TotalHours":0.25,"TotalMilliseconds":900000,"TotalMinutes":15,"TotalSeconds":900},"MaxTTL":{"Ticks":864000000000,"Days":1,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":1,"TotalHours":24,"TotalMilliseconds":86400000,"TotalMinutes":1440,"TotalSeconds":86400},"StoreEmptyAuthenticationResponse":true,"ZoneName":"..Cache","PSComputerName":null}`
+	serverCacheRecordListJson = `{"DistinguishedName":"DC=www,DC=example.com,cn=MicrosoftDNS,DC=..Cache","HostName":"www.example.com","RecordType":"A","Timestamp":null,"TimeToLive":{"Ticks":3000000000},"RecordData":{"CimInstanceProperties":"IPv4Address = \"93.184.216.34\"","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":1}
+`
+)
+
+// Test ServerCacheRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerCacheRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct cache settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerCache | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverCacheJson}, nil)
+		actual, err := c.ServerCacheRead(ctx)
+		suite.NoError(err)
+		suite.Equal(ServerCache{
+			MaxTTL:                           24 * time.Hour,
+			MaxNegativeTTL:                   15 * time.Minute,
+			LockingPercent:                   100,
+			EnablePollutionProtection:        true,
+			StoreEmptyAuthenticationResponse: true,
+		}, actual)
+	})
+}
+
+// Test ServerCacheUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestServerCacheUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerCacheUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with defaults",
+				ServerCacheUpdateParams{EnablePollutionProtection: true},
+				"Set-DnsServerCache -MaxTTL $(New-TimeSpan -Seconds 86400) -MaxNegativeTtl $(New-TimeSpan -Seconds 900) -MaxKBSize 0 -LockingPercent 100 -EnablePollutionProtection $true -StoreEmptyAuthenticationResponse $false -ErrorAction Stop ;Get-DnsServerCache | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with all parameters",
+				ServerCacheUpdateParams{MaxTTL: time.Hour, MaxNegativeTTL: time.Minute, MaxKBSize: 10240, LockingPercent: 50, StoreEmptyAuthenticationResponse: true},
+				"Set-DnsServerCache -MaxTTL $(New-TimeSpan -Seconds 3600) -MaxNegativeTtl $(New-TimeSpan -Seconds 60) -MaxKBSize 10240 -LockingPercent 50 -EnablePollutionProtection $false -StoreEmptyAuthenticationResponse $true -ErrorAction Stop ;Get-DnsServerCache | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestServerCacheUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		tcs := []struct {
+			description     string
+			inputParameters ServerCacheUpdateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with a negative TTL",
+				ServerCacheUpdateParams{MaxNegativeTTL: -time.Minute},
+				"windows.dns.ServerCacheUpdate: cache parameters 'MaxTTL' and 'MaxNegativeTTL' must not be negative",
+			},
+			{
+				"assert error with a TTL that exceeds the maximum time span",
+				ServerCacheUpdateParams{MaxTTL: maxTimeSpan + time.Second},
+				"windows.dns.ServerCacheUpdate: cache parameters 'MaxTTL' and 'MaxNegativeTTL' must not exceed 596523h14m7s",
+			},
+			{
+				"assert error with a locking percent above 100",
+				ServerCacheUpdateParams{LockingPercent: 101},
+				"windows.dns.ServerCacheUpdate: cache parameter 'LockingPercent' must not exceed 100, got 101",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := c.ServerCacheUpdate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ServerCacheRecordList related methods.
+func (suite *DnsServerUnitTestSuite) TestServerCacheRecordList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the cached records", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Show-DnsServerCache | ForEach-Object{ConvertTo-Json $_ -Compress}").
+			Return(connection.CmdResult{StdOut: serverCacheRecordListJson}, nil)
+		actual, err := c.ServerCacheRecordList(ctx)
+		suite.NoError(err)
+		suite.Len(actual.A, 1)
+		suite.Equal("www.example.com", actual.A[0].Name)
+		suite.Equal([]netip.Addr{netip.MustParseAddr("93.184.216.34")}, actual.A[0].Addresses)
+	})
+}
+
+// Test ServerCacheClear related methods.
+func (suite *DnsServerUnitTestSuite) TestServerCacheClear() {
+	suite.T().Parallel()
+
+	suite.Run("should clear the cache", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Clear-DnsServerCache -Force").
+			Return(connection.CmdResult{}, nil)
+		err := c.ServerCacheClear(ctx)
+		suite.NoError(err)
+	})
+}
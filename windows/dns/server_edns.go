@@ -0,0 +1,113 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// defaultEDnsCacheTimeout is the default time a DNS server remembers whether a remote server supports EDNS.
+const defaultEDnsCacheTimeout time.Duration = 15 * time.Minute
+
+// ServerEDns represents the extension mechanisms for DNS (EDNS) settings of a DNS server.
+// https://www.rfc-editor.org/rfc/rfc6891
+type ServerEDns struct {
+	CacheTimeout    time.Duration
+	EnableProbes    bool
+	EnableReception bool
+}
+
+// serverEDnsObject contains the unmarshaled json of the powershell EDNS object.
+type serverEDnsObject struct {
+	CacheTimeout    parsing.CimTimeDuration `json:"CacheTimeout"`
+	EnableProbes    bool                    `json:"EnableProbes"`
+	EnableReception bool                    `json:"EnableReception"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverEDnsObject to a ServerEDns object.
+func (e *ServerEDns) convertOutput(o serverEDnsObject) {
+	e.CacheTimeout = o.CacheTimeout.Duration
+	e.EnableProbes = o.EnableProbes
+	e.EnableReception = o.EnableReception
+}
+
+// ServerEDnsRead gets the EDNS settings of the DNS server. It returns a ServerEDns object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerEDnsRead(ctx context.Context) (ServerEDns, error) {
+	var e ServerEDns
+	var o serverEDnsObject
+
+	// Run command
+	cmd := "Get-DnsServerEDns | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return e, winerror.Errorf(cmd, "windows.dns.ServerEDnsRead: %w", err)
+	}
+
+	// Convert the output to a ServerEDns object.
+	e.convertOutput(o)
+
+	return e, nil
+}
+
+// ServerEDnsUpdateParams represents parameters for the ServerEDnsUpdate function.
+// All settings are applied, so the parameters describe the complete EDNS configuration.
+type ServerEDnsUpdateParams struct {
+	// Specifies the time the server remembers whether a remote server supports EDNS.
+	// If not provided, the default is 15 minutes.
+	CacheTimeout time.Duration
+
+	// Specifies whether the server sends queries with EDNS to remote servers.
+	EnableProbes bool
+
+	// Specifies whether the server accepts queries with EDNS.
+	EnableReception bool
+}
+
+// pwshCommand returns the PowerShell command to update the EDNS settings of the server.
+func (params ServerEDnsUpdateParams) pwshCommand() string {
+	// Set default cache timeout if not provided.
+	if params.CacheTimeout == 0 {
+		params.CacheTimeout = defaultEDnsCacheTimeout
+	}
+
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerEDns -CacheTimeout %s", pwshTimeSpan(params.CacheTimeout))}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-EnableProbes $%t", params.EnableProbes))
+	cmd = append(cmd, fmt.Sprintf("-EnableReception $%t", params.EnableReception))
+
+	cmd = append(cmd, "-ErrorAction Stop ;Get-DnsServerEDns | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// ServerEDnsUpdate updates the EDNS settings of the DNS server. It returns a ServerEDns object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerEDnsUpdate(ctx context.Context, params ServerEDnsUpdateParams) (ServerEDns, error) {
+	var e ServerEDns
+	var o serverEDnsObject
+
+	// Assert parameters
+	if params.CacheTimeout < 0 {
+		return e, errors.New("windows.dns.ServerEDnsUpdate: EDNS parameter 'CacheTimeout' must not be negative")
+	}
+	if exceedsTimeSpan(params.CacheTimeout) {
+		return e, fmt.Errorf("windows.dns.ServerEDnsUpdate: EDNS parameter 'CacheTimeout' must not exceed %s", maxTimeSpan)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return e, winerror.Errorf(cmd, "windows.dns.ServerEDnsUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerEDns object.
+	e.convertOutput(o)
+
+	return e, nil
+}
@@ -0,0 +1,101 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverEDnsJson = `{"CacheTimeout":{"Ticks":9000000000,"Days":0,"Hours":0,"Milliseconds":0,"Minutes":15,"Seconds":0,"TotalDays":0.010416666666666666,"TotalHours":0.25,"TotalMilliseconds":900000,"TotalMinutes":15,"TotalSeconds":900},"EnableProbes":true,"EnableReception":true,"PSComputerName":null}`
+)
+
+// Test ServerEDnsRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerEDnsRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct EDNS settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerEDns | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverEDnsJson}, nil)
+		actual, err := c.ServerEDnsRead(ctx)
+		suite.NoError(err)
+		suite.Equal(ServerEDns{CacheTimeout: 15 * time.Minute, EnableProbes: true, EnableReception: true}, actual)
+	})
+}
+
+// Test ServerEDnsUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestServerEDnsUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerEDnsUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with default cache timeout",
+				ServerEDnsUpdateParams{EnableReception: true},
+				"Set-DnsServerEDns -CacheTimeout $(New-TimeSpan -Seconds 900) -EnableProbes $false -EnableReception $true -ErrorAction Stop ;Get-DnsServerEDns | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with all parameters",
+				ServerEDnsUpdateParams{CacheTimeout: time.Hour, EnableProbes: true, EnableReception: true},
+				"Set-DnsServerEDns -CacheTimeout $(New-TimeSpan -Seconds 3600) -EnableProbes $true -EnableReception $true -ErrorAction Stop ;Get-DnsServerEDns | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestServerEDnsUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		tcs := []struct {
+			description     string
+			inputParameters ServerEDnsUpdateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with a negative cache timeout",
+				ServerEDnsUpdateParams{CacheTimeout: -time.Minute},
+				"windows.dns.ServerEDnsUpdate: EDNS parameter 'CacheTimeout' must not be negative",
+			},
+			{
+				"assert error with a cache timeout that exceeds the maximum time span",
+				ServerEDnsUpdateParams{CacheTimeout: maxTimeSpan + time.Second},
+				"windows.dns.ServerEDnsUpdate: EDNS parameter 'CacheTimeout' must not exceed 596523h14m7s",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := c.ServerEDnsUpdate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
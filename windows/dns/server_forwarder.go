@@ -0,0 +1,128 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// defaultServerForwarderTimeout is the default time a DNS server waits for a forwarder to resolve a query.
+const defaultServerForwarderTimeout time.Duration = 3 * time.Second
+
+// ServerForwarder represents the global forwarders of a DNS server.
+// Queries that the server cannot resolve from its zones are forwarded to these servers.
+type ServerForwarder struct {
+	IPAddresses      []netip.Addr
+	UseRootHint      bool
+	Timeout          time.Duration
+	EnableReordering bool
+}
+
+// serverForwarderObject contains the unmarshaled json of the powershell forwarder object.
+type serverForwarderObject struct {
+	IPAddress        parsing.CimIpAddressList `json:"IPAddress"`
+	UseRootHint      bool                     `json:"UseRootHint"`
+	Timeout          uint32                   `json:"Timeout"`
+	EnableReordering bool                     `json:"EnableReordering"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverForwarderObject to a ServerForwarder object.
+func (f *ServerForwarder) convertOutput(o serverForwarderObject) {
+	f.IPAddresses = o.IPAddress
+	f.UseRootHint = o.UseRootHint
+	f.Timeout = time.Duration(o.Timeout) * time.Second
+	f.EnableReordering = o.EnableReordering
+}
+
+// ServerForwarderRead gets the global forwarders of the DNS server. It returns a ServerForwarder object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerForwarderRead(ctx context.Context) (ServerForwarder, error) {
+	var f ServerForwarder
+	var o serverForwarderObject
+
+	// Run command
+	cmd := "Get-DnsServerForwarder | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ServerForwarderRead: %w", err)
+	}
+
+	// Convert the output to a ServerForwarder object.
+	f.convertOutput(o)
+
+	return f, nil
+}
+
+// ServerForwarderUpdateParams represents parameters for the ServerForwarderUpdate function.
+// All settings are applied, so the parameters describe the complete forwarder configuration.
+type ServerForwarderUpdateParams struct {
+	// Specifies the IP addresses of the forwarders in the order they are queried.
+	// If not provided, all forwarders are removed.
+	IPAddresses []netip.Addr
+
+	// Specifies whether the server falls back to the root hints if the forwarders cannot resolve a query.
+	UseRootHint bool
+
+	// Specifies the time the server waits for a forwarder to resolve a query.
+	// The timeout is rounded to seconds and must not exceed 15 seconds.
+	// If not provided, the default is 3 seconds.
+	Timeout time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update the global forwarders.
+func (params ServerForwarderUpdateParams) pwshCommand() string {
+	// Set default timeout if not provided.
+	if params.Timeout == 0 {
+		params.Timeout = defaultServerForwarderTimeout
+	}
+
+	cmd := []string{}
+
+	// Set-DnsServerForwarder replaces the forwarders, but does not accept an empty list.
+	// So the forwarders are removed explicitly if no forwarders are provided.
+	if len(params.IPAddresses) == 0 {
+		cmd = append(cmd, "$f=Get-DnsServerForwarder ;if($f.IPAddress){Remove-DnsServerForwarder -IPAddress $f.IPAddress -Force -ErrorAction Stop} ;Set-DnsServerForwarder")
+	} else {
+		cmd = append(cmd, fmt.Sprintf("Set-DnsServerForwarder -IPAddress %s", pwshIPAddressList(params.IPAddresses)))
+	}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-UseRootHint $%t", params.UseRootHint))
+	cmd = append(cmd, fmt.Sprintf("-Timeout %d", int64(params.Timeout.Round(time.Second).Seconds())))
+
+	cmd = append(cmd, "-ErrorAction Stop ;Get-DnsServerForwarder | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// ServerForwarderUpdate updates the global forwarders of the DNS server. It returns a ServerForwarder object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerForwarderUpdate(ctx context.Context, params ServerForwarderUpdateParams) (ServerForwarder, error) {
+	var f ServerForwarder
+	var o serverForwarderObject
+
+	// Assert parameters
+	for _, address := range params.IPAddresses {
+		if !address.IsValid() {
+			return f, errors.New("windows.dns.ServerForwarderUpdate: forwarder parameter 'IPAddresses' must be a list of valid IP addresses")
+		}
+	}
+	if params.Timeout < 0 || params.Timeout > maxForwarderTimeout {
+		return f, fmt.Errorf("windows.dns.ServerForwarderUpdate: forwarder parameter 'Timeout' must not be negative or exceed %s, got %s", maxForwarderTimeout, params.Timeout)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return f, winerror.Errorf(cmd, "windows.dns.ServerForwarderUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerForwarder object.
+	f.convertOutput(o)
+
+	return f, nil
+}
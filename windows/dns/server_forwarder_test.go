@@ -0,0 +1,125 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverForwarderJson = `{"EnableReordering":true,"IPAddress":[{"Address":134744072,"AddressFamily":2,"IPAddressToString":"8.8.8.8"},{"Address":16843009,"AddressFamily":2,"IPAddressToString":"1.1.1.1"}],"ReorderedIPAddress":[{"Address":134744072,"AddressFamily":2,"IPAddressToString":"8.8.8.8"},{"Address":16843009,"AddressFamily":2,"IPAddressToString":"1.1.1.1"}],"Timeout":3,"UseRootHint":true,"PSComputerName":null}`
+)
+
+var expectedServerForwarder = ServerForwarder{
+	IPAddresses:      []netip.Addr{netip.MustParseAddr("8.8.8.8"), netip.MustParseAddr("1.1.1.1")},
+	UseRootHint:      true,
+	Timeout:          3 * time.Second,
+	EnableReordering: true,
+}
+
+// Test ServerForwarderRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerForwarderRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct forwarders", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerForwarder | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverForwarderJson}, nil)
+		actual, err := c.ServerForwarderRead(ctx)
+		suite.NoError(err)
+		suite.Equal(expectedServerForwarder, actual)
+	})
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerForwarder | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.ServerForwarderRead(ctx)
+		suite.EqualError(err, "windows.dns.ServerForwarderRead: test-error")
+	})
+}
+
+// Test ServerForwarderUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestServerForwarderUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerForwarderUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with forwarders and default timeout",
+				ServerForwarderUpdateParams{IPAddresses: []netip.Addr{netip.MustParseAddr("8.8.8.8"), netip.MustParseAddr("1.1.1.1")}},
+				"Set-DnsServerForwarder -IPAddress @('8.8.8.8','1.1.1.1') -UseRootHint $false -Timeout 3 -ErrorAction Stop ;Get-DnsServerForwarder | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command without forwarders",
+				ServerForwarderUpdateParams{UseRootHint: true, Timeout: 5 * time.Second},
+				"$f=Get-DnsServerForwarder ;if($f.IPAddress){Remove-DnsServerForwarder -IPAddress $f.IPAddress -Force -ErrorAction Stop} ;Set-DnsServerForwarder -UseRootHint $true -Timeout 5 -ErrorAction Stop ;Get-DnsServerForwarder | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestServerForwarderUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerForwarderUpdateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with an invalid forwarder",
+				ServerForwarderUpdateParams{IPAddresses: []netip.Addr{{}}},
+				"windows.dns.ServerForwarderUpdate: forwarder parameter 'IPAddresses' must be a list of valid IP addresses",
+			},
+			{
+				"assert error with a negative timeout",
+				ServerForwarderUpdateParams{Timeout: -time.Second},
+				"windows.dns.ServerForwarderUpdate: forwarder parameter 'Timeout' must not be negative or exceed 15s, got -1s",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.ServerForwarderUpdate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
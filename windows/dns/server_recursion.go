@@ -0,0 +1,140 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// Default recursion timeouts of a DNS server.
+// https://learn.microsoft.com/en-us/powershell/module/dnsserver/set-dnsserverrecursion
+const (
+	defaultRecursionTimeout           time.Duration = 8 * time.Second
+	defaultRecursionRetryInterval     time.Duration = 3 * time.Second
+	defaultRecursionAdditionalTimeout time.Duration = 4 * time.Second
+)
+
+// ServerRecursion represents the recursion settings of a DNS server.
+// Queries for names outside of the zones of the server are resolved recursively if recursion is enabled.
+type ServerRecursion struct {
+	Enable            bool
+	SecureResponse    bool
+	Timeout           time.Duration
+	RetryInterval     time.Duration
+	AdditionalTimeout time.Duration
+}
+
+// serverRecursionObject contains the unmarshaled json of the powershell recursion object.
+type serverRecursionObject struct {
+	Enable            bool   `json:"Enable"`
+	SecureResponse    bool   `json:"SecureResponse"`
+	Timeout           uint32 `json:"Timeout"`
+	RetryInterval     uint32 `json:"RetryInterval"`
+	AdditionalTimeout uint32 `json:"AdditionalTimeout"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverRecursionObject to a ServerRecursion object.
+func (r *ServerRecursion) convertOutput(o serverRecursionObject) {
+	r.Enable = o.Enable
+	r.SecureResponse = o.SecureResponse
+	r.Timeout = time.Duration(o.Timeout) * time.Second
+	r.RetryInterval = time.Duration(o.RetryInterval) * time.Second
+	r.AdditionalTimeout = time.Duration(o.AdditionalTimeout) * time.Second
+}
+
+// ServerRecursionRead gets the recursion settings of the DNS server. It returns a ServerRecursion object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerRecursionRead(ctx context.Context) (ServerRecursion, error) {
+	var r ServerRecursion
+	var o serverRecursionObject
+
+	// Run command
+	cmd := "Get-DnsServerRecursion | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.ServerRecursionRead: %w", err)
+	}
+
+	// Convert the output to a ServerRecursion object.
+	r.convertOutput(o)
+
+	return r, nil
+}
+
+// ServerRecursionUpdateParams represents parameters for the ServerRecursionUpdate function.
+// All settings are applied, so the parameters describe the complete recursion configuration.
+type ServerRecursionUpdateParams struct {
+	// Specifies whether the server resolves queries recursively.
+	Enable bool
+
+	// Specifies whether the server only caches records of the domain of the queried name server.
+	// This protects the cache against pollution with unrelated records.
+	SecureResponse bool
+
+	// Specifies the time the server waits for a recursive query to be resolved.
+	// The timeout is rounded to seconds.
+	// If not provided, the default is 8 seconds.
+	Timeout time.Duration
+
+	// Specifies the time the server waits before it retries a recursive query.
+	// The interval is rounded to seconds.
+	// If not provided, the default is 3 seconds.
+	RetryInterval time.Duration
+
+	// Specifies the additional time the server waits for remote servers after a referral.
+	// The timeout is rounded to seconds.
+	// If not provided, the default is 4 seconds.
+	AdditionalTimeout time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update the recursion settings of the server.
+func (params ServerRecursionUpdateParams) pwshCommand() string {
+	// Set defaults if not provided.
+	if params.Timeout == 0 {
+		params.Timeout = defaultRecursionTimeout
+	}
+	if params.RetryInterval == 0 {
+		params.RetryInterval = defaultRecursionRetryInterval
+	}
+	if params.AdditionalTimeout == 0 {
+		params.AdditionalTimeout = defaultRecursionAdditionalTimeout
+	}
+
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerRecursion -Enable $%t", params.Enable)}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-SecureResponse $%t", params.SecureResponse))
+	cmd = append(cmd, fmt.Sprintf("-Timeout %d", int64(params.Timeout.Round(time.Second).Seconds())))
+	cmd = append(cmd, fmt.Sprintf("-RetryInterval %d", int64(params.RetryInterval.Round(time.Second).Seconds())))
+	cmd = append(cmd, fmt.Sprintf("-AdditionalTimeout %d", int64(params.AdditionalTimeout.Round(time.Second).Seconds())))
+
+	cmd = append(cmd, "-ErrorAction Stop ;Get-DnsServerRecursion | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// ServerRecursionUpdate updates the recursion settings of the DNS server. It returns a ServerRecursion object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerRecursionUpdate(ctx context.Context, params ServerRecursionUpdateParams) (ServerRecursion, error) {
+	var r ServerRecursion
+	var o serverRecursionObject
+
+	// Assert parameters
+	if params.Timeout < 0 || params.RetryInterval < 0 || params.AdditionalTimeout < 0 {
+		return r, errors.New("windows.dns.ServerRecursionUpdate: recursion parameters 'Timeout', 'RetryInterval' and 'AdditionalTimeout' must not be negative")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return r, winerror.Errorf(cmd, "windows.dns.ServerRecursionUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerRecursion object.
+	r.convertOutput(o)
+
+	return r, nil
+}
@@ -0,0 +1,87 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverRecursionJson = `{"AdditionalTimeout":4,"Enable":true,"RetryInterval":3,"SecureResponse":true,"Timeout":8,"PSComputerName":null}`
+)
+
+// Test ServerRecursionRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerRecursionRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct recursion settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerRecursion | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverRecursionJson}, nil)
+		actual, err := c.ServerRecursionRead(ctx)
+		suite.NoError(err)
+		suite.Equal(ServerRecursion{
+			Enable:            true,
+			SecureResponse:    true,
+			Timeout:           8 * time.Second,
+			RetryInterval:     3 * time.Second,
+			AdditionalTimeout: 4 * time.Second,
+		}, actual)
+	})
+}
+
+// Test ServerRecursionUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestServerRecursionUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerRecursionUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with defaults",
+				ServerRecursionUpdateParams{},
+				"Set-DnsServerRecursion -Enable $false -SecureResponse $false -Timeout 8 -RetryInterval 3 -AdditionalTimeout 4 -ErrorAction Stop ;Get-DnsServerRecursion | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with all parameters",
+				ServerRecursionUpdateParams{Enable: true, SecureResponse: true, Timeout: 10 * time.Second, RetryInterval: 2 * time.Second, AdditionalTimeout: 1500 * time.Millisecond},
+				"Set-DnsServerRecursion -Enable $true -SecureResponse $true -Timeout 10 -RetryInterval 2 -AdditionalTimeout 2 -ErrorAction Stop ;Get-DnsServerRecursion | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestServerRecursionUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return error with a negative timeout", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ServerRecursionUpdate(ctx, ServerRecursionUpdateParams{Timeout: -time.Second})
+		suite.EqualError(err, "windows.dns.ServerRecursionUpdate: recursion parameters 'Timeout', 'RetryInterval' and 'AdditionalTimeout' must not be negative")
+	})
+}
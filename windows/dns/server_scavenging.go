@@ -0,0 +1,134 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ServerScavenging represents the scavenging settings of a DNS server.
+// The intervals are the defaults of new zones, the scavenging interval defines how often stale records are removed.
+type ServerScavenging struct {
+	ScavengingState    bool
+	ScavengingInterval time.Duration
+	NoRefreshInterval  time.Duration
+	RefreshInterval    time.Duration
+	LastScavengeTime   time.Time
+}
+
+// serverScavengingObject contains the unmarshaled json of the powershell scavenging object.
+type serverScavengingObject struct {
+	ScavengingState    bool                    `json:"ScavengingState"`
+	ScavengingInterval parsing.CimTimeDuration `json:"ScavengingInterval"`
+	NoRefreshInterval  parsing.CimTimeDuration `json:"NoRefreshInterval"`
+	RefreshInterval    parsing.CimTimeDuration `json:"RefreshInterval"`
+	LastScavengeTime   parsing.DotnetTime      `json:"LastScavengeTime"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverScavengingObject to a ServerScavenging object.
+func (s *ServerScavenging) convertOutput(o serverScavengingObject) {
+	s.ScavengingState = o.ScavengingState
+	s.ScavengingInterval = o.ScavengingInterval.Duration
+	s.NoRefreshInterval = o.NoRefreshInterval.Duration
+	s.RefreshInterval = o.RefreshInterval.Duration
+	s.LastScavengeTime = o.LastScavengeTime.Time
+}
+
+// ServerScavengingRead gets the scavenging settings of the DNS server. It returns a ServerScavenging object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerScavengingRead(ctx context.Context) (ServerScavenging, error) {
+	var s ServerScavenging
+	var o serverScavengingObject
+
+	// Run command
+	cmd := "Get-DnsServerScavenging | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerScavengingRead: %w", err)
+	}
+
+	// Convert the output to a ServerScavenging object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ServerScavengingUpdateParams represents parameters for the ServerScavengingUpdate function.
+// The scavenging state is always set, the intervals that are not set keep their current value.
+type ServerScavengingUpdateParams struct {
+	// Specifies whether the server scavenges stale records automatically.
+	ScavengingState bool
+
+	// Specifies how often the server scavenges stale records.
+	ScavengingInterval time.Duration
+
+	// Specifies the default no-refresh interval of new zones.
+	NoRefreshInterval time.Duration
+
+	// Specifies the default refresh interval of new zones.
+	RefreshInterval time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update the scavenging settings of the server.
+func (params ServerScavengingUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerScavenging -ScavengingState $%t", params.ScavengingState)}
+
+	// Add parameters
+	if params.ScavengingInterval != 0 {
+		cmd = append(cmd, fmt.Sprintf("-ScavengingInterval %s", pwshTimeSpan(params.ScavengingInterval)))
+	}
+	if params.NoRefreshInterval != 0 {
+		cmd = append(cmd, fmt.Sprintf("-NoRefreshInterval %s", pwshTimeSpan(params.NoRefreshInterval)))
+	}
+	if params.RefreshInterval != 0 {
+		cmd = append(cmd, fmt.Sprintf("-RefreshInterval %s", pwshTimeSpan(params.RefreshInterval)))
+	}
+
+	cmd = append(cmd, "-ErrorAction Stop ;Get-DnsServerScavenging | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// ServerScavengingUpdate updates the scavenging settings of the DNS server. It returns a ServerScavenging object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerScavengingUpdate(ctx context.Context, params ServerScavengingUpdateParams) (ServerScavenging, error) {
+	var s ServerScavenging
+	var o serverScavengingObject
+
+	// Assert needed parameters
+	if params.ScavengingInterval < 0 || params.NoRefreshInterval < 0 || params.RefreshInterval < 0 {
+		return s, errors.New("windows.dns.ServerScavengingUpdate: scavenging parameters 'ScavengingInterval', 'NoRefreshInterval' and 'RefreshInterval' must not be negative")
+	}
+	if exceedsTimeSpan(params.ScavengingInterval, params.NoRefreshInterval, params.RefreshInterval) {
+		return s, fmt.Errorf("windows.dns.ServerScavengingUpdate: scavenging parameters 'ScavengingInterval', 'NoRefreshInterval' and 'RefreshInterval' must not exceed %s", maxTimeSpan)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerScavengingUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerScavenging object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ServerScavengingStart starts the scavenging of stale records in all zones with aging immediately.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerScavengingStart(ctx context.Context) error {
+	var o serverScavengingObject
+
+	// Run command
+	cmd := "Start-DnsServerScavenging -Force"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ServerScavengingStart: %w", err)
+	}
+
+	return nil
+}
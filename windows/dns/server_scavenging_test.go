@@ -0,0 +1,137 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverScavengingJson = `{"LastScavengeTime":"\/Date(1704067200000)\/","NoRefreshInterval":{"Ticks":6048000000000,"Days":7,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":7,"TotalHours":168,"TotalMilliseconds":604800000,"TotalMinutes":10080,"TotalSeconds":604800},"RefreshInterval":{"Ticks":6048000000000,"Days":7,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":7,"TotalHours":168,"TotalMilliseconds":604800000,"TotalMinutes":10080,"TotalSeconds":604800},"ScavengingInterval":{"Ticks":6048000000000,"Days":7,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":7,"TotalHours":168,"TotalMilliseconds":604800000,"TotalMinutes":10080,"TotalSeconds":604800},"ScavengingState":true,"PSComputerName":null}`
+)
+
+var expectedServerScavenging = ServerScavenging{
+	ScavengingState:    true,
+	ScavengingInterval: 7 * 24 * time.Hour,
+	NoRefreshInterval:  7 * 24 * time.Hour,
+	RefreshInterval:    7 * 24 * time.Hour,
+	LastScavengeTime:   time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+}
+
+// Test ServerScavengingRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerScavengingRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct scavenging settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerScavenging | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverScavengingJson}, nil)
+		actual, err := c.ServerScavengingRead(ctx)
+		suite.NoError(err)
+		suite.Equal(expectedServerScavenging, actual)
+	})
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerScavenging | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.ServerScavengingRead(ctx)
+		suite.EqualError(err, "windows.dns.ServerScavengingRead: test-error")
+	})
+}
+
+// Test ServerScavengingUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestServerScavengingUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerScavengingUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with scavenging state only",
+				ServerScavengingUpdateParams{},
+				"Set-DnsServerScavenging -ScavengingState $false -ErrorAction Stop ;Get-DnsServerScavenging | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with all parameters",
+				ServerScavengingUpdateParams{ScavengingState: true, ScavengingInterval: 24 * time.Hour, NoRefreshInterval: 48 * time.Hour, RefreshInterval: 72 * time.Hour},
+				"Set-DnsServerScavenging -ScavengingState $true -ScavengingInterval $(New-TimeSpan -Seconds 86400) -NoRefreshInterval $(New-TimeSpan -Seconds 172800) -RefreshInterval $(New-TimeSpan -Seconds 259200) -ErrorAction Stop ;Get-DnsServerScavenging | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestServerScavengingUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return error with a negative interval", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ServerScavengingUpdate(ctx, ServerScavengingUpdateParams{ScavengingInterval: -time.Hour})
+		suite.EqualError(err, "windows.dns.ServerScavengingUpdate: scavenging parameters 'ScavengingInterval', 'NoRefreshInterval' and 'RefreshInterval' must not be negative")
+	})
+
+	suite.Run("should return error with an interval that exceeds the maximum time span", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ServerScavengingUpdate(ctx, ServerScavengingUpdateParams{RefreshInterval: 100 * 365 * 24 * time.Hour})
+		suite.EqualError(err, "windows.dns.ServerScavengingUpdate: scavenging parameters 'ScavengingInterval', 'NoRefreshInterval' and 'RefreshInterval' must not exceed 596523h14m7s")
+	})
+}
+
+// Test ServerScavengingStart related methods.
+func (suite *DnsServerUnitTestSuite) TestServerScavengingStart() {
+	suite.T().Parallel()
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Start-DnsServerScavenging -Force").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		err := c.ServerScavengingStart(ctx)
+		suite.EqualError(err, "windows.dns.ServerScavengingStart: test-error")
+	})
+}
@@ -0,0 +1,114 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ServerSetting represents the general settings of a DNS server.
+// The server listens for DNS requests on the listening IP addresses, which are a subset of all IP addresses of the server.
+type ServerSetting struct {
+	ListeningIPAddresses []netip.Addr
+	AllIPAddresses       []netip.Addr
+	RoundRobin           bool
+	LocalNetPriority     bool
+}
+
+// serverSettingObject contains the unmarshaled json of the powershell server setting object.
+type serverSettingObject struct {
+	ListeningIPAddress parsing.CimIpAddressList `json:"ListeningIPAddress"`
+	AllIPAddress       parsing.CimIpAddressList `json:"AllIPAddress"`
+	RoundRobin         bool                     `json:"RoundRobin"`
+	LocalNetPriority   bool                     `json:"LocalNetPriority"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverSettingObject to a ServerSetting object.
+func (s *ServerSetting) convertOutput(o serverSettingObject) {
+	s.ListeningIPAddresses = o.ListeningIPAddress
+	s.AllIPAddresses = o.AllIPAddress
+	s.RoundRobin = o.RoundRobin
+	s.LocalNetPriority = o.LocalNetPriority
+}
+
+// ServerSettingRead gets the general settings of the DNS server. It returns a ServerSetting object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerSettingRead(ctx context.Context) (ServerSetting, error) {
+	var s ServerSetting
+	var o serverSettingObject
+
+	// Run command
+	cmd := "Get-DnsServerSetting -All | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerSettingRead: %w", err)
+	}
+
+	// Convert the output to a ServerSetting object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ServerSettingUpdateParams represents parameters for the ServerSettingUpdate function.
+// All settings are applied, so the parameters describe the complete general settings.
+type ServerSettingUpdateParams struct {
+	// Specifies the IP addresses on which the server listens for DNS requests.
+	// The addresses must be IP addresses of the server.
+	// If not provided, the server listens on all IP addresses.
+	ListeningIPAddresses []netip.Addr
+
+	// Specifies whether the server rotates the order of the records of a response.
+	RoundRobin bool
+
+	// Specifies whether the server orders the records of a response by the subnet of the client.
+	LocalNetPriority bool
+}
+
+// pwshCommand returns the PowerShell command to update the general settings of the server.
+// Set-DnsServerSetting only accepts a complete settings object, so the current settings are modified.
+func (params ServerSettingUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{"$s=Get-DnsServerSetting -All"}
+
+	// Add parameters
+	if len(params.ListeningIPAddresses) == 0 {
+		cmd = append(cmd, "$s.ListeningIPAddress=$s.AllIPAddress")
+	} else {
+		cmd = append(cmd, fmt.Sprintf("$s.ListeningIPAddress=%s", pwshIPAddressList(params.ListeningIPAddresses)))
+	}
+	cmd = append(cmd, fmt.Sprintf("$s.RoundRobin=$%t", params.RoundRobin))
+	cmd = append(cmd, fmt.Sprintf("$s.LocalNetPriority=$%t", params.LocalNetPriority))
+
+	cmd = append(cmd, "Set-DnsServerSetting -InputObject $s -ErrorAction Stop", "Get-DnsServerSetting -All | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ;")
+}
+
+// ServerSettingUpdate updates the general settings of the DNS server. It returns a ServerSetting object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerSettingUpdate(ctx context.Context, params ServerSettingUpdateParams) (ServerSetting, error) {
+	var s ServerSetting
+	var o serverSettingObject
+
+	// Assert parameters
+	for _, address := range params.ListeningIPAddresses {
+		if !address.IsValid() {
+			return s, errors.New("windows.dns.ServerSettingUpdate: server setting parameter 'ListeningIPAddresses' must be a list of valid IP addresses")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerSettingUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerSetting object.
+	s.convertOutput(o)
+
+	return s, nil
+}
@@ -0,0 +1,88 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverSettingJson = `{"ListeningIPAddress":[{"Address":17475776,"AddressFamily":2,"IPAddressToString":"192.168.10.1"}],"AllIPAddress":[{"Address":17475776,"AddressFamily":2,"IPAddressToString":"192.168.10.1"},{"AddressFamily":23,"IPAddressToString":"fd00::1"}],"RoundRobin":true,"LocalNetPriority":true,"BindSecondaries":false,"PSComputerName":null}`
+)
+
+var expectedServerSetting = ServerSetting{
+	ListeningIPAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1")},
+	AllIPAddresses:       []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("fd00::1")},
+	RoundRobin:           true,
+	LocalNetPriority:     true,
+}
+
+// Test ServerSettingRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerSettingRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct server settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerSetting -All | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverSettingJson}, nil)
+		actual, err := c.ServerSettingRead(ctx)
+		suite.NoError(err)
+		suite.Equal(expectedServerSetting, actual)
+	})
+}
+
+// Test ServerSettingUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestServerSettingUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerSettingUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command without listening addresses",
+				ServerSettingUpdateParams{RoundRobin: true},
+				"$s=Get-DnsServerSetting -All ;$s.ListeningIPAddress=$s.AllIPAddress ;$s.RoundRobin=$true ;$s.LocalNetPriority=$false ;Set-DnsServerSetting -InputObject $s -ErrorAction Stop ;Get-DnsServerSetting -All | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with listening addresses",
+				ServerSettingUpdateParams{ListeningIPAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1")}, LocalNetPriority: true},
+				"$s=Get-DnsServerSetting -All ;$s.ListeningIPAddress=@('192.168.10.1') ;$s.RoundRobin=$false ;$s.LocalNetPriority=$true ;Set-DnsServerSetting -InputObject $s -ErrorAction Stop ;Get-DnsServerSetting -All | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestServerSettingUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return error with an invalid listening address", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ServerSettingUpdate(ctx, ServerSettingUpdateParams{ListeningIPAddresses: []netip.Addr{{}}})
+		suite.EqualError(err, "windows.dns.ServerSettingUpdate: server setting parameter 'ListeningIPAddresses' must be a list of valid IP addresses")
+	})
+}
@@ -0,0 +1,164 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// serverStatisticsProjection selects the counters of the nested statistics objects,
+// so the counters do not exceed the default depth of the JSON conversion.
+const serverStatisticsProjection string = `ForEach-Object{[pscustomobject]@{ServerStartTime=$_.TimeStatistics.ServerStartTime;LastClearTime=$_.TimeStatistics.LastClearTime;TimeElapsedSinceServerStart=$_.TimeStatistics.TimeElapsedSinceServerStart;TimeElapsedSinceLastClear=$_.TimeStatistics.TimeElapsedSinceLastClear;Queries=$_.QueryStatistics | Select-Object UdpQueries,UdpResponses,TcpQueries,TcpResponses;QueryTypes=$_.Query2Statistics | Select-Object TotalQueries,Standard,Notify,Update,TypeA,TypeNs,TypeSoa,TypeMx,TypePtr,TypeSrv,TypeAll,TypeIxfr,TypeAxfr,TypeOther;Errors=$_.ErrorStatistics | Select-Object NoError,FormError,ServFail,NxDomain,NotImpl,Refused,YxDomain,YxRRSet,NxRRSet,NotAuth,NotZone,BadSig,BadKey,BadTime,UnknownError}}`
+
+// ServerStatistics represents the statistics of a DNS server since the start of the server or the last clear of the statistics.
+// The counters only increase, so the rates of a monitoring system are calculated from the difference of two reads.
+type ServerStatistics struct {
+	ServerStartTime             time.Time
+	LastClearTime               time.Time
+	TimeElapsedSinceServerStart time.Duration
+	TimeElapsedSinceLastClear   time.Duration
+	Queries                     ServerQueryStatistics
+	Errors                      ServerErrorStatistics
+}
+
+// ServerQueryStatistics represents the counters of the queries that a DNS server received.
+type ServerQueryStatistics struct {
+	// Number of queries and responses by transport protocol.
+	UdpQueries   uint64
+	UdpResponses uint64
+	TcpQueries   uint64
+	TcpResponses uint64
+
+	// Number of queries by operation code.
+	Total    uint64
+	Standard uint64
+	Notify   uint64
+	Update   uint64
+
+	// Number of standard queries by record type.
+	TypeA     uint64
+	TypeNS    uint64
+	TypeSOA   uint64
+	TypeMX    uint64
+	TypePTR   uint64
+	TypeSRV   uint64
+	TypeAll   uint64
+	TypeIXFR  uint64
+	TypeAXFR  uint64
+	TypeOther uint64
+}
+
+// ServerErrorStatistics represents the counters of the response codes that a DNS server sent.
+// https://www.rfc-editor.org/rfc/rfc6895#section-2.3
+type ServerErrorStatistics struct {
+	NoError      uint64
+	FormError    uint64
+	ServFail     uint64
+	NxDomain     uint64
+	NotImpl      uint64
+	Refused      uint64
+	YxDomain     uint64
+	YxRRSet      uint64
+	NxRRSet      uint64
+	NotAuth      uint64
+	NotZone      uint64
+	BadSig       uint64
+	BadKey       uint64
+	BadTime      uint64
+	UnknownError uint64
+}
+
+// serverStatisticsObject contains the unmarshaled json of the projected powershell statistics object.
+type serverStatisticsObject struct {
+	ServerStartTime             parsing.DotnetTime      `json:"ServerStartTime"`
+	LastClearTime               parsing.DotnetTime      `json:"LastClearTime"`
+	TimeElapsedSinceServerStart parsing.CimTimeDuration `json:"TimeElapsedSinceServerStart"`
+	TimeElapsedSinceLastClear   parsing.CimTimeDuration `json:"TimeElapsedSinceLastClear"`
+	Queries                     struct {
+		UdpQueries   uint64 `json:"UdpQueries"`
+		UdpResponses uint64 `json:"UdpResponses"`
+		TcpQueries   uint64 `json:"TcpQueries"`
+		TcpResponses uint64 `json:"TcpResponses"`
+	} `json:"Queries"`
+	QueryTypes struct {
+		TotalQueries uint64 `json:"TotalQueries"`
+		Standard     uint64 `json:"Standard"`
+		Notify       uint64 `json:"Notify"`
+		Update       uint64 `json:"Update"`
+		TypeA        uint64 `json:"TypeA"`
+		TypeNs       uint64 `json:"TypeNs"`
+		TypeSoa      uint64 `json:"TypeSoa"`
+		TypeMx       uint64 `json:"TypeMx"`
+		TypePtr      uint64 `json:"TypePtr"`
+		TypeSrv      uint64 `json:"TypeSrv"`
+		TypeAll      uint64 `json:"TypeAll"`
+		TypeIxfr     uint64 `json:"TypeIxfr"`
+		TypeAxfr     uint64 `json:"TypeAxfr"`
+		TypeOther    uint64 `json:"TypeOther"`
+	} `json:"QueryTypes"`
+	Errors ServerErrorStatistics `json:"Errors"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverStatisticsObject to a ServerStatistics object.
+func (s *ServerStatistics) convertOutput(o serverStatisticsObject) {
+	s.ServerStartTime = o.ServerStartTime.Time
+	s.LastClearTime = o.LastClearTime.Time
+	s.TimeElapsedSinceServerStart = o.TimeElapsedSinceServerStart.Duration
+	s.TimeElapsedSinceLastClear = o.TimeElapsedSinceLastClear.Duration
+	s.Queries = ServerQueryStatistics{
+		UdpQueries:   o.Queries.UdpQueries,
+		UdpResponses: o.Queries.UdpResponses,
+		TcpQueries:   o.Queries.TcpQueries,
+		TcpResponses: o.Queries.TcpResponses,
+		Total:        o.QueryTypes.TotalQueries,
+		Standard:     o.QueryTypes.Standard,
+		Notify:       o.QueryTypes.Notify,
+		Update:       o.QueryTypes.Update,
+		TypeA:        o.QueryTypes.TypeA,
+		TypeNS:       o.QueryTypes.TypeNs,
+		TypeSOA:      o.QueryTypes.TypeSoa,
+		TypeMX:       o.QueryTypes.TypeMx,
+		TypePTR:      o.QueryTypes.TypePtr,
+		TypeSRV:      o.QueryTypes.TypeSrv,
+		TypeAll:      o.QueryTypes.TypeAll,
+		TypeIXFR:     o.QueryTypes.TypeIxfr,
+		TypeAXFR:     o.QueryTypes.TypeAxfr,
+		TypeOther:    o.QueryTypes.TypeOther,
+	}
+	s.Errors = o.Errors
+}
+
+// ServerStatisticsRead gets the statistics of the DNS server. It returns a ServerStatistics object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerStatisticsRead(ctx context.Context) (ServerStatistics, error) {
+	var s ServerStatistics
+	var o serverStatisticsObject
+
+	// Run command
+	cmd := fmt.Sprintf("Get-DnsServerStatistics | %s | ConvertTo-Json -Compress", serverStatisticsProjection)
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ServerStatisticsRead: %w", err)
+	}
+
+	// Convert the output to a ServerStatistics object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ServerStatisticsClear resets the statistics of the DNS server.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerStatisticsClear(ctx context.Context) error {
+	var o serverStatisticsObject
+
+	// Run command
+	cmd := "Clear-DnsServerStatistics -Force"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ServerStatisticsClear: %w", err)
+	}
+
+	return nil
+}
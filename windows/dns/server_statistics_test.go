@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverStatisticsJson = `{"ServerStartTime":"\/Date(1704067200000)\/","LastClearTime":"\/Date(1704067200000)\/","TimeElapsedSinceServerStart":{"Ticks":36000000000,"TotalSeconds":3600},"TimeElapsedSinceLastClear":{"Ticks":36000000000,"TotalSeconds":3600},"Queries":{"UdpQueries":120,"UdpResponses":118,"TcpQueries":5,"TcpResponses":5},"QueryTypes":{"TotalQueries":125,"Standard":123,"Notify":0,"Update":2,"TypeA":80,"TypeNs":2,"TypeSoa":3,"TypeMx":1,"TypePtr":10,"TypeSrv":20,"TypeAll":0,"TypeIxfr":0,"TypeAxfr":1,"TypeOther":6},"Errors":{"NoError":110,"FormError":0,"ServFail":1,"NxDomain":12,"NotImpl":0,"Refused":0,"YxDomain":0,"YxRRSet":0,"NxRRSet":0,"NotAuth":0,"NotZone":0,"BadSig":0,"BadKey":0,"BadTime":0,"UnknownError":0}}`
+)
+
+// Test ServerStatisticsRead related methods.
+func (suite *DnsServerUnitTestSuite) TestServerStatisticsRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct statistics", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerStatistics | "+serverStatisticsProjection+" | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: serverStatisticsJson}, nil)
+		actual, err := c.ServerStatisticsRead(ctx)
+		suite.NoError(err)
+		suite.Equal(ServerStatistics{
+			ServerStartTime:             time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			LastClearTime:               time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			TimeElapsedSinceServerStart: time.Hour,
+			TimeElapsedSinceLastClear:   time.Hour,
+			Queries: ServerQueryStatistics{
+				UdpQueries:   120,
+				UdpResponses: 118,
+				TcpQueries:   5,
+				TcpResponses: 5,
+				Total:        125,
+				Standard:     123,
+				Update:       2,
+				TypeA:        80,
+				TypeNS:       2,
+				TypeSOA:      3,
+				TypeMX:       1,
+				TypePTR:      10,
+				TypeSRV:      20,
+				TypeAXFR:     1,
+				TypeOther:    6,
+			},
+			Errors: ServerErrorStatistics{NoError: 110, ServFail: 1, NxDomain: 12},
+		}, actual)
+	})
+}
+
+// Test ServerStatisticsClear related methods.
+func (suite *DnsServerUnitTestSuite) TestServerStatisticsClear() {
+	suite.T().Parallel()
+
+	suite.Run("should clear the statistics", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Clear-DnsServerStatistics -Force").
+			Return(connection.CmdResult{}, nil)
+		err := c.ServerStatisticsClear(ctx)
+		suite.NoError(err)
+	})
+}
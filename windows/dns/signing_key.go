@@ -0,0 +1,323 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// Key types of the signing keys.
+const (
+	SigningKeyTypeKeySigningKey  string = "KeySigningKey"
+	SigningKeyTypeZoneSigningKey string = "ZoneSigningKey"
+)
+
+// Key lengths of the RSA signing keys.
+// The key length of ECDSA signing keys is defined by their crypto algorithm.
+const (
+	minSigningKeyLength uint32 = 1024
+	maxSigningKeyLength uint32 = 4096
+)
+
+// SigningKey represents a DNSSEC signing key of a zone.
+type SigningKey struct {
+	KeyId                 string
+	KeyType               string
+	CryptoAlgorithm       string
+	KeyLength             uint32
+	KeyStorageProvider    string
+	StoreKeysInAD         bool
+	RolloverPeriod        time.Duration
+	CurrentRolloverStatus string
+	NextRolloverAction    string
+	LastRolloverTime      time.Time
+	NextRolloverTime      time.Time
+}
+
+// signingKeyObject contains the unmarshaled json of the powershell signing key object.
+type signingKeyObject struct {
+	KeyId                 string                  `json:"KeyId"`
+	KeyType               string                  `json:"KeyType"`
+	CryptoAlgorithm       string                  `json:"CryptoAlgorithm"`
+	KeyLength             uint32                  `json:"KeyLength"`
+	KeyStorageProvider    string                  `json:"KeyStorageProvider"`
+	StoreKeysInAD         bool                    `json:"StoreKeysInAD"`
+	RolloverPeriod        parsing.CimTimeDuration `json:"RolloverPeriod"`
+	CurrentRolloverStatus string                  `json:"CurrentRolloverStatus"`
+	NextRolloverAction    string                  `json:"NextRolloverAction"`
+	LastRolloverTime      parsing.DotnetTime      `json:"LastRolloverTime"`
+	NextRolloverTime      parsing.DotnetTime      `json:"NextRolloverTime"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the signingKeyObject to a SigningKey object.
+func (k *SigningKey) convertOutput(o signingKeyObject) {
+	k.KeyId = o.KeyId
+	k.KeyType = o.KeyType
+	k.CryptoAlgorithm = o.CryptoAlgorithm
+	k.KeyLength = o.KeyLength
+	k.KeyStorageProvider = o.KeyStorageProvider
+	k.StoreKeysInAD = o.StoreKeysInAD
+	k.RolloverPeriod = o.RolloverPeriod.Duration
+	k.CurrentRolloverStatus = o.CurrentRolloverStatus
+	k.NextRolloverAction = o.NextRolloverAction
+	k.LastRolloverTime = o.LastRolloverTime.Time
+	k.NextRolloverTime = o.NextRolloverTime.Time
+}
+
+// SigningKeyReadParams represents parameters for the SigningKeyRead function.
+type SigningKeyReadParams struct {
+	// Specifies the zone of the signing key.
+	Zone string
+
+	// Specifies the ID of the signing key.
+	KeyId string
+}
+
+// pwshCommand returns the PowerShell command to read a signing key.
+func (params SigningKeyReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsServerSigningKey -ZoneName '%s' -KeyId '%s' | ConvertTo-Json -Compress", params.Zone, params.KeyId)
+}
+
+// SigningKeyRead gets a signing key of a zone by its ID. It returns a SigningKey object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) SigningKeyRead(ctx context.Context, params SigningKeyReadParams) (SigningKey, error) {
+	var k SigningKey
+	var o signingKeyObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.KeyId == "" {
+		return k, errors.New("windows.dns.SigningKeyRead: signing key parameters 'Zone' and 'KeyId' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return k, winerror.Errorf(cmd, "windows.dns.SigningKeyRead: %w", err)
+	}
+
+	// Convert the output to a SigningKey object.
+	k.convertOutput(o)
+
+	return k, nil
+}
+
+// SigningKeyListParams represents parameters for the SigningKeyList function.
+type SigningKeyListParams struct {
+	// Specifies the zone of the signing keys.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to list the signing keys of a zone.
+func (params SigningKeyListParams) pwshCommand() string {
+	return fmt.Sprintf("$k=@(Get-DnsServerSigningKey -ZoneName '%s') ;if($k.Count -ge 2){ConvertTo-Json $k -Compress}else{ConvertTo-Json @($k) -Compress}", params.Zone)
+}
+
+// SigningKeyList gets all signing keys of a zone. It returns a list of SigningKey objects.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) SigningKeyList(ctx context.Context, params SigningKeyListParams) ([]SigningKey, error) {
+	var k []SigningKey
+	var o []signingKeyObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return k, errors.New("windows.dns.SigningKeyList: signing key parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return k, winerror.Errorf(cmd, "windows.dns.SigningKeyList: %w", err)
+	}
+
+	// Convert the output to SigningKey objects.
+	for _, object := range o {
+		var key SigningKey
+		key.convertOutput(object)
+		k = append(k, key)
+	}
+
+	return k, nil
+}
+
+// SigningKeyCreateParams represents parameters for the SigningKeyCreate function.
+type SigningKeyCreateParams struct {
+	// Specifies the zone of the signing key.
+	Zone string
+
+	// Specifies the type of the signing key.
+	// Possible values are "KeySigningKey" and "ZoneSigningKey".
+	KeyType string
+
+	// Specifies the crypto algorithm of the signing key.
+	// Possible values are "RsaSha1", "RsaSha1NSec3", "RsaSha256", "RsaSha512", "ECDsaP256Sha256" and "ECDsaP384Sha384".
+	CryptoAlgorithm string
+
+	// Specifies the length of a RSA signing key in bits, between 1024 and 4096.
+	// If not provided, the default is 2048 for key signing keys and 1024 for zone signing keys.
+	// The key length of ECDSA signing keys is defined by their crypto algorithm and must not be set.
+	KeyLength uint32
+
+	// Specifies the time after which the signing key is rolled over.
+	// If not provided, the default of the server is used, which is 755 days for key signing keys
+	// and 90 days for zone signing keys.
+	RolloverPeriod time.Duration
+}
+
+// pwshCommand returns the PowerShell command to create a signing key.
+func (params SigningKeyCreateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Add-DnsServerSigningKey -ZoneName '%s'", params.Zone)}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Type '%s'", params.KeyType))
+	cmd = append(cmd, fmt.Sprintf("-CryptoAlgorithm '%s'", params.CryptoAlgorithm))
+	if params.KeyLength != 0 {
+		cmd = append(cmd, fmt.Sprintf("-KeyLength %d", params.KeyLength))
+	}
+	if params.RolloverPeriod != 0 {
+		cmd = append(cmd, fmt.Sprintf("-RolloverPeriod %s", pwshTimeSpan(params.RolloverPeriod)))
+	}
+
+	cmd = append(cmd, "-PassThru -ErrorAction Stop | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// SigningKeyCreate adds a signing key to a zone. It returns a SigningKey object.
+// The key is used when the zone is signed or the next time the keys of a signed zone are rolled over.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) SigningKeyCreate(ctx context.Context, params SigningKeyCreateParams) (SigningKey, error) {
+	var k SigningKey
+	var o signingKeyObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return k, errors.New("windows.dns.SigningKeyCreate: signing key parameter 'Zone' must be set")
+	}
+	if params.KeyType != SigningKeyTypeKeySigningKey && params.KeyType != SigningKeyTypeZoneSigningKey {
+		return k, fmt.Errorf("windows.dns.SigningKeyCreate: signing key parameter 'KeyType' must be '%s' or '%s', got '%s'", SigningKeyTypeKeySigningKey, SigningKeyTypeZoneSigningKey, params.KeyType)
+	}
+	if _, ok := dnssecAlgorithms[params.CryptoAlgorithm]; !ok {
+		return k, fmt.Errorf("windows.dns.SigningKeyCreate: signing key parameter 'CryptoAlgorithm' is not supported, got '%s'", params.CryptoAlgorithm)
+	}
+	if params.RolloverPeriod < 0 {
+		return k, errors.New("windows.dns.SigningKeyCreate: signing key parameter 'RolloverPeriod' must not be negative")
+	}
+	if exceedsTimeSpan(params.RolloverPeriod) {
+		return k, fmt.Errorf("windows.dns.SigningKeyCreate: signing key parameter 'RolloverPeriod' must not exceed %s", maxTimeSpan)
+	}
+
+	// Set the default key length of RSA keys.
+	if strings.HasPrefix(params.CryptoAlgorithm, "Rsa") {
+		if params.KeyLength == 0 {
+			params.KeyLength = 1024
+			if params.KeyType == SigningKeyTypeKeySigningKey {
+				params.KeyLength = 2048
+			}
+		}
+		if params.KeyLength < minSigningKeyLength || params.KeyLength > maxSigningKeyLength {
+			return k, fmt.Errorf("windows.dns.SigningKeyCreate: signing key parameter 'KeyLength' must be between %d and %d, got %d", minSigningKeyLength, maxSigningKeyLength, params.KeyLength)
+		}
+	} else if params.KeyLength != 0 {
+		return k, errors.New("windows.dns.SigningKeyCreate: signing key parameter 'KeyLength' can only be set for RSA crypto algorithms")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return k, winerror.Errorf(cmd, "windows.dns.SigningKeyCreate: %w", err)
+	}
+
+	// Convert the output to a SigningKey object.
+	k.convertOutput(o)
+
+	return k, nil
+}
+
+// SigningKeyUpdateParams represents parameters for the SigningKeyUpdate function.
+type SigningKeyUpdateParams struct {
+	// Specifies the zone of the signing key.
+	Zone string
+
+	// Specifies the ID of the signing key.
+	KeyId string
+
+	// Specifies the time after which the signing key is rolled over.
+	RolloverPeriod time.Duration
+}
+
+// pwshCommand returns the PowerShell command to update a signing key.
+func (params SigningKeyUpdateParams) pwshCommand() string {
+	return fmt.Sprintf(
+		"Set-DnsServerSigningKey -ZoneName '%s' -KeyId '%s' -RolloverPeriod %s -PassThru -ErrorAction Stop | ConvertTo-Json -Compress",
+		params.Zone,
+		params.KeyId,
+		pwshTimeSpan(params.RolloverPeriod),
+	)
+}
+
+// SigningKeyUpdate updates the rollover period of a signing key. It returns a SigningKey object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) SigningKeyUpdate(ctx context.Context, params SigningKeyUpdateParams) (SigningKey, error) {
+	var k SigningKey
+	var o signingKeyObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.KeyId == "" {
+		return k, errors.New("windows.dns.SigningKeyUpdate: signing key parameters 'Zone' and 'KeyId' must be set")
+	}
+	if params.RolloverPeriod <= 0 {
+		return k, errors.New("windows.dns.SigningKeyUpdate: signing key parameter 'RolloverPeriod' must be positive")
+	}
+	if exceedsTimeSpan(params.RolloverPeriod) {
+		return k, fmt.Errorf("windows.dns.SigningKeyUpdate: signing key parameter 'RolloverPeriod' must not exceed %s", maxTimeSpan)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return k, winerror.Errorf(cmd, "windows.dns.SigningKeyUpdate: %w", err)
+	}
+
+	// Convert the output to a SigningKey object.
+	k.convertOutput(o)
+
+	return k, nil
+}
+
+// SigningKeyDeleteParams represents parameters for the SigningKeyDelete function.
+type SigningKeyDeleteParams struct {
+	// Specifies the zone of the signing key.
+	Zone string
+
+	// Specifies the ID of the signing key.
+	KeyId string
+}
+
+// pwshCommand returns the PowerShell command to delete a signing key.
+func (params SigningKeyDeleteParams) pwshCommand() string {
+	return fmt.Sprintf("Remove-DnsServerSigningKey -ZoneName '%s' -KeyId '%s' -Force", params.Zone, params.KeyId)
+}
+
+// SigningKeyDelete removes a signing key from a zone.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) SigningKeyDelete(ctx context.Context, params SigningKeyDeleteParams) error {
+	var o signingKeyObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.KeyId == "" {
+		return errors.New("windows.dns.SigningKeyDelete: signing key parameters 'Zone' and 'KeyId' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.SigningKeyDelete: %w", err)
+	}
+
+	return nil
+}
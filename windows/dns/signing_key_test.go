@@ -0,0 +1,264 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	signingKeyJson = `{"KeyId":"8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10","KeyType":"KeySigningKey","CurrentState":"Active","CurrentRolloverStatus":"NotRolling","CryptoAlgorithm":"RsaSha256","KeyLength":2048,"KeyStorageProvider":"Microsoft Software Key Storage Provider","StoreKeysInAD":true,"RolloverPeriod":{"Ticks":652320000000000,"Days":755,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":755,"TotalHours":18120,"TotalMilliseconds":65232000000,"TotalMinutes":1087200,"TotalSeconds":65232000},"NextRolloverAction":"Normal","LastRolloverTime":null,"NextRolloverTime":"\/Date(1767225600000)\/","PSComputerName":null}`
+)
+
+var expectedSigningKey = SigningKey{
+	KeyId:                 "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10",
+	KeyType:               SigningKeyTypeKeySigningKey,
+	CryptoAlgorithm:       "RsaSha256",
+	KeyLength:             2048,
+	KeyStorageProvider:    "Microsoft Software Key Storage Provider",
+	StoreKeysInAD:         true,
+	RolloverPeriod:        755 * 24 * time.Hour,
+	CurrentRolloverStatus: "NotRolling",
+	NextRolloverAction:    "Normal",
+	NextRolloverTime:      time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+}
+
+// Test SigningKeyRead related methods.
+func (suite *DnsServerUnitTestSuite) TestSigningKeyRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct signing key", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerSigningKey -ZoneName 'test.local' -KeyId '8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: signingKeyJson}, nil)
+		actual, err := c.SigningKeyRead(ctx, SigningKeyReadParams{Zone: "test.local", KeyId: "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10"})
+		suite.NoError(err)
+		suite.Equal(expectedSigningKey, actual)
+	})
+
+	suite.Run("should return error if key id is missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.SigningKeyRead(ctx, SigningKeyReadParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.SigningKeyRead: signing key parameters 'Zone' and 'KeyId' must be set")
+	})
+}
+
+// Test SigningKeyList related methods.
+func (suite *DnsServerUnitTestSuite) TestSigningKeyList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the signing keys", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$k=@(Get-DnsServerSigningKey -ZoneName 'test.local') ;if($k.Count -ge 2){ConvertTo-Json $k -Compress}else{ConvertTo-Json @($k) -Compress}").
+			Return(connection.CmdResult{StdOut: "[" + signingKeyJson + "]"}, nil)
+		actual, err := c.SigningKeyList(ctx, SigningKeyListParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal([]SigningKey{expectedSigningKey}, actual)
+	})
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$k=@(Get-DnsServerSigningKey -ZoneName 'test.local') ;if($k.Count -ge 2){ConvertTo-Json $k -Compress}else{ConvertTo-Json @($k) -Compress}").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.SigningKeyList(ctx, SigningKeyListParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.SigningKeyList: test-error")
+	})
+}
+
+// Test SigningKeyCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestSigningKeyCreatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters SigningKeyCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with a RSA key",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "RsaSha256", KeyLength: 2048, RolloverPeriod: 365 * 24 * time.Hour},
+				"Add-DnsServerSigningKey -ZoneName 'test.local' -Type 'KeySigningKey' -CryptoAlgorithm 'RsaSha256' -KeyLength 2048 -RolloverPeriod $(New-TimeSpan -Seconds 31536000) -PassThru -ErrorAction Stop | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with a ECDSA key",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeZoneSigningKey, CryptoAlgorithm: "ECDsaP256Sha256"},
+				"Add-DnsServerSigningKey -ZoneName 'test.local' -Type 'ZoneSigningKey' -CryptoAlgorithm 'ECDsaP256Sha256' -PassThru -ErrorAction Stop | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestSigningKeyCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should create a RSA key with the default key length", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerSigningKey -ZoneName 'test.local' -Type 'KeySigningKey' -CryptoAlgorithm 'RsaSha256' -KeyLength 2048 -PassThru -ErrorAction Stop | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: signingKeyJson}, nil)
+		actual, err := c.SigningKeyCreate(ctx, SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "RsaSha256"})
+		suite.NoError(err)
+		suite.Equal(expectedSigningKey, actual)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters SigningKeyCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with missing zone",
+				SigningKeyCreateParams{KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "RsaSha256"},
+				"windows.dns.SigningKeyCreate: signing key parameter 'Zone' must be set",
+			},
+			{
+				"assert error with an invalid key type",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: "KSK", CryptoAlgorithm: "RsaSha256"},
+				"windows.dns.SigningKeyCreate: signing key parameter 'KeyType' must be 'KeySigningKey' or 'ZoneSigningKey', got 'KSK'",
+			},
+			{
+				"assert error with an unsupported crypto algorithm",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "Ed25519"},
+				"windows.dns.SigningKeyCreate: signing key parameter 'CryptoAlgorithm' is not supported, got 'Ed25519'",
+			},
+			{
+				"assert error with a too long RSA key",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "RsaSha256", KeyLength: 8192},
+				"windows.dns.SigningKeyCreate: signing key parameter 'KeyLength' must be between 1024 and 4096, got 8192",
+			},
+			{
+				"assert error with a key length of a ECDSA key",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "ECDsaP384Sha384", KeyLength: 384},
+				"windows.dns.SigningKeyCreate: signing key parameter 'KeyLength' can only be set for RSA crypto algorithms",
+			},
+			{
+				"assert error with a rollover period that exceeds the maximum time span",
+				SigningKeyCreateParams{Zone: "test.local", KeyType: SigningKeyTypeKeySigningKey, CryptoAlgorithm: "RsaSha256", RolloverPeriod: 100 * 365 * 24 * time.Hour},
+				"windows.dns.SigningKeyCreate: signing key parameter 'RolloverPeriod' must not exceed 596523h14m7s",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.SigningKeyCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test SigningKeyUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestSigningKeyUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should update the rollover period", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Set-DnsServerSigningKey -ZoneName 'test.local' -KeyId '8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10' -RolloverPeriod $(New-TimeSpan -Seconds 65232000) -PassThru -ErrorAction Stop | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: signingKeyJson}, nil)
+		actual, err := c.SigningKeyUpdate(ctx, SigningKeyUpdateParams{Zone: "test.local", KeyId: "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10", RolloverPeriod: 755 * 24 * time.Hour})
+		suite.NoError(err)
+		suite.Equal(expectedSigningKey, actual)
+	})
+
+	suite.Run("should return error without a rollover period", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.SigningKeyUpdate(ctx, SigningKeyUpdateParams{Zone: "test.local", KeyId: "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10"})
+		suite.EqualError(err, "windows.dns.SigningKeyUpdate: signing key parameter 'RolloverPeriod' must be positive")
+	})
+
+	suite.Run("should return error with a rollover period that exceeds the maximum time span", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.SigningKeyUpdate(ctx, SigningKeyUpdateParams{Zone: "test.local", KeyId: "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10", RolloverPeriod: 100 * 365 * 24 * time.Hour})
+		suite.EqualError(err, "windows.dns.SigningKeyUpdate: signing key parameter 'RolloverPeriod' must not exceed 596523h14m7s")
+	})
+}
+
+// Test SigningKeyDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestSigningKeyDelete() {
+	suite.T().Parallel()
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Remove-DnsServerSigningKey -ZoneName 'test.local' -KeyId '8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10' -Force").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		err := c.SigningKeyDelete(ctx, SigningKeyDeleteParams{Zone: "test.local", KeyId: "8b3a4c8e-5a5f-4b4c-9a4e-2d0c1a6e7f10"})
+		suite.EqualError(err, "windows.dns.SigningKeyDelete: test-error")
+	})
+}
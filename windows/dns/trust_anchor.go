@@ -0,0 +1,104 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// TrustAnchor represents a DNSSEC trust anchor of the DNS server.
+// DNSKEY trust anchors contain the public key, DS trust anchors contain the digest of the key.
+type TrustAnchor struct {
+	Name            string
+	TrustAnchorType string
+	State           string
+	KeyTag          uint16
+	CryptoAlgorithm string
+	PublicKey       string
+	DigestType      string
+	Digest          string
+}
+
+// trustAnchorObject contains the unmarshaled json of the powershell trust anchor object.
+type trustAnchorObject struct {
+	TrustAnchorName  string           `json:"TrustAnchorName"`
+	TrustAnchorType  string           `json:"TrustAnchorType"`
+	TrustAnchorState string           `json:"TrustAnchorState"`
+	TrustAnchorData  recordRecordData `json:"TrustAnchorData"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the trustAnchorObject to a TrustAnchor object.
+func (t *TrustAnchor) convertOutput(o trustAnchorObject) error {
+	properties := o.TrustAnchorData.CimInstanceProperties
+
+	t.Name = o.TrustAnchorName
+	t.TrustAnchorType = o.TrustAnchorType
+	t.State = o.TrustAnchorState
+	t.CryptoAlgorithm = properties["CryptoAlgorithm"]
+
+	switch strings.ToUpper(o.TrustAnchorType) {
+	case "DNSKEY":
+		key, err := newDnsKey(properties)
+		if err != nil {
+			return err
+		}
+		t.KeyTag = key.keyTag()
+		t.PublicKey = properties["Base64Data"]
+	case "DS":
+		keyTag, err := strconv.ParseUint(properties["KeyTag"], 10, 16)
+		if err != nil {
+			return err
+		}
+		t.KeyTag = uint16(keyTag)
+		t.DigestType = properties["DigestType"]
+		t.Digest = properties["Digest"]
+	default:
+		return fmt.Errorf("unsupported trust anchor type '%s'", o.TrustAnchorType)
+	}
+
+	return nil
+}
+
+// TrustAnchorListParams represents parameters for the TrustAnchorList function.
+type TrustAnchorListParams struct {
+	// Specifies the name of the zone of the trust anchors.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to list the trust anchors of a zone.
+func (params TrustAnchorListParams) pwshCommand() string {
+	return fmt.Sprintf("$t=@(Get-DnsServerTrustAnchor -Name '%s') ;if($t.Count -ge 2){ConvertTo-Json $t -Compress}else{ConvertTo-Json @($t) -Compress}", params.Name)
+}
+
+// TrustAnchorList gets the trust anchors of a zone. It returns a list of TrustAnchor objects.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) TrustAnchorList(ctx context.Context, params TrustAnchorListParams) ([]TrustAnchor, error) {
+	var t []TrustAnchor
+	var o []trustAnchorObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return t, errors.New("windows.dns.TrustAnchorList: trust anchor parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return t, winerror.Errorf(cmd, "windows.dns.TrustAnchorList: %w", err)
+	}
+
+	// Convert the output to TrustAnchor objects.
+	for _, object := range o {
+		var anchor TrustAnchor
+		if err := anchor.convertOutput(object); err != nil {
+			return t, winerror.Errorf(cmd, "windows.dns.TrustAnchorList: %w", err)
+		}
+		t = append(t, anchor)
+	}
+
+	return t, nil
+}
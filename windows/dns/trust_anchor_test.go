@@ -0,0 +1,65 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	trustAnchorJson = `[{"TrustAnchorName":"dskey.example.com.","TrustAnchorType":"DNSKEY","TrustAnchorState":"Valid","TrustAnchorData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordDnsKey","CimInstanceProperties":"Base64Data = \"` + dnsKeyPublicKey + `\" CryptoAlgorithm = \"RsaSha1\" KeyProtocol = \"DnsSec\" Revoked = False SecureEntryPoint = True ZoneKey = True","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"PSComputerName":null},{"TrustAnchorName":"dskey.example.com.","TrustAnchorType":"DS","TrustAnchorState":"DSPending","TrustAnchorData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordDS","CimInstanceProperties":"CryptoAlgorithm = \"RsaSha1\" Digest = \"2BB183AF5F22588179A53B0A98631FAD1A292118\" DigestType = \"Sha1\" KeyTag = 60485","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"PSComputerName":null}]`
+)
+
+// Test TrustAnchorList related methods.
+func (suite *DnsServerUnitTestSuite) TestTrustAnchorList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the trust anchors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$t=@(Get-DnsServerTrustAnchor -Name 'dskey.example.com') ;if($t.Count -ge 2){ConvertTo-Json $t -Compress}else{ConvertTo-Json @($t) -Compress}").
+			Return(connection.CmdResult{StdOut: trustAnchorJson}, nil)
+		actual, err := c.TrustAnchorList(ctx, TrustAnchorListParams{Name: "dskey.example.com"})
+		suite.NoError(err)
+		suite.Equal([]TrustAnchor{
+			{
+				Name:            "dskey.example.com.",
+				TrustAnchorType: "DNSKEY",
+				State:           "Valid",
+				KeyTag:          60486,
+				CryptoAlgorithm: "RsaSha1",
+				PublicKey:       dnsKeyPublicKey,
+			},
+			{
+				Name:            "dskey.example.com.",
+				TrustAnchorType: "DS",
+				State:           "DSPending",
+				KeyTag:          60485,
+				CryptoAlgorithm: "RsaSha1",
+				DigestType:      "Sha1",
+				Digest:          "2BB183AF5F22588179A53B0A98631FAD1A292118",
+			},
+		}, actual)
+	})
+
+	suite.Run("should return error if name is missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.TrustAnchorList(ctx, TrustAnchorListParams{})
+		suite.EqualError(err, "windows.dns.TrustAnchorList: trust anchor parameter 'Name' must be set")
+	})
+}
@@ -4,34 +4,89 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/netip"
 	"strings"
 
+	"github.com/d-strobel/gowindows/parsing"
 	"github.com/d-strobel/gowindows/winerror"
 )
 
 // Zone represents a Windows DNS server zone with its properties.
 type Zone struct {
-	NotifyServers                     string `json:"NotifyServers"`
-	SecondaryServers                  string `json:"SecondaryServers"`
-	AllowedDcForNsRecordsAutoCreation string `json:"AllowedDcForNsRecordsAutoCreation"`
-	DistinguishedName                 string `json:"DistinguishedName"`
-	IsAutoCreated                     bool   `json:"IsAutoCreated"`
-	IsDsIntegrated                    bool   `json:"IsDsIntegrated"`
-	IsPaused                          bool   `json:"IsPaused"`
-	IsReadOnly                        bool   `json:"IsReadOnly"`
-	IsReverseLookupZone               bool   `json:"IsReverseLookupZone"`
-	IsShutdown                        bool   `json:"IsShutdown"`
-	ZoneName                          string `json:"ZoneName"`
-	ZoneType                          string `json:"ZoneType"`
-	DirectoryPartitionName            string `json:"DirectoryPartitionName"`
-	DynamicUpdate                     string `json:"DynamicUpdate"`
-	IgnorePolicies                    bool   `json:"IgnorePolicies"`
-	IsSigned                          bool   `json:"IsSigned"`
-	IsWinsEnabled                     bool   `json:"IsWinsEnabled"`
-	Notify                            string `json:"Notify"`
-	ReplicationScope                  string `json:"ReplicationScope"`
-	SecureSecondaries                 string `json:"SecureSecondaries"`
-	ZoneFile                          string `json:"ZoneFile"`
+	NotifyServers                     []netip.Addr
+	SecondaryServers                  []netip.Addr
+	MasterServers                     []netip.Addr
+	AllowedDcForNsRecordsAutoCreation []netip.Addr
+	DistinguishedName                 string
+	IsAutoCreated                     bool
+	IsDsIntegrated                    bool
+	IsPaused                          bool
+	IsReadOnly                        bool
+	IsReverseLookupZone               bool
+	IsShutdown                        bool
+	ZoneName                          string
+	ZoneType                          string
+	DirectoryPartitionName            string
+	DynamicUpdate                     string
+	IgnorePolicies                    bool
+	IsSigned                          bool
+	IsWinsEnabled                     bool
+	Notify                            string
+	ReplicationScope                  string
+	SecureSecondaries                 string
+	ZoneFile                          string
+}
+
+// zoneObject contains the unmarshaled json of the powershell zone object.
+type zoneObject struct {
+	NotifyServers                     parsing.CimIpAddressList `json:"NotifyServers"`
+	SecondaryServers                  parsing.CimIpAddressList `json:"SecondaryServers"`
+	MasterServers                     parsing.CimIpAddressList `json:"MasterServers"`
+	AllowedDcForNsRecordsAutoCreation parsing.CimIpAddressList `json:"AllowedDcForNsRecordsAutoCreation"`
+	DistinguishedName                 string                   `json:"DistinguishedName"`
+	IsAutoCreated                     bool                     `json:"IsAutoCreated"`
+	IsDsIntegrated                    bool                     `json:"IsDsIntegrated"`
+	IsPaused                          bool                     `json:"IsPaused"`
+	IsReadOnly                        bool                     `json:"IsReadOnly"`
+	IsReverseLookupZone               bool                     `json:"IsReverseLookupZone"`
+	IsShutdown                        bool                     `json:"IsShutdown"`
+	ZoneName                          string                   `json:"ZoneName"`
+	ZoneType                          string                   `json:"ZoneType"`
+	DirectoryPartitionName            string                   `json:"DirectoryPartitionName"`
+	DynamicUpdate                     string                   `json:"DynamicUpdate"`
+	IgnorePolicies                    bool                     `json:"IgnorePolicies"`
+	IsSigned                          bool                     `json:"IsSigned"`
+	IsWinsEnabled                     bool                     `json:"IsWinsEnabled"`
+	Notify                            string                   `json:"Notify"`
+	ReplicationScope                  string                   `json:"ReplicationScope"`
+	SecureSecondaries                 string                   `json:"SecureSecondaries"`
+	ZoneFile                          string                   `json:"ZoneFile"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the zoneObject to a Zone object.
+func (z *Zone) convertOutput(o zoneObject) {
+	z.NotifyServers = o.NotifyServers
+	z.SecondaryServers = o.SecondaryServers
+	z.MasterServers = o.MasterServers
+	z.AllowedDcForNsRecordsAutoCreation = o.AllowedDcForNsRecordsAutoCreation
+	z.DistinguishedName = o.DistinguishedName
+	z.IsAutoCreated = o.IsAutoCreated
+	z.IsDsIntegrated = o.IsDsIntegrated
+	z.IsPaused = o.IsPaused
+	z.IsReadOnly = o.IsReadOnly
+	z.IsReverseLookupZone = o.IsReverseLookupZone
+	z.IsShutdown = o.IsShutdown
+	z.ZoneName = o.ZoneName
+	z.ZoneType = o.ZoneType
+	z.DirectoryPartitionName = o.DirectoryPartitionName
+	z.DynamicUpdate = o.DynamicUpdate
+	z.IgnorePolicies = o.IgnorePolicies
+	z.IsSigned = o.IsSigned
+	z.IsWinsEnabled = o.IsWinsEnabled
+	z.Notify = o.Notify
+	z.ReplicationScope = o.ReplicationScope
+	z.SecureSecondaries = o.SecureSecondaries
+	z.ZoneFile = o.ZoneFile
 }
 
 // ZoneReadParams represents parameters for the ZoneRead function.
@@ -56,6 +111,7 @@ func (params ZoneReadParams) pwshCommand() string {
 // It returns a *winerror.WinError if the windows client returns an error.
 func (c *Client) ZoneRead(ctx context.Context, params ZoneReadParams) (Zone, error) {
 	var z Zone
+	var o zoneObject
 
 	// Assert needed parameters
 	if params.Name == "" {
@@ -69,9 +125,13 @@ func (c *Client) ZoneRead(ctx context.Context, params ZoneReadParams) (Zone, err
 
 	// Run command
 	cmd := params.pwshCommand()
-	if err := run(ctx, c, cmd, &z); err != nil {
-		return z, winerror.Errorf(cmd, "windows.dns.server.ZoneRead: %s", err)
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return z, winerror.Errorf(cmd, "windows.dns.server.ZoneRead: %w", err)
 	}
+
+	// Convert the output to a Zone object.
+	z.convertOutput(o)
+
 	return z, nil
 }
 
@@ -79,11 +139,390 @@ func (c *Client) ZoneRead(ctx context.Context, params ZoneReadParams) (Zone, err
 // It returns a *winerror.WinError if the windows client returns an error.
 func (c *Client) ZoneList(ctx context.Context) ([]Zone, error) {
 	var z []Zone
+	var o []zoneObject
 
 	// Run command
 	cmd := "Get-DnsServerZone | ConvertTo-Json -Compress"
-	if err := run(ctx, c, cmd, &z); err != nil {
-		return z, winerror.Errorf(cmd, "windows.dns.server.ZoneList: %s", err)
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return z, winerror.Errorf(cmd, "windows.dns.server.ZoneList: %w", err)
+	}
+
+	// Convert the output to Zone objects.
+	for _, object := range o {
+		var zone Zone
+		zone.convertOutput(object)
+		z = append(z, zone)
 	}
+
 	return z, nil
 }
+
+// reverseZoneName returns the name of the reverse lookup zone of a network,
+// e.g. "10.168.192.in-addr.arpa" for "192.168.10.0/24".
+// The prefix length must be a multiple of 8 for IPv4 and a multiple of 4 for IPv6 networks.
+func reverseZoneName(prefix netip.Prefix) (string, error) {
+	if !prefix.IsValid() || prefix.Bits() == 0 {
+		return "", fmt.Errorf("invalid network '%s'", prefix)
+	}
+	prefix = prefix.Masked()
+
+	var labels []string
+	if prefix.Addr().Is4() {
+		if prefix.Bits()%8 != 0 {
+			return "", fmt.Errorf("the prefix length of the IPv4 network '%s' must be a multiple of 8", prefix)
+		}
+
+		octets := prefix.Addr().As4()
+		for i := prefix.Bits()/8 - 1; i >= 0; i-- {
+			labels = append(labels, fmt.Sprintf("%d", octets[i]))
+		}
+		return strings.Join(append(labels, "in-addr.arpa"), "."), nil
+	}
+
+	if prefix.Bits()%4 != 0 {
+		return "", fmt.Errorf("the prefix length of the IPv6 network '%s' must be a multiple of 4", prefix)
+	}
+
+	nibbles := fmt.Sprintf("%x", prefix.Addr().AsSlice())
+	for i := prefix.Bits()/4 - 1; i >= 0; i-- {
+		labels = append(labels, string(nibbles[i]))
+	}
+	return strings.Join(append(labels, "ip6.arpa"), "."), nil
+}
+
+// zoneTransferParams returns the parameters for the zone transfer and notify settings of a zone.
+func zoneTransferParams(secureSecondaries string, secondaryServers []netip.Addr, notify string, notifyServers []netip.Addr) []string {
+	cmd := []string{}
+
+	if secureSecondaries != "" {
+		cmd = append(cmd, fmt.Sprintf("-SecureSecondaries '%s'", secureSecondaries))
+	}
+	if secondaryServers != nil {
+		cmd = append(cmd, fmt.Sprintf("-SecondaryServers %s", pwshIPAddressList(secondaryServers)))
+	}
+	if notify != "" {
+		cmd = append(cmd, fmt.Sprintf("-Notify '%s'", notify))
+	}
+	if notifyServers != nil {
+		cmd = append(cmd, fmt.Sprintf("-NotifyServers %s", pwshIPAddressList(notifyServers)))
+	}
+
+	return cmd
+}
+
+// validateZoneTransfer returns an error if the servers of the zone transfer and notify settings are missing.
+func validateZoneTransfer(secureSecondaries string, secondaryServers []netip.Addr, notify string, notifyServers []netip.Addr) error {
+	if secureSecondaries == "TransferToSecureServers" && len(secondaryServers) == 0 {
+		return errors.New("zone parameter 'SecondaryServers' must be set if 'SecureSecondaries' is 'TransferToSecureServers'")
+	}
+	if notify == "NotifyServers" && len(notifyServers) == 0 {
+		return errors.New("zone parameter 'NotifyServers' must be set if 'Notify' is 'NotifyServers'")
+	}
+	return nil
+}
+
+// ZoneCreateParams represents parameters for the ZoneCreate function.
+type ZoneCreateParams struct {
+	// Specifies the name of the zone.
+	// Either the Name or the NetworkID must be set.
+	Name string
+
+	// Specifies the network of a reverse lookup zone, e.g. "192.168.10.0/24".
+	// The name of the zone is derived from the network.
+	// The prefix length must be a multiple of 8 for IPv4 and a multiple of 4 for IPv6 networks.
+	NetworkID netip.Prefix
+
+	// Specifies the type of the zone.
+	// Possible values are "Primary", "Secondary" and "Stub".
+	// If not provided, a primary zone is created.
+	ZoneType string
+
+	// Specifies the replication scope of an Active Directory integrated zone.
+	// Possible values are "Forest", "Domain", "Legacy" and "Custom".
+	// If not provided, a file-backed zone is created. Secondary zones are always file-backed.
+	ReplicationScope string
+
+	// Specifies the directory partition of a zone with the replication scope "Custom".
+	DirectoryPartitionName string
+
+	// Specifies the file of a file-backed zone.
+	// If not provided, the file is named after the zone, e.g. "test.local.dns".
+	ZoneFile string
+
+	// Specifies the master servers of a secondary or stub zone.
+	MasterServers []netip.Addr
+
+	// Specifies how a primary zone accepts dynamic updates.
+	// Possible values are "None", "Secure" and "NonsecureAndSecure".
+	// Secure updates are only possible for Active Directory integrated zones.
+	DynamicUpdate string
+
+	// Specifies which servers are allowed to receive zone transfers of a primary zone.
+	// Possible values are "NoTransfer", "TransferAnyServer", "TransferToZoneNameServer" and "TransferToSecureServers".
+	SecureSecondaries string
+
+	// Specifies the secondary servers of a primary zone with the zone transfer setting "TransferToSecureServers".
+	SecondaryServers []netip.Addr
+
+	// Specifies which servers are notified about changes of a primary zone.
+	// Possible values are "NoNotify", "Notify" and "NotifyServers".
+	Notify string
+
+	// Specifies the servers that are notified with the notify setting "NotifyServers".
+	NotifyServers []netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to create a zone.
+func (params ZoneCreateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Add-DnsServer%sZone -Name '%s'", params.ZoneType, params.Name)}
+
+	// Add parameters
+	if params.ReplicationScope != "" {
+		cmd = append(cmd, fmt.Sprintf("-ReplicationScope '%s'", params.ReplicationScope))
+	} else {
+		cmd = append(cmd, fmt.Sprintf("-ZoneFile '%s'", params.ZoneFile))
+	}
+	if params.DirectoryPartitionName != "" {
+		cmd = append(cmd, fmt.Sprintf("-DirectoryPartitionName '%s'", params.DirectoryPartitionName))
+	}
+	if len(params.MasterServers) > 0 {
+		cmd = append(cmd, fmt.Sprintf("-MasterServers %s", pwshIPAddressList(params.MasterServers)))
+	}
+	if params.DynamicUpdate != "" {
+		cmd = append(cmd, fmt.Sprintf("-DynamicUpdate '%s'", params.DynamicUpdate))
+	}
+	cmd = append(cmd, "-ErrorAction Stop")
+
+	// The zone transfer and notify settings can only be set after the zone is created.
+	// The zone is removed again if the settings cannot be set.
+	transfer := zoneTransferParams(params.SecureSecondaries, params.SecondaryServers, params.Notify, params.NotifyServers)
+	if len(transfer) > 0 {
+		cmd = append(cmd, fmt.Sprintf(";try{Set-DnsServerPrimaryZone -Name '%s'", params.Name))
+		cmd = append(cmd, transfer...)
+		cmd = append(cmd, fmt.Sprintf("-ErrorAction Stop}catch{Remove-DnsServerZone -Name '%s' -Force -ErrorAction SilentlyContinue ;throw $_}", params.Name))
+	}
+
+	cmd = append(cmd, fmt.Sprintf(";Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Name))
+	return strings.Join(cmd, " ")
+}
+
+// ZoneCreate creates a primary, secondary or stub zone. It returns a Zone object.
+// If the zone transfer or notify settings cannot be set, the created zone is removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneCreate(ctx context.Context, params ZoneCreateParams) (Zone, error) {
+	var z Zone
+	var o zoneObject
+
+	// Assert needed parameters
+	if (params.Name == "") == !params.NetworkID.IsValid() {
+		return z, errors.New("windows.dns.ZoneCreate: either zone parameter 'Name' or 'NetworkID' must be set")
+	}
+
+	// Derive the name of a reverse lookup zone from the network.
+	if params.NetworkID.IsValid() {
+		name, err := reverseZoneName(params.NetworkID)
+		if err != nil {
+			return z, fmt.Errorf("windows.dns.ZoneCreate: zone parameter 'NetworkID' is invalid: %w", err)
+		}
+		params.Name = name
+	}
+
+	// Set defaults and assert the parameters of the zone type.
+	if params.ZoneType == "" {
+		params.ZoneType = "Primary"
+	}
+	if params.ReplicationScope == "" && params.ZoneFile == "" {
+		params.ZoneFile = params.Name + ".dns"
+	}
+	if err := params.validate(); err != nil {
+		return z, fmt.Errorf("windows.dns.ZoneCreate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle zone already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return z, winerror.Errorf(cmd, "windows.dns.ZoneCreate: the specified zone already exists")
+		}
+
+		return z, winerror.Errorf(cmd, "windows.dns.ZoneCreate: %w", err)
+	}
+
+	// Convert the output to a Zone object.
+	z.convertOutput(o)
+
+	return z, nil
+}
+
+// validate returns an error if the parameters are not valid for the zone type.
+func (params ZoneCreateParams) validate() error {
+	switch params.ZoneType {
+	case "Primary":
+		if len(params.MasterServers) > 0 {
+			return errors.New("zone parameter 'MasterServers' can only be set for secondary and stub zones")
+		}
+		if params.DynamicUpdate == "Secure" && params.ReplicationScope == "" {
+			return errors.New("zone parameter 'DynamicUpdate' can only be 'Secure' for Active Directory integrated zones")
+		}
+	case "Secondary", "Stub":
+		if len(params.MasterServers) == 0 {
+			return fmt.Errorf("zone parameter 'MasterServers' must be set for %s zones", strings.ToLower(params.ZoneType))
+		}
+		if params.ZoneType == "Secondary" && params.ReplicationScope != "" {
+			return errors.New("zone parameter 'ReplicationScope' can not be set for secondary zones")
+		}
+		if params.DynamicUpdate != "" || params.SecureSecondaries != "" || params.SecondaryServers != nil || params.Notify != "" || params.NotifyServers != nil {
+			return errors.New("zone parameters 'DynamicUpdate', 'SecureSecondaries', 'SecondaryServers', 'Notify' and 'NotifyServers' can only be set for primary zones")
+		}
+	default:
+		return fmt.Errorf("zone parameter 'ZoneType' must be 'Primary', 'Secondary' or 'Stub', got '%s'", params.ZoneType)
+	}
+
+	if params.DirectoryPartitionName != "" && params.ReplicationScope != "Custom" {
+		return errors.New("zone parameter 'DirectoryPartitionName' can only be set with the replication scope 'Custom'")
+	}
+
+	return validateZoneTransfer(params.SecureSecondaries, params.SecondaryServers, params.Notify, params.NotifyServers)
+}
+
+// ZoneUpdateParams represents parameters for the ZoneUpdate function.
+// Settings that are not set keep their current value.
+type ZoneUpdateParams struct {
+	// Specifies the name of the zone.
+	Name string
+
+	// Specifies the replication scope of an Active Directory integrated primary or stub zone.
+	// Possible values are "Forest", "Domain", "Legacy" and "Custom".
+	ReplicationScope string
+
+	// Specifies the directory partition of a zone with the replication scope "Custom".
+	DirectoryPartitionName string
+
+	// Specifies the master servers of a secondary or stub zone.
+	MasterServers []netip.Addr
+
+	// Specifies how a primary zone accepts dynamic updates.
+	// Possible values are "None", "Secure" and "NonsecureAndSecure".
+	DynamicUpdate string
+
+	// Specifies which servers are allowed to receive zone transfers of a primary zone.
+	// Possible values are "NoTransfer", "TransferAnyServer", "TransferToZoneNameServer" and "TransferToSecureServers".
+	SecureSecondaries string
+
+	// Specifies the secondary servers of a primary zone with the zone transfer setting "TransferToSecureServers".
+	SecondaryServers []netip.Addr
+
+	// Specifies which servers are notified about changes of a primary zone.
+	// Possible values are "NoNotify", "Notify" and "NotifyServers".
+	Notify string
+
+	// Specifies the servers that are notified with the notify setting "NotifyServers".
+	NotifyServers []netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to update a zone of the given zone type.
+func (params ZoneUpdateParams) pwshCommand(zoneType string) string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServer%sZone -Name '%s'", zoneType, params.Name)}
+
+	// Add parameters
+	if params.ReplicationScope != "" {
+		cmd = append(cmd, fmt.Sprintf("-ReplicationScope '%s'", params.ReplicationScope))
+	}
+	if params.DirectoryPartitionName != "" {
+		cmd = append(cmd, fmt.Sprintf("-DirectoryPartitionName '%s'", params.DirectoryPartitionName))
+	}
+	if len(params.MasterServers) > 0 {
+		cmd = append(cmd, fmt.Sprintf("-MasterServers %s", pwshIPAddressList(params.MasterServers)))
+	}
+	if params.DynamicUpdate != "" {
+		cmd = append(cmd, fmt.Sprintf("-DynamicUpdate '%s'", params.DynamicUpdate))
+	}
+	cmd = append(cmd, zoneTransferParams(params.SecureSecondaries, params.SecondaryServers, params.Notify, params.NotifyServers)...)
+
+	cmd = append(cmd, fmt.Sprintf("-ErrorAction Stop ;Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Name))
+	return strings.Join(cmd, " ")
+}
+
+// ZoneUpdate updates the settings of a primary, secondary or stub zone. It returns a Zone object.
+// The zone is read first to select the settings that are supported by its zone type.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneUpdate(ctx context.Context, params ZoneUpdateParams) (Zone, error) {
+	var z Zone
+	var o zoneObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return z, errors.New("windows.dns.ZoneUpdate: zone parameter 'Name' must be set")
+	}
+
+	// Read the zone type.
+	current, err := c.ZoneRead(ctx, ZoneReadParams{Name: params.Name})
+	if err != nil {
+		return z, fmt.Errorf("windows.dns.ZoneUpdate: %w", err)
+	}
+
+	// Assert the parameters of the zone type.
+	switch current.ZoneType {
+	case "Primary":
+		if len(params.MasterServers) > 0 {
+			return z, errors.New("windows.dns.ZoneUpdate: zone parameter 'MasterServers' can only be set for secondary and stub zones")
+		}
+	case "Secondary", "Stub":
+		if params.DynamicUpdate != "" || params.SecureSecondaries != "" || params.SecondaryServers != nil || params.Notify != "" || params.NotifyServers != nil {
+			return z, errors.New("windows.dns.ZoneUpdate: zone parameters 'DynamicUpdate', 'SecureSecondaries', 'SecondaryServers', 'Notify' and 'NotifyServers' can only be set for primary zones")
+		}
+		if current.ZoneType == "Secondary" && (params.ReplicationScope != "" || params.DirectoryPartitionName != "") {
+			return z, errors.New("windows.dns.ZoneUpdate: zone parameters 'ReplicationScope' and 'DirectoryPartitionName' can not be set for secondary zones")
+		}
+	default:
+		return z, fmt.Errorf("windows.dns.ZoneUpdate: zones of the type '%s' can not be updated", current.ZoneType)
+	}
+
+	if err := validateZoneTransfer(params.SecureSecondaries, params.SecondaryServers, params.Notify, params.NotifyServers); err != nil {
+		return z, fmt.Errorf("windows.dns.ZoneUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand(current.ZoneType)
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return z, winerror.Errorf(cmd, "windows.dns.ZoneUpdate: %w", err)
+	}
+
+	// Convert the output to a Zone object.
+	z.convertOutput(o)
+
+	return z, nil
+}
+
+// ZoneDeleteParams represents parameters for the ZoneDelete function.
+type ZoneDeleteParams struct {
+	// Specifies the name of the zone.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to delete a zone.
+func (params ZoneDeleteParams) pwshCommand() string {
+	return fmt.Sprintf("Remove-DnsServerZone -Force -Name '%s'", params.Name)
+}
+
+// ZoneDelete deletes a zone with all its records.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneDelete(ctx context.Context, params ZoneDeleteParams) error {
+	var o zoneObject
+
+	// Assert needed parameters
+	if params.Name == "" {
+		return errors.New("windows.dns.ZoneDelete: zone parameter 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ZoneDelete: %w", err)
+	}
+
+	return nil
+}
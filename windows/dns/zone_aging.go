@@ -0,0 +1,176 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/parsing"
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ZoneAging represents the aging settings of a zone.
+// Records with a timestamp become stale after the no-refresh and the refresh interval
+// and are removed by the next scavenging of the server.
+type ZoneAging struct {
+	ZoneName             string
+	AgingEnabled         bool
+	NoRefreshInterval    time.Duration
+	RefreshInterval      time.Duration
+	ScavengeServers      []netip.Addr
+	AvailForScavengeTime time.Time
+}
+
+// zoneAgingObject contains the unmarshaled json of the powershell zone aging object.
+type zoneAgingObject struct {
+	ZoneName             string                   `json:"ZoneName"`
+	AgingEnabled         bool                     `json:"AgingEnabled"`
+	NoRefreshInterval    parsing.CimTimeDuration  `json:"NoRefreshInterval"`
+	RefreshInterval      parsing.CimTimeDuration  `json:"RefreshInterval"`
+	ScavengeServers      parsing.CimIpAddressList `json:"ScavengeServers"`
+	AvailForScavengeTime parsing.DotnetTime       `json:"AvailForScavengeTime"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the zoneAgingObject to a ZoneAging object.
+func (a *ZoneAging) convertOutput(o zoneAgingObject) {
+	a.ZoneName = o.ZoneName
+	a.AgingEnabled = o.AgingEnabled
+	a.NoRefreshInterval = o.NoRefreshInterval.Duration
+	a.RefreshInterval = o.RefreshInterval.Duration
+	a.ScavengeServers = o.ScavengeServers
+	a.AvailForScavengeTime = o.AvailForScavengeTime.Time
+}
+
+// maxTimeSpan is the maximum duration of the pwshTimeSpan function.
+const maxTimeSpan time.Duration = math.MaxInt32 * time.Second
+
+// pwshTimeSpan returns the PowerShell expression of a duration, e.g. "$(New-TimeSpan -Seconds 3600)".
+// New-TimeSpan only allows int32 values. So we round the duration to seconds.
+// Durations that exceed maxTimeSpan must be rejected before, see exceedsTimeSpan.
+// https://learn.microsoft.com/de-de/powershell/module/microsoft.powershell.utility/new-timespan?view=powershell-7.4
+func pwshTimeSpan(d time.Duration) string {
+	return fmt.Sprintf("$(New-TimeSpan -Seconds %d)", int32(d.Round(time.Second).Seconds()))
+}
+
+// exceedsTimeSpan returns true if one of the durations exceeds maxTimeSpan after it is rounded to seconds.
+func exceedsTimeSpan(durations ...time.Duration) bool {
+	for _, d := range durations {
+		if d.Round(time.Second) > maxTimeSpan {
+			return true
+		}
+	}
+	return false
+}
+
+// ZoneAgingReadParams represents parameters for the ZoneAgingRead function.
+type ZoneAgingReadParams struct {
+	// Specifies the name of the zone.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to read the aging settings of a zone.
+func (params ZoneAgingReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsServerZoneAging -Name '%s' | ConvertTo-Json -Compress", params.Zone)
+}
+
+// ZoneAgingRead gets the aging settings of a zone. It returns a ZoneAging object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneAgingRead(ctx context.Context, params ZoneAgingReadParams) (ZoneAging, error) {
+	var a ZoneAging
+	var o zoneAgingObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return a, errors.New("windows.dns.ZoneAgingRead: aging parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return a, winerror.Errorf(cmd, "windows.dns.ZoneAgingRead: %w", err)
+	}
+
+	// Convert the output to a ZoneAging object.
+	a.convertOutput(o)
+
+	return a, nil
+}
+
+// ZoneAgingUpdateParams represents parameters for the ZoneAgingUpdate function.
+// The aging is always set, the intervals and scavenge servers that are not set keep their current value.
+type ZoneAgingUpdateParams struct {
+	// Specifies the name of the zone.
+	Zone string
+
+	// Specifies whether the records of the zone age and can be scavenged.
+	Aging bool
+
+	// Specifies the time after the last refresh of a record in which its timestamp is not refreshed.
+	NoRefreshInterval time.Duration
+
+	// Specifies the time after the no-refresh interval in which a record can be refreshed before it becomes stale.
+	RefreshInterval time.Duration
+
+	// Specifies the servers that are allowed to scavenge the zone.
+	// An empty list allows all servers that host the zone.
+	ScavengeServers []netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to update the aging settings of a zone.
+func (params ZoneAgingUpdateParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsServerZoneAging -Name '%s'", params.Zone)}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-Aging $%t", params.Aging))
+	if params.NoRefreshInterval != 0 {
+		cmd = append(cmd, fmt.Sprintf("-NoRefreshInterval %s", pwshTimeSpan(params.NoRefreshInterval)))
+	}
+	if params.RefreshInterval != 0 {
+		cmd = append(cmd, fmt.Sprintf("-RefreshInterval %s", pwshTimeSpan(params.RefreshInterval)))
+	}
+	if params.ScavengeServers != nil {
+		cmd = append(cmd, fmt.Sprintf("-ScavengeServers %s", pwshIPAddressList(params.ScavengeServers)))
+	}
+
+	cmd = append(cmd, fmt.Sprintf("-ErrorAction Stop ;Get-DnsServerZoneAging -Name '%s' | ConvertTo-Json -Compress", params.Zone))
+	return strings.Join(cmd, " ")
+}
+
+// ZoneAgingUpdate updates the aging settings of a zone. It returns a ZoneAging object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneAgingUpdate(ctx context.Context, params ZoneAgingUpdateParams) (ZoneAging, error) {
+	var a ZoneAging
+	var o zoneAgingObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return a, errors.New("windows.dns.ZoneAgingUpdate: aging parameter 'Zone' must be set")
+	}
+	if params.NoRefreshInterval < 0 || params.RefreshInterval < 0 {
+		return a, errors.New("windows.dns.ZoneAgingUpdate: aging parameters 'NoRefreshInterval' and 'RefreshInterval' must not be negative")
+	}
+	if exceedsTimeSpan(params.NoRefreshInterval, params.RefreshInterval) {
+		return a, fmt.Errorf("windows.dns.ZoneAgingUpdate: aging parameters 'NoRefreshInterval' and 'RefreshInterval' must not exceed %s", maxTimeSpan)
+	}
+	for _, address := range params.ScavengeServers {
+		if !address.IsValid() {
+			return a, errors.New("windows.dns.ZoneAgingUpdate: aging parameter 'ScavengeServers' must be a list of valid IP addresses")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return a, winerror.Errorf(cmd, "windows.dns.ZoneAgingUpdate: %w", err)
+	}
+
+	// Convert the output to a ZoneAging object.
+	a.convertOutput(o)
+
+	return a, nil
+}
@@ -0,0 +1,166 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/netip"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	zoneAgingJson = `{"AgingEnabled":true,"AvailForScavengeTime":"\/Date(1704067200000)\/","NoRefreshInterval":{"Ticks":6048000000000,"Days":7,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":7,"TotalHours":168,"TotalMilliseconds":604800000,"TotalMinutes":10080,"TotalSeconds":604800},"RefreshInterval":{"Ticks":6048000000000,"Days":7,"Hours":0,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":7,"TotalHours":168,"TotalMilliseconds":604800000,"TotalMinutes":10080,"TotalSeconds":604800},"ScavengeServers":[{"Address":16843009,"AddressFamily":2,"IPAddressToString":"1.1.1.1"}],"ZoneName":"test.local","PSComputerName":null}`
+)
+
+var expectedZoneAging = ZoneAging{
+	ZoneName:             "test.local",
+	AgingEnabled:         true,
+	NoRefreshInterval:    7 * 24 * time.Hour,
+	RefreshInterval:      7 * 24 * time.Hour,
+	ScavengeServers:      []netip.Addr{netip.MustParseAddr("1.1.1.1")},
+	AvailForScavengeTime: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+}
+
+// Test ZoneAgingRead related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneAgingRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct aging settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZoneAging -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneAgingJson}, nil)
+		actual, err := c.ZoneAgingRead(ctx, ZoneAgingReadParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal(expectedZoneAging, actual)
+	})
+
+	suite.Run("should return error if zone is missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ZoneAgingRead(ctx, ZoneAgingReadParams{})
+		suite.EqualError(err, "windows.dns.ZoneAgingRead: aging parameter 'Zone' must be set")
+	})
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZoneAging -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.ZoneAgingRead(ctx, ZoneAgingReadParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.ZoneAgingRead: test-error")
+	})
+}
+
+// Test ZoneAgingUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneAgingUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneAgingUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with aging only",
+				ZoneAgingUpdateParams{Zone: "test.local"},
+				"Set-DnsServerZoneAging -Name 'test.local' -Aging $false -ErrorAction Stop ;Get-DnsServerZoneAging -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with all parameters",
+				ZoneAgingUpdateParams{Zone: "test.local", Aging: true, NoRefreshInterval: 48 * time.Hour, RefreshInterval: 72 * time.Hour, ScavengeServers: []netip.Addr{netip.MustParseAddr("1.1.1.1")}},
+				"Set-DnsServerZoneAging -Name 'test.local' -Aging $true -NoRefreshInterval $(New-TimeSpan -Seconds 172800) -RefreshInterval $(New-TimeSpan -Seconds 259200) -ScavengeServers @('1.1.1.1') -ErrorAction Stop ;Get-DnsServerZoneAging -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneAgingUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return the updated aging settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Set-DnsServerZoneAging -Name 'test.local' -Aging $true -ScavengeServers @('1.1.1.1') -ErrorAction Stop ;Get-DnsServerZoneAging -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneAgingJson}, nil)
+		actual, err := c.ZoneAgingUpdate(ctx, ZoneAgingUpdateParams{Zone: "test.local", Aging: true, ScavengeServers: []netip.Addr{netip.MustParseAddr("1.1.1.1")}})
+		suite.NoError(err)
+		suite.Equal(expectedZoneAging, actual)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneAgingUpdateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with missing zone",
+				ZoneAgingUpdateParams{Aging: true},
+				"windows.dns.ZoneAgingUpdate: aging parameter 'Zone' must be set",
+			},
+			{
+				"assert error with a negative interval",
+				ZoneAgingUpdateParams{Zone: "test.local", RefreshInterval: -time.Hour},
+				"windows.dns.ZoneAgingUpdate: aging parameters 'NoRefreshInterval' and 'RefreshInterval' must not be negative",
+			},
+			{
+				"assert error with an interval that exceeds the maximum time span",
+				ZoneAgingUpdateParams{Zone: "test.local", NoRefreshInterval: (math.MaxInt32 + 1) * time.Second},
+				"windows.dns.ZoneAgingUpdate: aging parameters 'NoRefreshInterval' and 'RefreshInterval' must not exceed 596523h14m7s",
+			},
+			{
+				"assert error with an invalid scavenge server",
+				ZoneAgingUpdateParams{Zone: "test.local", ScavengeServers: []netip.Addr{{}}},
+				"windows.dns.ZoneAgingUpdate: aging parameter 'ScavengeServers' must be a list of valid IP addresses",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.ZoneAgingUpdate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
@@ -0,0 +1,340 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ZoneDelegation represents the delegation of a child zone to other name servers.
+type ZoneDelegation struct {
+	ChildZoneName string
+	NameServers   []ZoneDelegationNameServer
+}
+
+// ZoneDelegationNameServer represents a name server of a zone delegation with its glue records.
+type ZoneDelegationNameServer struct {
+	// Specifies the fully qualified domain name of the name server.
+	NameServer string
+
+	// Specifies the IPv4 and IPv6 addresses of the name server.
+	// The addresses are added as glue A- and AAAA-Records to the parent zone.
+	GlueAddresses []netip.Addr
+}
+
+// delegationObject contains the unmarshaled json of a name server of the powershell zone delegation object.
+type delegationObject struct {
+	ChildZoneName string   `json:"ChildZoneName"`
+	NameServer    string   `json:"NameServer"`
+	IPAddress     []string `json:"IPAddress"`
+}
+
+// delegationReadCommand returns the PowerShell command to read a zone delegation.
+// Every name server of the delegation is reduced to its name and the addresses of its glue records.
+func delegationReadCommand(zone string, childZoneName string) string {
+	return fmt.Sprintf(
+		"$d=@(Get-DnsServerZoneDelegation -Name '%s' -ChildZoneName '%s' | ForEach-Object{[pscustomobject]@{ChildZoneName=$_.ChildZoneName;NameServer=$_.NameServer.RecordData.NameServer;IPAddress=@($_.IPAddress.RecordData | ForEach-Object{\"$($_.IPv4Address)$($_.IPv6Address)\"})}}) ;if($d.Count -ge 2){ConvertTo-Json $d -Compress}else{ConvertTo-Json @($d) -Compress}",
+		zone,
+		childZoneName,
+	)
+}
+
+// pwshIPAddressList returns the PowerShell array of IP addresses, e.g. "@('192.168.1.1','fe80::1')".
+func pwshIPAddressList(addresses []netip.Addr) string {
+	addressList := []string{}
+	for _, address := range addresses {
+		addressList = append(addressList, fmt.Sprintf("'%s'", address.String()))
+	}
+	return fmt.Sprintf("@(%s)", strings.Join(addressList, ","))
+}
+
+// convertOutput converts the unmarshaled JSON output from the delegationObject to a ZoneDelegation object.
+func (d *ZoneDelegation) convertOutput(o []delegationObject) error {
+	if len(o) == 0 {
+		return errors.New("zone delegation not found")
+	}
+
+	d.ChildZoneName = o[0].ChildZoneName
+
+	for _, nameServer := range o {
+		ns := ZoneDelegationNameServer{NameServer: nameServer.NameServer}
+
+		for _, address := range nameServer.IPAddress {
+			// Name servers without glue records return an empty address.
+			if address == "" {
+				continue
+			}
+
+			ip, err := netip.ParseAddr(address)
+			if err != nil {
+				return err
+			}
+			ns.GlueAddresses = append(ns.GlueAddresses, ip)
+		}
+
+		d.NameServers = append(d.NameServers, ns)
+	}
+
+	return nil
+}
+
+// ZoneDelegationReadParams represents parameters for the ZoneDelegationRead function.
+type ZoneDelegationReadParams struct {
+	// Specifies the name of the parent zone.
+	Zone string
+
+	// Specifies the name of the delegated child zone, e.g. "child" for "child.test.local".
+	ChildZoneName string
+}
+
+// pwshCommand returns the PowerShell command to read a zone delegation.
+func (params ZoneDelegationReadParams) pwshCommand() string {
+	return delegationReadCommand(params.Zone, params.ChildZoneName)
+}
+
+// ZoneDelegationRead gets a zone delegation by its parent zone and child zone name.
+// The delegation is reconstructed from the NS-Records and glue records of the parent zone.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneDelegationRead(ctx context.Context, params ZoneDelegationReadParams) (ZoneDelegation, error) {
+	var d ZoneDelegation
+	var o []delegationObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.ChildZoneName == "" {
+		return d, errors.New("windows.dns.ZoneDelegationRead: delegation parameters 'Zone' and 'ChildZoneName' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationRead: %w", err)
+	}
+
+	// Convert the output to a ZoneDelegation object.
+	if err := d.convertOutput(o); err != nil {
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationRead: %w", err)
+	}
+
+	return d, nil
+}
+
+// ZoneDelegationCreateParams represents parameters for the ZoneDelegationCreate function.
+type ZoneDelegationCreateParams struct {
+	// Specifies the name of the parent zone.
+	Zone string
+
+	// Specifies the name of the delegated child zone, e.g. "child" for "child.test.local".
+	ChildZoneName string
+
+	// Specifies the name servers of the child zone.
+	// Each name server must have at least one glue address.
+	NameServers []ZoneDelegationNameServer
+}
+
+// pwshCommand returns the PowerShell command to create a zone delegation.
+func (params ZoneDelegationCreateParams) pwshCommand() string {
+	adds := []string{}
+
+	// The cmdlet only accepts a single name server, so the delegation is added for each name server.
+	// The ErrorAction stops the command at the first name server that cannot be added.
+	for _, ns := range params.NameServers {
+		adds = append(adds, pwshAddDelegation(params.Zone, params.ChildZoneName, ns))
+	}
+
+	return fmt.Sprintf(
+		"$n=@() ;try{%s}catch{%s ;throw $_} ;%s",
+		strings.Join(adds, " ;"),
+		pwshRemoveAddedDelegations(params.Zone, params.ChildZoneName),
+		delegationReadCommand(params.Zone, params.ChildZoneName),
+	)
+}
+
+// pwshAddDelegation returns the PowerShell command to add a name server to a zone delegation.
+// The name server is collected in $n, so that it can be removed again if a later command fails.
+func pwshAddDelegation(zone string, childZoneName string, ns ZoneDelegationNameServer) string {
+	return fmt.Sprintf(
+		"Add-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name '%s' -ChildZoneName '%s' -NameServer '%s' -IPAddress %s ;$n+='%s'",
+		zone,
+		childZoneName,
+		ns.NameServer,
+		pwshIPAddressList(ns.GlueAddresses),
+		ns.NameServer,
+	)
+}
+
+// pwshRemoveAddedDelegations returns the PowerShell command to remove the name servers collected in $n from a zone delegation.
+func pwshRemoveAddedDelegations(zone string, childZoneName string) string {
+	return fmt.Sprintf(
+		"$n|ForEach-Object{Remove-DnsServerZoneDelegation -Force -ErrorAction SilentlyContinue -Name '%s' -ChildZoneName '%s' -NameServer $_}",
+		zone,
+		childZoneName,
+	)
+}
+
+// ZoneDelegationCreate delegates a child zone of a parent zone to other name servers.
+// If a name server cannot be added, the name servers that were already added are removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneDelegationCreate(ctx context.Context, params ZoneDelegationCreateParams) (ZoneDelegation, error) {
+	var d ZoneDelegation
+	var o []delegationObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.ChildZoneName == "" || len(params.NameServers) == 0 {
+		return d, errors.New("windows.dns.ZoneDelegationCreate: delegation parameters 'Zone', 'ChildZoneName' and 'NameServers' must be set")
+	}
+
+	if err := validateDelegationNameServers(params.NameServers); err != nil {
+		return d, fmt.Errorf("windows.dns.ZoneDelegationCreate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		// Handle delegation already exists error.
+		if winerror.Category(err) == winerror.CategoryResourceExists {
+			return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationCreate: the specified delegation already exists")
+		}
+
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationCreate: %w", err)
+	}
+
+	// Convert the output to a ZoneDelegation object.
+	if err := d.convertOutput(o); err != nil {
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationCreate: %w", err)
+	}
+
+	return d, nil
+}
+
+// validateDelegationNameServers returns an error if a name server has no name or no glue addresses.
+func validateDelegationNameServers(nameServers []ZoneDelegationNameServer) error {
+	for _, ns := range nameServers {
+		if ns.NameServer == "" || len(ns.GlueAddresses) == 0 {
+			return errors.New("delegation parameters 'NameServer' and 'GlueAddresses' must be set for all name servers")
+		}
+
+		for _, address := range ns.GlueAddresses {
+			if !address.IsValid() {
+				return errors.New("delegation parameter 'GlueAddresses' must be a list of valid IP addresses")
+			}
+		}
+	}
+	return nil
+}
+
+// ZoneDelegationUpdateParams represents parameters for the ZoneDelegationUpdate function.
+type ZoneDelegationUpdateParams struct {
+	// Specifies the name of the parent zone.
+	Zone string
+
+	// Specifies the name of the delegated child zone, e.g. "child" for "child.test.local".
+	ChildZoneName string
+
+	// Specifies all name servers of the child zone with their glue addresses.
+	// Name servers that are not part of the delegation are added,
+	// name servers of the delegation that are not specified are removed.
+	NameServers []ZoneDelegationNameServer
+}
+
+// pwshCommand returns the PowerShell command to update a zone delegation.
+// The name servers are added or updated first and the name servers that are not specified are removed afterwards,
+// so that the delegation keeps at least one name server.
+func (params ZoneDelegationUpdateParams) pwshCommand() string {
+	changes := []string{}
+	nameServers := []string{}
+
+	for _, ns := range params.NameServers {
+		// The existing name servers are returned as fully qualified domain names.
+		ns.NameServer = strings.TrimSuffix(ns.NameServer, ".") + "."
+		nameServers = append(nameServers, fmt.Sprintf("'%s'", ns.NameServer))
+
+		changes = append(changes, fmt.Sprintf(
+			"if($e -contains '%s'){Set-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name '%s' -ChildZoneName '%s' -NameServer '%s' -IPAddress %s}else{%s}",
+			ns.NameServer,
+			params.Zone,
+			params.ChildZoneName,
+			ns.NameServer,
+			pwshIPAddressList(ns.GlueAddresses),
+			pwshAddDelegation(params.Zone, params.ChildZoneName, ns),
+		))
+	}
+
+	return fmt.Sprintf(
+		"$e=@(Get-DnsServerZoneDelegation -Name '%s' -ChildZoneName '%s' -ErrorAction Stop | ForEach-Object{$_.NameServer.RecordData.NameServer}) ;$n=@() ;try{%s}catch{%s ;throw $_} ;$e|Where-Object{@(%s) -notcontains $_}|ForEach-Object{Remove-DnsServerZoneDelegation -Force -ErrorAction Stop -Name '%s' -ChildZoneName '%s' -NameServer $_} ;%s",
+		params.Zone,
+		params.ChildZoneName,
+		strings.Join(changes, " ;"),
+		pwshRemoveAddedDelegations(params.Zone, params.ChildZoneName),
+		strings.Join(nameServers, ","),
+		params.Zone,
+		params.ChildZoneName,
+		delegationReadCommand(params.Zone, params.ChildZoneName),
+	)
+}
+
+// ZoneDelegationUpdate updates the name servers of a zone delegation and their glue addresses.
+// If a name server cannot be added, the name servers that were already added are removed again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneDelegationUpdate(ctx context.Context, params ZoneDelegationUpdateParams) (ZoneDelegation, error) {
+	var d ZoneDelegation
+	var o []delegationObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.ChildZoneName == "" || len(params.NameServers) == 0 {
+		return d, errors.New("windows.dns.ZoneDelegationUpdate: delegation parameters 'Zone', 'ChildZoneName' and 'NameServers' must be set")
+	}
+
+	if err := validateDelegationNameServers(params.NameServers); err != nil {
+		return d, fmt.Errorf("windows.dns.ZoneDelegationUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationUpdate: %w", err)
+	}
+
+	// Convert the output to a ZoneDelegation object.
+	if err := d.convertOutput(o); err != nil {
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDelegationUpdate: %w", err)
+	}
+
+	return d, nil
+}
+
+// ZoneDelegationDeleteParams represents parameters for the ZoneDelegationDelete function.
+type ZoneDelegationDeleteParams struct {
+	// Specifies the name of the parent zone.
+	Zone string
+
+	// Specifies the name of the delegated child zone, e.g. "child" for "child.test.local".
+	ChildZoneName string
+}
+
+// pwshCommand returns the PowerShell command to delete a zone delegation.
+func (params ZoneDelegationDeleteParams) pwshCommand() string {
+	return fmt.Sprintf("Remove-DnsServerZoneDelegation -Force -Name '%s' -ChildZoneName '%s'", params.Zone, params.ChildZoneName)
+}
+
+// ZoneDelegationDelete removes a zone delegation with all its name servers and glue records.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneDelegationDelete(ctx context.Context, params ZoneDelegationDeleteParams) error {
+	var o []delegationObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.ChildZoneName == "" {
+		return errors.New("windows.dns.ZoneDelegationDelete: delegation parameters 'Zone' and 'ChildZoneName' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ZoneDelegationDelete: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package dns
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	zoneDelegationJson = `[{"ChildZoneName":"child.test.local","NameServer":"ns1.child.test.local.","IPAddress":["192.168.10.1","fd00::1"]},{"ChildZoneName":"child.test.local","NameServer":"ns2.external.local.","IPAddress":[""]}]`
+)
+
+var (
+	expectedZoneDelegation = ZoneDelegation{
+		ChildZoneName: "child.test.local",
+		NameServers: []ZoneDelegationNameServer{
+			{NameServer: "ns1.child.test.local.", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("fd00::1")}},
+			{NameServer: "ns2.external.local."},
+		},
+	}
+	zoneDelegationReadCmd = "$d=@(Get-DnsServerZoneDelegation -Name 'test.local' -ChildZoneName 'child' | ForEach-Object{[pscustomobject]@{ChildZoneName=$_.ChildZoneName;NameServer=$_.NameServer.RecordData.NameServer;IPAddress=@($_.IPAddress.RecordData | ForEach-Object{\"$($_.IPv4Address)$($_.IPv6Address)\"})}}) ;if($d.Count -ge 2){ConvertTo-Json $d -Compress}else{ConvertTo-Json @($d) -Compress}"
+)
+
+// Test the convertOutput method.
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationConvertOutput() {
+	suite.Run("should return the correct ZoneDelegation object", func() {
+		o := []delegationObject{
+			{ChildZoneName: "child.test.local", NameServer: "ns1.child.test.local.", IPAddress: []string{"192.168.10.1", "fd00::1"}},
+			{ChildZoneName: "child.test.local", NameServer: "ns2.external.local.", IPAddress: []string{""}},
+		}
+
+		d := ZoneDelegation{}
+		err := d.convertOutput(o)
+		suite.NoError(err)
+		suite.Equal(expectedZoneDelegation, d)
+	})
+
+	suite.Run("should return an error", func() {
+		tcs := []struct {
+			description string
+			input       []delegationObject
+		}{
+			{"assert error without name servers", []delegationObject{}},
+			{"assert error with an invalid glue address", []delegationObject{{NameServer: "ns1.child.test.local.", IPAddress: []string{"invalid"}}}},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			d := ZoneDelegation{}
+			suite.Error(d.convertOutput(tc.input))
+		}
+	})
+}
+
+// Test ZoneDelegationRead related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationReadPwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := ZoneDelegationReadParams{Zone: "test.local", ChildZoneName: "child"}.pwshCommand()
+		suite.Equal(zoneDelegationReadCmd, actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationRead() {
+	suite.Run("should return the correct zone delegation", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, zoneDelegationReadCmd).
+			Return(connection.CmdResult{StdOut: zoneDelegationJson}, nil)
+		actualZoneDelegation, err := c.ZoneDelegationRead(ctx, ZoneDelegationReadParams{Zone: "test.local", ChildZoneName: "child"})
+		suite.NoError(err)
+		suite.Equal(expectedZoneDelegation, actualZoneDelegation)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return "", nil },
+		}
+		_, err := c.ZoneDelegationRead(ctx, ZoneDelegationReadParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.ZoneDelegationRead: delegation parameters 'Zone' and 'ChildZoneName' must be set")
+	})
+}
+
+// Test ZoneDelegationCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := ZoneDelegationCreateParams{
+			Zone:          "test.local",
+			ChildZoneName: "child",
+			NameServers: []ZoneDelegationNameServer{
+				{NameServer: "ns1.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("fd00::1")}},
+				{NameServer: "ns2.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.2")}},
+			},
+		}.pwshCommand()
+		suite.Equal("$n=@() ;try{Add-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer 'ns1.child.test.local' -IPAddress @('192.168.10.1','fd00::1') ;$n+='ns1.child.test.local' ;Add-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer 'ns2.child.test.local' -IPAddress @('192.168.10.2') ;$n+='ns2.child.test.local'}catch{$n|ForEach-Object{Remove-DnsServerZoneDelegation -Force -ErrorAction SilentlyContinue -Name 'test.local' -ChildZoneName 'child' -NameServer $_} ;throw $_} ;"+zoneDelegationReadCmd, actualCmd)
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneDelegationCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without name servers",
+				ZoneDelegationCreateParams{Zone: "test.local", ChildZoneName: "child"},
+				"windows.dns.ZoneDelegationCreate: delegation parameters 'Zone', 'ChildZoneName' and 'NameServers' must be set",
+			},
+			{
+				"assert error without glue addresses",
+				ZoneDelegationCreateParams{Zone: "test.local", ChildZoneName: "child", NameServers: []ZoneDelegationNameServer{{NameServer: "ns1.child.test.local"}}},
+				"windows.dns.ZoneDelegationCreate: delegation parameters 'NameServer' and 'GlueAddresses' must be set for all name servers",
+			},
+			{
+				"assert error with an invalid glue address",
+				ZoneDelegationCreateParams{Zone: "test.local", ChildZoneName: "child", NameServers: []ZoneDelegationNameServer{{NameServer: "ns1.child.test.local", GlueAddresses: []netip.Addr{{}}}}},
+				"windows.dns.ZoneDelegationCreate: delegation parameter 'GlueAddresses' must be a list of valid IP addresses",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.ZoneDelegationCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ZoneDelegationUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := ZoneDelegationUpdateParams{
+			Zone:          "test.local",
+			ChildZoneName: "child",
+			NameServers: []ZoneDelegationNameServer{
+				{NameServer: "ns1.child.test.local", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.3")}},
+				{NameServer: "ns3.child.test.local.", GlueAddresses: []netip.Addr{netip.MustParseAddr("192.168.10.4")}},
+			},
+		}.pwshCommand()
+		suite.Equal("$e=@(Get-DnsServerZoneDelegation -Name 'test.local' -ChildZoneName 'child' -ErrorAction Stop | ForEach-Object{$_.NameServer.RecordData.NameServer}) ;$n=@() ;try{if($e -contains 'ns1.child.test.local.'){Set-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer 'ns1.child.test.local.' -IPAddress @('192.168.10.3')}else{Add-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer 'ns1.child.test.local.' -IPAddress @('192.168.10.3') ;$n+='ns1.child.test.local.'} ;if($e -contains 'ns3.child.test.local.'){Set-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer 'ns3.child.test.local.' -IPAddress @('192.168.10.4')}else{Add-DnsServerZoneDelegation -Confirm:$false -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer 'ns3.child.test.local.' -IPAddress @('192.168.10.4') ;$n+='ns3.child.test.local.'}}catch{$n|ForEach-Object{Remove-DnsServerZoneDelegation -Force -ErrorAction SilentlyContinue -Name 'test.local' -ChildZoneName 'child' -NameServer $_} ;throw $_} ;$e|Where-Object{@('ns1.child.test.local.','ns3.child.test.local.') -notcontains $_}|ForEach-Object{Remove-DnsServerZoneDelegation -Force -ErrorAction Stop -Name 'test.local' -ChildZoneName 'child' -NameServer $_} ;"+zoneDelegationReadCmd, actualCmd)
+	})
+}
+
+// Test ZoneDelegationDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneDelegationDeletePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := ZoneDelegationDeleteParams{Zone: "test.local", ChildZoneName: "child"}.pwshCommand()
+		suite.Equal("Remove-DnsServerZoneDelegation -Force -Name 'test.local' -ChildZoneName 'child'", actualCmd)
+	})
+}
@@ -0,0 +1,742 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxZoneFileTTL is the maximum TTL of a record in a zone file.
+// https://www.rfc-editor.org/rfc/rfc2181#section-8
+const maxZoneFileTTL time.Duration = time.Second * 2147483647
+
+// zoneFileTTLUnits contains the units of a TTL in the BIND format, e.g. "1h30m".
+var zoneFileTTLUnits = map[rune]time.Duration{
+	'w': time.Hour * 24 * 7,
+	'd': time.Hour * 24,
+	'h': time.Hour,
+	'm': time.Minute,
+	's': time.Second,
+}
+
+// zoneFileField represents a single field of a zone file entry.
+type zoneFileField struct {
+	value  string
+	quoted bool
+}
+
+// zoneFileEntry represents a logical line of a zone file.
+// Lines that are continued with parentheses are combined into a single entry.
+type zoneFileEntry struct {
+	line   int
+	blank  bool // The line starts with a blank, so the owner of the previous entry is used.
+	fields []zoneFileField
+}
+
+// splitZoneFile splits the content of a zone file into entries and their fields.
+// Comments are removed and quoted strings are decoded, other fields keep their escape sequences.
+func splitZoneFile(data string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var field strings.Builder
+	inField := false
+	depth := 0
+	line := 1
+
+	startsBlank := func(i int) bool {
+		return i < len(data) && (data[i] == ' ' || data[i] == '\t')
+	}
+	entry := zoneFileEntry{line: line, blank: startsBlank(0)}
+
+	endField := func() {
+		if inField {
+			entry.fields = append(entry.fields, zoneFileField{value: field.String()})
+			field.Reset()
+			inField = false
+		}
+	}
+
+	for i := 0; i < len(data); i++ {
+		switch ch := data[i]; ch {
+		case '\n':
+			endField()
+			line++
+			if depth == 0 {
+				if len(entry.fields) > 0 {
+					entries = append(entries, entry)
+				}
+				entry = zoneFileEntry{line: line, blank: startsBlank(i + 1)}
+			}
+		case ' ', '\t', '\r':
+			endField()
+		case ';':
+			for i+1 < len(data) && data[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			endField()
+			depth++
+		case ')':
+			endField()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected closing parenthesis", line)
+			}
+			depth--
+		case '"':
+			endField()
+
+			// Read the quoted string up to the closing quote.
+			start := i + 1
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+				if i < len(data) && data[i] == '\n' {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+				}
+			}
+			if i >= len(data) {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+
+			value, err := decodeZoneFileString(data[start:i])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			entry.fields = append(entry.fields, zoneFileField{value: value, quoted: true})
+		case '\\':
+			// Keep the escape sequence, so the escaped character is not interpreted.
+			field.WriteByte(ch)
+			if i+1 < len(data) {
+				i++
+				field.WriteByte(data[i])
+			}
+			inField = true
+		default:
+			field.WriteByte(ch)
+			inField = true
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unterminated parenthesis", entry.line)
+	}
+
+	endField()
+	if len(entry.fields) > 0 {
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// decodeZoneFileString decodes the escape sequences of a character string.
+// "\X" is decoded to the character X and "\DDD" to the byte with the decimal value DDD.
+// https://www.rfc-editor.org/rfc/rfc1035#section-5.1
+func decodeZoneFileString(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		if i >= len(s) {
+			return "", errors.New("incomplete escape sequence")
+		}
+
+		if s[i] < '0' || s[i] > '9' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if i+3 > len(s) {
+			return "", fmt.Errorf("invalid escape sequence '\\%s'", s[i:])
+		}
+		value, err := strconv.ParseUint(s[i:i+3], 10, 8)
+		if err != nil {
+			return "", fmt.Errorf("invalid escape sequence '\\%s'", s[i:i+3])
+		}
+		b.WriteByte(byte(value))
+		i += 2
+	}
+
+	return b.String(), nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in the BIND format with units, e.g. "1h30m".
+// It returns false if the value is not a valid TTL.
+func parseZoneFileTTL(s string) (time.Duration, bool) {
+	var ttl, value time.Duration
+	digits := false
+
+	for _, ch := range strings.ToLower(s) {
+		unit, isUnit := zoneFileTTLUnits[ch]
+		switch {
+		case ch >= '0' && ch <= '9':
+			value = value*10 + time.Duration(ch-'0')
+			digits = true
+		case isUnit && digits:
+			ttl += value * unit
+			value = 0
+			digits = false
+		default:
+			return 0, false
+		}
+
+		if value > maxZoneFileTTL || ttl > maxZoneFileTTL {
+			return 0, false
+		}
+	}
+
+	// A value without unit is in seconds.
+	if digits {
+		ttl += value * time.Second
+	}
+
+	if s == "" || ttl > maxZoneFileTTL {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// zoneFileParser contains the state of the ParseZoneFile function.
+type zoneFileParser struct {
+	zone    string
+	origin  string
+	owner   string
+	records Records
+
+	// ttl is the TTL of the $TTL directive and lastTTL the last explicit TTL of a record.
+	// They are nil until they are set.
+	ttl     *time.Duration
+	lastTTL *time.Duration
+
+	// index contains the index of the record sets by their type and name,
+	// so records with the same name and type are combined.
+	index map[string]int
+}
+
+// absoluteName returns the fully qualified domain name with trailing dot of a name relative to the origin.
+func (p *zoneFileParser) absoluteName(name string) string {
+	if name == "@" {
+		return p.origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "." + p.origin
+}
+
+// relativeName returns the name of a record relative to the zone, "@" for the zone itself.
+func (p *zoneFileParser) relativeName(name string) (string, error) {
+	if strings.EqualFold(name, p.zone) {
+		return "@", nil
+	}
+	if !strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(p.zone)) {
+		return "", fmt.Errorf("owner name '%s' is not in zone '%s'", name, p.zone)
+	}
+	return name[:len(name)-len(p.zone)-1], nil
+}
+
+// recordSet returns the index of the record set with the record type and name.
+// If the record set does not exist yet, the index n of the new record set is stored and false is returned.
+func (p *zoneFileParser) recordSet(recordType string, name string, n int) (int, bool) {
+	key := recordType + " " + strings.ToLower(name)
+	if i, ok := p.index[key]; ok {
+		return i, true
+	}
+	p.index[key] = n
+	return n, false
+}
+
+// parseEntry parses a single entry of a zone file.
+func (p *zoneFileParser) parseEntry(entry zoneFileEntry) error {
+	fields := make([]string, len(entry.fields))
+	for i, field := range entry.fields {
+		fields[i] = field.value
+	}
+
+	// Handle the directives.
+	if !entry.blank && strings.HasPrefix(fields[0], "$") {
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) != 2 {
+				return errors.New("$ORIGIN must have a single domain name")
+			}
+			p.origin = p.absoluteName(fields[1])
+			return nil
+		case "$TTL":
+			if len(fields) != 2 {
+				return errors.New("$TTL must have a single TTL")
+			}
+			ttl, ok := parseZoneFileTTL(fields[1])
+			if !ok {
+				return fmt.Errorf("invalid TTL '%s'", fields[1])
+			}
+			p.ttl = &ttl
+			return nil
+		default:
+			return fmt.Errorf("unsupported directive '%s'", fields[0])
+		}
+	}
+
+	// Set the owner of the record.
+	if !entry.blank {
+		p.owner = p.absoluteName(fields[0])
+		fields = fields[1:]
+	}
+	if p.owner == "" {
+		return errors.New("missing owner name")
+	}
+
+	// The TTL and the class are optional and can be set in any order before the record type.
+	var ttl time.Duration
+	hasTTL := false
+	for len(fields) > 0 {
+		value, isTTL := parseZoneFileTTL(fields[0])
+		if isTTL && !hasTTL {
+			ttl, hasTTL = value, true
+		} else if slices.Contains([]string{"CS", "CH", "HS"}, strings.ToUpper(fields[0])) {
+			return fmt.Errorf("unsupported class '%s'", fields[0])
+		} else if !strings.EqualFold(fields[0], "IN") {
+			break
+		}
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return errors.New("missing record type")
+	}
+	recordType := strings.ToUpper(fields[0])
+	data := fields[1:]
+
+	// Set the TTL from the $TTL directive or the last explicit TTL.
+	// https://www.rfc-editor.org/rfc/rfc2308#section-4
+	if hasTTL {
+		p.lastTTL = &ttl
+	} else if p.ttl != nil {
+		ttl = *p.ttl
+	} else if p.lastTTL != nil {
+		ttl = *p.lastTTL
+	} else if recordType != "SOA" {
+		return errors.New("missing TTL")
+	}
+
+	// The SOA-Record is managed by the zone itself.
+	if recordType == "SOA" {
+		return nil
+	}
+
+	name, err := p.relativeName(p.owner)
+	if err != nil {
+		return err
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if len(data) != 1 {
+			return fmt.Errorf("%s-Record must have a single address", recordType)
+		}
+		address, err := netip.ParseAddr(data[0])
+		if err != nil || address.Zone() != "" || (recordType == "A") != address.Is4() {
+			return fmt.Errorf("invalid address '%s' of %s-Record", data[0], recordType)
+		}
+
+		if recordType == "A" {
+			i, ok := p.recordSet(recordType, name, len(p.records.A))
+			if !ok {
+				p.records.A = append(p.records.A, RecordA{Name: name, TimeToLive: ttl})
+			}
+			p.records.A[i].Addresses = append(p.records.A[i].Addresses, address)
+			p.records.A[i].TimeToLive = min(p.records.A[i].TimeToLive, ttl)
+		} else {
+			i, ok := p.recordSet(recordType, name, len(p.records.AAAA))
+			if !ok {
+				p.records.AAAA = append(p.records.AAAA, RecordAAAA{Name: name, TimeToLive: ttl})
+			}
+			p.records.AAAA[i].Addresses = append(p.records.AAAA[i].Addresses, address)
+			p.records.AAAA[i].TimeToLive = min(p.records.AAAA[i].TimeToLive, ttl)
+		}
+
+	case "CNAME":
+		if len(data) != 1 {
+			return errors.New("CNAME-Record must have a single domain name")
+		}
+		p.records.CName = append(p.records.CName, RecordCName{Name: name, CName: p.absoluteName(data[0]), TimeToLive: ttl})
+
+	case "PTR":
+		if len(data) != 1 {
+			return errors.New("PTR-Record must have a single domain name")
+		}
+		p.records.PTR = append(p.records.PTR, RecordPTR{Name: name, PTR: p.absoluteName(data[0]), TimeToLive: ttl})
+
+	case "NS":
+		if len(data) != 1 {
+			return errors.New("NS-Record must have a single domain name")
+		}
+		i, ok := p.recordSet(recordType, name, len(p.records.NS))
+		if !ok {
+			p.records.NS = append(p.records.NS, RecordNS{Name: name, TimeToLive: ttl})
+		}
+		p.records.NS[i].NameServers = append(p.records.NS[i].NameServers, p.absoluteName(data[0]))
+		p.records.NS[i].TimeToLive = min(p.records.NS[i].TimeToLive, ttl)
+
+	case "MX":
+		if len(data) != 2 {
+			return errors.New("MX-Record must have a preference and a domain name")
+		}
+		preference, err := strconv.ParseUint(data[0], 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid preference '%s' of MX-Record", data[0])
+		}
+
+		i, ok := p.recordSet(recordType, name, len(p.records.MX))
+		if !ok {
+			p.records.MX = append(p.records.MX, RecordMX{Name: name, TimeToLive: ttl})
+		}
+		p.records.MX[i].MailExchangers = append(p.records.MX[i].MailExchangers, MailExchanger{
+			MailExchange: p.absoluteName(data[1]),
+			Preference:   uint16(preference),
+		})
+		p.records.MX[i].TimeToLive = min(p.records.MX[i].TimeToLive, ttl)
+
+	case "SRV":
+		if len(data) != 4 {
+			return errors.New("SRV-Record must have a priority, weight, port and domain name")
+		}
+		match := srvNameRegex.FindStringSubmatch(name)
+		if match == nil {
+			return fmt.Errorf("owner name '%s' of SRV-Record must be in the format '_service._proto'", name)
+		}
+
+		target := SRVTarget{Target: p.absoluteName(data[3])}
+		for j, value := range []*uint16{&target.Priority, &target.Weight, &target.Port} {
+			v, err := strconv.ParseUint(data[j], 10, 16)
+			if err != nil {
+				return fmt.Errorf("invalid value '%s' of SRV-Record", data[j])
+			}
+			*value = uint16(v)
+		}
+
+		i, ok := p.recordSet(recordType, name, len(p.records.SRV))
+		if !ok {
+			p.records.SRV = append(p.records.SRV, RecordSRV{Name: name, Service: match[1], Protocol: match[2], TimeToLive: ttl})
+		}
+		p.records.SRV[i].Targets = append(p.records.SRV[i].Targets, target)
+		p.records.SRV[i].TimeToLive = min(p.records.SRV[i].TimeToLive, ttl)
+
+	case "TXT":
+		if len(data) == 0 {
+			return errors.New("TXT-Record must have at least one character string")
+		}
+
+		// The character strings of a record are concatenated to a single value like the read functions do.
+		var value strings.Builder
+		for _, field := range entry.fields[len(entry.fields)-len(data):] {
+			s := field.value
+			if !field.quoted {
+				if s, err = decodeZoneFileString(s); err != nil {
+					return err
+				}
+			}
+			value.WriteString(s)
+		}
+
+		i, ok := p.recordSet(recordType, name, len(p.records.TXT))
+		if !ok {
+			p.records.TXT = append(p.records.TXT, RecordTXT{Name: name, TimeToLive: ttl})
+		}
+		p.records.TXT[i].Values = append(p.records.TXT[i].Values, value.String())
+		p.records.TXT[i].TimeToLive = min(p.records.TXT[i].TimeToLive, ttl)
+
+	default:
+		return fmt.Errorf("unsupported record type '%s'", fields[0])
+	}
+
+	return nil
+}
+
+// ParseZoneFile parses a zone file in the master file format of RFC 1035 and returns its records.
+// The names of the records are relative to the zone, "@" for the zone itself,
+// and domain names in the record data are fully qualified with a trailing dot.
+// Records with the same name and type are combined and get the lowest TTL of the records.
+//
+// The SOA-Record is skipped, because it is managed by the zone itself.
+// Other record types than the types of the RecordList function,
+// other classes than IN and the $INCLUDE and $GENERATE directives return an error.
+//
+// https://www.rfc-editor.org/rfc/rfc1035#section-5
+func ParseZoneFile(r io.Reader, zone string) (Records, error) {
+	zone = strings.TrimSuffix(zone, ".") + "."
+	p := zoneFileParser{zone: zone, origin: zone, index: map[string]int{}}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return p.records, fmt.Errorf("windows.dns.ParseZoneFile: %w", err)
+	}
+
+	entries, err := splitZoneFile(string(data))
+	if err != nil {
+		return p.records, fmt.Errorf("windows.dns.ParseZoneFile: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := p.parseEntry(entry); err != nil {
+			return p.records, fmt.Errorf("windows.dns.ParseZoneFile: line %d: %w", entry.line, err)
+		}
+	}
+
+	return p.records, nil
+}
+
+// zoneFileLine represents a single record of the ZoneFile function.
+type zoneFileLine struct {
+	name       string
+	recordType string
+	ttl        time.Duration
+	data       string
+}
+
+// zoneFileName returns a domain name of the record data with a trailing dot,
+// so the name is not relative to the origin of the zone file.
+func zoneFileName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// zoneFileString returns a quoted character string of a zone file.
+// Quotes, backslashes and non-printable bytes are escaped.
+func zoneFileString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == '"' || ch == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		case ch < ' ' || ch == 0x7f:
+			fmt.Fprintf(&b, "\\%03d", ch)
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// zoneFileLines returns a line per record data with the record data in the master file format.
+// Values of TXT-Records that are longer than 255 bytes are split into multiple character strings.
+func (r Records) zoneFileLines() []zoneFileLine {
+	var lines []zoneFileLine
+	add := func(name string, recordType string, ttl time.Duration, data string) {
+		lines = append(lines, zoneFileLine{name: name, recordType: recordType, ttl: ttl, data: data})
+	}
+
+	for _, record := range r.A {
+		for _, address := range record.Addresses {
+			add(record.Name, "A", record.TimeToLive, address.String())
+		}
+	}
+	for _, record := range r.AAAA {
+		for _, address := range record.Addresses {
+			add(record.Name, "AAAA", record.TimeToLive, address.String())
+		}
+	}
+	for _, record := range r.CName {
+		add(record.Name, "CNAME", record.TimeToLive, zoneFileName(record.CName))
+	}
+	for _, record := range r.PTR {
+		add(record.Name, "PTR", record.TimeToLive, zoneFileName(record.PTR))
+	}
+	for _, record := range r.MX {
+		for _, mx := range record.MailExchangers {
+			add(record.Name, "MX", record.TimeToLive, fmt.Sprintf("%d %s", mx.Preference, zoneFileName(mx.MailExchange)))
+		}
+	}
+	for _, record := range r.SRV {
+		for _, target := range record.Targets {
+			add(record.Name, "SRV", record.TimeToLive, fmt.Sprintf("%d %d %d %s", target.Priority, target.Weight, target.Port, zoneFileName(target.Target)))
+		}
+	}
+	for _, record := range r.TXT {
+		for _, value := range record.Values {
+			var chunks []string
+			for _, chunk := range splitTXTValue(value) {
+				chunks = append(chunks, zoneFileString(chunk))
+			}
+			if len(chunks) == 0 {
+				chunks = append(chunks, `""`)
+			}
+			add(record.Name, "TXT", record.TimeToLive, strings.Join(chunks, " "))
+		}
+	}
+	for _, record := range r.NS {
+		for _, nameServer := range record.NameServers {
+			add(record.Name, "NS", record.TimeToLive, zoneFileName(nameServer))
+		}
+	}
+
+	return lines
+}
+
+// ZoneFile returns the records in the master file format of RFC 1035 with the zone as origin.
+// The records are sorted by name, record type and data with the zone itself first,
+// so the zone files of two zones or two points in time can be compared line by line.
+// Values of TXT-Records that are longer than 255 bytes are split into multiple character strings.
+//
+// https://www.rfc-editor.org/rfc/rfc1035#section-5
+func (r Records) ZoneFile(zone string) string {
+	lines := r.zoneFileLines()
+
+	// Sort the records with the zone itself first.
+	slices.SortStableFunc(lines, func(a, b zoneFileLine) int {
+		if (a.name == "@") != (b.name == "@") {
+			if a.name == "@" {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name)); c != 0 {
+			return c
+		}
+		if c := slices.Index(recordListTypes, a.recordType) - slices.Index(recordListTypes, b.recordType); c != 0 {
+			return c
+		}
+		return strings.Compare(a.data, b.data)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", zoneFileName(zone))
+	for _, line := range lines {
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", line.name, int64(line.ttl.Round(time.Second).Seconds()), line.recordType, line.data)
+	}
+
+	return b.String()
+}
+
+// ZoneExportParams represents parameters for the ZoneExport function.
+type ZoneExportParams struct {
+	// Specifies the zone to export.
+	Zone string
+
+	// Specifies the zone scope to export.
+	// If not provided, the default zone scope is exported.
+	ZoneScope string
+}
+
+// ZoneExport returns the records of a zone as zone file in the master file format of RFC 1035.
+// The zone file contains the record types of the RecordList function, other record types like the SOA-Record are skipped.
+// Use the ParseZoneFile function to convert the zone file back to records.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneExport(ctx context.Context, params ZoneExportParams) (string, error) {
+	// Assert needed parameters
+	if params.Zone == "" {
+		return "", errors.New("windows.dns.ZoneExport: zone parameter 'Zone' must be set")
+	}
+
+	records, err := c.RecordList(ctx, RecordListParams{Zone: params.Zone, ZoneScope: params.ZoneScope})
+	if err != nil {
+		return "", fmt.Errorf("windows.dns.ZoneExport: %w", err)
+	}
+
+	return records.ZoneFile(params.Zone), nil
+}
+
+// ZoneImportParams represents parameters for the ZoneImport function.
+type ZoneImportParams struct {
+	// Specifies the zone in which the records are created.
+	Zone string
+
+	// Specifies the zone scope in which the records are created.
+	// If not provided, the default zone scope is used.
+	ZoneScope string
+
+	// Specifies the records to create, e.g. the records returned by the ParseZoneFile function.
+	// Records that already exist in the zone, like the NS-Records of the zone itself, must be removed before the import.
+	Records Records
+}
+
+// ZoneImport creates the records in a zone. It returns the created records.
+// The records are created with the create function of their record type.
+// The import stops at the first record that cannot be created and returns the records created so far with the error.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneImport(ctx context.Context, params ZoneImportParams) (Records, error) {
+	var r Records
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return r, errors.New("windows.dns.ZoneImport: zone parameter 'Zone' must be set")
+	}
+
+	for _, record := range params.Records.A {
+		created, err := c.RecordACreate(ctx, RecordACreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, Addresses: record.Addresses, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.A = append(r.A, created)
+	}
+	for _, record := range params.Records.AAAA {
+		created, err := c.RecordAAAACreate(ctx, RecordAAAACreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, Addresses: record.Addresses, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.AAAA = append(r.AAAA, created)
+	}
+	for _, record := range params.Records.CName {
+		created, err := c.RecordCNameCreate(ctx, RecordCNameCreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, CName: record.CName, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.CName = append(r.CName, created)
+	}
+	for _, record := range params.Records.PTR {
+		created, err := c.RecordPTRCreate(ctx, RecordPTRCreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, PTR: record.PTR, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.PTR = append(r.PTR, created)
+	}
+	for _, record := range params.Records.MX {
+		created, err := c.RecordMXCreate(ctx, RecordMXCreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, MailExchangers: record.MailExchangers, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.MX = append(r.MX, created)
+	}
+	for _, record := range params.Records.SRV {
+		created, err := c.RecordSRVCreate(ctx, RecordSRVCreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, Targets: record.Targets, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.SRV = append(r.SRV, created)
+	}
+	for _, record := range params.Records.TXT {
+		created, err := c.RecordTXTCreate(ctx, RecordTXTCreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, Values: record.Values, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.TXT = append(r.TXT, created)
+	}
+	for _, record := range params.Records.NS {
+		created, err := c.RecordNSCreate(ctx, RecordNSCreateParams{Name: record.Name, Zone: params.Zone, ZoneScope: params.ZoneScope, NameServers: record.NameServers, TimeToLive: record.TimeToLive})
+		if err != nil {
+			return r, fmt.Errorf("windows.dns.ZoneImport: %w", err)
+		}
+		r.NS = append(r.NS, created)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,286 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	zoneFile = `$TTL 1h ; default TTL
+$ORIGIN test.local.
+@	IN	SOA	ns1.test.local. hostmaster.test.local. (
+			2024010101 ; serial
+			3600       ; refresh
+			600        ; retry
+			86400      ; expire
+			3600 )     ; minimum
+	IN	NS	ns1
+	IN	MX	10 mail
+	IN	MX	20 mail.example.com.
+	300 IN TXT "v=spf1 mx -all"
+web	IN	A	192.168.10.1
+	IN	A	192.168.10.2
+	30m	IN	AAAA	fd00::1
+www	CNAME	web
+_ldap._tcp 600 IN SRV 0 100 389 dc01
+txt	IN	TXT	"say \"hello\"" " world" ; two character strings
+$ORIGIN sub.test.local.
+host	IN	A	10.0.0.1
+`
+)
+
+var expectedZoneFileRecords = Records{
+	A: []RecordA{
+		{Name: "web", Addresses: []netip.Addr{netip.MustParseAddr("192.168.10.1"), netip.MustParseAddr("192.168.10.2")}, TimeToLive: time.Hour},
+		{Name: "host.sub", Addresses: []netip.Addr{netip.MustParseAddr("10.0.0.1")}, TimeToLive: time.Hour},
+	},
+	AAAA:  []RecordAAAA{{Name: "web", Addresses: []netip.Addr{netip.MustParseAddr("fd00::1")}, TimeToLive: time.Minute * 30}},
+	CName: []RecordCName{{Name: "www", CName: "web.test.local.", TimeToLive: time.Hour}},
+	MX: []RecordMX{{Name: "@", MailExchangers: []MailExchanger{
+		{MailExchange: "mail.test.local.", Preference: 10},
+		{MailExchange: "mail.example.com.", Preference: 20},
+	}, TimeToLive: time.Hour}},
+	SRV: []RecordSRV{{Name: "_ldap._tcp", Service: "_ldap", Protocol: "_tcp", Targets: []SRVTarget{
+		{Target: "dc01.test.local.", Priority: 0, Weight: 100, Port: 389},
+	}, TimeToLive: time.Minute * 10}},
+	TXT: []RecordTXT{
+		{Name: "@", Values: []string{"v=spf1 mx -all"}, TimeToLive: time.Minute * 5},
+		{Name: "txt", Values: []string{`say "hello" world`}, TimeToLive: time.Hour},
+	},
+	NS: []RecordNS{{Name: "@", NameServers: []string{"ns1.test.local."}, TimeToLive: time.Hour}},
+}
+
+// Test the parseZoneFileTTL function.
+func (suite *DnsServerUnitTestSuite) TestParseZoneFileTTL() {
+	suite.Run("should parse the TTL", func() {
+		tcs := []struct {
+			input       string
+			expectedTTL time.Duration
+			expectedOk  bool
+		}{
+			{"3600", time.Hour, true},
+			{"1h30m", time.Hour + time.Minute*30, true},
+			{"1W2D", time.Hour * 24 * 9, true},
+			{"1h30", time.Hour + time.Second*30, true},
+			{"0", 0, true},
+			{"2147483648", 0, false},
+			{"h", 0, false},
+			{"IN", 0, false},
+			{"", 0, false},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.input)
+			ttl, ok := parseZoneFileTTL(tc.input)
+			suite.Equal(tc.expectedOk, ok)
+			suite.Equal(tc.expectedTTL, ttl)
+		}
+	})
+}
+
+// Test the ParseZoneFile function.
+func (suite *DnsServerUnitTestSuite) TestParseZoneFile() {
+	suite.Run("should return the records of the zone file", func() {
+		actual, err := ParseZoneFile(strings.NewReader(zoneFile), "test.local")
+		suite.NoError(err)
+		suite.Equal(expectedZoneFileRecords, actual)
+	})
+
+	suite.Run("should use the last explicit TTL without $TTL directive", func() {
+		actual, err := ParseZoneFile(strings.NewReader("a 600 A 10.0.0.1\nb A 10.0.0.2\n"), "test.local.")
+		suite.NoError(err)
+		suite.Equal(time.Minute*10, actual.A[1].TimeToLive)
+	})
+
+	suite.Run("should return the lowest TTL of a record set", func() {
+		actual, err := ParseZoneFile(strings.NewReader("a 600 A 10.0.0.1\na 300 A 10.0.0.2\n"), "test.local")
+		suite.NoError(err)
+		suite.Len(actual.A, 1)
+		suite.Equal(time.Minute*5, actual.A[0].TimeToLive)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description string
+			input       string
+			expectedErr string
+		}{
+			{
+				"assert error without TTL",
+				"a IN A 10.0.0.1\n",
+				"windows.dns.ParseZoneFile: line 1: missing TTL",
+			},
+			{
+				"assert error with an unsupported record type",
+				"$TTL 3600\n\na IN LOC 52 22 23.000 N 4 53 32.000 E -2.00m 0.00m 10000m 10m\n",
+				"windows.dns.ParseZoneFile: line 3: unsupported record type 'LOC'",
+			},
+			{
+				"assert error with an unsupported class",
+				"a 3600 CH A 10.0.0.1\n",
+				"windows.dns.ParseZoneFile: line 1: unsupported class 'CH'",
+			},
+			{
+				"assert error with an unsupported directive",
+				"$INCLUDE other.zone\n",
+				"windows.dns.ParseZoneFile: line 1: unsupported directive '$INCLUDE'",
+			},
+			{
+				"assert error with an owner outside of the zone",
+				"example.com. 3600 A 10.0.0.1\n",
+				"windows.dns.ParseZoneFile: line 1: owner name 'example.com.' is not in zone 'test.local.'",
+			},
+			{
+				"assert error with an IPv6 address in an A-Record",
+				"a 3600 A fd00::1\n",
+				"windows.dns.ParseZoneFile: line 1: invalid address 'fd00::1' of A-Record",
+			},
+			{
+				"assert error with an invalid SRV-Record name",
+				"ldap 3600 SRV 0 100 389 dc01\n",
+				"windows.dns.ParseZoneFile: line 1: owner name 'ldap' of SRV-Record must be in the format '_service._proto'",
+			},
+			{
+				"assert error with an unterminated parenthesis",
+				"a 3600 MX ( 10\nmail\n",
+				"windows.dns.ParseZoneFile: line 1: unterminated parenthesis",
+			},
+			{
+				"assert error with an unterminated quoted string",
+				"a 3600 TXT \"value\n",
+				"windows.dns.ParseZoneFile: line 1: unterminated quoted string",
+			},
+			{
+				"assert error without owner",
+				" 3600 A 10.0.0.1\n",
+				"windows.dns.ParseZoneFile: line 1: missing owner name",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := ParseZoneFile(strings.NewReader(tc.input), "test.local")
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test the ZoneFile function.
+func (suite *DnsServerUnitTestSuite) TestZoneFile() {
+	suite.Run("should return the sorted zone file", func() {
+		expected := `$ORIGIN test.local.
+@	3600	IN	MX	10 mail.test.local.
+@	3600	IN	MX	20 mail.example.com.
+@	300	IN	TXT	"v=spf1 mx -all"
+@	3600	IN	NS	ns1.test.local.
+_ldap._tcp	600	IN	SRV	0 100 389 dc01.test.local.
+host.sub	3600	IN	A	10.0.0.1
+txt	3600	IN	TXT	"say \"hello\" world"
+web	3600	IN	A	192.168.10.1
+web	3600	IN	A	192.168.10.2
+web	1800	IN	AAAA	fd00::1
+www	3600	IN	CNAME	web.test.local.
+`
+		suite.Equal(expected, expectedZoneFileRecords.ZoneFile("test.local"))
+	})
+
+	suite.Run("should split long TXT values into character strings", func() {
+		records := Records{TXT: []RecordTXT{{Name: "@", Values: []string{strings.Repeat("a", 300)}, TimeToLive: time.Hour}}}
+		expected := "$ORIGIN test.local.\n@\t3600\tIN\tTXT\t\"" + strings.Repeat("a", 255) + "\" \"" + strings.Repeat("a", 45) + "\"\n"
+		suite.Equal(expected, records.ZoneFile("test.local"))
+	})
+
+	suite.Run("should return the same records after parsing the zone file", func() {
+		actual, err := ParseZoneFile(strings.NewReader(expectedZoneFileRecords.ZoneFile("test.local")), "test.local")
+		suite.NoError(err)
+		suite.Equal(expectedZoneFileRecords.ZoneFile("test.local"), actual.ZoneFile("test.local"))
+	})
+}
+
+// Test ZoneExport related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneExport() {
+	suite.T().Parallel()
+
+	suite.Run("should return the zone file of the zone", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerResourceRecord -ZoneName 'test.local' | ForEach-Object{ConvertTo-Json $_ -Compress}").
+			Return(connection.CmdResult{StdOut: recordListJson}, nil)
+		actual, err := c.ZoneExport(ctx, ZoneExportParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal("$ORIGIN test.local.\nweb\t3600\tIN\tA\t192.168.10.1\nweb\t3600\tIN\tA\t192.168.10.2\nwww\t3600\tIN\tCNAME\tweb.test.local.\n", actual)
+	})
+
+	suite.Run("should return error if zone is missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ZoneExport(ctx, ZoneExportParams{})
+		suite.EqualError(err, "windows.dns.ZoneExport: zone parameter 'Zone' must be set")
+	})
+}
+
+// Test ZoneImport related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneImport() {
+	suite.T().Parallel()
+
+	records := Records{
+		A:     []RecordA{{Name: "test", Addresses: []netip.Addr{netip.MustParseAddr("2.2.2.2")}, TimeToLive: time.Hour}},
+		CName: []RecordCName{{Name: "www", CName: "test.test.local.", TimeToLive: time.Hour}},
+	}
+
+	suite.Run("should create the records", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv4Address @('2.2.2.2') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordAJson}, nil)
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerResourceRecordCName -AllowUpdateAny:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'www' -ZoneName 'test.local' -HostNameAlias 'test.test.local.' -TimeToLive $(New-TimeSpan -Seconds 3600) | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: recordCNameJson}, nil)
+		actual, err := c.ZoneImport(ctx, ZoneImportParams{Zone: "test.local", Records: records})
+		suite.NoError(err)
+		suite.Len(actual.A, 1)
+		suite.Len(actual.CName, 1)
+	})
+
+	suite.Run("should stop at the first record that cannot be created", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Add-DnsServerResourceRecordA -AllowUpdateAny:$false -CreatePtr:$false -AgeRecord:$false -Confirm:$false -PassThru -Name 'test' -ZoneName 'test.local' -TimeToLive $(New-TimeSpan -Seconds 3600) -IPv4Address @('2.2.2.2') ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{}, errors.New("connection lost"))
+		actual, err := c.ZoneImport(ctx, ZoneImportParams{Zone: "test.local", Records: records})
+		suite.EqualError(err, "windows.dns.ZoneImport: windows.dns.RecordACreate: connection lost")
+		suite.Empty(actual.A)
+		suite.Empty(actual.CName)
+	})
+}
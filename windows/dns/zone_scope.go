@@ -0,0 +1,188 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// ZoneScope represents a scope of a zone.
+// A zone scope contains its own set of records, so a query resolution policy can
+// answer the same query with different records, e.g. for internal and external clients.
+type ZoneScope struct {
+	Name     string
+	FileName string
+}
+
+// zoneScopeObject contains the unmarshaled json of the powershell zone scope object.
+type zoneScopeObject struct {
+	ZoneScope string `json:"ZoneScope"`
+	FileName  string `json:"FileName"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the zoneScopeObject to a ZoneScope object.
+func (s *ZoneScope) convertOutput(o zoneScopeObject) {
+	s.Name = o.ZoneScope
+	s.FileName = o.FileName
+}
+
+// pwshZoneName returns the zone parameters of a record command, e.g. "-ZoneName 'test.local' -ZoneScope 'internal'".
+// The zone scope is omitted if not set, so the default zone scope is used.
+func pwshZoneName(zone string, zoneScope string) string {
+	if zoneScope == "" {
+		return fmt.Sprintf("-ZoneName '%s'", zone)
+	}
+	return fmt.Sprintf("-ZoneName '%s' -ZoneScope '%s'", zone, zoneScope)
+}
+
+// ZoneScopeReadParams represents parameters for the ZoneScopeRead function.
+type ZoneScopeReadParams struct {
+	// Specifies the name of the zone.
+	Zone string
+
+	// Specifies the name of the zone scope.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to read a zone scope.
+func (params ZoneScopeReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsServerZoneScope -ZoneName '%s' -Name '%s' | ConvertTo-Json -Compress", params.Zone, params.Name)
+}
+
+// ZoneScopeRead gets a zone scope by its name. It returns a ZoneScope object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneScopeRead(ctx context.Context, params ZoneScopeReadParams) (ZoneScope, error) {
+	var s ZoneScope
+	var o zoneScopeObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.Name == "" {
+		return s, errors.New("windows.dns.ZoneScopeRead: zone scope parameters 'Zone' and 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ZoneScopeRead: %w", err)
+	}
+
+	// Convert the output to a ZoneScope object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ZoneScopeListParams represents parameters for the ZoneScopeList function.
+type ZoneScopeListParams struct {
+	// Specifies the name of the zone.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to list the zone scopes of a zone.
+func (params ZoneScopeListParams) pwshCommand() string {
+	return fmt.Sprintf("$s=@(Get-DnsServerZoneScope -ZoneName '%s') ;if($s.Count -ge 2){ConvertTo-Json $s -Compress}else{ConvertTo-Json @($s) -Compress}", params.Zone)
+}
+
+// ZoneScopeList gets the zone scopes of a zone. It returns a list of ZoneScope objects.
+// The list contains the default zone scope, which has the name of the zone.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneScopeList(ctx context.Context, params ZoneScopeListParams) ([]ZoneScope, error) {
+	var s []ZoneScope
+	var o []zoneScopeObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return s, errors.New("windows.dns.ZoneScopeList: zone scope parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ZoneScopeList: %w", err)
+	}
+
+	// Convert the output to ZoneScope objects.
+	for _, object := range o {
+		var scope ZoneScope
+		scope.convertOutput(object)
+		s = append(s, scope)
+	}
+
+	return s, nil
+}
+
+// ZoneScopeCreateParams represents parameters for the ZoneScopeCreate function.
+type ZoneScopeCreateParams struct {
+	// Specifies the name of the zone.
+	Zone string
+
+	// Specifies the name of the zone scope, e.g. "internal".
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to create a zone scope.
+func (params ZoneScopeCreateParams) pwshCommand() string {
+	return fmt.Sprintf("Add-DnsServerZoneScope -ZoneName '%s' -Name '%s' -PassThru -ErrorAction Stop | ConvertTo-Json -Compress", params.Zone, params.Name)
+}
+
+// ZoneScopeCreate creates a zone scope. It returns a ZoneScope object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneScopeCreate(ctx context.Context, params ZoneScopeCreateParams) (ZoneScope, error) {
+	var s ZoneScope
+	var o zoneScopeObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.Name == "" {
+		return s, errors.New("windows.dns.ZoneScopeCreate: zone scope parameters 'Zone' and 'Name' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dns.ZoneScopeCreate: %w", err)
+	}
+
+	// Convert the output to a ZoneScope object.
+	s.convertOutput(o)
+
+	return s, nil
+}
+
+// ZoneScopeDeleteParams represents parameters for the ZoneScopeDelete function.
+type ZoneScopeDeleteParams struct {
+	// Specifies the name of the zone.
+	Zone string
+
+	// Specifies the name of the zone scope.
+	Name string
+}
+
+// pwshCommand returns the PowerShell command to delete a zone scope.
+func (params ZoneScopeDeleteParams) pwshCommand() string {
+	return fmt.Sprintf("Remove-DnsServerZoneScope -ZoneName '%s' -Name '%s' -Force", params.Zone, params.Name)
+}
+
+// ZoneScopeDelete deletes a zone scope and its records.
+// The default zone scope cannot be deleted.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneScopeDelete(ctx context.Context, params ZoneScopeDeleteParams) error {
+	var o zoneScopeObject
+
+	// Assert needed parameters
+	if params.Zone == "" || params.Name == "" {
+		return errors.New("windows.dns.ZoneScopeDelete: zone scope parameters 'Zone' and 'Name' must be set")
+	}
+	if params.Name == params.Zone {
+		return fmt.Errorf("windows.dns.ZoneScopeDelete: the default zone scope '%s' cannot be deleted", params.Name)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return winerror.Errorf(cmd, "windows.dns.ZoneScopeDelete: %w", err)
+	}
+
+	return nil
+}
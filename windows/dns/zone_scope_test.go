@@ -0,0 +1,132 @@
+package dns
+
+import (
+	"context"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	zoneScopeJson     = `{"ZoneScope":"internal","FileName":"internal.dns","PSComputerName":null}`
+	zoneScopeListJson = `[{"ZoneScope":"test.local","FileName":"test.local.dns","PSComputerName":null},{"ZoneScope":"internal","FileName":"internal.dns","PSComputerName":null}]`
+)
+
+func (suite *DnsServerUnitTestSuite) TestPwshZoneName() {
+	suite.Run("should return the correct zone parameters", func() {
+		suite.Equal("-ZoneName 'test.local'", pwshZoneName("test.local", ""))
+		suite.Equal("-ZoneName 'test.local' -ZoneScope 'internal'", pwshZoneName("test.local", "internal"))
+	})
+}
+
+// Test ZoneScopeRead related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneScopeRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the zone scope", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZoneScope -ZoneName 'test.local' -Name 'internal' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneScopeJson}, nil)
+		actual, err := c.ZoneScopeRead(ctx, ZoneScopeReadParams{Zone: "test.local", Name: "internal"})
+		suite.NoError(err)
+		suite.Equal(ZoneScope{Name: "internal", FileName: "internal.dns"}, actual)
+	})
+
+	suite.Run("should return error if parameters are missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ZoneScopeRead(ctx, ZoneScopeReadParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.ZoneScopeRead: zone scope parameters 'Zone' and 'Name' must be set")
+	})
+}
+
+// Test ZoneScopeList related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneScopeList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the zone scopes", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$s=@(Get-DnsServerZoneScope -ZoneName 'test.local') ;if($s.Count -ge 2){ConvertTo-Json $s -Compress}else{ConvertTo-Json @($s) -Compress}").
+			Return(connection.CmdResult{StdOut: zoneScopeListJson}, nil)
+		actual, err := c.ZoneScopeList(ctx, ZoneScopeListParams{Zone: "test.local"})
+		suite.NoError(err)
+		suite.Equal([]ZoneScope{
+			{Name: "test.local", FileName: "test.local.dns"},
+			{Name: "internal", FileName: "internal.dns"},
+		}, actual)
+	})
+}
+
+// Test ZoneScopeCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneScopeCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should create the zone scope", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerZoneScope -ZoneName 'test.local' -Name 'internal' -PassThru -ErrorAction Stop | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zoneScopeJson}, nil)
+		actual, err := c.ZoneScopeCreate(ctx, ZoneScopeCreateParams{Zone: "test.local", Name: "internal"})
+		suite.NoError(err)
+		suite.Equal(ZoneScope{Name: "internal", FileName: "internal.dns"}, actual)
+	})
+}
+
+// Test ZoneScopeDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneScopeDelete() {
+	suite.T().Parallel()
+
+	suite.Run("should delete the zone scope", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Remove-DnsServerZoneScope -ZoneName 'test.local' -Name 'internal' -Force").
+			Return(connection.CmdResult{}, nil)
+		err := c.ZoneScopeDelete(ctx, ZoneScopeDeleteParams{Zone: "test.local", Name: "internal"})
+		suite.NoError(err)
+	})
+
+	suite.Run("should return error if the default zone scope is deleted", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		err := c.ZoneScopeDelete(ctx, ZoneScopeDeleteParams{Zone: "test.local", Name: "test.local"})
+		suite.EqualError(err, "windows.dns.ZoneScopeDelete: the default zone scope 'test.local' cannot be deleted")
+	})
+}
@@ -0,0 +1,290 @@
+package dns
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// dnssecAlgorithms maps the crypto algorithms of the DNS server to their DNSSEC algorithm numbers.
+// https://www.iana.org/assignments/dns-sec-alg-numbers/dns-sec-alg-numbers.xhtml
+var dnssecAlgorithms = map[string]uint8{
+	"RsaSha1":         5,
+	"RsaSha1NSec3":    7,
+	"RsaSha256":       8,
+	"RsaSha512":       10,
+	"ECDsaP256Sha256": 13,
+	"ECDsaP384Sha384": 14,
+}
+
+// dsDigestTypes maps the digest types of DS-Records to their digest type numbers.
+// https://www.iana.org/assignments/ds-rr-types/ds-rr-types.xhtml
+var dsDigestTypes = map[string]struct {
+	number  uint8
+	newHash func() hash.Hash
+}{
+	"Sha1":   {1, sha1.New},
+	"Sha256": {2, sha256.New},
+	"Sha384": {4, sha512.New384},
+}
+
+// DNSKEY flags of RFC 4034 and RFC 5011.
+const (
+	dnsKeyFlagZoneKey          uint16 = 256
+	dnsKeyFlagRevoked          uint16 = 128
+	dnsKeyFlagSecureEntryPoint uint16 = 1
+)
+
+// dnsKey represents the data of a DNSKEY-Record.
+type dnsKey struct {
+	flags     uint16
+	algorithm uint8
+	publicKey []byte
+}
+
+// newDnsKey returns the dnsKey of the record data of a DNSKEY-Record.
+func newDnsKey(properties map[string]string) (dnsKey, error) {
+	var k dnsKey
+
+	algorithm, ok := dnssecAlgorithms[properties["CryptoAlgorithm"]]
+	if !ok {
+		return k, fmt.Errorf("unsupported crypto algorithm '%s'", properties["CryptoAlgorithm"])
+	}
+	k.algorithm = algorithm
+
+	publicKey, err := base64.StdEncoding.DecodeString(properties["Base64Data"])
+	if err != nil {
+		return k, fmt.Errorf("invalid public key: %w", err)
+	}
+	k.publicKey = publicKey
+
+	for flag, property := range map[uint16]string{
+		dnsKeyFlagZoneKey:          "ZoneKey",
+		dnsKeyFlagRevoked:          "Revoked",
+		dnsKeyFlagSecureEntryPoint: "SecureEntryPoint",
+	} {
+		if strings.EqualFold(properties[property], "True") {
+			k.flags |= flag
+		}
+	}
+
+	return k, nil
+}
+
+// rdata returns the wire format of the record data.
+// https://www.rfc-editor.org/rfc/rfc4034#section-2.1
+func (k dnsKey) rdata() []byte {
+	// The protocol is always 3.
+	b := binary.BigEndian.AppendUint16(nil, k.flags)
+	b = append(b, 3, k.algorithm)
+	return append(b, k.publicKey...)
+}
+
+// keyTag returns the key tag of the key.
+// https://www.rfc-editor.org/rfc/rfc4034#appendix-B
+func (k dnsKey) keyTag() uint16 {
+	var ac uint32
+	for i, b := range k.rdata() {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+	ac += ac >> 16 & 0xFFFF
+	return uint16(ac & 0xFFFF)
+}
+
+// digest returns the digest of the DS-Record of the key with the given owner name.
+// https://www.rfc-editor.org/rfc/rfc4034#section-5.1.4
+func (k dnsKey) digest(owner string, newHash func() hash.Hash) []byte {
+	h := newHash()
+
+	// The owner name is hashed in its canonical wire format.
+	for _, label := range strings.Split(strings.ToLower(strings.TrimSuffix(owner, ".")), ".") {
+		h.Write([]byte{byte(len(label))})
+		h.Write([]byte(label))
+	}
+	h.Write([]byte{0})
+
+	h.Write(k.rdata())
+	return h.Sum(nil)
+}
+
+// ZoneSignParams represents parameters for the ZoneSign function.
+type ZoneSignParams struct {
+	// Specifies the name of the zone.
+	Zone string
+
+	// Specifies whether the zone is signed with the default settings and signing keys of the server.
+	// If not set, the zone is signed with the signing keys that are added to the zone.
+	SignWithDefault bool
+}
+
+// pwshCommand returns the PowerShell command to sign a zone.
+func (params ZoneSignParams) pwshCommand() string {
+	// Base command
+	cmd := []string{fmt.Sprintf("Invoke-DnsServerZoneSign -ZoneName '%s'", params.Zone)}
+
+	// Add parameters
+	if params.SignWithDefault {
+		cmd = append(cmd, "-SignWithDefault")
+	}
+
+	cmd = append(cmd, fmt.Sprintf("-Force -ErrorAction Stop ;Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Zone))
+	return strings.Join(cmd, " ")
+}
+
+// ZoneSign signs a zone with DNSSEC. It returns the signed Zone object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneSign(ctx context.Context, params ZoneSignParams) (Zone, error) {
+	var z Zone
+	var o zoneObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return z, errors.New("windows.dns.ZoneSign: signing parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return z, winerror.Errorf(cmd, "windows.dns.ZoneSign: %w", err)
+	}
+
+	// Convert the output to a Zone object.
+	z.convertOutput(o)
+
+	return z, nil
+}
+
+// ZoneUnsignParams represents parameters for the ZoneUnsign function.
+type ZoneUnsignParams struct {
+	// Specifies the name of the zone.
+	Zone string
+}
+
+// pwshCommand returns the PowerShell command to unsign a zone.
+func (params ZoneUnsignParams) pwshCommand() string {
+	return fmt.Sprintf("Invoke-DnsServerZoneUnsign -ZoneName '%s' -Force -ErrorAction Stop ;Get-DnsServerZone -Name '%s' | ConvertTo-Json -Compress", params.Zone, params.Zone)
+}
+
+// ZoneUnsign removes the DNSSEC signatures and keys from a zone. It returns the unsigned Zone object.
+// The signing keys of the zone are kept, so the zone can be signed with them again.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneUnsign(ctx context.Context, params ZoneUnsignParams) (Zone, error) {
+	var z Zone
+	var o zoneObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return z, errors.New("windows.dns.ZoneUnsign: signing parameter 'Zone' must be set")
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return z, winerror.Errorf(cmd, "windows.dns.ZoneUnsign: %w", err)
+	}
+
+	// Convert the output to a Zone object.
+	z.convertOutput(o)
+
+	return z, nil
+}
+
+// DsRecord represents a DS-Record of a signed zone, that must be added to the parent zone
+// to establish the chain of trust.
+type DsRecord struct {
+	Name            string
+	KeyTag          uint16
+	CryptoAlgorithm string
+	DigestType      string
+	Digest          string
+}
+
+// String returns the DS-Record in the presentation format of a zone file,
+// e.g. "test.local. IN DS 12345 8 2 49FD46E6...".
+func (r DsRecord) String() string {
+	return fmt.Sprintf("%s. IN DS %d %d %d %s", strings.TrimSuffix(r.Name, "."), r.KeyTag, dnssecAlgorithms[r.CryptoAlgorithm], dsDigestTypes[r.DigestType].number, r.Digest)
+}
+
+// ZoneDsRecordListParams represents parameters for the ZoneDsRecordList function.
+type ZoneDsRecordListParams struct {
+	// Specifies the name of the signed zone.
+	Zone string
+
+	// Specifies the digest type of the DS-Records.
+	// Possible values are "Sha1", "Sha256" and "Sha384".
+	// If not provided, the default is "Sha256".
+	DigestType string
+}
+
+// pwshCommand returns the PowerShell command to read the DNSKEY-Records of a zone.
+func (params ZoneDsRecordListParams) pwshCommand() string {
+	return fmt.Sprintf("$r=Get-DnsServerResourceRecord -RRType 'DnsKey' -Node -Name '@' -ZoneName '%s' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}", params.Zone)
+}
+
+// ZoneDsRecordList returns the DS-Records of the key signing keys of a signed zone.
+// The DS-Records are calculated from the DNSKEY-Records of the zone, revoked keys are skipped.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ZoneDsRecordList(ctx context.Context, params ZoneDsRecordListParams) ([]DsRecord, error) {
+	var d []DsRecord
+	var o []recordObject
+
+	// Assert needed parameters
+	if params.Zone == "" {
+		return d, errors.New("windows.dns.ZoneDsRecordList: signing parameter 'Zone' must be set")
+	}
+	if params.DigestType == "" {
+		params.DigestType = "Sha256"
+	}
+	digestType, ok := dsDigestTypes[params.DigestType]
+	if !ok {
+		return d, fmt.Errorf("windows.dns.ZoneDsRecordList: signing parameter 'DigestType' must be 'Sha1', 'Sha256' or 'Sha384', got '%s'", params.DigestType)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return d, winerror.Errorf(cmd, "windows.dns.ZoneDsRecordList: %w", err)
+	}
+
+	// Calculate the DS-Records of the key signing keys.
+	for _, record := range o {
+		if record.RecordType == "" {
+			continue
+		}
+
+		key, err := newDnsKey(record.RecordData.CimInstanceProperties)
+		if err != nil {
+			return d, winerror.Errorf(cmd, "windows.dns.ZoneDsRecordList: %w", err)
+		}
+		if key.flags&dnsKeyFlagSecureEntryPoint == 0 || key.flags&dnsKeyFlagRevoked != 0 {
+			continue
+		}
+
+		d = append(d, DsRecord{
+			Name:            params.Zone,
+			KeyTag:          key.keyTag(),
+			CryptoAlgorithm: record.RecordData.CimInstanceProperties["CryptoAlgorithm"],
+			DigestType:      params.DigestType,
+			Digest:          fmt.Sprintf("%X", key.digest(params.Zone, digestType.newHash)),
+		})
+	}
+
+	if len(d) == 0 {
+		return d, fmt.Errorf("windows.dns.ZoneDsRecordList: the zone '%s' has no key signing key, the zone may not be signed", params.Zone)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,178 @@
+package dns
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/d-strobel/gowindows/connection"
+
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	// The public key of the example in RFC 4034 section 5.4.
+	dnsKeyPublicKey = "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw=="
+
+	recordDnsKeyJson = `[{"DistinguishedName":"DC=@,DC=dskey.example.com,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"DNSKEY","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordDnsKey","CimInstanceProperties":"Base64Data = \"` + dnsKeyPublicKey + `\" CryptoAlgorithm = \"RsaSha1\" KeyProtocol = \"DnsSec\" Revoked = False SecureEntryPoint = True ZoneKey = True","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":48},{"DistinguishedName":"DC=@,DC=dskey.example.com,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local","HostName":"@","RecordType":"DNSKEY","Timestamp":null,"TimeToLive":{"Ticks":36000000000,"Days":0,"Hours":1,"Milliseconds":0,"Minutes":0,"Seconds":0,"TotalDays":0.041666666666666664,"TotalHours":1,"TotalMilliseconds":3600000,"TotalMinutes":60,"TotalSeconds":3600},"RecordData":{"CimClass":"root/Microsoft/Windows/DNS:DnsServerResourceRecordDnsKey","CimInstanceProperties":"Base64Data = \"` + dnsKeyPublicKey + `\" CryptoAlgorithm = \"RsaSha1\" KeyProtocol = \"DnsSec\" Revoked = False SecureEntryPoint = False ZoneKey = True","CimSystemProperties":"Microsoft.Management.Infrastructure.CimSystemProperties"},"Type":48}]`
+)
+
+// Test the DNSKEY helpers with the examples of RFC 4034 section 5.4 and RFC 4509 section 2.3.
+func (suite *DnsServerUnitTestSuite) TestDnsKey() {
+	suite.T().Parallel()
+
+	key, err := newDnsKey(map[string]string{"Base64Data": dnsKeyPublicKey, "CryptoAlgorithm": "RsaSha1", "ZoneKey": "True", "SecureEntryPoint": "False"})
+	suite.Require().NoError(err)
+
+	suite.Run("should return the correct key tag", func() {
+		suite.Equal(uint16(60485), key.keyTag())
+	})
+
+	suite.Run("should return the correct digests", func() {
+		suite.Equal("2BB183AF5F22588179A53B0A98631FAD1A292118", fmt.Sprintf("%X", key.digest("dskey.example.com.", sha1.New)))
+		suite.Equal("D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A", fmt.Sprintf("%X", key.digest("DSKEY.example.com", sha256.New)))
+	})
+
+	suite.Run("should return error with an unsupported crypto algorithm", func() {
+		_, err := newDnsKey(map[string]string{"Base64Data": dnsKeyPublicKey, "CryptoAlgorithm": "Unknown"})
+		suite.EqualError(err, "unsupported crypto algorithm 'Unknown'")
+	})
+}
+
+// Test ZoneSign related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneSignPwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneSignParams
+			expectedCmd     string
+		}{
+			{
+				"assert command with the signing keys of the zone",
+				ZoneSignParams{Zone: "test.local"},
+				"Invoke-DnsServerZoneSign -ZoneName 'test.local' -Force -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with the default settings",
+				ZoneSignParams{Zone: "test.local", SignWithDefault: true},
+				"Invoke-DnsServerZoneSign -ZoneName 'test.local' -SignWithDefault -Force -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneSign() {
+	suite.T().Parallel()
+
+	suite.Run("should return error if zone is missing", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ZoneSign(ctx, ZoneSignParams{SignWithDefault: true})
+		suite.EqualError(err, "windows.dns.ZoneSign: signing parameter 'Zone' must be set")
+	})
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Invoke-DnsServerZoneSign -ZoneName 'test.local' -SignWithDefault -Force -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.ZoneSign(ctx, ZoneSignParams{Zone: "test.local", SignWithDefault: true})
+		suite.EqualError(err, "windows.dns.ZoneSign: test-error")
+	})
+}
+
+// Test ZoneUnsign related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneUnsign() {
+	suite.T().Parallel()
+
+	suite.Run("should return error if run fails", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Invoke-DnsServerZoneUnsign -ZoneName 'test.local' -Force -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{}, errors.New("test-error"))
+		_, err := c.ZoneUnsign(ctx, ZoneUnsignParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.ZoneUnsign: test-error")
+	})
+}
+
+// Test ZoneDsRecordList related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneDsRecordList() {
+	suite.T().Parallel()
+
+	suite.Run("should return the DS-Records of the key signing keys", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'DnsKey' -Node -Name '@' -ZoneName 'dskey.example.com' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: recordDnsKeyJson}, nil)
+		actual, err := c.ZoneDsRecordList(ctx, ZoneDsRecordListParams{Zone: "dskey.example.com"})
+		suite.Require().NoError(err)
+		suite.Equal([]DsRecord{{
+			Name:            "dskey.example.com",
+			KeyTag:          60486,
+			CryptoAlgorithm: "RsaSha1",
+			DigestType:      "Sha256",
+			Digest:          "A0091ADB6848CA53BA2EE803C283F76C32E8A4ECFAFE9B50EF143E18B7E7539D",
+		}}, actual)
+		suite.Equal("dskey.example.com. IN DS 60486 5 2 A0091ADB6848CA53BA2EE803C283F76C32E8A4ECFAFE9B50EF143E18B7E7539D", actual[0].String())
+	})
+
+	suite.Run("should return error if the zone has no key signing key", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$r=Get-DnsServerResourceRecord -RRType 'DnsKey' -Node -Name '@' -ZoneName 'test.local' ;if($r.Count -ge 2){ConvertTo-Json $r -Compress}else{ConvertTo-Json @($r) -Compress}").
+			Return(connection.CmdResult{StdOut: "[null]"}, nil)
+		_, err := c.ZoneDsRecordList(ctx, ZoneDsRecordListParams{Zone: "test.local"})
+		suite.EqualError(err, "windows.dns.ZoneDsRecordList: the zone 'test.local' has no key signing key, the zone may not be signed")
+	})
+
+	suite.Run("should return error with an unsupported digest type", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ZoneDsRecordList(ctx, ZoneDsRecordListParams{Zone: "test.local", DigestType: "Md5"})
+		suite.EqualError(err, "windows.dns.ZoneDsRecordList: signing parameter 'DigestType' must be 'Sha1', 'Sha256' or 'Sha384', got 'Md5'")
+	})
+}
@@ -3,6 +3,7 @@ package dns
 import (
 	"context"
 	"errors"
+	"net/netip"
 
 	"github.com/d-strobel/gowindows/connection"
 
@@ -17,74 +18,65 @@ const (
 
 var (
 	expectedZone = Zone{
-		NotifyServers:                     "",
-		SecondaryServers:                  "",
-		AllowedDcForNsRecordsAutoCreation: "",
-		DistinguishedName:                 "DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
-		IsAutoCreated:                     false,
-		IsDsIntegrated:                    true,
-		IsPaused:                          false,
-		IsReadOnly:                        false,
-		IsReverseLookupZone:               false,
-		IsShutdown:                        false,
-		ZoneName:                          "test.local",
-		ZoneType:                          "Primary",
-		DirectoryPartitionName:            "DomainDnsZones.test.local",
-		DynamicUpdate:                     "Secure",
-		IgnorePolicies:                    false,
-		IsSigned:                          false,
-		IsWinsEnabled:                     false,
-		Notify:                            "NotifyServers",
-		ReplicationScope:                  "Domain",
-		SecureSecondaries:                 "NoTransfer",
-		ZoneFile:                          "",
+		DistinguishedName:      "DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+		IsAutoCreated:          false,
+		IsDsIntegrated:         true,
+		IsPaused:               false,
+		IsReadOnly:             false,
+		IsReverseLookupZone:    false,
+		IsShutdown:             false,
+		ZoneName:               "test.local",
+		ZoneType:               "Primary",
+		DirectoryPartitionName: "DomainDnsZones.test.local",
+		DynamicUpdate:          "Secure",
+		IgnorePolicies:         false,
+		IsSigned:               false,
+		IsWinsEnabled:          false,
+		Notify:                 "NotifyServers",
+		ReplicationScope:       "Domain",
+		SecureSecondaries:      "NoTransfer",
+		ZoneFile:               "",
 	}
 	expectedZoneList = []Zone{
 		{
-			NotifyServers:                     "",
-			SecondaryServers:                  "",
-			AllowedDcForNsRecordsAutoCreation: "",
-			DistinguishedName:                 "DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
-			IsAutoCreated:                     false,
-			IsDsIntegrated:                    true,
-			IsPaused:                          false,
-			IsReadOnly:                        false,
-			IsReverseLookupZone:               false,
-			IsShutdown:                        false,
-			ZoneName:                          "test.local",
-			ZoneType:                          "Primary",
-			DirectoryPartitionName:            "DomainDnsZones.test.local",
-			DynamicUpdate:                     "Secure",
-			IgnorePolicies:                    false,
-			IsSigned:                          false,
-			IsWinsEnabled:                     false,
-			Notify:                            "NotifyServers",
-			ReplicationScope:                  "Domain",
-			SecureSecondaries:                 "NoTransfer",
-			ZoneFile:                          "",
+			DistinguishedName:      "DC=test.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test,DC=local",
+			IsAutoCreated:          false,
+			IsDsIntegrated:         true,
+			IsPaused:               false,
+			IsReadOnly:             false,
+			IsReverseLookupZone:    false,
+			IsShutdown:             false,
+			ZoneName:               "test.local",
+			ZoneType:               "Primary",
+			DirectoryPartitionName: "DomainDnsZones.test.local",
+			DynamicUpdate:          "Secure",
+			IgnorePolicies:         false,
+			IsSigned:               false,
+			IsWinsEnabled:          false,
+			Notify:                 "NotifyServers",
+			ReplicationScope:       "Domain",
+			SecureSecondaries:      "NoTransfer",
+			ZoneFile:               "",
 		},
 		{
-			NotifyServers:                     "",
-			SecondaryServers:                  "",
-			AllowedDcForNsRecordsAutoCreation: "",
-			DistinguishedName:                 "DC=test2.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test2,DC=local",
-			IsAutoCreated:                     false,
-			IsDsIntegrated:                    true,
-			IsPaused:                          false,
-			IsReadOnly:                        false,
-			IsReverseLookupZone:               false,
-			IsShutdown:                        false,
-			ZoneName:                          "test2.local",
-			ZoneType:                          "Primary",
-			DirectoryPartitionName:            "DomainDnsZones.test2.local",
-			DynamicUpdate:                     "Secure",
-			IgnorePolicies:                    false,
-			IsSigned:                          false,
-			IsWinsEnabled:                     false,
-			Notify:                            "NotifyServers",
-			ReplicationScope:                  "Domain",
-			SecureSecondaries:                 "NoTransfer",
-			ZoneFile:                          "",
+			DistinguishedName:      "DC=test2.local,cn=MicrosoftDNS,DC=DomainDnsZones,DC=test2,DC=local",
+			IsAutoCreated:          false,
+			IsDsIntegrated:         true,
+			IsPaused:               false,
+			IsReadOnly:             false,
+			IsReverseLookupZone:    false,
+			IsShutdown:             false,
+			ZoneName:               "test2.local",
+			ZoneType:               "Primary",
+			DirectoryPartitionName: "DomainDnsZones.test2.local",
+			DynamicUpdate:          "Secure",
+			IgnorePolicies:         false,
+			IsSigned:               false,
+			IsWinsEnabled:          false,
+			Notify:                 "NotifyServers",
+			ReplicationScope:       "Domain",
+			SecureSecondaries:      "NoTransfer",
+			ZoneFile:               "",
 		},
 	}
 )
@@ -212,3 +204,207 @@ func (suite *DnsServerUnitTestSuite) TestZoneList() {
 		suite.EqualError(err, "windows.dns.server.ZoneList: test-error")
 	})
 }
+
+// Test the reverseZoneName function.
+func (suite *DnsServerUnitTestSuite) TestReverseZoneName() {
+	suite.Run("should return the correct reverse zone name", func() {
+		tcs := []struct {
+			description  string
+			input        netip.Prefix
+			expectedName string
+		}{
+			{"assert IPv4 class C network", netip.MustParsePrefix("192.168.10.0/24"), "10.168.192.in-addr.arpa"},
+			{"assert IPv4 class A network with host bits", netip.MustParsePrefix("10.1.2.3/8"), "10.in-addr.arpa"},
+			{"assert IPv6 network", netip.MustParsePrefix("fd00:1234::/32"), "4.3.2.1.0.0.d.f.ip6.arpa"},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualName, err := reverseZoneName(tc.input)
+			suite.NoError(err)
+			suite.Equal(tc.expectedName, actualName)
+		}
+	})
+
+	suite.Run("should return an error", func() {
+		for _, prefix := range []netip.Prefix{{}, netip.MustParsePrefix("192.168.10.0/23"), netip.MustParsePrefix("fd00::/30"), netip.MustParsePrefix("0.0.0.0/0")} {
+			_, err := reverseZoneName(prefix)
+			suite.Error(err)
+		}
+	})
+}
+
+// Test ZoneCreate related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneCreatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneCreateParams
+			expectedCmd     string
+		}{
+			{
+				"assert file-backed primary zone",
+				ZoneCreateParams{Name: "test.local", ZoneType: "Primary", ZoneFile: "test.local.dns", DynamicUpdate: "None"},
+				"Add-DnsServerPrimaryZone -Name 'test.local' -ZoneFile 'test.local.dns' -DynamicUpdate 'None' -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert Active Directory integrated primary zone with transfer and notify settings",
+				ZoneCreateParams{Name: "test.local", ZoneType: "Primary", ReplicationScope: "Custom", DirectoryPartitionName: "app.test.local", SecureSecondaries: "TransferToSecureServers", SecondaryServers: []netip.Addr{netip.MustParseAddr("192.168.10.2")}, Notify: "NotifyServers", NotifyServers: []netip.Addr{netip.MustParseAddr("192.168.10.2"), netip.MustParseAddr("fd00::2")}},
+				"Add-DnsServerPrimaryZone -Name 'test.local' -ReplicationScope 'Custom' -DirectoryPartitionName 'app.test.local' -ErrorAction Stop ;try{Set-DnsServerPrimaryZone -Name 'test.local' -SecureSecondaries 'TransferToSecureServers' -SecondaryServers @('192.168.10.2') -Notify 'NotifyServers' -NotifyServers @('192.168.10.2','fd00::2') -ErrorAction Stop}catch{Remove-DnsServerZone -Name 'test.local' -Force -ErrorAction SilentlyContinue ;throw $_} ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert secondary zone",
+				ZoneCreateParams{Name: "test.local", ZoneType: "Secondary", ZoneFile: "test.local.dns", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.10.1")}},
+				"Add-DnsServerSecondaryZone -Name 'test.local' -ZoneFile 'test.local.dns' -MasterServers @('192.168.10.1') -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand()
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneCreate() {
+	suite.T().Parallel()
+
+	suite.Run("should create a reverse lookup zone", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Add-DnsServerPrimaryZone -Name '10.168.192.in-addr.arpa' -ReplicationScope 'Domain' -DynamicUpdate 'Secure' -ErrorAction Stop ;Get-DnsServerZone -Name '10.168.192.in-addr.arpa' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zone}, nil)
+		_, err := c.ZoneCreate(ctx, ZoneCreateParams{NetworkID: netip.MustParsePrefix("192.168.10.0/24"), ReplicationScope: "Domain", DynamicUpdate: "Secure"})
+		suite.NoError(err)
+	})
+
+	suite.Run("should return specific errors", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneCreateParams
+			expectedErr     string
+		}{
+			{
+				"assert error without name and network",
+				ZoneCreateParams{},
+				"windows.dns.ZoneCreate: either zone parameter 'Name' or 'NetworkID' must be set",
+			},
+			{
+				"assert error with an invalid network",
+				ZoneCreateParams{NetworkID: netip.MustParsePrefix("192.168.10.0/23")},
+				"windows.dns.ZoneCreate: zone parameter 'NetworkID' is invalid: the prefix length of the IPv4 network '192.168.10.0/23' must be a multiple of 8",
+			},
+			{
+				"assert error with an invalid zone type",
+				ZoneCreateParams{Name: "test.local", ZoneType: "Forwarder"},
+				"windows.dns.ZoneCreate: zone parameter 'ZoneType' must be 'Primary', 'Secondary' or 'Stub', got 'Forwarder'",
+			},
+			{
+				"assert error with secure updates on a file-backed zone",
+				ZoneCreateParams{Name: "test.local", DynamicUpdate: "Secure"},
+				"windows.dns.ZoneCreate: zone parameter 'DynamicUpdate' can only be 'Secure' for Active Directory integrated zones",
+			},
+			{
+				"assert error without master servers",
+				ZoneCreateParams{Name: "test.local", ZoneType: "Stub"},
+				"windows.dns.ZoneCreate: zone parameter 'MasterServers' must be set for stub zones",
+			},
+			{
+				"assert error with an Active Directory integrated secondary zone",
+				ZoneCreateParams{Name: "test.local", ZoneType: "Secondary", ReplicationScope: "Domain", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.10.1")}},
+				"windows.dns.ZoneCreate: zone parameter 'ReplicationScope' can not be set for secondary zones",
+			},
+			{
+				"assert error with a directory partition without custom replication scope",
+				ZoneCreateParams{Name: "test.local", ReplicationScope: "Domain", DirectoryPartitionName: "app.test.local"},
+				"windows.dns.ZoneCreate: zone parameter 'DirectoryPartitionName' can only be set with the replication scope 'Custom'",
+			},
+			{
+				"assert error without notify servers",
+				ZoneCreateParams{Name: "test.local", Notify: "NotifyServers"},
+				"windows.dns.ZoneCreate: zone parameter 'NotifyServers' must be set if 'Notify' is 'NotifyServers'",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockConn := mockConnection.NewMockConnection(suite.T())
+			c := &Client{
+				Connection:      mockConn,
+				decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+			}
+			_, err := c.ZoneCreate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
+
+// Test ZoneUpdate related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneUpdatePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ZoneUpdateParams
+			zoneType        string
+			expectedCmd     string
+		}{
+			{
+				"assert primary zone",
+				ZoneUpdateParams{Name: "test.local", DynamicUpdate: "NonsecureAndSecure", SecureSecondaries: "TransferAnyServer"},
+				"Primary",
+				"Set-DnsServerPrimaryZone -Name 'test.local' -DynamicUpdate 'NonsecureAndSecure' -SecureSecondaries 'TransferAnyServer' -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+			{
+				"assert stub zone",
+				ZoneUpdateParams{Name: "test.local", ReplicationScope: "Forest", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.10.1")}},
+				"Stub",
+				"Set-DnsServerStubZone -Name 'test.local' -ReplicationScope 'Forest' -MasterServers @('192.168.10.1') -ErrorAction Stop ;Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			actualCmd := tc.inputParameters.pwshCommand(tc.zoneType)
+			suite.Equal(tc.expectedCmd, actualCmd)
+		}
+	})
+}
+
+func (suite *DnsServerUnitTestSuite) TestZoneUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ZoneUpdate(ctx, ZoneUpdateParams{})
+		suite.EqualError(err, "windows.dns.ZoneUpdate: zone parameter 'Name' must be set")
+
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsServerZone -Name 'test.local' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: zone}, nil)
+		_, err = c.ZoneUpdate(ctx, ZoneUpdateParams{Name: "test.local", MasterServers: []netip.Addr{netip.MustParseAddr("192.168.10.1")}})
+		suite.EqualError(err, "windows.dns.ZoneUpdate: zone parameter 'MasterServers' can only be set for secondary and stub zones")
+	})
+}
+
+// Test ZoneDelete related methods.
+func (suite *DnsServerUnitTestSuite) TestZoneDeletePwshCommand() {
+	suite.Run("should return the correct command", func() {
+		actualCmd := ZoneDeleteParams{Name: "test.local"}.pwshCommand()
+		suite.Equal("Remove-DnsServerZone -Force -Name 'test.local'", actualCmd)
+	})
+}
@@ -0,0 +1,108 @@
+// Package dnsclient provides a Go library for handling the Windows DNS client.
+// The functions are related to the Powershell dns client cmdlets provided by Windows,
+// which configure how a Windows host resolves names.
+//
+// https://learn.microsoft.com/en-us/powershell/module/dnsclient/?view=windowsserver2022-ps
+package dnsclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/d-strobel/gowindows/connection"
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// dnsclient is a type constraint for the run function, ensuring it works with specific types.
+type dnsclient interface {
+	[]serverAddressObject | interfaceObject | []interfaceObject | globalSettingObject
+}
+
+// API defines the DNS client functions of the Client.
+// Use this interface to replace the Client with a mock in tests.
+type API interface {
+	ServerAddressRead(ctx context.Context, params ServerAddressReadParams) (ServerAddress, error)
+	ServerAddressUpdate(ctx context.Context, params ServerAddressUpdateParams) (ServerAddress, error)
+
+	InterfaceRead(ctx context.Context, params InterfaceReadParams) (Interface, error)
+	InterfaceList(ctx context.Context) ([]Interface, error)
+	InterfaceUpdate(ctx context.Context, params InterfaceUpdateParams) (Interface, error)
+
+	GlobalSettingRead(ctx context.Context) (GlobalSetting, error)
+	GlobalSettingUpdate(ctx context.Context, params GlobalSettingUpdateParams) (GlobalSetting, error)
+}
+
+// Ensure that the Client implements the API interface.
+var _ API = (*Client)(nil)
+
+// Client represents a client for handling DNS client functions.
+type Client struct {
+	// Connection represents a connection.Connection object.
+	Connection connection.Connection
+
+	// decodeCliXmlErr represents a function that decodes a CLIXML error and returns a human readable string.
+	decodeCliXmlErr func(string) (string, error)
+}
+
+// NewClient returns a new instance of the Client.
+func NewClient(conn connection.Connection) *Client {
+	return NewClientWithParser(conn, parsing.DecodeCliXmlErr)
+}
+
+// NewClientWithParser returns a new instance of the Client.
+// It requires a connection and parsing as input parameters.
+func NewClientWithParser(conn connection.Connection, parsing func(string) (string, error)) *Client {
+	return &Client{Connection: conn, decodeCliXmlErr: parsing}
+}
+
+// pwshInterface returns the parameter that selects a network interface by its alias or index.
+func pwshInterface(alias string, index uint32) string {
+	if alias != "" {
+		return fmt.Sprintf("-InterfaceAlias '%s'", alias)
+	}
+	return fmt.Sprintf("-InterfaceIndex %d", index)
+}
+
+// validateInterface returns an error if the network interface is not selected by either its alias or its index.
+func validateInterface(alias string, index uint32) error {
+	if alias == "" && index == 0 {
+		return errors.New("interface parameter 'InterfaceAlias' or 'InterfaceIndex' must be set")
+	}
+	if alias != "" && index != 0 {
+		return errors.New("interface parameters 'InterfaceAlias' and 'InterfaceIndex' can not be used together")
+	}
+	return nil
+}
+
+// run runs a PowerShell command against a Windows system, handles the command results,
+// and unmarshals the output into a local object type.
+func run[T dnsclient](ctx context.Context, c *Client, cmd string, d *T) error {
+	// Run the command
+	result, err := c.Connection.RunWithPowershell(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	// Handle stderr
+	if result.StdErr != "" {
+		stderr, err := c.decodeCliXmlErr(result.StdErr)
+		if err != nil {
+			return err
+		}
+
+		return parsing.NewPwshError(stderr)
+	}
+
+	if result.StdOut == "" {
+		return nil
+	}
+
+	// Unmarshal stdout
+	if err = json.Unmarshal([]byte(result.StdOut), &d); err != nil {
+		return err
+	}
+
+	return nil
+}
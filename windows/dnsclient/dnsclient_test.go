@@ -0,0 +1,86 @@
+package dnsclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+// Unit test suite for all dns client functions
+type DnsClientUnitTestSuite struct {
+	suite.Suite
+}
+
+// Run all dns client unit tests
+func TestDnsClientUnitTestSuite(t *testing.T) {
+	suite.Run(t, &DnsClientUnitTestSuite{})
+}
+
+func (suite *DnsClientUnitTestSuite) TestNewClient() {
+	suite.Run("should return a new dns client", func() {
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		mockDecodeCliXmlErr := func(s string) (string, error) { return "", nil }
+		actualClient := NewClientWithParser(mockConn, mockDecodeCliXmlErr)
+		expectedClient := &Client{Connection: mockConn, decodeCliXmlErr: mockDecodeCliXmlErr}
+		suite.IsType(expectedClient, actualClient)
+		suite.Equal(expectedClient.Connection, actualClient.Connection)
+	})
+}
+
+func (suite *DnsClientUnitTestSuite) TestDnsClientRun() {
+	suite.T().Parallel()
+
+	suite.Run("should return the global settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		cmd := "Get-DnsClientGlobalSetting | ConvertTo-Json -Compress"
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, cmd).
+			Return(connection.CmdResult{StdOut: globalSettingJson}, nil)
+		var o globalSettingObject
+		err := run(ctx, c, cmd, &o)
+		suite.NoError(err)
+		suite.Equal([]string{"test.local", "example.com"}, o.SuffixSearchList)
+	})
+
+	suite.Run("should return an error on stderr", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		cmd := "Get-DnsClient -InterfaceAlias 'notexist' | ConvertTo-Json -Compress"
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, cmd).
+			Return(connection.CmdResult{StdErr: interfaceNotFoundErr}, nil)
+		var o interfaceObject
+		err := run(ctx, c, cmd, &o)
+		suite.ErrorContains(err, "No MSFT_DNSClient objects found")
+	})
+}
+
+func (suite *DnsClientUnitTestSuite) TestPwshInterface() {
+	suite.T().Parallel()
+
+	suite.Run("should select the interface by alias or index", func() {
+		suite.Equal("-InterfaceAlias 'Ethernet'", pwshInterface("Ethernet", 0))
+		suite.Equal("-InterfaceIndex 4", pwshInterface("", 4))
+	})
+
+	suite.Run("should return an error if the interface is not selected", func() {
+		suite.EqualError(validateInterface("", 0), "interface parameter 'InterfaceAlias' or 'InterfaceIndex' must be set")
+		suite.EqualError(validateInterface("Ethernet", 4), "interface parameters 'InterfaceAlias' and 'InterfaceIndex' can not be used together")
+		suite.NoError(validateInterface("Ethernet", 0))
+		suite.NoError(validateInterface("", 4))
+	})
+}
@@ -0,0 +1,108 @@
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// GlobalSetting represents the DNS client settings that apply to all network interfaces.
+// Names without a dot are queried with the suffixes of the search list in the order of the list.
+type GlobalSetting struct {
+	SuffixSearchList []string
+	UseDevolution    bool
+	DevolutionLevel  uint32
+}
+
+// globalSettingObject contains the unmarshaled json of the powershell dns client global setting object.
+type globalSettingObject struct {
+	SuffixSearchList []string `json:"SuffixSearchList"`
+	UseDevolution    bool     `json:"UseDevolution"`
+	DevolutionLevel  uint32   `json:"DevolutionLevel"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the globalSettingObject to a GlobalSetting object.
+func (g *GlobalSetting) convertOutput(o globalSettingObject) {
+	g.SuffixSearchList = o.SuffixSearchList
+	g.UseDevolution = o.UseDevolution
+	g.DevolutionLevel = o.DevolutionLevel
+}
+
+// GlobalSettingRead gets the global DNS client settings. It returns a GlobalSetting object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) GlobalSettingRead(ctx context.Context) (GlobalSetting, error) {
+	var g GlobalSetting
+	var o globalSettingObject
+
+	// Run command
+	cmd := "Get-DnsClientGlobalSetting | ConvertTo-Json -Compress"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return g, winerror.Errorf(cmd, "windows.dnsclient.GlobalSettingRead: %w", err)
+	}
+
+	// Convert the output to a GlobalSetting object.
+	g.convertOutput(o)
+
+	return g, nil
+}
+
+// GlobalSettingUpdateParams represents parameters for the GlobalSettingUpdate function.
+// All settings are applied, so the parameters describe the complete global configuration.
+type GlobalSettingUpdateParams struct {
+	// Specifies the DNS suffixes that are appended to names without a dot, e.g. "corp.example.com".
+	// If not provided, the search list is cleared and the suffixes of the connections are used.
+	SuffixSearchList []string
+
+	// Specifies whether the parent domains of the primary DNS suffix are appended to names without a dot.
+	UseDevolution bool
+
+	// Specifies the minimum number of labels of the parent domains that are appended.
+	// If not provided, the level is determined by the number of labels of the forest root domain.
+	DevolutionLevel uint32
+}
+
+// pwshCommand returns the PowerShell command to update the global DNS client settings.
+func (params GlobalSettingUpdateParams) pwshCommand() string {
+	suffixes := []string{}
+	for _, suffix := range params.SuffixSearchList {
+		suffixes = append(suffixes, fmt.Sprintf("'%s'", suffix))
+	}
+
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsClientGlobalSetting -SuffixSearchList @(%s)", strings.Join(suffixes, ","))}
+
+	// Add parameters
+	cmd = append(cmd, fmt.Sprintf("-UseDevolution $%t", params.UseDevolution))
+	cmd = append(cmd, fmt.Sprintf("-DevolutionLevel %d", params.DevolutionLevel))
+
+	cmd = append(cmd, "-ErrorAction Stop ;Get-DnsClientGlobalSetting | ConvertTo-Json -Compress")
+	return strings.Join(cmd, " ")
+}
+
+// GlobalSettingUpdate updates the global DNS client settings. It returns a GlobalSetting object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) GlobalSettingUpdate(ctx context.Context, params GlobalSettingUpdateParams) (GlobalSetting, error) {
+	var g GlobalSetting
+	var o globalSettingObject
+
+	// Assert parameters
+	for _, suffix := range params.SuffixSearchList {
+		if suffix == "" {
+			return g, errors.New("windows.dnsclient.GlobalSettingUpdate: global setting parameter 'SuffixSearchList' must not contain empty suffixes")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return g, winerror.Errorf(cmd, "windows.dnsclient.GlobalSettingUpdate: %w", err)
+	}
+
+	// Convert the output to a GlobalSetting object.
+	g.convertOutput(o)
+
+	return g, nil
+}
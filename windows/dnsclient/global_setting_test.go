@@ -0,0 +1,96 @@
+package dnsclient
+
+import (
+	"context"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	globalSettingJson = `{"UseSuffixSearchList":true,"SuffixSearchList":["test.local","example.com"],"UseDevolution":true,"DevolutionLevel":0,"PSComputerName":null}`
+)
+
+// Test GlobalSettingRead related methods.
+func (suite *DnsClientUnitTestSuite) TestGlobalSettingRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the global settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsClientGlobalSetting | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: globalSettingJson}, nil)
+		actual, err := c.GlobalSettingRead(ctx)
+		suite.NoError(err)
+		suite.Equal(GlobalSetting{SuffixSearchList: []string{"test.local", "example.com"}, UseDevolution: true}, actual)
+	})
+}
+
+// Test GlobalSettingUpdate related methods.
+func (suite *DnsClientUnitTestSuite) TestGlobalSettingUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters GlobalSettingUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command without suffixes",
+				GlobalSettingUpdateParams{UseDevolution: true},
+				"Set-DnsClientGlobalSetting -SuffixSearchList @() -UseDevolution $true -DevolutionLevel 0 -ErrorAction Stop ;Get-DnsClientGlobalSetting | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with suffixes",
+				GlobalSettingUpdateParams{SuffixSearchList: []string{"test.local", "example.com"}, DevolutionLevel: 2},
+				"Set-DnsClientGlobalSetting -SuffixSearchList @('test.local','example.com') -UseDevolution $false -DevolutionLevel 2 -ErrorAction Stop ;Get-DnsClientGlobalSetting | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsClientUnitTestSuite) TestGlobalSettingUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return the updated global settings", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := GlobalSettingUpdateParams{SuffixSearchList: []string{"test.local", "example.com"}, UseDevolution: true}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: globalSettingJson}, nil)
+		actual, err := c.GlobalSettingUpdate(ctx, params)
+		suite.NoError(err)
+		suite.Equal(GlobalSetting{SuffixSearchList: []string{"test.local", "example.com"}, UseDevolution: true}, actual)
+	})
+
+	suite.Run("should return an error with an empty suffix", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.GlobalSettingUpdate(ctx, GlobalSettingUpdateParams{SuffixSearchList: []string{""}})
+		suite.EqualError(err, "windows.dnsclient.GlobalSettingUpdate: global setting parameter 'SuffixSearchList' must not contain empty suffixes")
+	})
+}
@@ -0,0 +1,163 @@
+package dnsclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// Interface represents the DNS client settings of a network interface.
+type Interface struct {
+	InterfaceAlias                     string
+	InterfaceIndex                     uint32
+	ConnectionSpecificSuffix           string
+	ConnectionSpecificSuffixSearchList []string
+	RegisterThisConnectionsAddress     bool
+	UseSuffixWhenRegistering           bool
+}
+
+// interfaceObject contains the unmarshaled json of the powershell dns client object.
+type interfaceObject struct {
+	InterfaceAlias                     string   `json:"InterfaceAlias"`
+	InterfaceIndex                     uint32   `json:"InterfaceIndex"`
+	ConnectionSpecificSuffix           string   `json:"ConnectionSpecificSuffix"`
+	ConnectionSpecificSuffixSearchList []string `json:"ConnectionSpecificSuffixSearchList"`
+	RegisterThisConnectionsAddress     bool     `json:"RegisterThisConnectionsAddress"`
+	UseSuffixWhenRegistering           bool     `json:"UseSuffixWhenRegistering"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the interfaceObject to an Interface object.
+func (i *Interface) convertOutput(o interfaceObject) {
+	i.InterfaceAlias = o.InterfaceAlias
+	i.InterfaceIndex = o.InterfaceIndex
+	i.ConnectionSpecificSuffix = o.ConnectionSpecificSuffix
+	i.ConnectionSpecificSuffixSearchList = o.ConnectionSpecificSuffixSearchList
+	i.RegisterThisConnectionsAddress = o.RegisterThisConnectionsAddress
+	i.UseSuffixWhenRegistering = o.UseSuffixWhenRegistering
+}
+
+// InterfaceReadParams represents parameters for the InterfaceRead function.
+// The network interface is selected by either its alias or its index.
+type InterfaceReadParams struct {
+	// Specifies the alias of the network interface, e.g. "Ethernet".
+	InterfaceAlias string
+
+	// Specifies the index of the network interface.
+	InterfaceIndex uint32
+}
+
+// pwshCommand returns the PowerShell command to read the DNS client settings of a network interface.
+func (params InterfaceReadParams) pwshCommand() string {
+	return fmt.Sprintf("Get-DnsClient %s | ConvertTo-Json -Compress", pwshInterface(params.InterfaceAlias, params.InterfaceIndex))
+}
+
+// InterfaceRead gets the DNS client settings of a network interface. It returns an Interface object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) InterfaceRead(ctx context.Context, params InterfaceReadParams) (Interface, error) {
+	var i Interface
+	var o interfaceObject
+
+	// Assert needed parameters
+	if err := validateInterface(params.InterfaceAlias, params.InterfaceIndex); err != nil {
+		return i, fmt.Errorf("windows.dnsclient.InterfaceRead: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return i, winerror.Errorf(cmd, "windows.dnsclient.InterfaceRead: %w", err)
+	}
+
+	// Convert the output to an Interface object.
+	i.convertOutput(o)
+
+	return i, nil
+}
+
+// InterfaceList gets the DNS client settings of all network interfaces. It returns a list of Interface objects.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) InterfaceList(ctx context.Context) ([]Interface, error) {
+	var i []Interface
+	var o []interfaceObject
+
+	// Run command
+	cmd := "$i=@(Get-DnsClient) ;if($i.Count -ge 2){ConvertTo-Json $i -Compress}else{ConvertTo-Json @($i) -Compress}"
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return i, winerror.Errorf(cmd, "windows.dnsclient.InterfaceList: %w", err)
+	}
+
+	// Convert the output to Interface objects.
+	for _, object := range o {
+		var netInterface Interface
+		netInterface.convertOutput(object)
+		i = append(i, netInterface)
+	}
+
+	return i, nil
+}
+
+// InterfaceUpdateParams represents parameters for the InterfaceUpdate function.
+// The network interface is selected by either its alias or its index.
+// All settings are applied, so the parameters describe the complete DNS client settings of the interface.
+type InterfaceUpdateParams struct {
+	// Specifies the alias of the network interface, e.g. "Ethernet".
+	InterfaceAlias string
+
+	// Specifies the index of the network interface.
+	InterfaceIndex uint32
+
+	// Specifies the DNS suffix of the connection, e.g. "corp.example.com".
+	// If not provided, the suffix is reset to the suffix assigned by DHCP.
+	ConnectionSpecificSuffix string
+
+	// Specifies whether the IP addresses of the connection are registered in DNS.
+	RegisterThisConnectionsAddress bool
+
+	// Specifies whether the IP addresses of the connection are registered with the suffix of the connection.
+	UseSuffixWhenRegistering bool
+}
+
+// pwshCommand returns the PowerShell command to update the DNS client settings of a network interface.
+func (params InterfaceUpdateParams) pwshCommand() string {
+	selector := pwshInterface(params.InterfaceAlias, params.InterfaceIndex)
+
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsClient %s", selector)}
+
+	// Add parameters
+	if params.ConnectionSpecificSuffix != "" {
+		cmd = append(cmd, fmt.Sprintf("-ConnectionSpecificSuffix '%s'", params.ConnectionSpecificSuffix))
+	} else {
+		cmd = append(cmd, "-ResetConnectionSpecificSuffix")
+	}
+	cmd = append(cmd, fmt.Sprintf("-RegisterThisConnectionsAddress $%t", params.RegisterThisConnectionsAddress))
+	cmd = append(cmd, fmt.Sprintf("-UseSuffixWhenRegistering $%t", params.UseSuffixWhenRegistering))
+
+	cmd = append(cmd, fmt.Sprintf("-ErrorAction Stop ;Get-DnsClient %s | ConvertTo-Json -Compress", selector))
+	return strings.Join(cmd, " ")
+}
+
+// InterfaceUpdate updates the DNS client settings of a network interface. It returns an Interface object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) InterfaceUpdate(ctx context.Context, params InterfaceUpdateParams) (Interface, error) {
+	var i Interface
+	var o interfaceObject
+
+	// Assert needed parameters
+	if err := validateInterface(params.InterfaceAlias, params.InterfaceIndex); err != nil {
+		return i, fmt.Errorf("windows.dnsclient.InterfaceUpdate: %w", err)
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return i, winerror.Errorf(cmd, "windows.dnsclient.InterfaceUpdate: %w", err)
+	}
+
+	// Convert the output to an Interface object.
+	i.convertOutput(o)
+
+	return i, nil
+}
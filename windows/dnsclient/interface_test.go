@@ -0,0 +1,155 @@
+package dnsclient
+
+import (
+	"context"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	interfaceJson     = `{"InterfaceAlias":"Ethernet","InterfaceIndex":4,"ConnectionSpecificSuffix":"test.local","ConnectionSpecificSuffixSearchList":["test.local"],"RegisterThisConnectionsAddress":true,"UseSuffixWhenRegistering":false,"PSComputerName":null}`
+	interfaceListJson = `[{"InterfaceAlias":"Ethernet","InterfaceIndex":4,"ConnectionSpecificSuffix":"test.local","ConnectionSpecificSuffixSearchList":["test.local"],"RegisterThisConnectionsAddress":true,"UseSuffixWhenRegistering":false,"PSComputerName":null},{"InterfaceAlias":"Loopback Pseudo-Interface 1","InterfaceIndex":1,"ConnectionSpecificSuffix":"","ConnectionSpecificSuffixSearchList":[],"RegisterThisConnectionsAddress":true,"UseSuffixWhenRegistering":false,"PSComputerName":null}]`
+
+	interfaceNotFoundErr = `Get-DnsClient : No MSFT_DNSClient objects found with property 'InterfaceAlias' equal to 'notexist'.  Verify the value of the property and retry.
+        CategoryInfo          : ObjectNotFound: (notexist:String) [Get-DnsClient], CimJobException
+        FullyQualifiedErrorId : CmdletizationQuery_NotFound_InterfaceAlias,Get-DnsClient
+	`
+)
+
+var expectedInterface = Interface{
+	InterfaceAlias:                     "Ethernet",
+	InterfaceIndex:                     4,
+	ConnectionSpecificSuffix:           "test.local",
+	ConnectionSpecificSuffixSearchList: []string{"test.local"},
+	RegisterThisConnectionsAddress:     true,
+}
+
+// Test InterfaceRead related methods.
+func (suite *DnsClientUnitTestSuite) TestInterfaceReadPwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		suite.Equal("Get-DnsClient -InterfaceAlias 'Ethernet' | ConvertTo-Json -Compress", InterfaceReadParams{InterfaceAlias: "Ethernet"}.pwshCommand())
+		suite.Equal("Get-DnsClient -InterfaceIndex 4 | ConvertTo-Json -Compress", InterfaceReadParams{InterfaceIndex: 4}.pwshCommand())
+	})
+}
+
+func (suite *DnsClientUnitTestSuite) TestInterfaceRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the interface", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "Get-DnsClient -InterfaceAlias 'Ethernet' | ConvertTo-Json -Compress").
+			Return(connection.CmdResult{StdOut: interfaceJson}, nil)
+		actual, err := c.InterfaceRead(ctx, InterfaceReadParams{InterfaceAlias: "Ethernet"})
+		suite.NoError(err)
+		suite.Equal(expectedInterface, actual)
+	})
+
+	suite.Run("should return an error without interface", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.InterfaceRead(ctx, InterfaceReadParams{})
+		suite.EqualError(err, "windows.dnsclient.InterfaceRead: interface parameter 'InterfaceAlias' or 'InterfaceIndex' must be set")
+	})
+}
+
+// Test InterfaceList related methods.
+func (suite *DnsClientUnitTestSuite) TestInterfaceList() {
+	suite.T().Parallel()
+
+	suite.Run("should return all interfaces", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$i=@(Get-DnsClient) ;if($i.Count -ge 2){ConvertTo-Json $i -Compress}else{ConvertTo-Json @($i) -Compress}").
+			Return(connection.CmdResult{StdOut: interfaceListJson}, nil)
+		actual, err := c.InterfaceList(ctx)
+		suite.NoError(err)
+		suite.Equal([]Interface{
+			expectedInterface,
+			{InterfaceAlias: "Loopback Pseudo-Interface 1", InterfaceIndex: 1, ConnectionSpecificSuffixSearchList: []string{}, RegisterThisConnectionsAddress: true},
+		}, actual)
+	})
+}
+
+// Test InterfaceUpdate related methods.
+func (suite *DnsClientUnitTestSuite) TestInterfaceUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters InterfaceUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command without suffix",
+				InterfaceUpdateParams{InterfaceIndex: 4, RegisterThisConnectionsAddress: true},
+				"Set-DnsClient -InterfaceIndex 4 -ResetConnectionSpecificSuffix -RegisterThisConnectionsAddress $true -UseSuffixWhenRegistering $false -ErrorAction Stop ;Get-DnsClient -InterfaceIndex 4 | ConvertTo-Json -Compress",
+			},
+			{
+				"assert command with suffix",
+				InterfaceUpdateParams{InterfaceAlias: "Ethernet", ConnectionSpecificSuffix: "test.local", UseSuffixWhenRegistering: true},
+				"Set-DnsClient -InterfaceAlias 'Ethernet' -ConnectionSpecificSuffix 'test.local' -RegisterThisConnectionsAddress $false -UseSuffixWhenRegistering $true -ErrorAction Stop ;Get-DnsClient -InterfaceAlias 'Ethernet' | ConvertTo-Json -Compress",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsClientUnitTestSuite) TestInterfaceUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return the updated interface", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := InterfaceUpdateParams{InterfaceAlias: "Ethernet", ConnectionSpecificSuffix: "test.local", RegisterThisConnectionsAddress: true}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: interfaceJson}, nil)
+		actual, err := c.InterfaceUpdate(ctx, params)
+		suite.NoError(err)
+		suite.Equal(expectedInterface, actual)
+	})
+
+	suite.Run("should return an error with alias and index", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.InterfaceUpdate(ctx, InterfaceUpdateParams{InterfaceAlias: "Ethernet", InterfaceIndex: 4})
+		suite.EqualError(err, "windows.dnsclient.InterfaceUpdate: interface parameters 'InterfaceAlias' and 'InterfaceIndex' can not be used together")
+	})
+}
@@ -0,0 +1,436 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dnsclient
+
+import (
+	context "context"
+
+	dnsclient "github.com/d-strobel/gowindows/windows/dnsclient"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAPI is an autogenerated mock type for the API type
+type MockAPI struct {
+	mock.Mock
+}
+
+type MockAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPI) EXPECT() *MockAPI_Expecter {
+	return &MockAPI_Expecter{mock: &_m.Mock}
+}
+
+// GlobalSettingRead provides a mock function with given fields: ctx
+func (_m *MockAPI) GlobalSettingRead(ctx context.Context) (dnsclient.GlobalSetting, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSettingRead")
+	}
+
+	var r0 dnsclient.GlobalSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dnsclient.GlobalSetting, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dnsclient.GlobalSetting); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dnsclient.GlobalSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GlobalSettingRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSettingRead'
+type MockAPI_GlobalSettingRead_Call struct {
+	*mock.Call
+}
+
+// GlobalSettingRead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) GlobalSettingRead(ctx interface{}) *MockAPI_GlobalSettingRead_Call {
+	return &MockAPI_GlobalSettingRead_Call{Call: _e.mock.On("GlobalSettingRead", ctx)}
+}
+
+func (_c *MockAPI_GlobalSettingRead_Call) Run(run func(ctx context.Context)) *MockAPI_GlobalSettingRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GlobalSettingRead_Call) Return(_a0 dnsclient.GlobalSetting, _a1 error) *MockAPI_GlobalSettingRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GlobalSettingRead_Call) RunAndReturn(run func(context.Context) (dnsclient.GlobalSetting, error)) *MockAPI_GlobalSettingRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSettingUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GlobalSettingUpdate(ctx context.Context, params dnsclient.GlobalSettingUpdateParams) (dnsclient.GlobalSetting, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSettingUpdate")
+	}
+
+	var r0 dnsclient.GlobalSetting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.GlobalSettingUpdateParams) (dnsclient.GlobalSetting, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.GlobalSettingUpdateParams) dnsclient.GlobalSetting); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dnsclient.GlobalSetting)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dnsclient.GlobalSettingUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GlobalSettingUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSettingUpdate'
+type MockAPI_GlobalSettingUpdate_Call struct {
+	*mock.Call
+}
+
+// GlobalSettingUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dnsclient.GlobalSettingUpdateParams
+func (_e *MockAPI_Expecter) GlobalSettingUpdate(ctx interface{}, params interface{}) *MockAPI_GlobalSettingUpdate_Call {
+	return &MockAPI_GlobalSettingUpdate_Call{Call: _e.mock.On("GlobalSettingUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_GlobalSettingUpdate_Call) Run(run func(ctx context.Context, params dnsclient.GlobalSettingUpdateParams)) *MockAPI_GlobalSettingUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dnsclient.GlobalSettingUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GlobalSettingUpdate_Call) Return(_a0 dnsclient.GlobalSetting, _a1 error) *MockAPI_GlobalSettingUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GlobalSettingUpdate_Call) RunAndReturn(run func(context.Context, dnsclient.GlobalSettingUpdateParams) (dnsclient.GlobalSetting, error)) *MockAPI_GlobalSettingUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InterfaceList provides a mock function with given fields: ctx
+func (_m *MockAPI) InterfaceList(ctx context.Context) ([]dnsclient.Interface, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InterfaceList")
+	}
+
+	var r0 []dnsclient.Interface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dnsclient.Interface, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dnsclient.Interface); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dnsclient.Interface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_InterfaceList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InterfaceList'
+type MockAPI_InterfaceList_Call struct {
+	*mock.Call
+}
+
+// InterfaceList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) InterfaceList(ctx interface{}) *MockAPI_InterfaceList_Call {
+	return &MockAPI_InterfaceList_Call{Call: _e.mock.On("InterfaceList", ctx)}
+}
+
+func (_c *MockAPI_InterfaceList_Call) Run(run func(ctx context.Context)) *MockAPI_InterfaceList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_InterfaceList_Call) Return(_a0 []dnsclient.Interface, _a1 error) *MockAPI_InterfaceList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_InterfaceList_Call) RunAndReturn(run func(context.Context) ([]dnsclient.Interface, error)) *MockAPI_InterfaceList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InterfaceRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) InterfaceRead(ctx context.Context, params dnsclient.InterfaceReadParams) (dnsclient.Interface, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InterfaceRead")
+	}
+
+	var r0 dnsclient.Interface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.InterfaceReadParams) (dnsclient.Interface, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.InterfaceReadParams) dnsclient.Interface); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dnsclient.Interface)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dnsclient.InterfaceReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_InterfaceRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InterfaceRead'
+type MockAPI_InterfaceRead_Call struct {
+	*mock.Call
+}
+
+// InterfaceRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dnsclient.InterfaceReadParams
+func (_e *MockAPI_Expecter) InterfaceRead(ctx interface{}, params interface{}) *MockAPI_InterfaceRead_Call {
+	return &MockAPI_InterfaceRead_Call{Call: _e.mock.On("InterfaceRead", ctx, params)}
+}
+
+func (_c *MockAPI_InterfaceRead_Call) Run(run func(ctx context.Context, params dnsclient.InterfaceReadParams)) *MockAPI_InterfaceRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dnsclient.InterfaceReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_InterfaceRead_Call) Return(_a0 dnsclient.Interface, _a1 error) *MockAPI_InterfaceRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_InterfaceRead_Call) RunAndReturn(run func(context.Context, dnsclient.InterfaceReadParams) (dnsclient.Interface, error)) *MockAPI_InterfaceRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InterfaceUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) InterfaceUpdate(ctx context.Context, params dnsclient.InterfaceUpdateParams) (dnsclient.Interface, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InterfaceUpdate")
+	}
+
+	var r0 dnsclient.Interface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.InterfaceUpdateParams) (dnsclient.Interface, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.InterfaceUpdateParams) dnsclient.Interface); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dnsclient.Interface)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dnsclient.InterfaceUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_InterfaceUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InterfaceUpdate'
+type MockAPI_InterfaceUpdate_Call struct {
+	*mock.Call
+}
+
+// InterfaceUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dnsclient.InterfaceUpdateParams
+func (_e *MockAPI_Expecter) InterfaceUpdate(ctx interface{}, params interface{}) *MockAPI_InterfaceUpdate_Call {
+	return &MockAPI_InterfaceUpdate_Call{Call: _e.mock.On("InterfaceUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_InterfaceUpdate_Call) Run(run func(ctx context.Context, params dnsclient.InterfaceUpdateParams)) *MockAPI_InterfaceUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dnsclient.InterfaceUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_InterfaceUpdate_Call) Return(_a0 dnsclient.Interface, _a1 error) *MockAPI_InterfaceUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_InterfaceUpdate_Call) RunAndReturn(run func(context.Context, dnsclient.InterfaceUpdateParams) (dnsclient.Interface, error)) *MockAPI_InterfaceUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerAddressRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerAddressRead(ctx context.Context, params dnsclient.ServerAddressReadParams) (dnsclient.ServerAddress, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerAddressRead")
+	}
+
+	var r0 dnsclient.ServerAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.ServerAddressReadParams) (dnsclient.ServerAddress, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.ServerAddressReadParams) dnsclient.ServerAddress); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dnsclient.ServerAddress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dnsclient.ServerAddressReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerAddressRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerAddressRead'
+type MockAPI_ServerAddressRead_Call struct {
+	*mock.Call
+}
+
+// ServerAddressRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dnsclient.ServerAddressReadParams
+func (_e *MockAPI_Expecter) ServerAddressRead(ctx interface{}, params interface{}) *MockAPI_ServerAddressRead_Call {
+	return &MockAPI_ServerAddressRead_Call{Call: _e.mock.On("ServerAddressRead", ctx, params)}
+}
+
+func (_c *MockAPI_ServerAddressRead_Call) Run(run func(ctx context.Context, params dnsclient.ServerAddressReadParams)) *MockAPI_ServerAddressRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dnsclient.ServerAddressReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerAddressRead_Call) Return(_a0 dnsclient.ServerAddress, _a1 error) *MockAPI_ServerAddressRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerAddressRead_Call) RunAndReturn(run func(context.Context, dnsclient.ServerAddressReadParams) (dnsclient.ServerAddress, error)) *MockAPI_ServerAddressRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ServerAddressUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) ServerAddressUpdate(ctx context.Context, params dnsclient.ServerAddressUpdateParams) (dnsclient.ServerAddress, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerAddressUpdate")
+	}
+
+	var r0 dnsclient.ServerAddress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.ServerAddressUpdateParams) (dnsclient.ServerAddress, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dnsclient.ServerAddressUpdateParams) dnsclient.ServerAddress); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dnsclient.ServerAddress)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dnsclient.ServerAddressUpdateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_ServerAddressUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerAddressUpdate'
+type MockAPI_ServerAddressUpdate_Call struct {
+	*mock.Call
+}
+
+// ServerAddressUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params dnsclient.ServerAddressUpdateParams
+func (_e *MockAPI_Expecter) ServerAddressUpdate(ctx interface{}, params interface{}) *MockAPI_ServerAddressUpdate_Call {
+	return &MockAPI_ServerAddressUpdate_Call{Call: _e.mock.On("ServerAddressUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_ServerAddressUpdate_Call) Run(run func(ctx context.Context, params dnsclient.ServerAddressUpdateParams)) *MockAPI_ServerAddressUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dnsclient.ServerAddressUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_ServerAddressUpdate_Call) Return(_a0 dnsclient.ServerAddress, _a1 error) *MockAPI_ServerAddressUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_ServerAddressUpdate_Call) RunAndReturn(run func(context.Context, dnsclient.ServerAddressUpdateParams) (dnsclient.ServerAddress, error)) *MockAPI_ServerAddressUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPI creates a new instance of MockAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPI {
+	mock := &MockAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,174 @@
+package dnsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/d-strobel/gowindows/winerror"
+)
+
+// Address families of the powershell server address objects.
+const (
+	addressFamilyIPv4 uint16 = 2
+	addressFamilyIPv6 uint16 = 23
+)
+
+// ServerAddress represents the DNS server addresses of a network interface.
+// The addresses are queried in the order of the list.
+type ServerAddress struct {
+	InterfaceAlias string
+	InterfaceIndex uint32
+	IPv4Addresses  []netip.Addr
+	IPv6Addresses  []netip.Addr
+}
+
+// serverAddressObject contains the unmarshaled json of the powershell server address object.
+// The server addresses of each address family are returned as a separate object.
+type serverAddressObject struct {
+	InterfaceAlias  string   `json:"InterfaceAlias"`
+	InterfaceIndex  uint32   `json:"InterfaceIndex"`
+	AddressFamily   uint16   `json:"AddressFamily"`
+	ServerAddresses []string `json:"ServerAddresses"`
+}
+
+// convertOutput converts the unmarshaled JSON output from the serverAddressObjects to a ServerAddress object.
+func (s *ServerAddress) convertOutput(o []serverAddressObject) error {
+	for _, object := range o {
+		s.InterfaceAlias = object.InterfaceAlias
+		s.InterfaceIndex = object.InterfaceIndex
+
+		for _, address := range object.ServerAddresses {
+			ip, err := netip.ParseAddr(address)
+			if err != nil {
+				return err
+			}
+
+			switch object.AddressFamily {
+			case addressFamilyIPv4:
+				s.IPv4Addresses = append(s.IPv4Addresses, ip)
+			case addressFamilyIPv6:
+				s.IPv6Addresses = append(s.IPv6Addresses, ip)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pwshServerAddressRead returns the PowerShell command to read the DNS server addresses of both address families.
+func pwshServerAddressRead(alias string, index uint32) string {
+	return fmt.Sprintf("$a=@(Get-DnsClientServerAddress %s) ;if($a.Count -ge 2){ConvertTo-Json $a -Compress}else{ConvertTo-Json @($a) -Compress}", pwshInterface(alias, index))
+}
+
+// ServerAddressReadParams represents parameters for the ServerAddressRead function.
+// The network interface is selected by either its alias or its index.
+type ServerAddressReadParams struct {
+	// Specifies the alias of the network interface, e.g. "Ethernet".
+	InterfaceAlias string
+
+	// Specifies the index of the network interface.
+	InterfaceIndex uint32
+}
+
+// ServerAddressRead gets the DNS server addresses of a network interface. It returns a ServerAddress object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerAddressRead(ctx context.Context, params ServerAddressReadParams) (ServerAddress, error) {
+	var s ServerAddress
+	var o []serverAddressObject
+
+	// Assert needed parameters
+	if err := validateInterface(params.InterfaceAlias, params.InterfaceIndex); err != nil {
+		return s, fmt.Errorf("windows.dnsclient.ServerAddressRead: %w", err)
+	}
+
+	// Run command
+	cmd := pwshServerAddressRead(params.InterfaceAlias, params.InterfaceIndex)
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dnsclient.ServerAddressRead: %w", err)
+	}
+
+	// Convert the output to a ServerAddress object.
+	if err := s.convertOutput(o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dnsclient.ServerAddressRead: failed to convert output to ServerAddress object: %w", err)
+	}
+
+	return s, nil
+}
+
+// ServerAddressUpdateParams represents parameters for the ServerAddressUpdate function.
+// The network interface is selected by either its alias or its index.
+// The addresses of both address families are applied, so the parameters describe the complete configuration.
+type ServerAddressUpdateParams struct {
+	// Specifies the alias of the network interface, e.g. "Ethernet".
+	InterfaceAlias string
+
+	// Specifies the index of the network interface.
+	InterfaceIndex uint32
+
+	// Specifies the IPv4 addresses of the DNS servers in the order they are queried.
+	// If not provided, the IPv4 DNS servers are reset to the addresses assigned by DHCP.
+	IPv4Addresses []netip.Addr
+
+	// Specifies the IPv6 addresses of the DNS servers in the order they are queried.
+	// If not provided, the IPv6 DNS servers are reset to the addresses assigned automatically.
+	IPv6Addresses []netip.Addr
+}
+
+// pwshCommand returns the PowerShell command to update the DNS server addresses of a network interface.
+// The addresses are reset first, so an address family without addresses falls back to the automatic configuration.
+func (params ServerAddressUpdateParams) pwshCommand() string {
+	selector := pwshInterface(params.InterfaceAlias, params.InterfaceIndex)
+
+	// Base command
+	cmd := []string{fmt.Sprintf("Set-DnsClientServerAddress %s -ResetServerAddresses -ErrorAction Stop", selector)}
+
+	// Add addresses
+	addresses := []string{}
+	for _, address := range append(params.IPv4Addresses, params.IPv6Addresses...) {
+		addresses = append(addresses, fmt.Sprintf("'%s'", address.String()))
+	}
+	if len(addresses) > 0 {
+		cmd = append(cmd, fmt.Sprintf("Set-DnsClientServerAddress %s -ServerAddresses @(%s) -ErrorAction Stop", selector, strings.Join(addresses, ",")))
+	}
+
+	cmd = append(cmd, pwshServerAddressRead(params.InterfaceAlias, params.InterfaceIndex))
+	return strings.Join(cmd, " ;")
+}
+
+// ServerAddressUpdate updates the DNS server addresses of a network interface. It returns a ServerAddress object.
+// It returns a *winerror.WinError if the windows client returns an error.
+func (c *Client) ServerAddressUpdate(ctx context.Context, params ServerAddressUpdateParams) (ServerAddress, error) {
+	var s ServerAddress
+	var o []serverAddressObject
+
+	// Assert needed parameters
+	if err := validateInterface(params.InterfaceAlias, params.InterfaceIndex); err != nil {
+		return s, fmt.Errorf("windows.dnsclient.ServerAddressUpdate: %w", err)
+	}
+	for _, address := range params.IPv4Addresses {
+		if !address.Is4() {
+			return s, errors.New("windows.dnsclient.ServerAddressUpdate: server address parameter 'IPv4Addresses' must be a list of valid IPv4 addresses")
+		}
+	}
+	for _, address := range params.IPv6Addresses {
+		if !address.Is6() || address.Is4In6() {
+			return s, errors.New("windows.dnsclient.ServerAddressUpdate: server address parameter 'IPv6Addresses' must be a list of valid IPv6 addresses")
+		}
+	}
+
+	// Run command
+	cmd := params.pwshCommand()
+	if err := run(ctx, c, cmd, &o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dnsclient.ServerAddressUpdate: %w", err)
+	}
+
+	// Convert the output to a ServerAddress object.
+	if err := s.convertOutput(o); err != nil {
+		return s, winerror.Errorf(cmd, "windows.dnsclient.ServerAddressUpdate: failed to convert output to ServerAddress object: %w", err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,159 @@
+package dnsclient
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/d-strobel/gowindows/connection"
+	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+)
+
+// Fixtures
+const (
+	serverAddressJson = `[{"Address":"0x1c0ba8c0","InterfaceAlias":"Ethernet","InterfaceIndex":4,"AddressFamily":2,"ServerAddresses":["192.168.5.1","192.168.5.2"],"PSComputerName":null},{"InterfaceAlias":"Ethernet","InterfaceIndex":4,"AddressFamily":23,"ServerAddresses":["fd00::5:1"],"PSComputerName":null}]`
+
+	serverAddressEmptyJson = `[{"InterfaceAlias":"Ethernet","InterfaceIndex":4,"AddressFamily":2,"ServerAddresses":[],"PSComputerName":null},{"InterfaceAlias":"Ethernet","InterfaceIndex":4,"AddressFamily":23,"ServerAddresses":[],"PSComputerName":null}]`
+)
+
+var expectedServerAddress = ServerAddress{
+	InterfaceAlias: "Ethernet",
+	InterfaceIndex: 4,
+	IPv4Addresses:  []netip.Addr{netip.MustParseAddr("192.168.5.1"), netip.MustParseAddr("192.168.5.2")},
+	IPv6Addresses:  []netip.Addr{netip.MustParseAddr("fd00::5:1")},
+}
+
+// Test ServerAddressRead related methods.
+func (suite *DnsClientUnitTestSuite) TestServerAddressRead() {
+	suite.T().Parallel()
+
+	suite.Run("should return the server addresses by alias", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$a=@(Get-DnsClientServerAddress -InterfaceAlias 'Ethernet') ;if($a.Count -ge 2){ConvertTo-Json $a -Compress}else{ConvertTo-Json @($a) -Compress}").
+			Return(connection.CmdResult{StdOut: serverAddressJson}, nil)
+		actual, err := c.ServerAddressRead(ctx, ServerAddressReadParams{InterfaceAlias: "Ethernet"})
+		suite.NoError(err)
+		suite.Equal(expectedServerAddress, actual)
+	})
+
+	suite.Run("should return the server addresses by index", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, "$a=@(Get-DnsClientServerAddress -InterfaceIndex 4) ;if($a.Count -ge 2){ConvertTo-Json $a -Compress}else{ConvertTo-Json @($a) -Compress}").
+			Return(connection.CmdResult{StdOut: serverAddressEmptyJson}, nil)
+		actual, err := c.ServerAddressRead(ctx, ServerAddressReadParams{InterfaceIndex: 4})
+		suite.NoError(err)
+		suite.Equal(ServerAddress{InterfaceAlias: "Ethernet", InterfaceIndex: 4}, actual)
+	})
+
+	suite.Run("should return an error without interface", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		_, err := c.ServerAddressRead(ctx, ServerAddressReadParams{})
+		suite.EqualError(err, "windows.dnsclient.ServerAddressRead: interface parameter 'InterfaceAlias' or 'InterfaceIndex' must be set")
+	})
+}
+
+// Test ServerAddressUpdate related methods.
+func (suite *DnsClientUnitTestSuite) TestServerAddressUpdatePwshCommand() {
+	suite.T().Parallel()
+
+	suite.Run("should return the correct command", func() {
+		tcs := []struct {
+			description     string
+			inputParameters ServerAddressUpdateParams
+			expectedCmd     string
+		}{
+			{
+				"assert command without addresses",
+				ServerAddressUpdateParams{InterfaceAlias: "Ethernet"},
+				"Set-DnsClientServerAddress -InterfaceAlias 'Ethernet' -ResetServerAddresses -ErrorAction Stop ;$a=@(Get-DnsClientServerAddress -InterfaceAlias 'Ethernet') ;if($a.Count -ge 2){ConvertTo-Json $a -Compress}else{ConvertTo-Json @($a) -Compress}",
+			},
+			{
+				"assert command with addresses of both families",
+				ServerAddressUpdateParams{InterfaceIndex: 4, IPv4Addresses: expectedServerAddress.IPv4Addresses, IPv6Addresses: expectedServerAddress.IPv6Addresses},
+				"Set-DnsClientServerAddress -InterfaceIndex 4 -ResetServerAddresses -ErrorAction Stop ;Set-DnsClientServerAddress -InterfaceIndex 4 -ServerAddresses @('192.168.5.1','192.168.5.2','fd00::5:1') -ErrorAction Stop ;$a=@(Get-DnsClientServerAddress -InterfaceIndex 4) ;if($a.Count -ge 2){ConvertTo-Json $a -Compress}else{ConvertTo-Json @($a) -Compress}",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			suite.Equal(tc.expectedCmd, tc.inputParameters.pwshCommand())
+		}
+	})
+}
+
+func (suite *DnsClientUnitTestSuite) TestServerAddressUpdate() {
+	suite.T().Parallel()
+
+	suite.Run("should return the updated server addresses", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		params := ServerAddressUpdateParams{InterfaceAlias: "Ethernet", IPv4Addresses: expectedServerAddress.IPv4Addresses, IPv6Addresses: expectedServerAddress.IPv6Addresses}
+		mockConn.EXPECT().
+			RunWithPowershell(ctx, params.pwshCommand()).
+			Return(connection.CmdResult{StdOut: serverAddressJson}, nil)
+		actual, err := c.ServerAddressUpdate(ctx, params)
+		suite.NoError(err)
+		suite.Equal(expectedServerAddress, actual)
+	})
+
+	suite.Run("should return specific errors", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		mockConn := mockConnection.NewMockConnection(suite.T())
+		c := &Client{
+			Connection:      mockConn,
+			decodeCliXmlErr: func(s string) (string, error) { return s, nil },
+		}
+		tcs := []struct {
+			description     string
+			inputParameters ServerAddressUpdateParams
+			expectedErr     string
+		}{
+			{
+				"assert error with alias and index",
+				ServerAddressUpdateParams{InterfaceAlias: "Ethernet", InterfaceIndex: 4},
+				"windows.dnsclient.ServerAddressUpdate: interface parameters 'InterfaceAlias' and 'InterfaceIndex' can not be used together",
+			},
+			{
+				"assert error with IPv6 address in IPv4 addresses",
+				ServerAddressUpdateParams{InterfaceAlias: "Ethernet", IPv4Addresses: []netip.Addr{netip.MustParseAddr("fd00::5:1")}},
+				"windows.dnsclient.ServerAddressUpdate: server address parameter 'IPv4Addresses' must be a list of valid IPv4 addresses",
+			},
+			{
+				"assert error with IPv4 address in IPv6 addresses",
+				ServerAddressUpdateParams{InterfaceAlias: "Ethernet", IPv6Addresses: []netip.Addr{netip.MustParseAddr("192.168.5.1")}},
+				"windows.dnsclient.ServerAddressUpdate: server address parameter 'IPv6Addresses' must be a list of valid IPv6 addresses",
+			},
+		}
+
+		for _, tc := range tcs {
+			suite.T().Logf("test case: %s", tc.description)
+			_, err := c.ServerAddressUpdate(ctx, tc.inputParameters)
+			suite.EqualError(err, tc.expectedErr)
+		}
+	})
+}
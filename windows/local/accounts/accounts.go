@@ -8,7 +8,6 @@ import (
 	"context"
 	"encoding/json"
 
-	"errors"
 	"github.com/d-strobel/gowindows/connection"
 	"github.com/d-strobel/gowindows/parsing"
 )
@@ -18,6 +17,30 @@ type accounts interface {
 	Group | []Group | User | []User | GroupMember | []GroupMember
 }
 
+// API defines the local Windows account functions of the Client.
+// Use this interface to replace the Client with a mock in tests.
+type API interface {
+	GroupRead(ctx context.Context, params GroupReadParams) (Group, error)
+	GroupList(ctx context.Context) ([]Group, error)
+	GroupCreate(ctx context.Context, params GroupCreateParams) (Group, error)
+	GroupUpdate(ctx context.Context, params GroupUpdateParams) error
+	GroupDelete(ctx context.Context, params GroupDeleteParams) error
+
+	GroupMemberRead(ctx context.Context, params GroupMemberReadParams) (GroupMember, error)
+	GroupMemberList(ctx context.Context, params GroupMemberListParams) ([]GroupMember, error)
+	GroupMemberCreate(ctx context.Context, params GroupMemberCreateParams) error
+	GroupMemberDelete(ctx context.Context, params GroupMemberDeleteParams) error
+
+	UserRead(ctx context.Context, params UserReadParams) (User, error)
+	UserList(ctx context.Context) ([]User, error)
+	UserCreate(ctx context.Context, params UserCreateParams) (User, error)
+	UserUpdate(ctx context.Context, params UserUpdateParams) error
+	UserDelete(ctx context.Context, params UserDeleteParams) error
+}
+
+// Ensure that the Client implements the API interface.
+var _ API = (*Client)(nil)
+
 // Client represents a client for handling local Windows functions.
 type Client struct {
 	// Connection represents a connection.Connection object.
@@ -44,6 +67,22 @@ type SID struct {
 	Value string `json:"Value"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface for the SID type.
+// Windows PowerShell 5.1 returns the SID as an object with a "Value" field.
+// PowerShell 7 loads the local accounts module with the Windows PowerShell compatibility feature,
+// which returns deserialized objects with the SID as a plain string.
+func (s *SID) UnmarshalJSON(b []byte) error {
+	// Handle the PowerShell 7 output.
+	if len(b) > 0 && b[0] == '"' {
+		return json.Unmarshal(b, &s.Value)
+	}
+
+	// Handle the Windows PowerShell 5.1 output.
+	// The alias type prevents a recursive call of this function.
+	type sidAlias SID
+	return json.Unmarshal(b, (*sidAlias)(s))
+}
+
 // run runs a PowerShell command against a Windows system, handles the command results,
 // and unmarshals the output into a local object type.
 func run[T accounts](ctx context.Context, c *Client, cmd string, l *T) error {
@@ -60,7 +99,7 @@ func run[T accounts](ctx context.Context, c *Client, cmd string, l *T) error {
 			return err
 		}
 
-		return errors.New(stderr)
+		return parsing.NewPwshError(stderr)
 	}
 
 	if result.StdOut == "" {
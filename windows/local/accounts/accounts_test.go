@@ -2,11 +2,13 @@ package accounts
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 
 	"github.com/d-strobel/gowindows/connection"
 	mockConnection "github.com/d-strobel/gowindows/connection/mocks"
+	"github.com/d-strobel/gowindows/parsing"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -121,7 +123,7 @@ func (suite *LocalUnitTestSuite) TestLocalRun() {
 			RunWithPowershell(ctx, cmd).
 			Return(connection.CmdResult{StdOut: "", StdErr: "test-error"}, nil)
 		var g Group
-		expectedErr := errors.New("test-error")
+		expectedErr := &parsing.PwshError{Message: "test-error"}
 		err := run(ctx, c, cmd, &g)
 		suite.Error(err)
 		suite.Equal(expectedErr, err)
@@ -144,3 +146,28 @@ func (suite *LocalUnitTestSuite) TestLocalRun() {
 		suite.Error(err)
 	})
 }
+
+func (suite *LocalUnitTestSuite) TestSIDUnmarshalJSON() {
+	suite.T().Parallel()
+
+	suite.Run("should unmarshal the Windows PowerShell SID object", func() {
+		var sid SID
+		err := json.Unmarshal([]byte(`{"BinaryLength":16,"AccountDomainSid":null,"Value":"S-1-5-32-545"}`), &sid)
+		suite.NoError(err)
+		suite.Equal(SID{Value: "S-1-5-32-545"}, sid)
+	})
+
+	suite.Run("should unmarshal the PowerShell 7 SID string", func() {
+		var sid SID
+		err := json.Unmarshal([]byte(`"S-1-5-32-545"`), &sid)
+		suite.NoError(err)
+		suite.Equal(SID{Value: "S-1-5-32-545"}, sid)
+	})
+
+	suite.Run("should unmarshal a PowerShell 7 group", func() {
+		var g Group
+		err := json.Unmarshal([]byte(`{"Description":"Test group","Name":"Test","SID":"S-123456789","PrincipalSource":"Local","ObjectClass":"Group"}`), &g)
+		suite.NoError(err)
+		suite.Equal(expectedTestGroup, g)
+	})
+}
@@ -60,7 +60,7 @@ func (c *Client) GroupRead(ctx context.Context, params GroupReadParams) (Group,
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &g); err != nil {
-		return g, winerror.Errorf(cmd, "windows.local.accounts.GroupRead: %s", err)
+		return g, winerror.Errorf(cmd, "windows.local.accounts.GroupRead: %w", err)
 	}
 	return g, nil
 }
@@ -75,7 +75,7 @@ func (c *Client) GroupList(ctx context.Context) ([]Group, error) {
 
 	// Run command
 	if err := run(ctx, c, cmd, &g); err != nil {
-		return g, winerror.Errorf(cmd, "windows.local.accounts.GroupList: %s", err)
+		return g, winerror.Errorf(cmd, "windows.local.accounts.GroupList: %w", err)
 	}
 	return g, nil
 }
@@ -120,7 +120,7 @@ func (c *Client) GroupCreate(ctx context.Context, params GroupCreateParams) (Gro
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &g); err != nil {
-		return g, winerror.Errorf(cmd, "windows.local.accounts.GroupCreate: %s", err)
+		return g, winerror.Errorf(cmd, "windows.local.accounts.GroupCreate: %w", err)
 	}
 
 	return g, nil
@@ -173,7 +173,7 @@ func (c *Client) GroupUpdate(ctx context.Context, params GroupUpdateParams) erro
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &g); err != nil {
-		return winerror.Errorf(cmd, "windows.local.accounts.GroupUpdate: %s", err)
+		return winerror.Errorf(cmd, "windows.local.accounts.GroupUpdate: %w", err)
 	}
 
 	return nil
@@ -217,7 +217,7 @@ func (c *Client) GroupDelete(ctx context.Context, params GroupDeleteParams) erro
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &g); err != nil {
-		return winerror.Errorf(cmd, "windows.local.accounts.GroupDelete: %s", err)
+		return winerror.Errorf(cmd, "windows.local.accounts.GroupDelete: %w", err)
 	}
 
 	return nil
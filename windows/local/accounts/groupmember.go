@@ -63,7 +63,7 @@ func (c *Client) GroupMemberRead(ctx context.Context, params GroupMemberReadPara
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &gm); err != nil {
-		return gm, winerror.Errorf(cmd, "windows.local.accounts.GroupMemberRead: %s", err)
+		return gm, winerror.Errorf(cmd, "windows.local.accounts.GroupMemberRead: %w", err)
 	}
 
 	return gm, nil
@@ -109,7 +109,7 @@ func (c *Client) GroupMemberList(ctx context.Context, params GroupMemberListPara
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &gm); err != nil {
-		return gm, winerror.Errorf(cmd, "windows.local.accounts.GroupMemberList: %s", err)
+		return gm, winerror.Errorf(cmd, "windows.local.accounts.GroupMemberList: %w", err)
 	}
 
 	return gm, nil
@@ -161,7 +161,7 @@ func (c *Client) GroupMemberCreate(ctx context.Context, params GroupMemberCreate
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &gm); err != nil {
-		return winerror.Errorf(cmd, "windows.local.accounts.GroupMemberCreate: %s", err)
+		return winerror.Errorf(cmd, "windows.local.accounts.GroupMemberCreate: %w", err)
 	}
 
 	return nil
@@ -213,7 +213,7 @@ func (c *Client) GroupMemberDelete(ctx context.Context, params GroupMemberDelete
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &gm); err != nil {
-		return winerror.Errorf(cmd, "windows.local.accounts.GroupMemberDelete: %s", err)
+		return winerror.Errorf(cmd, "windows.local.accounts.GroupMemberDelete: %w", err)
 	}
 
 	return nil
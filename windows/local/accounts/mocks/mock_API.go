@@ -0,0 +1,780 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package accounts
+
+import (
+	context "context"
+
+	accounts "github.com/d-strobel/gowindows/windows/local/accounts"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAPI is an autogenerated mock type for the API type
+type MockAPI struct {
+	mock.Mock
+}
+
+type MockAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPI) EXPECT() *MockAPI_Expecter {
+	return &MockAPI_Expecter{mock: &_m.Mock}
+}
+
+// GroupCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupCreate(ctx context.Context, params accounts.GroupCreateParams) (accounts.Group, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupCreate")
+	}
+
+	var r0 accounts.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupCreateParams) (accounts.Group, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupCreateParams) accounts.Group); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(accounts.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accounts.GroupCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GroupCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupCreate'
+type MockAPI_GroupCreate_Call struct {
+	*mock.Call
+}
+
+// GroupCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupCreateParams
+func (_e *MockAPI_Expecter) GroupCreate(ctx interface{}, params interface{}) *MockAPI_GroupCreate_Call {
+	return &MockAPI_GroupCreate_Call{Call: _e.mock.On("GroupCreate", ctx, params)}
+}
+
+func (_c *MockAPI_GroupCreate_Call) Run(run func(ctx context.Context, params accounts.GroupCreateParams)) *MockAPI_GroupCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupCreate_Call) Return(_a0 accounts.Group, _a1 error) *MockAPI_GroupCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GroupCreate_Call) RunAndReturn(run func(context.Context, accounts.GroupCreateParams) (accounts.Group, error)) *MockAPI_GroupCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupDelete(ctx context.Context, params accounts.GroupDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_GroupDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupDelete'
+type MockAPI_GroupDelete_Call struct {
+	*mock.Call
+}
+
+// GroupDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupDeleteParams
+func (_e *MockAPI_Expecter) GroupDelete(ctx interface{}, params interface{}) *MockAPI_GroupDelete_Call {
+	return &MockAPI_GroupDelete_Call{Call: _e.mock.On("GroupDelete", ctx, params)}
+}
+
+func (_c *MockAPI_GroupDelete_Call) Run(run func(ctx context.Context, params accounts.GroupDeleteParams)) *MockAPI_GroupDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupDelete_Call) Return(_a0 error) *MockAPI_GroupDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_GroupDelete_Call) RunAndReturn(run func(context.Context, accounts.GroupDeleteParams) error) *MockAPI_GroupDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupList provides a mock function with given fields: ctx
+func (_m *MockAPI) GroupList(ctx context.Context) ([]accounts.Group, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupList")
+	}
+
+	var r0 []accounts.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]accounts.Group, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []accounts.Group); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounts.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GroupList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupList'
+type MockAPI_GroupList_Call struct {
+	*mock.Call
+}
+
+// GroupList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) GroupList(ctx interface{}) *MockAPI_GroupList_Call {
+	return &MockAPI_GroupList_Call{Call: _e.mock.On("GroupList", ctx)}
+}
+
+func (_c *MockAPI_GroupList_Call) Run(run func(ctx context.Context)) *MockAPI_GroupList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupList_Call) Return(_a0 []accounts.Group, _a1 error) *MockAPI_GroupList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GroupList_Call) RunAndReturn(run func(context.Context) ([]accounts.Group, error)) *MockAPI_GroupList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupMemberCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupMemberCreate(ctx context.Context, params accounts.GroupMemberCreateParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupMemberCreate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupMemberCreateParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_GroupMemberCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupMemberCreate'
+type MockAPI_GroupMemberCreate_Call struct {
+	*mock.Call
+}
+
+// GroupMemberCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupMemberCreateParams
+func (_e *MockAPI_Expecter) GroupMemberCreate(ctx interface{}, params interface{}) *MockAPI_GroupMemberCreate_Call {
+	return &MockAPI_GroupMemberCreate_Call{Call: _e.mock.On("GroupMemberCreate", ctx, params)}
+}
+
+func (_c *MockAPI_GroupMemberCreate_Call) Run(run func(ctx context.Context, params accounts.GroupMemberCreateParams)) *MockAPI_GroupMemberCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupMemberCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberCreate_Call) Return(_a0 error) *MockAPI_GroupMemberCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberCreate_Call) RunAndReturn(run func(context.Context, accounts.GroupMemberCreateParams) error) *MockAPI_GroupMemberCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupMemberDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupMemberDelete(ctx context.Context, params accounts.GroupMemberDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupMemberDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupMemberDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_GroupMemberDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupMemberDelete'
+type MockAPI_GroupMemberDelete_Call struct {
+	*mock.Call
+}
+
+// GroupMemberDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupMemberDeleteParams
+func (_e *MockAPI_Expecter) GroupMemberDelete(ctx interface{}, params interface{}) *MockAPI_GroupMemberDelete_Call {
+	return &MockAPI_GroupMemberDelete_Call{Call: _e.mock.On("GroupMemberDelete", ctx, params)}
+}
+
+func (_c *MockAPI_GroupMemberDelete_Call) Run(run func(ctx context.Context, params accounts.GroupMemberDeleteParams)) *MockAPI_GroupMemberDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupMemberDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberDelete_Call) Return(_a0 error) *MockAPI_GroupMemberDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberDelete_Call) RunAndReturn(run func(context.Context, accounts.GroupMemberDeleteParams) error) *MockAPI_GroupMemberDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupMemberList provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupMemberList(ctx context.Context, params accounts.GroupMemberListParams) ([]accounts.GroupMember, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupMemberList")
+	}
+
+	var r0 []accounts.GroupMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupMemberListParams) ([]accounts.GroupMember, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupMemberListParams) []accounts.GroupMember); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounts.GroupMember)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accounts.GroupMemberListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GroupMemberList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupMemberList'
+type MockAPI_GroupMemberList_Call struct {
+	*mock.Call
+}
+
+// GroupMemberList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupMemberListParams
+func (_e *MockAPI_Expecter) GroupMemberList(ctx interface{}, params interface{}) *MockAPI_GroupMemberList_Call {
+	return &MockAPI_GroupMemberList_Call{Call: _e.mock.On("GroupMemberList", ctx, params)}
+}
+
+func (_c *MockAPI_GroupMemberList_Call) Run(run func(ctx context.Context, params accounts.GroupMemberListParams)) *MockAPI_GroupMemberList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupMemberListParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberList_Call) Return(_a0 []accounts.GroupMember, _a1 error) *MockAPI_GroupMemberList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberList_Call) RunAndReturn(run func(context.Context, accounts.GroupMemberListParams) ([]accounts.GroupMember, error)) *MockAPI_GroupMemberList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupMemberRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupMemberRead(ctx context.Context, params accounts.GroupMemberReadParams) (accounts.GroupMember, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupMemberRead")
+	}
+
+	var r0 accounts.GroupMember
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupMemberReadParams) (accounts.GroupMember, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupMemberReadParams) accounts.GroupMember); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(accounts.GroupMember)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accounts.GroupMemberReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GroupMemberRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupMemberRead'
+type MockAPI_GroupMemberRead_Call struct {
+	*mock.Call
+}
+
+// GroupMemberRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupMemberReadParams
+func (_e *MockAPI_Expecter) GroupMemberRead(ctx interface{}, params interface{}) *MockAPI_GroupMemberRead_Call {
+	return &MockAPI_GroupMemberRead_Call{Call: _e.mock.On("GroupMemberRead", ctx, params)}
+}
+
+func (_c *MockAPI_GroupMemberRead_Call) Run(run func(ctx context.Context, params accounts.GroupMemberReadParams)) *MockAPI_GroupMemberRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupMemberReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberRead_Call) Return(_a0 accounts.GroupMember, _a1 error) *MockAPI_GroupMemberRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GroupMemberRead_Call) RunAndReturn(run func(context.Context, accounts.GroupMemberReadParams) (accounts.GroupMember, error)) *MockAPI_GroupMemberRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupRead(ctx context.Context, params accounts.GroupReadParams) (accounts.Group, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupRead")
+	}
+
+	var r0 accounts.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupReadParams) (accounts.Group, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupReadParams) accounts.Group); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(accounts.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accounts.GroupReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_GroupRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupRead'
+type MockAPI_GroupRead_Call struct {
+	*mock.Call
+}
+
+// GroupRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupReadParams
+func (_e *MockAPI_Expecter) GroupRead(ctx interface{}, params interface{}) *MockAPI_GroupRead_Call {
+	return &MockAPI_GroupRead_Call{Call: _e.mock.On("GroupRead", ctx, params)}
+}
+
+func (_c *MockAPI_GroupRead_Call) Run(run func(ctx context.Context, params accounts.GroupReadParams)) *MockAPI_GroupRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupRead_Call) Return(_a0 accounts.Group, _a1 error) *MockAPI_GroupRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_GroupRead_Call) RunAndReturn(run func(context.Context, accounts.GroupReadParams) (accounts.Group, error)) *MockAPI_GroupRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GroupUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) GroupUpdate(ctx context.Context, params accounts.GroupUpdateParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.GroupUpdateParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_GroupUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GroupUpdate'
+type MockAPI_GroupUpdate_Call struct {
+	*mock.Call
+}
+
+// GroupUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.GroupUpdateParams
+func (_e *MockAPI_Expecter) GroupUpdate(ctx interface{}, params interface{}) *MockAPI_GroupUpdate_Call {
+	return &MockAPI_GroupUpdate_Call{Call: _e.mock.On("GroupUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_GroupUpdate_Call) Run(run func(ctx context.Context, params accounts.GroupUpdateParams)) *MockAPI_GroupUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.GroupUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_GroupUpdate_Call) Return(_a0 error) *MockAPI_GroupUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_GroupUpdate_Call) RunAndReturn(run func(context.Context, accounts.GroupUpdateParams) error) *MockAPI_GroupUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserCreate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) UserCreate(ctx context.Context, params accounts.UserCreateParams) (accounts.User, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserCreate")
+	}
+
+	var r0 accounts.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.UserCreateParams) (accounts.User, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.UserCreateParams) accounts.User); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(accounts.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accounts.UserCreateParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_UserCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserCreate'
+type MockAPI_UserCreate_Call struct {
+	*mock.Call
+}
+
+// UserCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.UserCreateParams
+func (_e *MockAPI_Expecter) UserCreate(ctx interface{}, params interface{}) *MockAPI_UserCreate_Call {
+	return &MockAPI_UserCreate_Call{Call: _e.mock.On("UserCreate", ctx, params)}
+}
+
+func (_c *MockAPI_UserCreate_Call) Run(run func(ctx context.Context, params accounts.UserCreateParams)) *MockAPI_UserCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.UserCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UserCreate_Call) Return(_a0 accounts.User, _a1 error) *MockAPI_UserCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_UserCreate_Call) RunAndReturn(run func(context.Context, accounts.UserCreateParams) (accounts.User, error)) *MockAPI_UserCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserDelete provides a mock function with given fields: ctx, params
+func (_m *MockAPI) UserDelete(ctx context.Context, params accounts.UserDeleteParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.UserDeleteParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UserDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserDelete'
+type MockAPI_UserDelete_Call struct {
+	*mock.Call
+}
+
+// UserDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.UserDeleteParams
+func (_e *MockAPI_Expecter) UserDelete(ctx interface{}, params interface{}) *MockAPI_UserDelete_Call {
+	return &MockAPI_UserDelete_Call{Call: _e.mock.On("UserDelete", ctx, params)}
+}
+
+func (_c *MockAPI_UserDelete_Call) Run(run func(ctx context.Context, params accounts.UserDeleteParams)) *MockAPI_UserDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.UserDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UserDelete_Call) Return(_a0 error) *MockAPI_UserDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UserDelete_Call) RunAndReturn(run func(context.Context, accounts.UserDeleteParams) error) *MockAPI_UserDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserList provides a mock function with given fields: ctx
+func (_m *MockAPI) UserList(ctx context.Context) ([]accounts.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserList")
+	}
+
+	var r0 []accounts.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]accounts.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []accounts.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounts.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_UserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserList'
+type MockAPI_UserList_Call struct {
+	*mock.Call
+}
+
+// UserList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPI_Expecter) UserList(ctx interface{}) *MockAPI_UserList_Call {
+	return &MockAPI_UserList_Call{Call: _e.mock.On("UserList", ctx)}
+}
+
+func (_c *MockAPI_UserList_Call) Run(run func(ctx context.Context)) *MockAPI_UserList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UserList_Call) Return(_a0 []accounts.User, _a1 error) *MockAPI_UserList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_UserList_Call) RunAndReturn(run func(context.Context) ([]accounts.User, error)) *MockAPI_UserList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserRead provides a mock function with given fields: ctx, params
+func (_m *MockAPI) UserRead(ctx context.Context, params accounts.UserReadParams) (accounts.User, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserRead")
+	}
+
+	var r0 accounts.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.UserReadParams) (accounts.User, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.UserReadParams) accounts.User); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(accounts.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, accounts.UserReadParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPI_UserRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserRead'
+type MockAPI_UserRead_Call struct {
+	*mock.Call
+}
+
+// UserRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.UserReadParams
+func (_e *MockAPI_Expecter) UserRead(ctx interface{}, params interface{}) *MockAPI_UserRead_Call {
+	return &MockAPI_UserRead_Call{Call: _e.mock.On("UserRead", ctx, params)}
+}
+
+func (_c *MockAPI_UserRead_Call) Run(run func(ctx context.Context, params accounts.UserReadParams)) *MockAPI_UserRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.UserReadParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UserRead_Call) Return(_a0 accounts.User, _a1 error) *MockAPI_UserRead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPI_UserRead_Call) RunAndReturn(run func(context.Context, accounts.UserReadParams) (accounts.User, error)) *MockAPI_UserRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserUpdate provides a mock function with given fields: ctx, params
+func (_m *MockAPI) UserUpdate(ctx context.Context, params accounts.UserUpdateParams) error {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, accounts.UserUpdateParams) error); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPI_UserUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserUpdate'
+type MockAPI_UserUpdate_Call struct {
+	*mock.Call
+}
+
+// UserUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params accounts.UserUpdateParams
+func (_e *MockAPI_Expecter) UserUpdate(ctx interface{}, params interface{}) *MockAPI_UserUpdate_Call {
+	return &MockAPI_UserUpdate_Call{Call: _e.mock.On("UserUpdate", ctx, params)}
+}
+
+func (_c *MockAPI_UserUpdate_Call) Run(run func(ctx context.Context, params accounts.UserUpdateParams)) *MockAPI_UserUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(accounts.UserUpdateParams))
+	})
+	return _c
+}
+
+func (_c *MockAPI_UserUpdate_Call) Return(_a0 error) *MockAPI_UserUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPI_UserUpdate_Call) RunAndReturn(run func(context.Context, accounts.UserUpdateParams) error) *MockAPI_UserUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPI creates a new instance of MockAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPI {
+	mock := &MockAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -71,7 +71,7 @@ func (c *Client) UserRead(ctx context.Context, params UserReadParams) (User, err
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &u); err != nil {
-		return u, winerror.Errorf(cmd, "windows.local.accounts.UserRead: %s", err)
+		return u, winerror.Errorf(cmd, "windows.local.accounts.UserRead: %w", err)
 	}
 
 	return u, nil
@@ -87,7 +87,7 @@ func (c *Client) UserList(ctx context.Context) ([]User, error) {
 
 	// Run command
 	if err := run(ctx, c, cmd, &u); err != nil {
-		return u, winerror.Errorf(cmd, "windows.local.accounts.UserList: %s", err)
+		return u, winerror.Errorf(cmd, "windows.local.accounts.UserList: %w", err)
 	}
 
 	return u, nil
@@ -182,7 +182,7 @@ func (c *Client) UserCreate(ctx context.Context, params UserCreateParams) (User,
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &u); err != nil {
-		return u, winerror.Errorf(cmd, "windows.local.accounts.UserCreate: %s", err)
+		return u, winerror.Errorf(cmd, "windows.local.accounts.UserCreate: %w", err)
 	}
 
 	return u, nil
@@ -279,7 +279,7 @@ func (c *Client) UserUpdate(ctx context.Context, params UserUpdateParams) error
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &u); err != nil {
-		return winerror.Errorf(cmd, "windows.local.accounts.UserUpdate: %s", err)
+		return winerror.Errorf(cmd, "windows.local.accounts.UserUpdate: %w", err)
 	}
 
 	return nil
@@ -323,7 +323,7 @@ func (c *Client) UserDelete(ctx context.Context, params UserDeleteParams) error
 	// Run command
 	cmd := params.pwshCommand()
 	if err := run(ctx, c, cmd, &u); err != nil {
-		return winerror.Errorf(cmd, "windows.local.accounts.UserDelete: %s", err)
+		return winerror.Errorf(cmd, "windows.local.accounts.UserDelete: %w", err)
 	}
 
 	return nil
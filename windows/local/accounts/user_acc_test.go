@@ -9,6 +9,15 @@ import (
 	"github.com/d-strobel/gowindows/windows/local/accounts"
 )
 
+// truncateUserTimes truncates the timestamps of a user to seconds.
+// The sub-second part of the timestamps depends on the host, so the acceptance tests compare them at second precision.
+func truncateUserTimes(u accounts.User) accounts.User {
+	for _, t := range []*parsing.DotnetTime{&u.AccountExpires, &u.PasswordChangeableDate, &u.PasswordExpires, &u.PasswordLastSet, &u.LastLogon} {
+		t.Time = t.Time.Truncate(time.Second)
+	}
+	return u
+}
+
 // We insert numbers into the function names to ensure that
 // the test functions for each local_* file run in a specific order.
 func (suite *LocalAccTestSuite) TestUser1Read() {
@@ -36,7 +45,7 @@ func (suite *LocalAccTestSuite) TestUser1Read() {
 			SID: accounts.SID{
 				Value: "S-1-5-21-153895498-367353507-3704405138-500",
 			},
-		}, u)
+		}, truncateUserTimes(u))
 	}
 }
 
@@ -47,6 +56,9 @@ func (suite *LocalAccTestSuite) TestUser2List() {
 	for _, c := range suite.clients {
 		u, err := c.UserList(ctx)
 		suite.Require().NoError(err)
+		for i := range u {
+			u[i] = truncateUserTimes(u[i])
+		}
 		suite.Contains(u, accounts.User{
 			AccountExpires:         parsing.DotnetTime{},
 			Description:            "Built-in account for administering the computer/domain",
@@ -2,7 +2,25 @@
 package winerror
 
 import (
+	"errors"
 	"fmt"
+
+	"github.com/d-strobel/gowindows/parsing"
+)
+
+// Culture-neutral PowerShell error categories that can be compared with the result of the Category function.
+// https://learn.microsoft.com/en-us/dotnet/api/system.management.automation.errorcategory
+const (
+	CategoryNotSpecified     string = "NotSpecified"
+	CategoryInvalidArgument  string = "InvalidArgument"
+	CategoryInvalidOperation string = "InvalidOperation"
+	CategoryInvalidData      string = "InvalidData"
+	CategoryObjectNotFound   string = "ObjectNotFound"
+	CategoryPermissionDenied string = "PermissionDenied"
+	CategoryResourceExists   string = "ResourceExists"
+	CategoryResourceBusy     string = "ResourceBusy"
+	CategoryConnectionError  string = "ConnectionError"
+	CategoryNotInstalled     string = "NotInstalled"
 )
 
 // WinError represents a custom error type for Windows client errors.
@@ -42,3 +60,23 @@ func UnwrapCommand(err error) string {
 	}
 	return ""
 }
+
+// Category returns the culture-neutral PowerShell error category, e.g. "ResourceExists",
+// if the error was caused by a PowerShell command. Otherwise, it returns an empty string.
+func Category(err error) string {
+	var pwshErr *parsing.PwshError
+	if errors.As(err, &pwshErr) {
+		return pwshErr.Category
+	}
+	return ""
+}
+
+// FullyQualifiedErrorId returns the culture-neutral PowerShell error ID, e.g. "WIN32 9711,Add-DnsServerResourceRecordA",
+// if the error was caused by a PowerShell command. Otherwise, it returns an empty string.
+func FullyQualifiedErrorId(err error) string {
+	var pwshErr *parsing.PwshError
+	if errors.As(err, &pwshErr) {
+		return pwshErr.FullyQualifiedErrorId
+	}
+	return ""
+}
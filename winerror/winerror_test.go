@@ -2,6 +2,7 @@ package winerror
 
 import (
 	"errors"
+	"github.com/d-strobel/gowindows/parsing"
 	"github.com/stretchr/testify/suite"
 	"testing"
 )
@@ -98,3 +99,20 @@ func (suite *WinErrorUnitTestSuite) TestUnwrapCommand() {
 		suite.Equal(UnwrapCommand(err), "")
 	})
 }
+
+func (suite *WinErrorUnitTestSuite) TestCategory() {
+	suite.T().Parallel()
+
+	suite.Run("should return the category of a wrapped PowerShell error", func() {
+		pwshErr := &parsing.PwshError{Message: "Fehler", Category: "ResourceExists", FullyQualifiedErrorId: "WIN32 9711,Add-DnsServerResourceRecordA"}
+		err := Errorf("test-command", "error-message: %w", pwshErr)
+		suite.Equal(CategoryResourceExists, Category(err))
+		suite.Equal("WIN32 9711,Add-DnsServerResourceRecordA", FullyQualifiedErrorId(err))
+	})
+
+	suite.Run("should return empty strings when error is not a PowerShell error", func() {
+		err := Errorf("test-command", "error-message: %s", errors.New("ResourceExists"))
+		suite.Equal("", Category(err))
+		suite.Equal("", FullyQualifiedErrorId(err))
+	})
+}